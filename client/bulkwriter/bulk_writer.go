@@ -0,0 +1,484 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/client/v2/column"
+	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/client/v2/row"
+)
+
+const (
+	// DefaultChunkSize is the default file size threshold, in bytes, to roll a new file.
+	DefaultChunkSize int64 = 128 * 1024 * 1024
+
+	dynamicFieldName = "$meta"
+)
+
+// BulkFileType is the file format generated by bulk writer.
+type BulkFileType int
+
+const (
+	// BulkFileTypeJSON writes rows as one json array, `[{...}, {...}]`.
+	BulkFileTypeJSON BulkFileType = iota + 1
+	// BulkFileTypeCSV writes rows as csv with a header line of field names.
+	BulkFileTypeCSV
+	// BulkFileTypeParquet writes rows as parquet, which is the most efficient format for bulk import.
+	BulkFileTypeParquet
+)
+
+func (t BulkFileType) extension() string {
+	switch t {
+	case BulkFileTypeJSON:
+		return ".json"
+	case BulkFileTypeCSV:
+		return ".csv"
+	case BulkFileTypeParquet:
+		return ".parquet"
+	default:
+		return ""
+	}
+}
+
+// LocalBulkWriterOption is the option for LocalBulkWriter.
+type LocalBulkWriterOption struct {
+	Schema    *entity.Schema
+	LocalPath string
+	ChunkSize int64
+	FileType  BulkFileType
+
+	// csv only params, shall be the same as the `sep` and `nullkey` import options.
+	Separator rune
+	NullKey   string
+}
+
+func (opt *LocalBulkWriterOption) WithChunkSize(chunkSize int64) *LocalBulkWriterOption {
+	opt.ChunkSize = chunkSize
+	return opt
+}
+
+func (opt *LocalBulkWriterOption) WithFileType(fileType BulkFileType) *LocalBulkWriterOption {
+	opt.FileType = fileType
+	return opt
+}
+
+func (opt *LocalBulkWriterOption) WithSeparator(sep rune) *LocalBulkWriterOption {
+	opt.Separator = sep
+	return opt
+}
+
+func (opt *LocalBulkWriterOption) WithNullKey(nullKey string) *LocalBulkWriterOption {
+	opt.NullKey = nullKey
+	return opt
+}
+
+func (opt *LocalBulkWriterOption) validate() error {
+	if opt.Schema == nil {
+		return errors.New("bulk writer schema not provided")
+	}
+	if opt.Schema.PKField() == nil {
+		return errors.New("bulk writer schema has no primary key field")
+	}
+	if opt.LocalPath == "" {
+		return errors.New("bulk writer local path not provided")
+	}
+	if opt.ChunkSize <= 0 {
+		return errors.Newf("invalid chunk size %d", opt.ChunkSize)
+	}
+	switch opt.FileType {
+	case BulkFileTypeJSON, BulkFileTypeCSV, BulkFileTypeParquet:
+	default:
+		return errors.Newf("unsupported bulk file type %d", opt.FileType)
+	}
+	if opt.FileType == BulkFileTypeCSV {
+		if lo.Contains([]rune{0, '\n', '\r', '"', 0xFFFD}, opt.Separator) {
+			return errors.Newf("unsupported csv separator %q", opt.Separator)
+		}
+	}
+	return nil
+}
+
+// NewLocalBulkWriterOption returns LocalBulkWriterOption with default json format and chunk size.
+func NewLocalBulkWriterOption(schema *entity.Schema, localPath string) *LocalBulkWriterOption {
+	return &LocalBulkWriterOption{
+		Schema:    schema,
+		LocalPath: localPath,
+		ChunkSize: DefaultChunkSize,
+		FileType:  BulkFileTypeJSON,
+		Separator: ',',
+	}
+}
+
+// LocalBulkWriter writes rows into local files which could be imported by milvus bulk import directly.
+//
+// Files are written under `{LocalPath}/{uuid}/`, a new file is rolled once
+// the current file size exceeds the chunk size.
+type LocalBulkWriter struct {
+	mut sync.Mutex
+
+	option *LocalBulkWriterOption
+	schema *entity.Schema
+	// fields shall be provided in import files, in schema order
+	fields  []*entity.Field
+	dynamic bool
+	header  []string
+
+	uuid        string
+	fileCount   int
+	writer      fileWriter
+	currentFile string
+	// closed files not committed yet
+	pendingFiles []string
+	batchFiles   [][]string
+	rowCount     int64
+}
+
+// NewLocalBulkWriter creates a LocalBulkWriter with provided option.
+func NewLocalBulkWriter(option *LocalBulkWriterOption) (*LocalBulkWriter, error) {
+	if err := option.validate(); err != nil {
+		return nil, err
+	}
+
+	functionOutputs := make(map[string]struct{})
+	for _, fn := range option.Schema.Functions {
+		for _, name := range fn.OutputFieldNames {
+			functionOutputs[name] = struct{}{}
+		}
+	}
+
+	// auto id primary key, function output and dynamic field shall not be provided in import files
+	fields := lo.Filter(option.Schema.Fields, func(field *entity.Field, _ int) bool {
+		_, isOutput := functionOutputs[field.Name]
+		return !(field.PrimaryKey && field.AutoID) && !isOutput && !field.IsDynamic
+	})
+	header := lo.Map(fields, func(field *entity.Field, _ int) string { return field.Name })
+	if option.Schema.EnableDynamicField {
+		header = append(header, dynamicFieldName)
+	}
+
+	w := &LocalBulkWriter{
+		option:  option,
+		schema:  option.Schema,
+		fields:  fields,
+		dynamic: option.Schema.EnableDynamicField,
+		header:  header,
+		uuid:    uuid.NewString(),
+	}
+	if err := os.MkdirAll(w.dataPath(), 0o755); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *LocalBulkWriter) dataPath() string {
+	return path.Join(w.option.LocalPath, w.uuid)
+}
+
+// UUID returns the unique id of this writer, which is used as sub directory of data files.
+func (w *LocalBulkWriter) UUID() string {
+	return w.uuid
+}
+
+// AppendRow appends one row into writer,
+// the row could be a struct or `map[string]any` which is accepted by `row.AnyToColumns`.
+func (w *LocalBulkWriter) AppendRow(r any) error {
+	columns, err := row.AnyToColumns([]any{r}, w.schema)
+	if err != nil {
+		return err
+	}
+	return w.AppendColumns(columns...)
+}
+
+// AppendColumns appends column-based data into writer.
+// All columns are validated against the writer schema before any row is written.
+func (w *LocalBulkWriter) AppendColumns(columns ...column.Column) error {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+
+	fieldColumns, dynamicColumn, rowNum, err := w.validateColumns(columns)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < rowNum; i++ {
+		record, err := w.buildRecord(fieldColumns, dynamicColumn, i)
+		if err != nil {
+			return err
+		}
+		if err := w.writeRecord(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *LocalBulkWriter) validateColumns(columns []column.Column) (map[string]column.Column, *column.ColumnJSONBytes, int, error) {
+	if len(columns) == 0 {
+		return nil, nil, 0, errors.New("no column provided")
+	}
+
+	rowNum := columns[0].Len()
+	nameColumns := make(map[string]column.Column)
+	var dynamicColumn *column.ColumnJSONBytes
+	for _, col := range columns {
+		if col.Len() != rowNum {
+			return nil, nil, 0, errors.Newf("column %s row number %d not equal to %d", col.Name(), col.Len(), rowNum)
+		}
+		if jsonCol, ok := col.(*column.ColumnJSONBytes); ok && w.dynamic && (col.Name() == "" || col.Name() == dynamicFieldName) {
+			dynamicColumn = jsonCol
+			continue
+		}
+		nameColumns[col.Name()] = col
+	}
+
+	fieldColumns := make(map[string]column.Column)
+	for _, field := range w.fields {
+		col, ok := nameColumns[field.Name]
+		if !ok {
+			if field.Nullable || field.DefaultValue != nil {
+				continue
+			}
+			return nil, nil, 0, errors.Newf("column of field %s not provided", field.Name)
+		}
+		if err := validateColumn(field, col); err != nil {
+			return nil, nil, 0, err
+		}
+		fieldColumns[field.Name] = col
+		delete(nameColumns, field.Name)
+	}
+
+	if len(nameColumns) > 0 {
+		return nil, nil, 0, errors.Newf("columns %v not in schema or shall not be provided", lo.Keys(nameColumns))
+	}
+	return fieldColumns, dynamicColumn, rowNum, nil
+}
+
+func validateColumn(field *entity.Field, col column.Column) error {
+	if col.Type() != field.DataType {
+		return errors.Newf("column %s type %s not match field type %s", col.Name(), col.Type().Name(), field.DataType.Name())
+	}
+	if col.Nullable() && !field.Nullable {
+		return errors.Newf("column %s is nullable but field is not", col.Name())
+	}
+
+	switch field.DataType {
+	case entity.FieldTypeArray:
+		arrCol, ok := col.(interface{ ElementType() entity.FieldType })
+		if ok && arrCol.ElementType() != field.ElementType {
+			return errors.Newf("column %s element type %s not match field element type %s", col.Name(), arrCol.ElementType().Name(), field.ElementType.Name())
+		}
+	case entity.FieldTypeFloatVector, entity.FieldTypeBinaryVector, entity.FieldTypeFloat16Vector,
+		entity.FieldTypeBFloat16Vector, entity.FieldTypeInt8Vector:
+		dim, err := field.GetDim()
+		if err != nil {
+			return err
+		}
+		vecCol, ok := col.(interface{ Dim() int })
+		if ok && int64(vecCol.Dim()) != dim {
+			return errors.Newf("column %s dim %d not match field dim %d", col.Name(), vecCol.Dim(), dim)
+		}
+	}
+	return nil
+}
+
+// buildRecord converts the idx-th row into the value layout accepted by import row parsers.
+func (w *LocalBulkWriter) buildRecord(fieldColumns map[string]column.Column, dynamicColumn *column.ColumnJSONBytes, idx int) (map[string]any, error) {
+	record := make(map[string]any, len(w.header))
+	for _, field := range w.fields {
+		col, ok := fieldColumns[field.Name]
+		if !ok {
+			// nullable or default value field not provided
+			record[field.Name] = nil
+			continue
+		}
+		value, err := w.fieldValue(field, col, idx)
+		if err != nil {
+			return nil, err
+		}
+		record[field.Name] = value
+	}
+
+	if w.dynamic {
+		dynamicValue := []byte("{}")
+		if dynamicColumn != nil {
+			bs, err := dynamicColumn.Value(idx)
+			if err != nil {
+				return nil, err
+			}
+			if len(bs) > 0 {
+				dynamicValue = bs
+			}
+		}
+		if !json.Valid(dynamicValue) {
+			return nil, errors.Newf("dynamic field value of row %d is not valid json", idx)
+		}
+		record[dynamicFieldName] = json.RawMessage(dynamicValue)
+	}
+	return record, nil
+}
+
+func (w *LocalBulkWriter) fieldValue(field *entity.Field, col column.Column, idx int) (any, error) {
+	if w.option.FileType == BulkFileTypeParquet {
+		return parquetValue(field, col, idx)
+	}
+	return importValue(field, col, idx)
+}
+
+func importValue(field *entity.Field, col column.Column, idx int) (any, error) {
+	if col.Nullable() {
+		isNull, err := col.IsNull(idx)
+		if err != nil {
+			return nil, err
+		}
+		if isNull {
+			return nil, nil
+		}
+	}
+
+	value, err := col.Get(idx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch field.DataType {
+	case entity.FieldTypeBinaryVector:
+		// byte slice shall be written as number array instead of base64 string
+		return lo.Map(value.(entity.BinaryVector), func(b byte, _ int) int { return int(b) }), nil
+	case entity.FieldTypeFloat16Vector:
+		return value.(entity.Float16Vector).ToFloat32Vector(), nil
+	case entity.FieldTypeBFloat16Vector:
+		return value.(entity.BFloat16Vector).ToFloat32Vector(), nil
+	case entity.FieldTypeSparseVector:
+		embedding := value.(entity.SparseEmbedding)
+		indices := make([]uint32, 0, embedding.Len())
+		values := make([]float32, 0, embedding.Len())
+		for i := 0; i < embedding.Len(); i++ {
+			pos, val, _ := embedding.Get(i)
+			indices = append(indices, pos)
+			values = append(values, val)
+		}
+		return map[string]any{"indices": indices, "values": values}, nil
+	case entity.FieldTypeJSON:
+		bs := value.([]byte)
+		if !json.Valid(bs) {
+			return nil, errors.Newf("value of json field %s is not valid json", field.Name)
+		}
+		return json.RawMessage(bs), nil
+	default:
+		return value, nil
+	}
+}
+
+func (w *LocalBulkWriter) writeRecord(record map[string]any) error {
+	if w.writer == nil {
+		if err := w.newFile(); err != nil {
+			return err
+		}
+	}
+
+	if err := w.writer.WriteRecord(record); err != nil {
+		return err
+	}
+	w.rowCount++
+
+	if w.writer.Size() >= w.option.ChunkSize {
+		return w.closeFile()
+	}
+	return nil
+}
+
+func (w *LocalBulkWriter) newFile() error {
+	w.fileCount++
+	filePath := path.Join(w.dataPath(), fmt.Sprintf("%d%s", w.fileCount, w.option.FileType.extension()))
+	writer, err := newFileWriter(filePath, w.option, w.fields, w.header)
+	if err != nil {
+		return err
+	}
+	w.writer = writer
+	w.currentFile = filePath
+	return nil
+}
+
+func (w *LocalBulkWriter) closeFile() error {
+	if w.writer == nil {
+		return nil
+	}
+	err := w.writer.Close()
+	w.writer = nil
+	if err != nil {
+		return err
+	}
+	w.pendingFiles = append(w.pendingFiles, w.currentFile)
+	w.currentFile = ""
+	return nil
+}
+
+// Commit closes the file in writing and records all data files into batch files.
+func (w *LocalBulkWriter) Commit(ctx context.Context) error {
+	return w.commit(ctx, func(_ context.Context, localFile string) (string, error) {
+		return localFile, nil
+	})
+}
+
+// commit closes current file and persists all pending files with provided function,
+// the returned path of persist function is recorded as batch file.
+func (w *LocalBulkWriter) commit(ctx context.Context, persist func(ctx context.Context, localFile string) (string, error)) error {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+
+	if err := w.closeFile(); err != nil {
+		return err
+	}
+
+	for len(w.pendingFiles) > 0 {
+		file, err := persist(ctx, w.pendingFiles[0])
+		if err != nil {
+			return err
+		}
+		// each data file is one import task
+		w.batchFiles = append(w.batchFiles, []string{file})
+		w.pendingFiles = w.pendingFiles[1:]
+	}
+	return nil
+}
+
+// BatchFiles returns committed files, which could be used as `Files` of BulkImportOption.
+func (w *LocalBulkWriter) BatchFiles() [][]string {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	return lo.Map(w.batchFiles, func(files []string, _ int) []string {
+		return append([]string{}, files...)
+	})
+}
+
+// RowCount returns the number of rows appended.
+func (w *LocalBulkWriter) RowCount() int64 {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	return w.rowCount
+}
@@ -0,0 +1,294 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/apache/arrow/go/v17/parquet/file"
+	"github.com/apache/arrow/go/v17/parquet/pqarrow"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/client/v2/column"
+	"github.com/milvus-io/milvus/client/v2/entity"
+)
+
+type BulkWriterSuite struct {
+	suite.Suite
+
+	schema *entity.Schema
+}
+
+func (s *BulkWriterSuite) SetupSuite() {
+	s.schema = entity.NewSchema().
+		WithDynamicFieldEnabled(true).
+		WithField(entity.NewField().WithName("id").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+		WithField(entity.NewField().WithName("text").WithDataType(entity.FieldTypeVarChar).WithMaxLength(64)).
+		WithField(entity.NewField().WithName("tag").WithDataType(entity.FieldTypeVarChar).WithMaxLength(64).WithNullable(true)).
+		WithField(entity.NewField().WithName("bin").WithDataType(entity.FieldTypeBinaryVector).WithDim(16)).
+		WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(2))
+}
+
+func (s *BulkWriterSuite) columns() []column.Column {
+	return []column.Column{
+		column.NewColumnInt64("id", []int64{1, 2}),
+		column.NewColumnVarChar("text", []string{"a", "b,c"}),
+		column.NewColumnBinaryVector("bin", 16, [][]byte{{1, 2}, {3, 4}}),
+		column.NewColumnFloatVector("vector", 2, [][]float32{{0.1, 0.2}, {0.3, 0.4}}),
+		column.NewColumnJSONBytes("", [][]byte{[]byte(`{"x": 1}`), []byte(`{}`)}).WithIsDynamic(true),
+	}
+}
+
+func (s *BulkWriterSuite) TestJSON() {
+	ctx := context.Background()
+	w, err := NewLocalBulkWriter(NewLocalBulkWriterOption(s.schema, s.T().TempDir()))
+	s.Require().NoError(err)
+
+	s.NoError(w.AppendColumns(s.columns()...))
+	s.NoError(w.AppendRow(map[string]any{
+		"id":     int64(3),
+		"text":   "d",
+		"bin":    []byte{5, 6},
+		"vector": []float32{0.5, 0.6},
+		"y":      "dynamic",
+	}))
+	s.NoError(w.Commit(ctx))
+	s.EqualValues(3, w.RowCount())
+
+	files := w.BatchFiles()
+	s.Require().Len(files, 1)
+	s.Require().Len(files[0], 1)
+	s.True(strings.HasSuffix(files[0][0], ".json"))
+
+	bs, err := os.ReadFile(files[0][0])
+	s.Require().NoError(err)
+	var rows []map[string]any
+	s.Require().NoError(json.Unmarshal(bs, &rows))
+	s.Require().Len(rows, 3)
+
+	s.EqualValues(1, rows[0]["id"])
+	s.Equal("b,c", rows[1]["text"])
+	s.Nil(rows[0]["tag"])
+	s.Equal([]any{float64(1), float64(2)}, rows[0]["bin"])
+	s.Equal(map[string]any{"x": float64(1)}, rows[0]["$meta"])
+	s.Equal(map[string]any{"y": "dynamic"}, rows[2]["$meta"])
+}
+
+func (s *BulkWriterSuite) TestCSV() {
+	ctx := context.Background()
+	w, err := NewLocalBulkWriter(NewLocalBulkWriterOption(s.schema, s.T().TempDir()).
+		WithFileType(BulkFileTypeCSV).
+		WithSeparator('\t').
+		WithNullKey("NULL"))
+	s.Require().NoError(err)
+
+	s.NoError(w.AppendColumns(s.columns()...))
+	s.NoError(w.Commit(ctx))
+
+	files := w.BatchFiles()
+	s.Require().Len(files, 1)
+	f, err := os.Open(files[0][0])
+	s.Require().NoError(err)
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.Comma = '\t'
+	records, err := r.ReadAll()
+	s.Require().NoError(err)
+	s.Require().Len(records, 3)
+	s.Equal([]string{"id", "text", "tag", "bin", "vector", "$meta"}, records[0])
+	s.Equal([]string{"1", "a", "NULL", "[1,2]", "[0.1,0.2]", `{"x": 1}`}, records[1])
+}
+
+func (s *BulkWriterSuite) TestParquet() {
+	ctx := context.Background()
+	schema := entity.NewSchema().
+		WithDynamicFieldEnabled(true).
+		WithField(entity.NewField().WithName("id").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true).WithIsAutoID(true)).
+		WithField(entity.NewField().WithName("text").WithDataType(entity.FieldTypeVarChar).WithMaxLength(64)).
+		WithField(entity.NewField().WithName("tag").WithDataType(entity.FieldTypeVarChar).WithMaxLength(64).WithNullable(true)).
+		WithField(entity.NewField().WithName("tags").WithDataType(entity.FieldTypeArray).WithElementType(entity.FieldTypeInt32).WithMaxCapacity(4)).
+		WithField(entity.NewField().WithName("bin").WithDataType(entity.FieldTypeBinaryVector).WithDim(16)).
+		WithField(entity.NewField().WithName("fp16").WithDataType(entity.FieldTypeFloat16Vector).WithDim(2)).
+		WithField(entity.NewField().WithName("sparse").WithDataType(entity.FieldTypeSparseVector)).
+		WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(2))
+	w, err := NewLocalBulkWriter(NewLocalBulkWriterOption(schema, s.T().TempDir()).WithFileType(BulkFileTypeParquet))
+	s.Require().NoError(err)
+
+	sparse, err := entity.NewSliceSparseEmbedding([]uint32{1, 10}, []float32{0.5, 1.5})
+	s.Require().NoError(err)
+	s.NoError(w.AppendRow(map[string]any{
+		"text":   "a",
+		"tags":   []int32{1, 2},
+		"bin":    []byte{1, 2},
+		"fp16":   []byte{1, 2, 3, 4},
+		"sparse": sparse,
+		"vector": []float32{0.1, 0.2},
+		"y":      "dynamic",
+	}))
+	s.NoError(w.Commit(ctx))
+
+	files := w.BatchFiles()
+	s.Require().Len(files, 1)
+	s.True(strings.HasSuffix(files[0][0], ".parquet"))
+
+	f, err := os.Open(files[0][0])
+	s.Require().NoError(err)
+	defer f.Close()
+	reader, err := file.NewParquetReader(f)
+	s.Require().NoError(err)
+	fr, err := pqarrow.NewFileReader(reader, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	s.Require().NoError(err)
+	table, err := fr.ReadTable(ctx)
+	s.Require().NoError(err)
+	defer table.Release()
+
+	s.EqualValues(1, table.NumRows())
+	columns := make(map[string]arrow.Array)
+	for i := 0; i < int(table.NumCols()); i++ {
+		columns[table.Schema().Field(i).Name] = table.Column(i).Data().Chunk(0)
+	}
+	s.NotContains(columns, "id")
+	s.Equal("a", columns["text"].(*array.String).Value(0))
+	s.True(columns["tag"].IsNull(0))
+	s.Equal([]int32{1, 2}, columns["tags"].(*array.List).ListValues().(*array.Int32).Int32Values())
+	s.Equal([]uint8{1, 2}, columns["bin"].(*array.List).ListValues().(*array.Uint8).Uint8Values())
+	s.Equal([]uint8{1, 2, 3, 4}, columns["fp16"].(*array.List).ListValues().(*array.Uint8).Uint8Values())
+	s.JSONEq(`{"indices": [1, 10], "values": [0.5, 1.5]}`, columns["sparse"].(*array.String).Value(0))
+	s.Equal([]float32{0.1, 0.2}, columns["vector"].(*array.List).ListValues().(*array.Float32).Float32Values())
+	s.JSONEq(`{"y": "dynamic"}`, columns["$meta"].(*array.String).Value(0))
+}
+
+func (s *BulkWriterSuite) TestRollFile() {
+	ctx := context.Background()
+	w, err := NewLocalBulkWriter(NewLocalBulkWriterOption(s.schema, s.T().TempDir()).WithChunkSize(1))
+	s.Require().NoError(err)
+
+	s.NoError(w.AppendColumns(s.columns()...))
+	s.NoError(w.Commit(ctx))
+	s.Len(w.BatchFiles(), 2)
+}
+
+func (s *BulkWriterSuite) TestValidation() {
+	s.Run("bad_option", func() {
+		_, err := NewLocalBulkWriter(NewLocalBulkWriterOption(s.schema, ""))
+		s.Error(err)
+		_, err = NewLocalBulkWriter(NewLocalBulkWriterOption(s.schema, s.T().TempDir()).WithChunkSize(0))
+		s.Error(err)
+		_, err = NewLocalBulkWriter(NewLocalBulkWriterOption(s.schema, s.T().TempDir()).WithFileType(BulkFileTypeCSV).WithSeparator('"'))
+		s.Error(err)
+	})
+
+	w, err := NewLocalBulkWriter(NewLocalBulkWriterOption(s.schema, s.T().TempDir()))
+	s.Require().NoError(err)
+
+	s.Run("missing_field", func() {
+		err := w.AppendColumns(column.NewColumnInt64("id", []int64{1}))
+		s.Error(err)
+	})
+
+	s.Run("type_mismatch", func() {
+		columns := s.columns()
+		columns[1] = column.NewColumnInt64("text", []int64{1, 2})
+		s.Error(w.AppendColumns(columns...))
+	})
+
+	s.Run("dim_mismatch", func() {
+		columns := s.columns()
+		columns[3] = column.NewColumnFloatVector("vector", 3, [][]float32{{0.1, 0.2, 0.3}, {0.3, 0.4, 0.5}})
+		s.Error(w.AppendColumns(columns...))
+	})
+
+	s.Run("row_num_mismatch", func() {
+		columns := s.columns()
+		columns[0] = column.NewColumnInt64("id", []int64{1})
+		s.Error(w.AppendColumns(columns...))
+	})
+
+	s.Run("unknown_column", func() {
+		columns := append(s.columns(), column.NewColumnInt64("other", []int64{1, 2}))
+		s.Error(w.AppendColumns(columns...))
+	})
+
+	s.EqualValues(0, w.RowCount())
+}
+
+func (s *BulkWriterSuite) TestRemote() {
+	ctx := context.Background()
+	uploaded := make(map[string]string)
+	svr := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		s.Equal(http.MethodPut, req.Method)
+		s.True(strings.HasPrefix(req.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=minioadmin/"))
+		s.NotEmpty(req.Header.Get("X-Amz-Content-Sha256"))
+		bs, err := io.ReadAll(req.Body)
+		s.NoError(err)
+		uploaded[req.URL.Path] = string(bs)
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer svr.Close()
+
+	storage := NewS3Option(strings.TrimPrefix(svr.URL, "http://"), "minioadmin", "minioadmin", "a-bucket")
+	option := NewRemoteBulkWriterOption(s.schema, "bulk_data", storage)
+	option.LocalPath = s.T().TempDir()
+	w, err := NewRemoteBulkWriter(option)
+	s.Require().NoError(err)
+	defer w.Close()
+
+	s.NoError(w.AppendColumns(s.columns()...))
+	s.NoError(w.Commit(ctx))
+
+	files := w.BatchFiles()
+	s.Require().Len(files, 1)
+	s.True(strings.HasPrefix(files[0][0], "bulk_data/"))
+	content, ok := uploaded["/a-bucket/"+files[0][0]]
+	s.Require().True(ok)
+	// payload may be chunk signed, the json file is inside the body
+	s.Contains(content, `"text":"b,c"`)
+
+	s.Run("upload_fail", func() {
+		failSvr := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(http.StatusForbidden)
+		}))
+		defer failSvr.Close()
+
+		storage := NewS3Option(strings.TrimPrefix(failSvr.URL, "http://"), "minioadmin", "minioadmin", "a-bucket")
+		option := NewRemoteBulkWriterOption(s.schema, "bulk_data", storage)
+		option.LocalPath = s.T().TempDir()
+		w, err := NewRemoteBulkWriter(option)
+		s.Require().NoError(err)
+		defer w.Close()
+
+		s.NoError(w.AppendColumns(s.columns()...))
+		s.Error(w.Commit(ctx))
+		s.Len(w.BatchFiles(), 0)
+	})
+}
+
+func TestBulkWriter(t *testing.T) {
+	suite.Run(t, new(BulkWriterSuite))
+}
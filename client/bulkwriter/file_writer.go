@@ -0,0 +1,199 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/client/v2/entity"
+)
+
+// fileWriter writes records into one data file.
+type fileWriter interface {
+	WriteRecord(record map[string]any) error
+	// Size returns the bytes written so far.
+	Size() int64
+	Close() error
+}
+
+func newFileWriter(filePath string, option *LocalBulkWriterOption, fields []*entity.Field, header []string) (fileWriter, error) {
+	f, err := os.Create(filePath)
+	if err != nil {
+		return nil, err
+	}
+	cw := &countingWriter{w: bufio.NewWriter(f), f: f}
+
+	switch option.FileType {
+	case BulkFileTypeJSON:
+		return newJSONWriter(cw)
+	case BulkFileTypeCSV:
+		return newCSVWriter(cw, header, option.Separator, option.NullKey)
+	case BulkFileTypeParquet:
+		return newParquetWriter(cw, fields, option.Schema.EnableDynamicField)
+	default:
+		f.Close()
+		return nil, errors.Newf("unsupported bulk file type %d", option.FileType)
+	}
+}
+
+// countingWriter is a buffered file writer which records the bytes written.
+type countingWriter struct {
+	w    *bufio.Writer
+	f    *os.File
+	size int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.size += int64(n)
+	return n, err
+}
+
+func (cw *countingWriter) Close() error {
+	if err := cw.w.Flush(); err != nil {
+		cw.f.Close()
+		return err
+	}
+	return cw.f.Close()
+}
+
+// jsonWriter writes records as json array, which is the row-based json import layout.
+type jsonWriter struct {
+	cw    *countingWriter
+	first bool
+}
+
+func newJSONWriter(cw *countingWriter) (*jsonWriter, error) {
+	if _, err := cw.Write([]byte("[\n")); err != nil {
+		cw.Close()
+		return nil, err
+	}
+	return &jsonWriter{cw: cw, first: true}, nil
+}
+
+func (w *jsonWriter) WriteRecord(record map[string]any) error {
+	bs, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if !w.first {
+		if _, err := w.cw.Write([]byte(",\n")); err != nil {
+			return err
+		}
+	}
+	w.first = false
+	_, err = w.cw.Write(bs)
+	return err
+}
+
+func (w *jsonWriter) Size() int64 {
+	return w.cw.size
+}
+
+func (w *jsonWriter) Close() error {
+	if _, err := w.cw.Write([]byte("\n]\n")); err != nil {
+		w.cw.Close()
+		return err
+	}
+	return w.cw.Close()
+}
+
+// csvWriter writes records as csv with header,
+// non-scalar values are written as json strings.
+type csvWriter struct {
+	cw      *countingWriter
+	w       *csv.Writer
+	header  []string
+	nullKey string
+}
+
+func newCSVWriter(cw *countingWriter, header []string, sep rune, nullKey string) (*csvWriter, error) {
+	w := csv.NewWriter(cw)
+	w.Comma = sep
+	if err := w.Write(header); err != nil {
+		cw.Close()
+		return nil, err
+	}
+	return &csvWriter{cw: cw, w: w, header: header, nullKey: nullKey}, nil
+}
+
+func (w *csvWriter) WriteRecord(record map[string]any) error {
+	line := make([]string, 0, len(w.header))
+	for _, name := range w.header {
+		value, err := w.format(record[name])
+		if err != nil {
+			return err
+		}
+		line = append(line, value)
+	}
+	if err := w.w.Write(line); err != nil {
+		return err
+	}
+	// flush to underlying writer so that size is accurate
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func (w *csvWriter) format(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return w.nullKey, nil
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int8:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int16:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int32:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64), nil
+	case json.RawMessage:
+		return string(v), nil
+	default:
+		bs, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(bs), nil
+	}
+}
+
+func (w *csvWriter) Size() int64 {
+	return w.cw.size
+}
+
+func (w *csvWriter) Close() error {
+	w.w.Flush()
+	if err := w.w.Error(); err != nil {
+		w.cw.Close()
+		return err
+	}
+	return w.cw.Close()
+}
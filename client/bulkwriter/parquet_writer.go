@@ -0,0 +1,232 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"encoding/json"
+	"io"
+	"reflect"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/apache/arrow/go/v17/parquet"
+	"github.com/apache/arrow/go/v17/parquet/pqarrow"
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/client/v2/column"
+	"github.com/milvus-io/milvus/client/v2/entity"
+)
+
+// parquetBatchRows is the number of rows buffered before written as one row group.
+const parquetBatchRows = 1024
+
+// parquetWriter writes records as parquet with the column layout accepted by parquet import:
+// binary and float16 vectors are uint8 lists of raw bytes, json and sparse vectors are json strings.
+type parquetWriter struct {
+	cw      *countingWriter
+	schema  *arrow.Schema
+	builder *array.RecordBuilder
+	writer  *pqarrow.FileWriter
+	rows    int
+}
+
+func newParquetWriter(cw *countingWriter, fields []*entity.Field, dynamic bool) (*parquetWriter, error) {
+	arrowFields := make([]arrow.Field, 0, len(fields)+1)
+	for _, field := range fields {
+		dataType, err := parquetDataType(field.DataType, field.ElementType)
+		if err != nil {
+			cw.Close()
+			return nil, err
+		}
+		arrowFields = append(arrowFields, arrow.Field{
+			Name:     field.Name,
+			Type:     dataType,
+			Nullable: field.Nullable || field.DefaultValue != nil,
+		})
+	}
+	if dynamic {
+		arrowFields = append(arrowFields, arrow.Field{Name: dynamicFieldName, Type: arrow.BinaryTypes.String})
+	}
+	schema := arrow.NewSchema(arrowFields, nil)
+
+	// the file writer closes its sink, hide Close of countingWriter to close it only once
+	writer, err := pqarrow.NewFileWriter(schema, struct{ io.Writer }{cw}, parquet.NewWriterProperties(), pqarrow.DefaultWriterProps())
+	if err != nil {
+		cw.Close()
+		return nil, err
+	}
+	return &parquetWriter{
+		cw:      cw,
+		schema:  schema,
+		builder: array.NewRecordBuilder(memory.DefaultAllocator, schema),
+		writer:  writer,
+	}, nil
+}
+
+func parquetDataType(dataType entity.FieldType, elementType entity.FieldType) (arrow.DataType, error) {
+	switch dataType {
+	case entity.FieldTypeBool:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case entity.FieldTypeInt8:
+		return arrow.PrimitiveTypes.Int8, nil
+	case entity.FieldTypeInt16:
+		return arrow.PrimitiveTypes.Int16, nil
+	case entity.FieldTypeInt32:
+		return arrow.PrimitiveTypes.Int32, nil
+	case entity.FieldTypeInt64:
+		return arrow.PrimitiveTypes.Int64, nil
+	case entity.FieldTypeFloat:
+		return arrow.PrimitiveTypes.Float32, nil
+	case entity.FieldTypeDouble:
+		return arrow.PrimitiveTypes.Float64, nil
+	case entity.FieldTypeString, entity.FieldTypeVarChar, entity.FieldTypeJSON, entity.FieldTypeSparseVector:
+		return arrow.BinaryTypes.String, nil
+	case entity.FieldTypeArray:
+		elemType, err := parquetDataType(elementType, entity.FieldTypeNone)
+		if err != nil {
+			return nil, err
+		}
+		return arrow.ListOf(elemType), nil
+	case entity.FieldTypeFloatVector:
+		return arrow.ListOf(arrow.PrimitiveTypes.Float32), nil
+	case entity.FieldTypeBinaryVector, entity.FieldTypeFloat16Vector, entity.FieldTypeBFloat16Vector:
+		return arrow.ListOf(arrow.PrimitiveTypes.Uint8), nil
+	case entity.FieldTypeInt8Vector:
+		return arrow.ListOf(arrow.PrimitiveTypes.Int8), nil
+	default:
+		return nil, errors.Newf("unsupported data type %s for parquet", dataType.Name())
+	}
+}
+
+// parquetValue returns the idx-th value of column in the layout of parquetWriter.
+func parquetValue(field *entity.Field, col column.Column, idx int) (any, error) {
+	switch field.DataType {
+	case entity.FieldTypeBinaryVector, entity.FieldTypeFloat16Vector, entity.FieldTypeBFloat16Vector:
+		vector, err := col.Get(idx)
+		if err != nil {
+			return nil, err
+		}
+		return vector.(entity.Vector).Serialize(), nil
+	case entity.FieldTypeSparseVector:
+		value, err := importValue(field, col, idx)
+		if err != nil {
+			return nil, err
+		}
+		bs, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		return string(bs), nil
+	default:
+		return importValue(field, col, idx)
+	}
+}
+
+func (w *parquetWriter) WriteRecord(record map[string]any) error {
+	for i, field := range w.schema.Fields() {
+		if err := appendArrowValue(w.builder.Field(i), record[field.Name]); err != nil {
+			return errors.Wrapf(err, "field %s", field.Name)
+		}
+	}
+	w.rows++
+	if w.rows >= parquetBatchRows {
+		return w.flush()
+	}
+	return nil
+}
+
+func (w *parquetWriter) flush() error {
+	if w.rows == 0 {
+		return nil
+	}
+	rec := w.builder.NewRecord()
+	defer rec.Release()
+	w.rows = 0
+	return w.writer.Write(rec)
+}
+
+// Size returns the bytes written so far, rows buffered in current batch are not counted.
+func (w *parquetWriter) Size() int64 {
+	return w.cw.size
+}
+
+func (w *parquetWriter) Close() error {
+	defer w.builder.Release()
+	if err := w.flush(); err != nil {
+		w.cw.Close()
+		return err
+	}
+	if err := w.writer.Close(); err != nil {
+		w.cw.Close()
+		return err
+	}
+	return w.cw.Close()
+}
+
+func appendArrowValue(builder array.Builder, value any) error {
+	if value == nil {
+		builder.AppendNull()
+		return nil
+	}
+	switch b := builder.(type) {
+	case *array.BooleanBuilder:
+		return appendTyped(b.Append, value)
+	case *array.Int8Builder:
+		return appendTyped(b.Append, value)
+	case *array.Int16Builder:
+		return appendTyped(b.Append, value)
+	case *array.Int32Builder:
+		return appendTyped(b.Append, value)
+	case *array.Int64Builder:
+		return appendTyped(b.Append, value)
+	case *array.Uint8Builder:
+		return appendTyped(b.Append, value)
+	case *array.Float32Builder:
+		return appendTyped(b.Append, value)
+	case *array.Float64Builder:
+		return appendTyped(b.Append, value)
+	case *array.StringBuilder:
+		if raw, ok := value.(json.RawMessage); ok {
+			value = string(raw)
+		}
+		return appendTyped(b.Append, value)
+	case *array.ListBuilder:
+		list := reflect.ValueOf(value)
+		if list.Kind() != reflect.Slice {
+			return errors.Newf("unexpected value type %T of list", value)
+		}
+		b.Append(true)
+		for i := 0; i < list.Len(); i++ {
+			if err := appendArrowValue(b.ValueBuilder(), list.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return errors.Newf("unexpected arrow builder %T", builder)
+	}
+}
+
+func appendTyped[T any](appendFn func(T), value any) error {
+	v, ok := value.(T)
+	if !ok {
+		return errors.Newf("unexpected value type %T", value)
+	}
+	appendFn(v)
+	return nil
+}
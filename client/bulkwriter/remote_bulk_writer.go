@@ -0,0 +1,155 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"context"
+	"os"
+	"path"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/client/v2/column"
+	"github.com/milvus-io/milvus/client/v2/entity"
+)
+
+// ObjectUploader uploads local file into object storage.
+type ObjectUploader interface {
+	Upload(ctx context.Context, objectKey string, localFile string) error
+}
+
+// RemoteBulkWriterOption is the option for RemoteBulkWriter.
+type RemoteBulkWriterOption struct {
+	*LocalBulkWriterOption
+
+	// RemotePath is the object key prefix in bucket where data files are uploaded to.
+	RemotePath string
+	Storage    *S3Option
+	// Uploader overrides the default S3 uploader built from Storage if provided.
+	Uploader ObjectUploader
+}
+
+func (opt *RemoteBulkWriterOption) WithChunkSize(chunkSize int64) *RemoteBulkWriterOption {
+	opt.LocalBulkWriterOption.WithChunkSize(chunkSize)
+	return opt
+}
+
+func (opt *RemoteBulkWriterOption) WithFileType(fileType BulkFileType) *RemoteBulkWriterOption {
+	opt.LocalBulkWriterOption.WithFileType(fileType)
+	return opt
+}
+
+func (opt *RemoteBulkWriterOption) WithSeparator(sep rune) *RemoteBulkWriterOption {
+	opt.LocalBulkWriterOption.WithSeparator(sep)
+	return opt
+}
+
+func (opt *RemoteBulkWriterOption) WithNullKey(nullKey string) *RemoteBulkWriterOption {
+	opt.LocalBulkWriterOption.WithNullKey(nullKey)
+	return opt
+}
+
+func (opt *RemoteBulkWriterOption) WithUploader(uploader ObjectUploader) *RemoteBulkWriterOption {
+	opt.Uploader = uploader
+	return opt
+}
+
+// NewRemoteBulkWriterOption returns RemoteBulkWriterOption,
+// data files are staged in system temp dir before uploading.
+func NewRemoteBulkWriterOption(schema *entity.Schema, remotePath string, storage *S3Option) *RemoteBulkWriterOption {
+	return &RemoteBulkWriterOption{
+		LocalBulkWriterOption: NewLocalBulkWriterOption(schema, path.Join(os.TempDir(), "bulk_writer")),
+		RemotePath:            remotePath,
+		Storage:               storage,
+	}
+}
+
+// RemoteBulkWriter writes rows into local files and uploads them into S3 compatible object storage on commit.
+//
+// The uploaded object keys, relative to the bucket, are returned by BatchFiles
+// and could be passed to BulkImport directly if milvus uses the same bucket.
+type RemoteBulkWriter struct {
+	local    *LocalBulkWriter
+	option   *RemoteBulkWriterOption
+	uploader ObjectUploader
+}
+
+// NewRemoteBulkWriter creates a RemoteBulkWriter with provided option.
+func NewRemoteBulkWriter(option *RemoteBulkWriterOption) (*RemoteBulkWriter, error) {
+	uploader := option.Uploader
+	if uploader == nil {
+		if option.Storage == nil {
+			return nil, errors.New("remote bulk writer storage option not provided")
+		}
+		var err error
+		uploader, err = NewS3Uploader(option.Storage)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	local, err := NewLocalBulkWriter(option.LocalBulkWriterOption)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RemoteBulkWriter{
+		local:    local,
+		option:   option,
+		uploader: uploader,
+	}, nil
+}
+
+// AppendRow appends one row into writer, see LocalBulkWriter.AppendRow.
+func (w *RemoteBulkWriter) AppendRow(r any) error {
+	return w.local.AppendRow(r)
+}
+
+// AppendColumns appends column-based data into writer, see LocalBulkWriter.AppendColumns.
+func (w *RemoteBulkWriter) AppendColumns(columns ...column.Column) error {
+	return w.local.AppendColumns(columns...)
+}
+
+// Commit closes the file in writing and uploads all data files,
+// local files are removed once uploaded.
+func (w *RemoteBulkWriter) Commit(ctx context.Context) error {
+	return w.local.commit(ctx, func(ctx context.Context, localFile string) (string, error) {
+		objectKey := path.Join(w.option.RemotePath, w.local.UUID(), path.Base(localFile))
+		if err := w.uploader.Upload(ctx, objectKey, localFile); err != nil {
+			return "", err
+		}
+		if err := os.Remove(localFile); err != nil {
+			return "", err
+		}
+		return objectKey, nil
+	})
+}
+
+// BatchFiles returns object keys of uploaded files.
+func (w *RemoteBulkWriter) BatchFiles() [][]string {
+	return w.local.BatchFiles()
+}
+
+// RowCount returns the number of rows appended.
+func (w *RemoteBulkWriter) RowCount() int64 {
+	return w.local.RowCount()
+}
+
+// Close removes local staging directory of the writer.
+func (w *RemoteBulkWriter) Close() error {
+	return os.RemoveAll(w.local.dataPath())
+}
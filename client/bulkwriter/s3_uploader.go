@@ -0,0 +1,107 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3Option is the connection option of S3 compatible object storage, e.g. AWS S3 and MinIO.
+type S3Option struct {
+	// Endpoint is the address of storage service, `host:port` without scheme.
+	Endpoint   string
+	AccessKey  string
+	SecretKey  string
+	BucketName string
+	Region     string
+	UseSSL     bool
+	// SessionToken is the token of temporary credentials, e.g. the ones issued by STS.
+	SessionToken string
+	// PartSize is the part size of multipart upload, files larger than it are uploaded in parts.
+	// Zero means decided by file size.
+	PartSize uint64
+}
+
+// NewS3Option returns S3Option with default region `us-east-1`.
+func NewS3Option(endpoint, accessKey, secretKey, bucketName string) *S3Option {
+	return &S3Option{
+		Endpoint:   endpoint,
+		AccessKey:  accessKey,
+		SecretKey:  secretKey,
+		BucketName: bucketName,
+		Region:     "us-east-1",
+	}
+}
+
+func (opt *S3Option) WithRegion(region string) *S3Option {
+	opt.Region = region
+	return opt
+}
+
+func (opt *S3Option) WithSSL(useSSL bool) *S3Option {
+	opt.UseSSL = useSSL
+	return opt
+}
+
+func (opt *S3Option) WithSessionToken(sessionToken string) *S3Option {
+	opt.SessionToken = sessionToken
+	return opt
+}
+
+func (opt *S3Option) WithPartSize(partSize uint64) *S3Option {
+	opt.PartSize = partSize
+	return opt
+}
+
+// s3Uploader uploads objects with minio client, large files are uploaded by multipart upload.
+type s3Uploader struct {
+	option *S3Option
+	client *minio.Client
+}
+
+// NewS3Uploader returns the ObjectUploader for S3 compatible object storage.
+func NewS3Uploader(option *S3Option) (ObjectUploader, error) {
+	if option.Endpoint == "" || option.BucketName == "" {
+		return nil, errors.New("s3 endpoint and bucket name must be provided")
+	}
+	client, err := minio.New(option.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(option.AccessKey, option.SecretKey, option.SessionToken),
+		Secure: option.UseSSL,
+		Region: option.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &s3Uploader{
+		option: option,
+		client: client,
+	}, nil
+}
+
+func (u *s3Uploader) Upload(ctx context.Context, objectKey string, localFile string) error {
+	_, err := u.client.FPutObject(ctx, u.option.BucketName, objectKey, localFile, minio.PutObjectOptions{
+		PartSize: u.option.PartSize,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to upload %s", objectKey)
+	}
+	return nil
+}
@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"math"
+	"strconv"
 
 	"github.com/cockroachdb/errors"
 	"google.golang.org/grpc"
@@ -38,10 +40,16 @@ const (
 	IteratorSearchLastBoundKey = "search_iter_last_bound"
 	IteratorSearchIDKey        = "search_iter_id"
 	CollectionIDKey            = `collection_id`
+
+	// IteratorReduceStopForBestKey is the const query param key asking proxy to stop reduce once batch is full.
+	IteratorReduceStopForBestKey = "reduce_stop_for_best"
 )
 
 var ErrServerVersionIncompatible = errors.New("server version incompatible")
 
+// ErrIteratorCollectionChanged is returned when the collection is dropped and recreated during iteration.
+var ErrIteratorCollectionChanged = errors.New("collection changed during iteration")
+
 // SearchIterator is the interface for search iterator.
 type SearchIterator interface {
 	// Next returns next batch of iterator
@@ -184,3 +192,190 @@ func (c *Client) SearchIterator(ctx context.Context, option SearchIteratorOption
 
 	return newSearchIteratorV1(c)
 }
+
+// QueryIterator is the interface for query iterator.
+type QueryIterator interface {
+	// Next returns next batch of iterator
+	// when iterator reaches the end, return `io.EOF`.
+	Next(ctx context.Context) (ResultSet, error)
+}
+
+// queryIterator walks the query result set in primary key order.
+//
+// Each batch is fetched with expression `(filter) and pk > lastPK`,
+// all batches after the first one are executed with the session timestamp
+// returned by server so that the whole iteration observes one consistent MVCC snapshot.
+type queryIterator struct {
+	client      *Client
+	option      QueryIteratorOption
+	callOptions []grpc.CallOption
+
+	collectionID int64
+	pkField      *entity.Field
+
+	sessionTs uint64
+	lastPK    any
+	returned  int
+}
+
+func (it *queryIterator) Next(ctx context.Context) (ResultSet, error) {
+	batchSize := it.option.BatchSize()
+	if limit := it.option.Limit(); limit >= 0 {
+		remain := limit - it.returned
+		if remain <= 0 {
+			return ResultSet{}, io.EOF
+		}
+		batchSize = min(batchSize, remain)
+	}
+
+	var rs ResultSet
+	err := it.client.retryIfSchemaError(ctx, it.option.CollectionName(), func(ctx context.Context) (uint64, error) {
+		collection, err := it.client.getCollection(ctx, it.option.CollectionName())
+		if err != nil {
+			return math.MaxUint64, err
+		}
+		if collection.ID != it.collectionID {
+			return collection.UpdateTimestamp, ErrIteratorCollectionChanged
+		}
+
+		req, err := it.request(batchSize)
+		if err != nil {
+			return collection.UpdateTimestamp, err
+		}
+
+		return collection.UpdateTimestamp, it.client.callService(func(milvusService milvuspb.MilvusServiceClient) error {
+			resp, err := milvusService.Query(ctx, req, it.callOptions...)
+			err = merr.CheckRPCCall(resp, err)
+			if err != nil {
+				return err
+			}
+
+			columns, err := it.client.parseSearchResult(collection.Schema, resp.GetOutputFields(), resp.GetFieldsData(), 0, 0, -1)
+			if err != nil {
+				// result does not match cached schema, retry with newer schema
+				return merr.WrapErrCollectionSchemaMisMatch(err)
+			}
+			// pin mvcc timestamp for following batches
+			if it.sessionTs == 0 {
+				it.sessionTs = resp.GetSessionTs()
+			}
+			rs = ResultSet{
+				sch:    collection.Schema,
+				Fields: columns,
+			}
+			if len(columns) > 0 {
+				rs.ResultCount = columns[0].Len()
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return ResultSet{}, err
+	}
+
+	if rs.ResultCount == 0 {
+		return ResultSet{}, io.EOF
+	}
+
+	if err := it.updateCursor(rs); err != nil {
+		return ResultSet{}, err
+	}
+	it.returned += rs.ResultCount
+	return rs, nil
+}
+
+func (it *queryIterator) request(batchSize int) (*milvuspb.QueryRequest, error) {
+	opt := it.option.QueryOption()
+	opt.WithLimit(batchSize)
+	opt.queryParams[CollectionIDKey] = strconv.FormatInt(it.collectionID, 10)
+
+	req, err := opt.Request()
+	if err != nil {
+		return nil, err
+	}
+	req.Expr = it.cursorExpr(req.GetExpr())
+	if it.sessionTs > 0 {
+		req.GuaranteeTimestamp = it.sessionTs
+	}
+	return req, nil
+}
+
+// cursorExpr appends the primary key cursor condition to the user provided filter.
+func (it *queryIterator) cursorExpr(expr string) string {
+	if it.lastPK == nil {
+		return expr
+	}
+
+	var cursor string
+	switch pk := it.lastPK.(type) {
+	case int64:
+		cursor = fmt.Sprintf("%s > %d", it.pkField.Name, pk)
+	case string:
+		cursor = fmt.Sprintf("%s > %s", it.pkField.Name, strconv.Quote(pk))
+	}
+	if expr == "" {
+		return cursor
+	}
+	return fmt.Sprintf("(%s) and %s", expr, cursor)
+}
+
+func (it *queryIterator) updateCursor(rs ResultSet) error {
+	pkColumn := rs.GetColumn(it.pkField.Name)
+	if pkColumn == nil {
+		return errors.Newf("primary key field %s not found in query result", it.pkField.Name)
+	}
+
+	idx := pkColumn.Len() - 1
+	switch it.pkField.DataType {
+	case entity.FieldTypeInt64:
+		pk, err := pkColumn.GetAsInt64(idx)
+		if err != nil {
+			return err
+		}
+		it.lastPK = pk
+	case entity.FieldTypeVarChar:
+		pk, err := pkColumn.GetAsString(idx)
+		if err != nil {
+			return err
+		}
+		it.lastPK = pk
+	default:
+		return errors.Newf("unsupported primary key type %s", it.pkField.DataType.Name())
+	}
+	return nil
+}
+
+// newQueryIterator creates a new query iterator.
+//
+// It fetches the collection meta to pin the collection id and primary key field.
+func newQueryIterator(ctx context.Context, client *Client, option QueryIteratorOption, callOptions ...grpc.CallOption) (*queryIterator, error) {
+	if option.BatchSize() <= 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("batch size must be positive, got %d", option.BatchSize())
+	}
+
+	collection, err := client.getCollection(ctx, option.CollectionName())
+	if err != nil {
+		return nil, err
+	}
+
+	pkField := collection.Schema.PKField()
+	if pkField == nil {
+		return nil, errors.Newf("primary key field not found in collection %s", option.CollectionName())
+	}
+
+	return &queryIterator{
+		client:       client,
+		option:       option,
+		callOptions:  callOptions,
+		collectionID: collection.ID,
+		pkField:      pkField,
+	}, nil
+}
+
+// QueryIterator creates a query iterator from a collection.
+//
+// The iterator returns query results in batches ordered by primary key,
+// all batches share the same MVCC timestamp.
+func (c *Client) QueryIterator(ctx context.Context, option QueryIteratorOption, callOptions ...grpc.CallOption) (QueryIterator, error) {
+	return newQueryIterator(ctx, c, option, callOptions...)
+}
@@ -117,3 +117,82 @@ func NewSearchIteratorOption(collectionName string, vector entity.Vector) *searc
 		batchSize: 1000,
 	}
 }
+
+type QueryIteratorOption interface {
+	CollectionName() string
+	QueryOption() *queryOption
+	BatchSize() int
+	Limit() int
+}
+
+type queryIteratorOption struct {
+	*queryOption
+	batchSize int
+	limit     int
+}
+
+func (opt *queryIteratorOption) CollectionName() string {
+	return opt.collectionName
+}
+
+func (opt *queryIteratorOption) QueryOption() *queryOption {
+	opt.queryParams[IteratorKey] = "true"
+	opt.queryParams[IteratorReduceStopForBestKey] = "true"
+	return opt.queryOption
+}
+
+func (opt *queryIteratorOption) BatchSize() int {
+	return opt.batchSize
+}
+
+func (opt *queryIteratorOption) Limit() int {
+	return opt.limit
+}
+
+func (opt *queryIteratorOption) WithBatchSize(batchSize int) *queryIteratorOption {
+	opt.batchSize = batchSize
+	return opt
+}
+
+// WithIteratorLimit sets the total number of entities returned by the iterator,
+// negative value means no limit.
+func (opt *queryIteratorOption) WithIteratorLimit(limit int) *queryIteratorOption {
+	opt.limit = limit
+	return opt
+}
+
+func (opt *queryIteratorOption) WithPartitions(partitionNames ...string) *queryIteratorOption {
+	opt.partitionNames = partitionNames
+	return opt
+}
+
+func (opt *queryIteratorOption) WithFilter(expr string) *queryIteratorOption {
+	opt.expr = expr
+	return opt
+}
+
+func (opt *queryIteratorOption) WithTemplateParam(key string, val any) *queryIteratorOption {
+	opt.templateParams[key] = val
+	return opt
+}
+
+func (opt *queryIteratorOption) WithOutputFields(fieldNames ...string) *queryIteratorOption {
+	opt.outputFields = fieldNames
+	return opt
+}
+
+func (opt *queryIteratorOption) WithConsistencyLevel(consistencyLevel entity.ConsistencyLevel) *queryIteratorOption {
+	opt.consistencyLevel = consistencyLevel
+	opt.useDefaultConsistencyLevel = false
+	return opt
+}
+
+func NewQueryIteratorOption(collectionName string) *queryIteratorOption {
+	queryOpt := NewQueryOption(collectionName)
+	queryOpt.queryParams = make(map[string]string)
+	return &queryIteratorOption{
+		queryOption: queryOpt,
+		batchSize:   1000,
+		limit:       -1,
+	}
+}
@@ -301,3 +301,204 @@ func (s *SearchIteratorSuite) TestNext() {
 func TestSearchIterator(t *testing.T) {
 	suite.Run(t, new(SearchIteratorSuite))
 }
+
+type QueryIteratorSuite struct {
+	MockSuiteBase
+
+	schema *entity.Schema
+}
+
+func (s *QueryIteratorSuite) SetupSuite() {
+	s.MockSuiteBase.SetupSuite()
+	s.schema = entity.NewSchema().
+		WithField(entity.NewField().WithName("ID").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+		WithField(entity.NewField().WithName("Vector").WithDataType(entity.FieldTypeFloatVector).WithDim(128))
+}
+
+func (s *QueryIteratorSuite) TestQueryIteratorInit() {
+	ctx := context.Background()
+	s.Run("success", func() {
+		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+		s.mock.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+			CollectionID: 1,
+			Schema:       s.schema.ProtoMessage(),
+		}, nil).Once()
+
+		iter, err := s.client.QueryIterator(ctx, NewQueryIteratorOption(collectionName))
+		s.NoError(err)
+		_, ok := iter.(*queryIterator)
+		s.True(ok)
+	})
+
+	s.Run("failure", func() {
+		s.Run("describe_fail", func() {
+			collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+			s.mock.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(nil, fmt.Errorf("mock error")).Once()
+
+			_, err := s.client.QueryIterator(ctx, NewQueryIteratorOption(collectionName))
+			s.Error(err)
+		})
+
+		s.Run("bad_batch_size", func() {
+			collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+			_, err := s.client.QueryIterator(ctx, NewQueryIteratorOption(collectionName).WithBatchSize(0))
+			s.Error(err)
+		})
+	})
+}
+
+func (s *QueryIteratorSuite) TestNext() {
+	ctx := context.Background()
+	collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+	sessionTs := uint64(rand.Int63())
+
+	checkQueryParam := func(kvs []*commonpb.KeyValuePair, key string, value string) bool {
+		for _, kv := range kvs {
+			if kv.GetKey() == key && kv.GetValue() == value {
+				return true
+			}
+		}
+		return false
+	}
+
+	s.mock.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		CollectionID: 1,
+		Schema:       s.schema.ProtoMessage(),
+	}, nil).Once()
+
+	iter, err := s.client.QueryIterator(ctx, NewQueryIteratorOption(collectionName).
+		WithFilter("ID > 0").
+		WithOutputFields("ID").
+		WithPartitions("part_1").
+		WithBatchSize(2).
+		WithIteratorLimit(3))
+	s.Require().NoError(err)
+	s.Require().NotNil(iter)
+
+	s.mock.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, qr *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+		s.Equal(collectionName, qr.GetCollectionName())
+		s.Equal("ID > 0", qr.GetExpr())
+		s.ElementsMatch([]string{"part_1"}, qr.GetPartitionNames())
+		s.EqualValues(0, qr.GetGuaranteeTimestamp())
+		s.True(checkQueryParam(qr.GetQueryParams(), IteratorKey, "true"))
+		s.True(checkQueryParam(qr.GetQueryParams(), IteratorReduceStopForBestKey, "true"))
+		s.True(checkQueryParam(qr.GetQueryParams(), CollectionIDKey, "1"))
+		s.True(checkQueryParam(qr.GetQueryParams(), spLimit, "2"))
+		return &milvuspb.QueryResults{
+			Status:       merr.Success(),
+			OutputFields: []string{"ID"},
+			FieldsData: []*schemapb.FieldData{
+				s.getInt64FieldData("ID", []int64{1, 2}),
+			},
+			SessionTs: sessionTs,
+		}, nil
+	}).Once()
+
+	rs, err := iter.Next(ctx)
+	s.NoError(err)
+	s.EqualValues(2, rs.ResultCount)
+
+	s.mock.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, qr *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+		s.Equal("(ID > 0) and ID > 2", qr.GetExpr())
+		s.Equal(sessionTs, qr.GetGuaranteeTimestamp())
+		s.True(checkQueryParam(qr.GetQueryParams(), spLimit, "1"))
+		return &milvuspb.QueryResults{
+			Status:       merr.Success(),
+			OutputFields: []string{"ID"},
+			FieldsData: []*schemapb.FieldData{
+				s.getInt64FieldData("ID", []int64{3}),
+			},
+		}, nil
+	}).Once()
+
+	rs, err = iter.Next(ctx)
+	s.NoError(err)
+	s.EqualValues(1, rs.ResultCount)
+
+	// iterator limit reached, no more rpc
+	_, err = iter.Next(ctx)
+	s.ErrorIs(err, io.EOF)
+}
+
+func (s *QueryIteratorSuite) TestNextVarCharPK() {
+	ctx := context.Background()
+	collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+	schema := entity.NewSchema().
+		WithField(entity.NewField().WithName("Name").WithDataType(entity.FieldTypeVarChar).WithMaxLength(64).WithIsPrimaryKey(true)).
+		WithField(entity.NewField().WithName("Vector").WithDataType(entity.FieldTypeFloatVector).WithDim(128))
+
+	s.mock.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		CollectionID: 1,
+		Schema:       schema.ProtoMessage(),
+	}, nil).Once()
+
+	iter, err := s.client.QueryIterator(ctx, NewQueryIteratorOption(collectionName).WithOutputFields("Name"))
+	s.Require().NoError(err)
+
+	s.mock.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, qr *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+		s.Equal("", qr.GetExpr())
+		return &milvuspb.QueryResults{
+			Status:       merr.Success(),
+			OutputFields: []string{"Name"},
+			FieldsData: []*schemapb.FieldData{
+				s.getVarcharFieldData("Name", []string{"a", "b\"c"}),
+			},
+		}, nil
+	}).Once()
+
+	rs, err := iter.Next(ctx)
+	s.NoError(err)
+	s.EqualValues(2, rs.ResultCount)
+
+	s.mock.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, qr *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+		s.Equal(`Name > "b\"c"`, qr.GetExpr())
+		return &milvuspb.QueryResults{
+			Status:       merr.Success(),
+			OutputFields: []string{"Name"},
+			FieldsData: []*schemapb.FieldData{
+				s.getVarcharFieldData("Name", []string{}),
+			},
+		}, nil
+	}).Once()
+
+	_, err = iter.Next(ctx)
+	s.ErrorIs(err, io.EOF)
+}
+
+func (s *QueryIteratorSuite) TestNextSchemaMismatch() {
+	ctx := context.Background()
+	collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+
+	s.mock.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		CollectionID:    1,
+		Schema:          s.schema.ProtoMessage(),
+		UpdateTimestamp: 1,
+	}, nil).Once()
+
+	iter, err := s.client.QueryIterator(ctx, NewQueryIteratorOption(collectionName))
+	s.Require().NoError(err)
+
+	s.mock.EXPECT().Query(mock.Anything, mock.Anything).Return(&milvuspb.QueryResults{
+		Status: merr.Status(merr.WrapErrCollectionSchemaMisMatch(collectionName)),
+	}, nil).Once()
+	s.mock.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		CollectionID:    1,
+		Schema:          s.schema.ProtoMessage(),
+		UpdateTimestamp: 2,
+	}, nil).Once()
+	s.mock.EXPECT().Query(mock.Anything, mock.Anything).Return(&milvuspb.QueryResults{
+		Status:       merr.Success(),
+		OutputFields: []string{"ID"},
+		FieldsData: []*schemapb.FieldData{
+			s.getInt64FieldData("ID", []int64{1}),
+		},
+	}, nil).Once()
+
+	rs, err := iter.Next(ctx)
+	s.NoError(err)
+	s.EqualValues(1, rs.ResultCount)
+}
+
+func TestQueryIterator(t *testing.T) {
+	suite.Run(t, new(QueryIteratorSuite))
+}
@@ -40,6 +40,8 @@ const (
 	spTopK            = `topk`
 	spOffset          = `offset`
 	spLimit           = `limit`
+	spOrderBy         = `order_by`
+	spGroupByFields   = `group_by_fields`
 	spParams          = `params`
 	spMetricsType     = `metric_type`
 	spRoundDecimal    = `round_decimal`
@@ -570,6 +572,25 @@ func (opt *queryOption) WithLimit(limit int) *queryOption {
 	return opt
 }
 
+// WithOrderBy sets the fields to order the query results by, e.g. "age desc" or "age asc nulls first".
+func (opt *queryOption) WithOrderBy(orderBys ...string) *queryOption {
+	if opt.queryParams == nil {
+		opt.queryParams = make(map[string]string)
+	}
+	opt.queryParams[spOrderBy] = strings.Join(orderBys, ",")
+	return opt
+}
+
+// WithGroupByFields sets the fields to group the query results by, the output fields shall be
+// the group by fields or the aggregates, e.g. "count(*)" or "max(price)".
+func (opt *queryOption) WithGroupByFields(fieldNames ...string) *queryOption {
+	if opt.queryParams == nil {
+		opt.queryParams = make(map[string]string)
+	}
+	opt.queryParams[spGroupByFields] = strings.Join(fieldNames, ",")
+	return opt
+}
+
 func (opt *queryOption) WithOutputFields(fieldNames ...string) *queryOption {
 	opt.outputFields = fieldNames
 	return opt
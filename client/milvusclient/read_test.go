@@ -277,6 +277,38 @@ func (s *ReadSuite) TestQuery() {
 		s.NotNil(rs.sch)
 	})
 
+	s.Run("order_by", func() {
+		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+		s.setupCache(collectionName, s.schema)
+
+		s.mock.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, qr *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+			params := entity.KvPairsMap(qr.GetQueryParams())
+			s.Equal("id desc,name", params[spOrderBy])
+			s.Equal("10", params[spLimit])
+
+			return &milvuspb.QueryResults{}, nil
+		}).Once()
+
+		_, err := s.client.Query(ctx, NewQueryOption(collectionName).WithLimit(10).WithOrderBy("id desc", "name"))
+		s.NoError(err)
+	})
+
+	s.Run("group_by", func() {
+		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+		s.setupCache(collectionName, s.schema)
+
+		s.mock.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, qr *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+			params := entity.KvPairsMap(qr.GetQueryParams())
+			s.Equal("name,tag", params[spGroupByFields])
+			s.Equal([]string{"name", "tag", "count(*)"}, qr.GetOutputFields())
+
+			return &milvuspb.QueryResults{}, nil
+		}).Once()
+
+		_, err := s.client.Query(ctx, NewQueryOption(collectionName).WithGroupByFields("name", "tag").WithOutputFields("name", "tag", "count(*)"))
+		s.NoError(err)
+	})
+
 	s.Run("bad_request", func() {
 		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
 		s.setupCache(collectionName, s.schema)
@@ -12,8 +12,9 @@ const (
 	rffParam      = "k"
 	weightedParam = "weights"
 
-	rrfRerankType      = `rrf`
-	weightedRerankType = `weighted`
+	rrfRerankType        = `rrf`
+	weightedRerankType   = `weighted`
+	normFusionRerankType = `norm_fusion`
 )
 
 type Reranker interface {
@@ -60,3 +61,33 @@ func NewWeightedReranker(weights []float64) *weightedReranker {
 		Weights: weights,
 	}
 }
+
+// normFusionReranker normalizes scores of each sub-search with `min_max` or `z_score` method,
+// then combines them with weighted sum.
+type normFusionReranker struct {
+	Weights    []float64 `json:"weights,omitempty"`
+	NormMethod string    `json:"norm_method,omitempty"`
+}
+
+func (r *normFusionReranker) WithWeights(weights []float64) *normFusionReranker {
+	r.Weights = weights
+	return r
+}
+
+func (r *normFusionReranker) WithZScore() *normFusionReranker {
+	r.NormMethod = "z_score"
+	return r
+}
+
+func (r *normFusionReranker) GetParams() []*commonpb.KeyValuePair {
+	bs, _ := json.Marshal(r)
+
+	return []*commonpb.KeyValuePair{
+		{Key: rerankType, Value: normFusionRerankType},
+		{Key: rerankParams, Value: string(bs)},
+	}
+}
+
+func NewNormFusionReranker() *normFusionReranker {
+	return &normFusionReranker{NormMethod: "min_max"}
+}
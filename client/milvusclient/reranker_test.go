@@ -52,4 +52,15 @@ func TestReranker(t *testing.T) {
 		assert.True(t, checkParam(params, rerankType, weightedRerankType))
 		assert.True(t, checkParam(params, rerankParams, `{"weights":[1,2,1]}`))
 	})
+
+	t.Run("normFusionReranker", func(t *testing.T) {
+		rr := NewNormFusionReranker()
+		params := rr.GetParams()
+		assert.True(t, checkParam(params, rerankType, normFusionRerankType))
+		assert.True(t, checkParam(params, rerankParams, `{"norm_method":"min_max"}`))
+
+		rr.WithWeights([]float64{0.2, 0.8}).WithZScore()
+		params = rr.GetParams()
+		assert.True(t, checkParam(params, rerankParams, `{"weights":[0.2,0.8],"norm_method":"z_score"}`))
+	})
 }
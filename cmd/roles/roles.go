@@ -68,6 +68,7 @@ func init() {
 	metrics.RegisterMetaMetrics(Registry.GoRegistry)
 	metrics.RegisterMsgStreamMetrics(Registry.GoRegistry)
 	metrics.RegisterStorageMetrics(Registry.GoRegistry)
+	metrics.RegisterFunctionMetrics(Registry.GoRegistry)
 }
 
 // stopRocksmqIfUsed closes the RocksMQ if it is used.
@@ -1053,6 +1053,22 @@ func (s *mixCoordImpl) ListImports(ctx context.Context, req *internalpb.ListImpo
 	return s.datacoordServer.ListImports(ctx, req)
 }
 
+func (s *mixCoordImpl) ExportV2(ctx context.Context, req *internalpb.ExportRequestInternal) (*internalpb.ExportResponse, error) {
+	return s.datacoordServer.ExportV2(ctx, req)
+}
+
+func (s *mixCoordImpl) GetExportProgress(ctx context.Context, req *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error) {
+	return s.datacoordServer.GetExportProgress(ctx, req)
+}
+
+func (s *mixCoordImpl) ListExports(ctx context.Context, req *internalpb.ListExportsRequestInternal) (*internalpb.ListExportsResponse, error) {
+	return s.datacoordServer.ListExports(ctx, req)
+}
+
+func (s *mixCoordImpl) CancelExport(ctx context.Context, req *internalpb.CancelExportRequest) (*commonpb.Status, error) {
+	return s.datacoordServer.CancelExport(ctx, req)
+}
+
 func (s *mixCoordImpl) ListIndexes(ctx context.Context, req *indexpb.ListIndexesRequest) (*indexpb.ListIndexesResponse, error) {
 	return s.datacoordServer.ListIndexes(ctx, req)
 }
@@ -16,6 +16,10 @@
 
 #include "ColumnExpr.h"
 
+#include <numeric>
+
+#include "common/Json.h"
+
 namespace milvus {
 namespace exec {
 
@@ -58,6 +62,10 @@ PhyColumnExpr::Eval(EvalCtx& context, VectorPtr& result) {
             result = DoEval<std::string>(input);
             break;
         }
+        case DataType::JSON: {
+            result = DoEvalJsonString(input);
+            break;
+        }
         default:
             ThrowInfo(DataTypeInvalid,
                       "unsupported data type: {}",
@@ -65,6 +73,55 @@ PhyColumnExpr::Eval(EvalCtx& context, VectorPtr& result) {
     }
 }
 
+int64_t
+PhyColumnExpr::GetNextJsonBatchSize() const {
+    return std::min(batch_size_,
+                    segment_chunk_reader_.active_count_ - json_current_pos_);
+}
+
+VectorPtr
+PhyColumnExpr::DoEvalJsonString(OffsetVector* input) {
+    std::vector<int64_t> offsets;
+    if (has_offset_input_) {
+        offsets.assign(input->begin(), input->end());
+    } else {
+        offsets.resize(GetNextJsonBatchSize());
+        std::iota(offsets.begin(), offsets.end(), json_current_pos_);
+        json_current_pos_ += offsets.size();
+    }
+    if (offsets.empty()) {
+        return nullptr;
+    }
+
+    auto& column = expr_->GetColumn();
+    auto data = segment_chunk_reader_.segment_->bulk_subscript(
+        column.field_id_, offsets.data(), offsets.size());
+    auto& jsons = data->scalars().json_data().data();
+    auto& valid_data = data->valid_data();
+
+    auto res_vec =
+        std::make_shared<ColumnVector>(DataType::VARCHAR, offsets.size());
+    auto* res_value = res_vec->RawAsValues<std::string>();
+    TargetBitmapView valid_res(res_vec->GetValidRawData(), offsets.size());
+    valid_res.set();
+    auto pointer = milvus::Json::pointer(column.nested_path_);
+    for (size_t i = 0; i < offsets.size(); ++i) {
+        if (!valid_data.empty() && !valid_data[i]) {
+            valid_res[i] = false;
+            continue;
+        }
+        milvus::Json json(simdjson::padded_string(jsons[i]));
+        auto value = json.at<std::string_view>(pointer);
+        // the values which are missing or not strings are null
+        if (value.error()) {
+            valid_res[i] = false;
+            continue;
+        }
+        res_value[i] = std::string(value.value());
+    }
+    return res_vec;
+}
+
 template <typename T>
 VectorPtr
 PhyColumnExpr::DoEval(OffsetVector* input) {
@@ -68,7 +68,9 @@ class PhyColumnExpr : public Expr {
     void
     MoveCursor() override {
         if (!has_offset_input_) {
-            if (segment_chunk_reader_.segment_->is_chunked()) {
+            if (expr_->type() == DataType::JSON) {
+                json_current_pos_ += GetNextJsonBatchSize();
+            } else if (segment_chunk_reader_.segment_->is_chunked()) {
                 segment_chunk_reader_.MoveCursorForMultipleChunk(
                     current_chunk_id_,
                     current_chunk_pos_,
@@ -114,6 +116,14 @@ class PhyColumnExpr : public Expr {
     VectorPtr
     DoEval(OffsetVector* input = nullptr);
 
+    int64_t
+    GetNextJsonBatchSize() const;
+
+    // DoEvalJsonString evaluates the strings at the nested path of the json
+    // column for the string functions, the other values are null.
+    VectorPtr
+    DoEvalJsonString(OffsetVector* input = nullptr);
+
     std::string
     ToString() const {
         return fmt::format("{}", expr_->ToString());
@@ -135,6 +145,8 @@ class PhyColumnExpr : public Expr {
     int64_t num_chunk_{0};
     int64_t current_chunk_id_{0};
     int64_t current_chunk_pos_{0};
+    // the json columns are read by offsets, only the row cursor is kept
+    int64_t json_current_pos_{0};
 
     const segcore::SegmentChunkReader segment_chunk_reader_;
     int64_t batch_size_;
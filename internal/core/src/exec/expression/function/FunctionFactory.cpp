@@ -56,6 +56,20 @@ FunctionFactory::RegisterAllFunctions() {
     RegisterFilterFunction("starts_with",
                            {DataType::VARCHAR, DataType::VARCHAR},
                            function::StartsWithVarchar);
+    RegisterFilterFunction("ends_with",
+                           {DataType::VARCHAR, DataType::VARCHAR},
+                           function::EndsWithVarchar);
+    RegisterFilterFunction("regex_match",
+                           {DataType::VARCHAR, DataType::VARCHAR},
+                           function::RegexMatchVarchar);
+    RegisterFilterFunction(
+        "length",
+        {DataType::VARCHAR, DataType::INT64, DataType::INT64},
+        function::LengthVarchar);
+    // the json columns are evaluated as the strings at the nested paths
+    RegisterFilterFunction("length",
+                           {DataType::JSON, DataType::INT64, DataType::INT64},
+                           function::LengthVarchar);
     LOG_INFO("{} functions registered", GetFilterFunctionNum());
 }
 
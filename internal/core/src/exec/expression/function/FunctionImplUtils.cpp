@@ -27,4 +27,13 @@ CheckVarcharOrStringType(std::shared_ptr<SimpleVector>& vec) {
     }
 }
 
+void
+CheckInt64Type(std::shared_ptr<SimpleVector>& vec) {
+    if (vec->type() != DataType::INT64) {
+        ThrowInfo(ExprInvalid,
+                  "invalid argument type, expect INT64, actual {}",
+                  vec->type());
+    }
+}
+
 }  // namespace milvus::exec::expression::function
@@ -22,4 +22,7 @@ namespace milvus::exec::expression::function {
 void
 CheckVarcharOrStringType(std::shared_ptr<SimpleVector>& vec);
 
+void
+CheckInt64Type(std::shared_ptr<SimpleVector>& vec);
+
 }  // namespace milvus::exec::expression::function
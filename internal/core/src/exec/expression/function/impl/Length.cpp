@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "exec/expression/function/FunctionImplUtils.h"
+#include "exec/expression/function/impl/StringFunctions.h"
+
+#include <string>
+#include "common/EasyAssert.h"
+#include "exec/expression/function/FunctionFactory.h"
+
+namespace milvus {
+namespace exec {
+namespace expression {
+namespace function {
+
+static int64_t
+CountUtf8Chars(const std::string& str) {
+    int64_t count = 0;
+    for (auto c : str) {
+        // the continuation bytes of UTF-8 are 10xxxxxx
+        count += (static_cast<uint8_t>(c) & 0xC0) != 0x80;
+    }
+    return count;
+}
+
+// LengthVarchar returns true if the number of the UTF-8 characters of the
+// string is in the range [min_length, max_length]. The strings of a json
+// column are the ones at its nested path, the values which are not strings
+// are null and never match.
+void
+LengthVarchar(const RowVector& args, FilterFunctionReturn& result) {
+    if (args.childrens().size() != 3) {
+        ThrowInfo(ExprInvalid,
+                  "invalid argument count, expect 3, actual {}",
+                  args.childrens().size());
+    }
+    auto strs = std::dynamic_pointer_cast<SimpleVector>(args.child(0));
+    Assert(strs != nullptr);
+    CheckVarcharOrStringType(strs);
+    auto min_lens = std::dynamic_pointer_cast<SimpleVector>(args.child(1));
+    Assert(min_lens != nullptr);
+    CheckInt64Type(min_lens);
+    auto max_lens = std::dynamic_pointer_cast<SimpleVector>(args.child(2));
+    Assert(max_lens != nullptr);
+    CheckInt64Type(max_lens);
+
+    TargetBitmap bitmap(strs->size(), false);
+    TargetBitmap valid_bitmap(strs->size(), true);
+    for (size_t i = 0; i < strs->size(); ++i) {
+        if (strs->ValidAt(i) && min_lens->ValidAt(i) && max_lens->ValidAt(i)) {
+            auto* str_ptr = reinterpret_cast<std::string*>(
+                strs->RawValueAt(i, sizeof(std::string)));
+            auto min_length = *reinterpret_cast<int64_t*>(
+                min_lens->RawValueAt(i, sizeof(int64_t)));
+            auto max_length = *reinterpret_cast<int64_t*>(
+                max_lens->RawValueAt(i, sizeof(int64_t)));
+            auto length = CountUtf8Chars(*str_ptr);
+            bitmap.set(i, length >= min_length && length <= max_length);
+        } else {
+            valid_bitmap[i] = false;
+        }
+    }
+    result = std::make_shared<ColumnVector>(std::move(bitmap),
+                                            std::move(valid_bitmap));
+}
+
+}  // namespace function
+}  // namespace expression
+}  // namespace exec
+}  // namespace milvus
@@ -0,0 +1,78 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "exec/expression/function/FunctionImplUtils.h"
+#include "exec/expression/function/impl/StringFunctions.h"
+
+#include <re2/re2.h>
+#include <memory>
+#include <string>
+#include "common/EasyAssert.h"
+#include "exec/expression/function/FunctionFactory.h"
+
+namespace milvus {
+namespace exec {
+namespace expression {
+namespace function {
+
+// RegexMatchVarchar returns true if any substring of the string matches the
+// pattern, patterns use the RE2 syntax and can be anchored with ^ and $.
+void
+RegexMatchVarchar(const RowVector& args, FilterFunctionReturn& result) {
+    if (args.childrens().size() != 2) {
+        ThrowInfo(ExprInvalid,
+                  "invalid argument count, expect 2, actual {}",
+                  args.childrens().size());
+    }
+    auto strs = std::dynamic_pointer_cast<SimpleVector>(args.child(0));
+    Assert(strs != nullptr);
+    CheckVarcharOrStringType(strs);
+    auto patterns = std::dynamic_pointer_cast<SimpleVector>(args.child(1));
+    Assert(patterns != nullptr);
+    CheckVarcharOrStringType(patterns);
+
+    TargetBitmap bitmap(strs->size(), false);
+    TargetBitmap valid_bitmap(strs->size(), true);
+    // the pattern is a constant in most cases, avoid compiling it for every row.
+    std::unique_ptr<RE2> regex;
+    for (size_t i = 0; i < strs->size(); ++i) {
+        if (strs->ValidAt(i) && patterns->ValidAt(i)) {
+            auto* str_ptr = reinterpret_cast<std::string*>(
+                strs->RawValueAt(i, sizeof(std::string)));
+            auto* pattern_ptr = reinterpret_cast<std::string*>(
+                patterns->RawValueAt(i, sizeof(std::string)));
+            if (regex == nullptr || regex->pattern() != *pattern_ptr) {
+                regex = std::make_unique<RE2>(*pattern_ptr, RE2::Quiet);
+                if (!regex->ok()) {
+                    ThrowInfo(ExprInvalid,
+                              "invalid regular expression {}: {}",
+                              *pattern_ptr,
+                              regex->error());
+                }
+            }
+            bitmap.set(i, RE2::PartialMatch(*str_ptr, *regex));
+        } else {
+            valid_bitmap[i] = false;
+        }
+    }
+    result = std::make_shared<ColumnVector>(std::move(bitmap),
+                                            std::move(valid_bitmap));
+}
+
+}  // namespace function
+}  // namespace expression
+}  // namespace exec
+}  // namespace milvus
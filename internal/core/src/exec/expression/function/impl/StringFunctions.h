@@ -29,6 +29,15 @@ EmptyVarchar(const RowVector& args, FilterFunctionReturn& result);
 void
 StartsWithVarchar(const RowVector& args, FilterFunctionReturn& result);
 
+void
+EndsWithVarchar(const RowVector& args, FilterFunctionReturn& result);
+
+void
+RegexMatchVarchar(const RowVector& args, FilterFunctionReturn& result);
+
+void
+LengthVarchar(const RowVector& args, FilterFunctionReturn& result);
+
 }  // namespace function
 }  // namespace expression
 }  // namespace exec
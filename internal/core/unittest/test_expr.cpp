@@ -2605,6 +2605,91 @@ TEST_P(ExprTest, TestCall) {
     }
 }
 
+TEST_P(ExprTest, TestCallLengthJson) {
+    milvus::exec::expression::FunctionFactory& factory =
+        milvus::exec::expression::FunctionFactory::Instance();
+    factory.Initialize();
+
+    auto schema = std::make_shared<Schema>();
+    auto vec_fid = schema->AddDebugField("fakevec", data_type, 16, metric_type);
+    auto i64_fid = schema->AddDebugField("id", DataType::INT64);
+    auto json_fid = schema->AddDebugField("json", DataType::JSON);
+    schema->set_primary_field_id(i64_fid);
+
+    auto seg = CreateGrowingSegment(schema, empty_index_meta);
+    int N = 1000;
+    auto raw_data = DataGen(schema, N);
+    auto json_col = raw_data.get_col<std::string>(json_fid);
+    seg->PreInsert(N);
+    seg->Insert(0,
+                N,
+                raw_data.row_ids_.data(),
+                raw_data.timestamps_.data(),
+                raw_data.raw_);
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+
+    auto raw_plan_tmp = R"(vector_anns: <
+                field_id: 100
+                predicates: <
+                  call_expr: <
+                    function_name: "length"
+                    function_parameters: <
+                        column_expr: <
+                            info: <
+                                field_id: 102
+                                data_type: JSON
+                                nested_path: "@@@@"
+                            >
+                        >
+                    >
+                    function_parameters: <
+                        value_expr: <
+                            value: <
+                                int64_val: 0
+                            >
+                        >
+                    >
+                    function_parameters: <
+                        value_expr: <
+                            value: <
+                                int64_val: 9
+                            >
+                        >
+                    >
+                  >
+                >
+                query_info: <
+                  topk: 10
+                  round_decimal: 3
+                  metric_type: "L2"
+                  search_params: "{\"nprobe\": 10}"
+                >
+                placeholder_tag: "$0"
+     >)";
+
+    // the length of the strings are compared, the other values never match
+    for (std::string key : {"string", "int"}) {
+        auto raw_plan = std::string(raw_plan_tmp);
+        raw_plan.replace(raw_plan.find("@@@@"), 4, key);
+        auto plan_str = translate_text_plan_with_metric_type(raw_plan);
+        auto plan =
+            CreateSearchPlanByExpr(schema, plan_str.data(), plan_str.size());
+        BitsetType final = ExecuteQueryExpr(
+            plan->plan_node_->plannodes_->sources()[0]->sources()[0],
+            seg_promote,
+            N,
+            MAX_TIMESTAMP);
+        EXPECT_EQ(final.size(), N);
+
+        for (int i = 0; i < N; ++i) {
+            auto json = milvus::Json(simdjson::padded_string(json_col[i]));
+            auto value = json.at<std::string_view>("/" + key);
+            auto ref = !value.error() && value.value().size() <= 9;
+            ASSERT_EQ(final[i], ref) << key << "@" << i << "!!" << json_col[i];
+        }
+    }
+}
+
 TEST_P(ExprTest, TestCompare) {
     std::vector<std::tuple<std::string, std::function<bool(int, int64_t)>>>
         testcases = {
@@ -237,3 +237,113 @@ TEST_F(FunctionTest, StartsWithIncorrectArgs) {
     milvus::RowVector three_args(arg_vec);
     EXPECT_ANY_THROW(StartsWithVarchar(three_args, result));
 }
+
+TEST_F(FunctionTest, EndsWithColumnAndConstantVector) {
+    std::vector<milvus::VectorPtr> arg_vec;
+
+    auto col1 = std::make_shared<milvus::ColumnVector>(milvus::DataType::STRING,
+                                                       STARTS_WITH_ROW_COUNT);
+    InitStrsForStartWith(col1);
+    arg_vec.push_back(col1);
+
+    const std::string constant_str = "aa";
+    auto col2 = std::make_shared<milvus::ConstantVector<std::string>>(
+        milvus::DataType::STRING, STARTS_WITH_ROW_COUNT, constant_str);
+    arg_vec.push_back(col2);
+
+    milvus::RowVector args(std::move(arg_vec));
+
+    bool valid[STARTS_WITH_ROW_COUNT] = {
+        true, true, false, true, true, true, true, true};
+    bool expected[STARTS_WITH_ROW_COUNT] = {
+        false, false, false, true, true, false, false, false};
+
+    VectorPtr result;
+    EndsWithVarchar(args, result);
+    StartWithCheck(result, valid, expected);
+}
+
+TEST_F(FunctionTest, RegexMatchColumnAndConstantVector) {
+    std::vector<milvus::VectorPtr> arg_vec;
+
+    auto col1 = std::make_shared<milvus::ColumnVector>(milvus::DataType::STRING,
+                                                       STARTS_WITH_ROW_COUNT);
+    InitStrsForStartWith(col1);
+    arg_vec.push_back(col1);
+
+    const std::string constant_str = "(?i)^A+B{3}|^1$";
+    auto col2 = std::make_shared<milvus::ConstantVector<std::string>>(
+        milvus::DataType::STRING, STARTS_WITH_ROW_COUNT, constant_str);
+    arg_vec.push_back(col2);
+
+    milvus::RowVector args(std::move(arg_vec));
+
+    bool valid[STARTS_WITH_ROW_COUNT] = {
+        true, true, false, true, true, true, true, true};
+    bool expected[STARTS_WITH_ROW_COUNT] = {
+        false, false, false, true, true, false, false, true};
+
+    VectorPtr result;
+    RegexMatchVarchar(args, result);
+    StartWithCheck(result, valid, expected);
+}
+
+TEST_F(FunctionTest, RegexMatchIncorrectArgs) {
+    VectorPtr result;
+
+    std::vector<milvus::VectorPtr> arg_vec;
+    arg_vec.push_back(std::make_shared<milvus::ColumnVector>(
+        milvus::DataType::STRING, 15));
+    milvus::RowVector single_args(arg_vec);
+    EXPECT_ANY_THROW(RegexMatchVarchar(single_args, result));
+
+    // invalid pattern
+    arg_vec.push_back(std::make_shared<milvus::ConstantVector<std::string>>(
+        milvus::DataType::STRING, 15, "a(b"));
+    milvus::RowVector invalid_pattern_args(arg_vec);
+    EXPECT_ANY_THROW(RegexMatchVarchar(invalid_pattern_args, result));
+}
+
+TEST_F(FunctionTest, LengthColumnAndConstantVector) {
+    std::vector<milvus::VectorPtr> arg_vec;
+
+    auto col1 = std::make_shared<milvus::ColumnVector>(milvus::DataType::STRING,
+                                                       STARTS_WITH_ROW_COUNT);
+    InitStrsForStartWith(col1);
+    // 3 characters in 9 bytes
+    col1->RawAsValues<std::string>()[6] = "你好吗";
+    arg_vec.push_back(col1);
+    arg_vec.push_back(std::make_shared<milvus::ConstantVector<int64_t>>(
+        milvus::DataType::INT64, STARTS_WITH_ROW_COUNT, 2));
+    arg_vec.push_back(std::make_shared<milvus::ConstantVector<int64_t>>(
+        milvus::DataType::INT64, STARTS_WITH_ROW_COUNT, 3));
+
+    milvus::RowVector args(std::move(arg_vec));
+
+    bool valid[STARTS_WITH_ROW_COUNT] = {
+        true, true, false, true, true, true, true, true};
+    bool expected[STARTS_WITH_ROW_COUNT] = {
+        true, false, false, false, false, true, true, false};
+
+    VectorPtr result;
+    LengthVarchar(args, result);
+    StartWithCheck(result, valid, expected);
+}
+
+TEST_F(FunctionTest, LengthIncorrectArgs) {
+    VectorPtr result;
+
+    std::vector<milvus::VectorPtr> arg_vec;
+    arg_vec.push_back(std::make_shared<milvus::ColumnVector>(
+        milvus::DataType::STRING, 15));
+    arg_vec.push_back(std::make_shared<milvus::ConstantVector<int64_t>>(
+        milvus::DataType::INT64, 15, 0));
+    milvus::RowVector two_args(arg_vec);
+    EXPECT_ANY_THROW(LengthVarchar(two_args, result));
+
+    // the range must be integers
+    arg_vec.push_back(std::make_shared<milvus::ConstantVector<std::string>>(
+        milvus::DataType::STRING, 15, "3"));
+    milvus::RowVector string_range_args(arg_vec);
+    EXPECT_ANY_THROW(LengthVarchar(string_range_args, result));
+}
@@ -0,0 +1,251 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/datacoord/allocator"
+	"github.com/milvus-io/milvus/internal/datacoord/task"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+)
+
+type ExportChecker interface {
+	Start()
+	Close()
+}
+
+// exportChecker drives the export jobs: it splits the pending jobs into tasks,
+// enqueues the tasks into the global scheduler, and updates the job states by the task states.
+type exportChecker struct {
+	ctx        context.Context
+	meta       *meta
+	alloc      allocator.Allocator
+	exportMeta ExportMeta
+	scheduler  task.GlobalScheduler
+
+	closeOnce sync.Once
+	closeChan chan struct{}
+}
+
+func NewExportChecker(ctx context.Context,
+	meta *meta,
+	alloc allocator.Allocator,
+	exportMeta ExportMeta,
+	scheduler task.GlobalScheduler,
+) ExportChecker {
+	return &exportChecker{
+		ctx:        ctx,
+		meta:       meta,
+		alloc:      alloc,
+		exportMeta: exportMeta,
+		scheduler:  scheduler,
+		closeChan:  make(chan struct{}),
+	}
+}
+
+func (c *exportChecker) Start() {
+	c.reloadFromMeta()
+	log.Info("start export checker")
+	ticker := time.NewTicker(Params.DataCoordCfg.ExportCheckInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.closeChan:
+			log.Info("export checker exited")
+			return
+		case <-ticker.C:
+			c.check()
+		}
+	}
+}
+
+func (c *exportChecker) Close() {
+	c.closeOnce.Do(func() {
+		close(c.closeChan)
+	})
+}
+
+func (c *exportChecker) reloadFromMeta() {
+	tasks := c.exportMeta.GetTaskBy(c.ctx, WithExportTaskStates(datapb.ImportTaskStateV2_InProgress))
+	for _, t := range tasks {
+		c.scheduler.Enqueue(t)
+	}
+}
+
+func (c *exportChecker) check() {
+	jobs := c.exportMeta.GetJobBy(c.ctx)
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].GetJobID() < jobs[j].GetJobID()
+	})
+	for _, job := range jobs {
+		switch job.GetState() {
+		case internalpb.ExportJobState_ExportPending:
+			c.checkPendingJob(job)
+		case internalpb.ExportJobState_Exporting:
+			c.checkExportingJob(job)
+		case internalpb.ExportJobState_ExportFailed:
+			c.checkFailedJob(job)
+		}
+		c.checkGC(job)
+	}
+}
+
+func (c *exportChecker) checkPendingJob(job ExportJob) {
+	log := log.With(zap.Int64("jobID", job.GetJobID()))
+	if len(c.exportMeta.GetTaskBy(c.ctx, WithExportJob(job.GetJobID()))) == 0 {
+		if len(job.GetSegmentIDs()) == 0 {
+			if !c.snapshotSegments(job) {
+				return
+			}
+			job = c.exportMeta.GetJob(c.ctx, job.GetJobID())
+		}
+		newTasks, err := NewExportTasks(job, c.meta, c.alloc, c.exportMeta)
+		if err != nil {
+			log.Warn("new export tasks failed", zap.Error(err))
+			return
+		}
+		for _, t := range newTasks {
+			err = c.exportMeta.AddTask(c.ctx, t)
+			if err != nil {
+				log.Warn("add export task failed", WrapExportTaskLog(t, zap.Error(err))...)
+				updateErr := c.exportMeta.UpdateJob(c.ctx, job.GetJobID(), UpdateExportJobState(internalpb.ExportJobState_ExportFailed),
+					UpdateExportJobReason(err.Error()))
+				if updateErr != nil {
+					log.Warn("failed to update export job state to Failed", zap.Error(updateErr))
+				}
+				return
+			}
+			log.Info("add new export task", WrapExportTaskLog(t, zap.Int64s("segmentIDs", t.GetSegmentIDs()))...)
+		}
+	}
+	err := c.exportMeta.UpdateJob(c.ctx, job.GetJobID(), UpdateExportJobState(internalpb.ExportJobState_Exporting))
+	if err != nil {
+		log.Warn("failed to update export job state to Exporting", zap.Error(err))
+		return
+	}
+	log.Info("export job start to execute", zap.Duration("jobTimeCost/pending", job.GetTR().RecordSpan()))
+}
+
+// snapshotSegments takes the snapshot of segments to export once the collection has been flushed,
+// returns false if the job isn't ready. The segments in the snapshot may be compacted during export,
+// they are kept in meta and storage by the garbage collector until the job finishes, see getExportingSegments.
+func (c *exportChecker) snapshotSegments(job ExportJob) bool {
+	log := log.With(zap.Int64("jobID", job.GetJobID())).WithRateGroup("exportChecker.snapshotSegments", 1, 60)
+	if !IsExportFlushed(c.ctx, c.meta, job) {
+		log.RatedInfo(10, "waiting for the collection to be flushed before export", zap.Uint64("flushTs", job.GetFlushTs()))
+		return false
+	}
+	segmentIDs := SelectExportSegments(c.ctx, c.meta, job)
+	err := c.exportMeta.UpdateJob(c.ctx, job.GetJobID(), UpdateExportJobSegmentIDs(segmentIDs))
+	if err != nil {
+		log.Warn("failed to update the segments of export job", zap.Error(err))
+		return false
+	}
+	log.Info("take the snapshot of segments to export", zap.Int64s("segmentIDs", segmentIDs))
+	return true
+}
+
+func (c *exportChecker) checkExportingJob(job ExportJob) {
+	log := log.With(zap.Int64("jobID", job.GetJobID()))
+	tasks := c.exportMeta.GetTaskBy(c.ctx, WithExportJob(job.GetJobID()))
+	completed := true
+	for _, t := range tasks {
+		switch t.GetState() {
+		case datapb.ImportTaskStateV2_Pending:
+			c.scheduler.Enqueue(t)
+			completed = false
+		case datapb.ImportTaskStateV2_Completed:
+		default:
+			completed = false
+		}
+	}
+	if !completed {
+		return
+	}
+	completeTime := time.Now().Format("2006-01-02T15:04:05Z07:00")
+	err := c.exportMeta.UpdateJob(c.ctx, job.GetJobID(), UpdateExportJobState(internalpb.ExportJobState_ExportCompleted),
+		UpdateExportJobCompleteTime(completeTime))
+	if err != nil {
+		log.Warn("failed to update export job state to Completed", zap.Error(err))
+		return
+	}
+	log.Info("export job all completed", zap.Int("taskNum", len(tasks)),
+		zap.Duration("jobTimeCost/total", job.GetTR().ElapseSpan()))
+}
+
+// checkFailedJob aborts the unfinished tasks of the failed or canceled job.
+func (c *exportChecker) checkFailedJob(job ExportJob) {
+	tasks := c.exportMeta.GetTaskBy(c.ctx, WithExportJob(job.GetJobID()),
+		WithExportTaskStates(datapb.ImportTaskStateV2_Pending, datapb.ImportTaskStateV2_InProgress))
+	if len(tasks) == 0 {
+		return
+	}
+	log.Warn("export job has failed, all unfinished tasks will be aborted",
+		zap.Int64("jobID", job.GetJobID()), zap.String("reason", job.GetReason()))
+	for _, t := range tasks {
+		c.scheduler.AbortAndRemoveTask(t.GetTaskID())
+		err := c.exportMeta.UpdateTask(c.ctx, t.GetTaskID(), UpdateExportTaskState(datapb.ImportTaskStateV2_Failed),
+			UpdateExportTaskReason(job.GetReason()))
+		if err != nil {
+			log.Warn("failed to update export task state to failed", WrapExportTaskLog(t, zap.Error(err))...)
+		}
+	}
+}
+
+func (c *exportChecker) checkGC(job ExportJob) {
+	if job.GetState() != internalpb.ExportJobState_ExportCompleted &&
+		job.GetState() != internalpb.ExportJobState_ExportFailed {
+		return
+	}
+	cleanupTime := tsoutil.PhysicalTime(job.GetCleanupTs())
+	if time.Now().Before(cleanupTime) {
+		return
+	}
+	log := log.With(zap.Int64("jobID", job.GetJobID()))
+	shouldRemoveJob := true
+	for _, t := range c.exportMeta.GetTaskBy(c.ctx, WithExportJob(job.GetJobID())) {
+		if t.GetNodeID() != NullNodeID {
+			shouldRemoveJob = false
+			continue
+		}
+		err := c.exportMeta.RemoveTask(c.ctx, t.GetTaskID())
+		if err != nil {
+			log.Warn("remove export task failed during GC", WrapExportTaskLog(t, zap.Error(err))...)
+			shouldRemoveJob = false
+			continue
+		}
+		log.Info("reached GC retention, export task removed", WrapExportTaskLog(t)...)
+	}
+	if !shouldRemoveJob {
+		return
+	}
+	err := c.exportMeta.RemoveJob(c.ctx, job.GetJobID())
+	if err != nil {
+		log.Warn("remove export job failed", zap.Error(err))
+		return
+	}
+	log.Info("export job removed")
+}
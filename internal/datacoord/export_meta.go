@@ -0,0 +1,218 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/pkg/v2/taskcommon"
+	"github.com/milvus-io/milvus/pkg/v2/util/lock"
+	"github.com/milvus-io/milvus/pkg/v2/util/timerecord"
+)
+
+type ExportMeta interface {
+	AddJob(ctx context.Context, job ExportJob) error
+	UpdateJob(ctx context.Context, jobID int64, actions ...UpdateExportJobAction) error
+	GetJob(ctx context.Context, jobID int64) ExportJob
+	GetJobBy(ctx context.Context, filters ...ExportJobFilter) []ExportJob
+	CountJobBy(ctx context.Context, filters ...ExportJobFilter) int
+	RemoveJob(ctx context.Context, jobID int64) error
+
+	AddTask(ctx context.Context, task ExportTask) error
+	UpdateTask(ctx context.Context, taskID int64, actions ...UpdateExportTaskAction) error
+	GetTask(ctx context.Context, taskID int64) ExportTask
+	GetTaskBy(ctx context.Context, filters ...ExportTaskFilter) []ExportTask
+	RemoveTask(ctx context.Context, taskID int64) error
+}
+
+type exportMeta struct {
+	mu      lock.RWMutex // guards jobs and tasks
+	jobs    map[int64]ExportJob
+	tasks   map[int64]ExportTask
+	catalog metastore.DataCoordCatalog
+}
+
+func NewExportMeta(ctx context.Context, catalog metastore.DataCoordCatalog, meta *meta) (ExportMeta, error) {
+	restoredTasks, err := catalog.ListExportTasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	restoredJobs, err := catalog.ListExportJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exportMeta := &exportMeta{
+		jobs:    make(map[int64]ExportJob),
+		tasks:   make(map[int64]ExportTask),
+		catalog: catalog,
+	}
+	for _, task := range restoredTasks {
+		t := &exportTask{
+			meta:       meta,
+			exportMeta: exportMeta,
+			times:      taskcommon.NewTimes(),
+		}
+		t.task.Store(task)
+		exportMeta.tasks[task.GetTaskID()] = t
+	}
+	for _, job := range restoredJobs {
+		exportMeta.jobs[job.GetJobID()] = &exportJob{
+			ExportJob: job,
+			tr:        timerecord.NewTimeRecorder("export job"),
+		}
+	}
+	return exportMeta, nil
+}
+
+func (m *exportMeta) AddJob(ctx context.Context, job ExportJob) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	err := m.catalog.SaveExportJob(ctx, job.(*exportJob).ExportJob)
+	if err != nil {
+		return err
+	}
+	m.jobs[job.GetJobID()] = job
+	return nil
+}
+
+func (m *exportMeta) UpdateJob(ctx context.Context, jobID int64, actions ...UpdateExportJobAction) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if job, ok := m.jobs[jobID]; ok {
+		updatedJob := job.Clone()
+		for _, action := range actions {
+			action(updatedJob)
+		}
+		err := m.catalog.SaveExportJob(ctx, updatedJob.(*exportJob).ExportJob)
+		if err != nil {
+			return err
+		}
+		m.jobs[updatedJob.GetJobID()] = updatedJob
+	}
+	return nil
+}
+
+func (m *exportMeta) GetJob(ctx context.Context, jobID int64) ExportJob {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.jobs[jobID]
+}
+
+func (m *exportMeta) GetJobBy(ctx context.Context, filters ...ExportJobFilter) []ExportJob {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.getJobBy(filters...)
+}
+
+func (m *exportMeta) getJobBy(filters ...ExportJobFilter) []ExportJob {
+	ret := make([]ExportJob, 0)
+OUTER:
+	for _, job := range m.jobs {
+		for _, f := range filters {
+			if !f(job) {
+				continue OUTER
+			}
+		}
+		ret = append(ret, job)
+	}
+	return ret
+}
+
+func (m *exportMeta) CountJobBy(ctx context.Context, filters ...ExportJobFilter) int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.getJobBy(filters...))
+}
+
+func (m *exportMeta) RemoveJob(ctx context.Context, jobID int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.jobs[jobID]; ok {
+		err := m.catalog.DropExportJob(ctx, jobID)
+		if err != nil {
+			return err
+		}
+		delete(m.jobs, jobID)
+	}
+	return nil
+}
+
+func (m *exportMeta) AddTask(ctx context.Context, task ExportTask) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	err := m.catalog.SaveExportTask(ctx, task.(*exportTask).task.Load())
+	if err != nil {
+		return err
+	}
+	m.tasks[task.GetTaskID()] = task
+	return nil
+}
+
+func (m *exportMeta) UpdateTask(ctx context.Context, taskID int64, actions ...UpdateExportTaskAction) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if task, ok := m.tasks[taskID]; ok {
+		updatedTask := task.Clone()
+		for _, action := range actions {
+			action(updatedTask)
+		}
+		err := m.catalog.SaveExportTask(ctx, updatedTask.(*exportTask).task.Load())
+		if err != nil {
+			return err
+		}
+		// update memory task
+		task.(*exportTask).task.Store(updatedTask.(*exportTask).task.Load())
+	}
+	return nil
+}
+
+func (m *exportMeta) GetTask(ctx context.Context, taskID int64) ExportTask {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.tasks[taskID]
+}
+
+func (m *exportMeta) GetTaskBy(ctx context.Context, filters ...ExportTaskFilter) []ExportTask {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ret := make([]ExportTask, 0)
+OUTER:
+	for _, task := range m.tasks {
+		for _, f := range filters {
+			if !f(task) {
+				continue OUTER
+			}
+		}
+		ret = append(ret, task)
+	}
+	return ret
+}
+
+func (m *exportMeta) RemoveTask(ctx context.Context, taskID int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.tasks[taskID]; ok {
+		err := m.catalog.DropExportTask(ctx, taskID)
+		if err != nil {
+			return err
+		}
+		delete(m.tasks, taskID)
+	}
+	return nil
+}
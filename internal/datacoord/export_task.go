@@ -0,0 +1,405 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/datacoord/session"
+	"github.com/milvus-io/milvus/internal/datacoord/task"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/taskcommon"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+type ExportTaskFilter func(task ExportTask) bool
+
+func WithExportJob(jobID int64) ExportTaskFilter {
+	return func(task ExportTask) bool {
+		return task.GetJobID() == jobID
+	}
+}
+
+func WithExportTaskStates(states ...datapb.ImportTaskStateV2) ExportTaskFilter {
+	return func(task ExportTask) bool {
+		for _, state := range states {
+			if task.GetState() == state {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+type UpdateExportTaskAction func(task ExportTask)
+
+func UpdateExportTaskState(state datapb.ImportTaskStateV2) UpdateExportTaskAction {
+	return func(t ExportTask) {
+		t.(*exportTask).task.Load().State = state
+	}
+}
+
+func UpdateExportTaskReason(reason string) UpdateExportTaskAction {
+	return func(t ExportTask) {
+		t.(*exportTask).task.Load().Reason = reason
+	}
+}
+
+func UpdateExportTaskNodeID(nodeID int64) UpdateExportTaskAction {
+	return func(t ExportTask) {
+		t.(*exportTask).task.Load().NodeID = nodeID
+	}
+}
+
+func UpdateExportTaskCompleteTime(completeTime string) UpdateExportTaskAction {
+	return func(t ExportTask) {
+		t.(*exportTask).task.Load().CompleteTime = completeTime
+	}
+}
+
+func UpdateExportTaskResult(outputFiles []string, exportedRows int64) UpdateExportTaskAction {
+	return func(t ExportTask) {
+		t.(*exportTask).task.Load().OutputFiles = outputFiles
+		t.(*exportTask).task.Load().ExportedRows = exportedRows
+	}
+}
+
+type ExportTask interface {
+	task.Task
+	GetJobID() int64
+	GetCollectionID() int64
+	GetSegmentIDs() []int64
+	GetNodeID() int64
+	GetState() datapb.ImportTaskStateV2
+	GetReason() string
+	GetOutputFiles() []string
+	GetExportedRows() int64
+	GetCreatedTime() string
+	GetCompleteTime() string
+	Clone() ExportTask
+}
+
+var _ ExportTask = (*exportTask)(nil)
+
+// exportTask exports a group of sealed segments of the same vchannel,
+// the L0 segments of the vchannel are attached to apply the deletes.
+type exportTask struct {
+	task atomic.Pointer[datapb.ExportTask]
+
+	meta       *meta
+	exportMeta ExportMeta
+	times      *taskcommon.Times
+	retryTimes int64
+}
+
+func (t *exportTask) GetJobID() int64 {
+	return t.task.Load().GetJobID()
+}
+
+func (t *exportTask) GetTaskID() int64 {
+	return t.task.Load().GetTaskID()
+}
+
+func (t *exportTask) GetCollectionID() int64 {
+	return t.task.Load().GetCollectionID()
+}
+
+func (t *exportTask) GetSegmentIDs() []int64 {
+	return t.task.Load().GetSegmentIDs()
+}
+
+func (t *exportTask) GetNodeID() int64 {
+	return t.task.Load().GetNodeID()
+}
+
+func (t *exportTask) GetState() datapb.ImportTaskStateV2 {
+	return t.task.Load().GetState()
+}
+
+func (t *exportTask) GetReason() string {
+	return t.task.Load().GetReason()
+}
+
+func (t *exportTask) GetOutputFiles() []string {
+	return t.task.Load().GetOutputFiles()
+}
+
+func (t *exportTask) GetExportedRows() int64 {
+	return t.task.Load().GetExportedRows()
+}
+
+func (t *exportTask) GetCreatedTime() string {
+	return t.task.Load().GetCreatedTime()
+}
+
+func (t *exportTask) GetCompleteTime() string {
+	return t.task.Load().GetCompleteTime()
+}
+
+func (t *exportTask) GetTaskType() taskcommon.Type {
+	return taskcommon.Export
+}
+
+func (t *exportTask) GetTaskState() taskcommon.State {
+	return taskcommon.FromImportState(t.GetState())
+}
+
+func (t *exportTask) GetTaskSlot() int64 {
+	return 1
+}
+
+func (t *exportTask) SetTaskTime(timeType taskcommon.TimeType, time time.Time) {
+	t.times.SetTaskTime(timeType, time)
+}
+
+func (t *exportTask) GetTaskTime(timeType taskcommon.TimeType) time.Time {
+	return timeType.GetTaskTime(t.times)
+}
+
+func (t *exportTask) GetTaskVersion() int64 {
+	return t.retryTimes
+}
+
+func (t *exportTask) CreateTaskOnWorker(nodeID int64, cluster session.Cluster) {
+	log.Info("processing pending export task...", WrapExportTaskLog(t)...)
+	job := t.exportMeta.GetJob(context.TODO(), t.GetJobID())
+	if job == nil {
+		log.Warn("export job not found", WrapExportTaskLog(t)...)
+		return
+	}
+	req, err := AssembleExportRequest(t, job, t.meta)
+	if err != nil {
+		log.Warn("assemble export request failed", WrapExportTaskLog(t, zap.Error(err))...)
+		updateErr := t.exportMeta.UpdateJob(context.TODO(), t.GetJobID(), UpdateExportJobState(internalpb.ExportJobState_ExportFailed),
+			UpdateExportJobReason(err.Error()))
+		if updateErr != nil {
+			log.Warn("failed to update export job state to Failed", zap.Int64("jobID", t.GetJobID()), zap.Error(updateErr))
+		}
+		updateErr = t.exportMeta.UpdateTask(context.TODO(), t.GetTaskID(), UpdateExportTaskState(datapb.ImportTaskStateV2_Failed),
+			UpdateExportTaskReason(err.Error()))
+		if updateErr != nil {
+			log.Warn("failed to update export task state to Failed", WrapExportTaskLog(t, zap.Error(updateErr))...)
+		}
+		return
+	}
+	err = cluster.CreateExport(nodeID, req, t.GetTaskSlot())
+	if err != nil {
+		log.Warn("export failed", WrapExportTaskLog(t, zap.Error(err))...)
+		t.retryTimes++
+		return
+	}
+	err = t.exportMeta.UpdateTask(context.TODO(), t.GetTaskID(),
+		UpdateExportTaskState(datapb.ImportTaskStateV2_InProgress),
+		UpdateExportTaskNodeID(nodeID))
+	if err != nil {
+		log.Warn("update export task failed", WrapExportTaskLog(t, zap.Error(err))...)
+		return
+	}
+	log.Info("export task start to execute", WrapExportTaskLog(t, zap.Int64("scheduledNodeID", nodeID))...)
+}
+
+func (t *exportTask) QueryTaskOnWorker(cluster session.Cluster) {
+	req := &datapb.QueryExportRequest{
+		JobID:  t.GetJobID(),
+		TaskID: t.GetTaskID(),
+	}
+	resp, err := cluster.QueryExport(t.GetNodeID(), req)
+	if err != nil || resp.GetState() == datapb.ImportTaskStateV2_Retry {
+		updateErr := t.exportMeta.UpdateTask(context.TODO(), t.GetTaskID(), UpdateExportTaskState(datapb.ImportTaskStateV2_Pending))
+		if updateErr != nil {
+			log.Warn("failed to update export task state to pending", WrapExportTaskLog(t, zap.Error(updateErr))...)
+		}
+		log.Info("reset export task state to pending due to error occurs", WrapExportTaskLog(t, zap.Error(err), zap.String("reason", resp.GetReason()))...)
+		return
+	}
+	switch resp.GetState() {
+	case datapb.ImportTaskStateV2_Failed:
+		err = t.exportMeta.UpdateJob(context.TODO(), t.GetJobID(), UpdateExportJobState(internalpb.ExportJobState_ExportFailed),
+			UpdateExportJobReason(resp.GetReason()))
+		if err != nil {
+			log.Warn("failed to update export job state to Failed", zap.Int64("jobID", t.GetJobID()), zap.Error(err))
+		}
+		err = t.exportMeta.UpdateTask(context.TODO(), t.GetTaskID(), UpdateExportTaskState(datapb.ImportTaskStateV2_Failed),
+			UpdateExportTaskReason(resp.GetReason()))
+		if err != nil {
+			log.Warn("failed to update export task state to Failed", WrapExportTaskLog(t, zap.Error(err))...)
+		}
+		log.Warn("export failed", WrapExportTaskLog(t, zap.String("reason", resp.GetReason()))...)
+	case datapb.ImportTaskStateV2_InProgress:
+		err = t.exportMeta.UpdateTask(context.TODO(), t.GetTaskID(), UpdateExportTaskResult(resp.GetOutputFiles(), resp.GetExportedRows()))
+		if err != nil {
+			log.Warn("update export task failed", WrapExportTaskLog(t, zap.Error(err))...)
+		}
+	case datapb.ImportTaskStateV2_Completed:
+		completeTime := time.Now().Format("2006-01-02T15:04:05Z07:00")
+		err = t.exportMeta.UpdateTask(context.TODO(), t.GetTaskID(), UpdateExportTaskState(datapb.ImportTaskStateV2_Completed),
+			UpdateExportTaskResult(resp.GetOutputFiles(), resp.GetExportedRows()), UpdateExportTaskCompleteTime(completeTime))
+		if err != nil {
+			log.Warn("update export task failed", WrapExportTaskLog(t, zap.Error(err))...)
+			return
+		}
+		log.Info("export done", WrapExportTaskLog(t, zap.Int64("exportedRows", resp.GetExportedRows()),
+			zap.Strings("outputFiles", resp.GetOutputFiles()))...)
+	}
+}
+
+func (t *exportTask) DropTaskOnWorker(cluster session.Cluster) {
+	if t.GetNodeID() == NullNodeID {
+		return
+	}
+	err := cluster.DropExport(t.GetNodeID(), t.GetTaskID())
+	if err != nil && !errors.Is(err, merr.ErrNodeNotFound) {
+		log.Warn("drop export failed", WrapExportTaskLog(t, zap.Error(err))...)
+		return
+	}
+	err = t.exportMeta.UpdateTask(context.TODO(), t.GetTaskID(), UpdateExportTaskNodeID(NullNodeID))
+	if err != nil {
+		log.Warn("update export task failed", WrapExportTaskLog(t, zap.Error(err))...)
+		return
+	}
+	log.Info("drop export task done", WrapExportTaskLog(t)...)
+}
+
+func (t *exportTask) Clone() ExportTask {
+	cloned := &exportTask{
+		meta:       t.meta,
+		exportMeta: t.exportMeta,
+		times:      t.times,
+		retryTimes: t.retryTimes,
+	}
+	cloned.task.Store(typeutil.Clone(t.task.Load()))
+	return cloned
+}
+
+func WrapExportTaskLog(task ExportTask, fields ...zap.Field) []zap.Field {
+	res := []zap.Field{
+		zap.Int64("taskID", task.GetTaskID()),
+		zap.Int64("jobID", task.GetJobID()),
+		zap.Int64("collectionID", task.GetCollectionID()),
+		zap.String("state", task.GetTaskState().String()),
+		zap.Int64("nodeID", task.GetNodeID()),
+	}
+	res = append(res, fields...)
+	return res
+}
+
+// AssembleExportRequest collects the binlogs of the segments to export, along with
+// the L0 segments of the same vchannel in the snapshot of job, whose deletes are applied by the datanode.
+func AssembleExportRequest(task ExportTask, job ExportJob, meta *meta) (*datapb.ExportRequest, error) {
+	toExportSegment := func(segment *SegmentInfo) (*datapb.ExportSegment, error) {
+		// the binlogs in meta only keep the logIDs, clone the segment before filling the paths
+		cloned := segment.Clone()
+		if err := binlog.DecompressBinLogs(cloned.SegmentInfo); err != nil {
+			return nil, err
+		}
+		return &datapb.ExportSegment{
+			SegmentID:      cloned.GetID(),
+			PartitionID:    cloned.GetPartitionID(),
+			Vchannel:       cloned.GetInsertChannel(),
+			StorageVersion: cloned.GetStorageVersion(),
+			Binlogs:        cloned.GetBinlogs(),
+			Deltalogs:      cloned.GetDeltalogs(),
+		}, nil
+	}
+
+	vchannels := typeutil.NewSet[string]()
+	segments := make([]*datapb.ExportSegment, 0, len(task.GetSegmentIDs()))
+	for _, segmentID := range task.GetSegmentIDs() {
+		// the segment may have been compacted, it's kept by the garbage collector until the job finishes
+		segment := meta.GetSegment(context.TODO(), segmentID)
+		if segment == nil {
+			return nil, merr.WrapErrSegmentNotFound(segmentID, "the segment to export has been removed, "+
+				"please retry with a new export job")
+		}
+		exportSegment, err := toExportSegment(segment)
+		if err != nil {
+			return nil, err
+		}
+		vchannels.Insert(segment.GetInsertChannel())
+		segments = append(segments, exportSegment)
+	}
+
+	l0Segments := make([]*datapb.ExportSegment, 0)
+	for _, segmentID := range job.GetSegmentIDs() {
+		segment := meta.GetSegment(context.TODO(), segmentID)
+		if segment == nil || segment.GetLevel() != datapb.SegmentLevel_L0 || !vchannels.Contain(segment.GetInsertChannel()) {
+			continue
+		}
+		exportSegment, err := toExportSegment(segment)
+		if err != nil {
+			return nil, err
+		}
+		l0Segments = append(l0Segments, exportSegment)
+	}
+
+	outputFieldIDs, err := getExportFieldIDs(job)
+	if err != nil {
+		return nil, err
+	}
+	maxFileSize, err := GetExportMaxFileSize(job.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+	startTs, err := GetExportStartTs(job.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+	return &datapb.ExportRequest{
+		ClusterID:       paramtable.Get().CommonCfg.ClusterPrefix.GetValue(),
+		JobID:           task.GetJobID(),
+		TaskID:          task.GetTaskID(),
+		CollectionID:    task.GetCollectionID(),
+		Schema:          job.GetSchema(),
+		Segments:        segments,
+		L0Segments:      l0Segments,
+		ExportTimestamp: job.GetExportTimestamp(),
+		StartTimestamp:  startTs,
+		Expr:            job.GetExpr(),
+		OutputFieldIDs:  outputFieldIDs,
+		OutputPath:      job.GetOutputPath(),
+		MaxFileSize:     maxFileSize,
+		StorageConfig:   createStorageConfig(),
+		TaskSlot:        task.GetTaskSlot(),
+	}, nil
+}
+
+func getExportFieldIDs(job ExportJob) ([]int64, error) {
+	nameToID := make(map[string]int64, len(job.GetSchema().GetFields()))
+	for _, field := range job.GetSchema().GetFields() {
+		nameToID[field.GetName()] = field.GetFieldID()
+	}
+	fieldIDs := make([]int64, 0, len(job.GetOutputFields()))
+	for _, name := range job.GetOutputFields() {
+		fieldID, ok := nameToID[name]
+		if !ok {
+			return nil, merr.WrapErrParameterInvalidMsg(fmt.Sprintf("output field '%s' does not exist", name))
+		}
+		fieldIDs = append(fieldIDs, fieldID)
+	}
+	return fieldIDs, nil
+}
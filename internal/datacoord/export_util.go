@@ -0,0 +1,243 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/datacoord/allocator"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/taskcommon"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+const (
+	// ExportMaxFileSize is the option to roll the output file once its size reaches the limit in bytes,
+	// it can't exceed dataCoord.export.maxFileSizeInMB, which is used if it's not specified or 0.
+	ExportMaxFileSize = "max_file_size"
+
+	// ExportStartTs is the option of incremental export, only the rows inserted after it are exported.
+	// Notice that the rows which have been exported before and deleted later are not reported.
+	ExportStartTs = "start_ts"
+)
+
+// GetExportMaxFileSize returns the max size of the output files, the output file is buffered
+// in the memory of datanode, so the size is limited by dataCoord.export.maxFileSizeInMB.
+func GetExportMaxFileSize(options []*commonpb.KeyValuePair) (int64, error) {
+	limit := Params.DataCoordCfg.ExportMaxFileSize.GetAsInt64()
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(ExportMaxFileSize, options)
+	if err != nil {
+		return limit, nil
+	}
+	size, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || size < 0 {
+		return 0, merr.WrapErrParameterInvalidMsg(fmt.Sprintf("invalid export option %s=%s", ExportMaxFileSize, value))
+	}
+	if size > limit {
+		return 0, merr.WrapErrParameterInvalidMsg(fmt.Sprintf("export option %s=%s exceeds the limit %d", ExportMaxFileSize, value, limit))
+	}
+	if size == 0 {
+		return limit, nil
+	}
+	return size, nil
+}
+
+func GetExportStartTs(options []*commonpb.KeyValuePair) (uint64, error) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(ExportStartTs, options)
+	if err != nil {
+		return 0, nil
+	}
+	ts, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, merr.WrapErrParameterInvalidMsg(fmt.Sprintf("invalid export option %s=%s", ExportStartTs, value))
+	}
+	return ts, nil
+}
+
+// GetExportOutputPath resolves the output path of the export job under the export root path,
+// the output path of the request must be a relative path without "..", and the job id is used if it's empty.
+// The datanodes write the files with the storage credentials of milvus, so the output path is restricted
+// to keep the files of milvus away from the users.
+func GetExportOutputPath(storageRootPath string, outputPath string, jobID int64) (string, error) {
+	exportRoot := path.Join(storageRootPath, Params.DataCoordCfg.ExportRootPath.GetValue())
+	if outputPath == "" {
+		return path.Join(exportRoot, strconv.FormatInt(jobID, 10)), nil
+	}
+	if path.IsAbs(outputPath) || strings.Contains(outputPath, "\\") {
+		return "", merr.WrapErrParameterInvalidMsg(fmt.Sprintf("output path %s should be a relative path under the export root path", outputPath))
+	}
+	for _, elem := range strings.Split(outputPath, "/") {
+		if elem == ".." {
+			return "", merr.WrapErrParameterInvalidMsg(fmt.Sprintf("output path %s should not contain ..", outputPath))
+		}
+	}
+	resolved := path.Join(exportRoot, outputPath)
+	if resolved == exportRoot {
+		return "", merr.WrapErrParameterInvalidMsg(fmt.Sprintf("output path %s should not be the export root path", outputPath))
+	}
+	return resolved, nil
+}
+
+func ValidateMaxExportJobExceed(ctx context.Context, exportMeta ExportMeta) error {
+	maxNum := Params.DataCoordCfg.MaxExportJobNum.GetAsInt()
+	executingNum := exportMeta.CountJobBy(ctx, WithExportJobStates(internalpb.ExportJobState_ExportPending,
+		internalpb.ExportJobState_Exporting))
+	if executingNum >= maxNum {
+		return merr.WrapErrServiceQuotaExceeded(
+			fmt.Sprintf("the number of executing export jobs has reached the limit %d, please try again later", maxNum))
+	}
+	return nil
+}
+
+// IsExportFlushed returns true if the collection has been flushed for the export job, i.e. the segments
+// sealed by the flush are flushed and the checkpoints of all vchannels have passed the flush timestamp,
+// so that all the rows visible at the export timestamp are in the flushed segments.
+func IsExportFlushed(ctx context.Context, meta *meta, job ExportJob) bool {
+	for _, segmentID := range job.GetSealedSegmentIDs() {
+		segment := meta.GetHealthySegment(ctx, segmentID)
+		// segment is nil if it was compacted, or it's an empty segment and is set to dropped
+		if segment != nil && segment.GetState() != commonpb.SegmentState_Flushed {
+			return false
+		}
+	}
+	flushTs := max(job.GetFlushTs(), job.GetExportTimestamp())
+	for _, vchannel := range job.GetVchannels() {
+		cp := meta.GetChannelCheckpoint(vchannel)
+		if cp == nil || cp.GetTimestamp() < flushTs {
+			return false
+		}
+	}
+	return true
+}
+
+// SelectExportSegments returns the flushed segments of the partitions to export,
+// the L0 segments of the whole collection are included to apply the deletes.
+func SelectExportSegments(ctx context.Context, meta *meta, job ExportJob) []int64 {
+	partitionIDs := typeutil.NewSet(job.GetPartitionIDs()...)
+	segments := meta.SelectSegments(ctx, WithCollection(job.GetCollectionID()), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		if !isSegmentHealthy(segment) || segment.GetState() != commonpb.SegmentState_Flushed ||
+			segment.GetIsImporting() || segment.GetIsInvisible() {
+			return false
+		}
+		if partitionIDs.Len() == 0 || partitionIDs.Contain(segment.GetPartitionID()) {
+			return true
+		}
+		return segment.GetLevel() == datapb.SegmentLevel_L0 && segment.GetPartitionID() == common.AllPartitionsID
+	}))
+	segmentIDs := lo.Map(segments, func(segment *SegmentInfo, _ int) int64 {
+		return segment.GetID()
+	})
+	sort.Slice(segmentIDs, func(i, j int) bool {
+		return segmentIDs[i] < segmentIDs[j]
+	})
+	return segmentIDs
+}
+
+// NewExportTasks splits the sealed segments of the job into tasks, each task exports
+// at most MaxSegmentNumPerExportTask segments of the same vchannel.
+func NewExportTasks(job ExportJob, meta *meta, alloc allocator.Allocator, exportMeta ExportMeta) ([]ExportTask, error) {
+	segments := lo.Filter(meta.GetSegmentInfos(job.GetSegmentIDs()), func(segment *SegmentInfo, _ int) bool {
+		return segment.GetLevel() != datapb.SegmentLevel_L0
+	})
+	byChannel := lo.GroupBy(segments, func(segment *SegmentInfo) string {
+		return segment.GetInsertChannel()
+	})
+	channels := lo.Keys(byChannel)
+	sort.Strings(channels)
+
+	groups := make([][]int64, 0)
+	for _, channel := range channels {
+		segmentIDs := lo.Map(byChannel[channel], func(segment *SegmentInfo, _ int) int64 {
+			return segment.GetID()
+		})
+		sort.Slice(segmentIDs, func(i, j int) bool {
+			return segmentIDs[i] < segmentIDs[j]
+		})
+		groups = append(groups, lo.Chunk(segmentIDs, Params.DataCoordCfg.MaxSegmentNumPerExportTask.GetAsInt())...)
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	idStart, _, err := alloc.AllocN(int64(len(groups)))
+	if err != nil {
+		return nil, err
+	}
+	tasks := make([]ExportTask, 0, len(groups))
+	for i, segmentIDs := range groups {
+		t := &exportTask{
+			meta:       meta,
+			exportMeta: exportMeta,
+			times:      taskcommon.NewTimes(),
+		}
+		t.task.Store(&datapb.ExportTask{
+			JobID:        job.GetJobID(),
+			TaskID:       idStart + int64(i),
+			CollectionID: job.GetCollectionID(),
+			SegmentIDs:   segmentIDs,
+			NodeID:       NullNodeID,
+			State:        datapb.ImportTaskStateV2_Pending,
+			CreatedTime:  time.Now().Format("2006-01-02T15:04:05Z07:00"),
+		})
+		tasks = append(tasks, t)
+	}
+	return tasks, nil
+}
+
+// GetExportJobProgress returns the progress, output files and exported rows of job,
+// the progress is the percentage of the exported segments.
+func GetExportJobProgress(ctx context.Context, job ExportJob, exportMeta ExportMeta) (int64, []string, int64) {
+	tasks := exportMeta.GetTaskBy(ctx, WithExportJob(job.GetJobID()))
+	outputFiles := make([]string, 0)
+	exportedRows := int64(0)
+	totalSegments, exportedSegments := 0, 0
+	for _, t := range tasks {
+		outputFiles = append(outputFiles, t.GetOutputFiles()...)
+		exportedRows += t.GetExportedRows()
+		totalSegments += len(t.GetSegmentIDs())
+		if t.GetState() == datapb.ImportTaskStateV2_Completed {
+			exportedSegments += len(t.GetSegmentIDs())
+		}
+	}
+	sort.Strings(outputFiles)
+
+	switch job.GetState() {
+	case internalpb.ExportJobState_ExportCompleted:
+		return 100, outputFiles, exportedRows
+	case internalpb.ExportJobState_Exporting:
+		if totalSegments == 0 {
+			return 0, outputFiles, exportedRows
+		}
+		// the job is completed until all the tasks are dropped from the workers
+		return min(int64(exportedSegments*100/totalSegments), 99), outputFiles, exportedRows
+	default:
+		return 0, outputFiles, exportedRows
+	}
+}
@@ -0,0 +1,249 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/datacoord/allocator"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+)
+
+func TestExportUtil_GetExportOptions(t *testing.T) {
+	limit := Params.DataCoordCfg.ExportMaxFileSize.GetAsInt64()
+	size, err := GetExportMaxFileSize(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, limit, size)
+	size, err = GetExportMaxFileSize([]*commonpb.KeyValuePair{{Key: ExportMaxFileSize, Value: "0"}})
+	assert.NoError(t, err)
+	assert.Equal(t, limit, size)
+	size, err = GetExportMaxFileSize([]*commonpb.KeyValuePair{{Key: ExportMaxFileSize, Value: "1024"}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1024), size)
+	_, err = GetExportMaxFileSize([]*commonpb.KeyValuePair{{Key: ExportMaxFileSize, Value: strconv.FormatInt(limit+1, 10)}})
+	assert.Error(t, err)
+	_, err = GetExportMaxFileSize([]*commonpb.KeyValuePair{{Key: ExportMaxFileSize, Value: "-1"}})
+	assert.Error(t, err)
+	_, err = GetExportMaxFileSize([]*commonpb.KeyValuePair{{Key: ExportMaxFileSize, Value: "abc"}})
+	assert.Error(t, err)
+
+	ts, err := GetExportStartTs(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), ts)
+	ts, err = GetExportStartTs([]*commonpb.KeyValuePair{{Key: ExportStartTs, Value: "449835349350711297"}})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(449835349350711297), ts)
+	_, err = GetExportStartTs([]*commonpb.KeyValuePair{{Key: ExportStartTs, Value: "-1"}})
+	assert.Error(t, err)
+}
+
+func TestExportUtil_GetExportOutputPath(t *testing.T) {
+	outputPath, err := GetExportOutputPath("files", "", 100)
+	assert.NoError(t, err)
+	assert.Equal(t, "files/export/100", outputPath)
+	outputPath, err = GetExportOutputPath("files", "backup/2024/", 100)
+	assert.NoError(t, err)
+	assert.Equal(t, "files/export/backup/2024", outputPath)
+
+	for _, invalid := range []string{"/files/insert_log", "../insert_log", "backup/../../insert_log", ".", "backup\\..\\x"} {
+		_, err = GetExportOutputPath("files", invalid, 100)
+		assert.Error(t, err, invalid)
+	}
+
+	Params.Save(Params.DataCoordCfg.ExportRootPath.Key, "dump")
+	defer Params.Reset(Params.DataCoordCfg.ExportRootPath.Key)
+	outputPath, err = GetExportOutputPath("files", "backup", 100)
+	assert.NoError(t, err)
+	assert.Equal(t, "files/dump/backup", outputPath)
+}
+
+func TestExportUtil_ValidateMaxExportJobExceed(t *testing.T) {
+	catalog := mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListExportJobs(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListExportTasks(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().SaveExportJob(mock.Anything, mock.Anything).Return(nil)
+
+	exportMeta, err := NewExportMeta(context.TODO(), catalog, nil)
+	assert.NoError(t, err)
+
+	Params.Save(Params.DataCoordCfg.MaxExportJobNum.Key, "1")
+	defer Params.Reset(Params.DataCoordCfg.MaxExportJobNum.Key)
+
+	assert.NoError(t, ValidateMaxExportJobExceed(context.TODO(), exportMeta))
+	err = exportMeta.AddJob(context.TODO(), &exportJob{
+		ExportJob: &datapb.ExportJob{
+			JobID: 1,
+			State: internalpb.ExportJobState_Exporting,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Error(t, ValidateMaxExportJobExceed(context.TODO(), exportMeta))
+}
+
+func TestExportUtil_SelectExportSegments(t *testing.T) {
+	meta := &meta{segments: NewSegmentsInfo(), channelCPs: newChannelCps()}
+	segments := []*datapb.SegmentInfo{
+		{ID: 10, CollectionID: 1, PartitionID: 100, State: commonpb.SegmentState_Flushed},
+		{ID: 11, CollectionID: 1, PartitionID: 101, State: commonpb.SegmentState_Flushed},
+		{ID: 12, CollectionID: 1, PartitionID: 100, State: commonpb.SegmentState_Growing},
+		{ID: 13, CollectionID: 1, PartitionID: 100, State: commonpb.SegmentState_Dropped},
+		{ID: 14, CollectionID: 1, PartitionID: 100, State: commonpb.SegmentState_Flushed, IsImporting: true},
+		{ID: 15, CollectionID: 1, PartitionID: common.AllPartitionsID, State: commonpb.SegmentState_Flushed, Level: datapb.SegmentLevel_L0},
+		{ID: 16, CollectionID: 2, PartitionID: 200, State: commonpb.SegmentState_Flushed},
+	}
+	for _, segment := range segments {
+		meta.segments.SetSegment(segment.GetID(), NewSegmentInfo(segment))
+	}
+
+	job := &exportJob{ExportJob: &datapb.ExportJob{CollectionID: 1}}
+	assert.Equal(t, []int64{10, 11, 15}, SelectExportSegments(context.TODO(), meta, job))
+	job.PartitionIDs = []int64{101}
+	assert.Equal(t, []int64{11, 15}, SelectExportSegments(context.TODO(), meta, job))
+}
+
+func TestExportUtil_IsExportFlushed(t *testing.T) {
+	meta := &meta{segments: NewSegmentsInfo(), channelCPs: newChannelCps()}
+	meta.segments.SetSegment(10, NewSegmentInfo(&datapb.SegmentInfo{ID: 10, State: commonpb.SegmentState_Sealed}))
+	meta.channelCPs.checkpoints["ch0"] = &msgpb.MsgPosition{ChannelName: "ch0", Timestamp: 90}
+
+	job := &exportJob{
+		ExportJob: &datapb.ExportJob{
+			ExportTimestamp:  90,
+			FlushTs:          100,
+			SealedSegmentIDs: []int64{10, 11},
+			Vchannels:        []string{"ch0", "ch1"},
+		},
+	}
+	// the sealed segment isn't flushed
+	assert.False(t, IsExportFlushed(context.TODO(), meta, job))
+
+	meta.segments.SetSegment(10, NewSegmentInfo(&datapb.SegmentInfo{ID: 10, State: commonpb.SegmentState_Flushed}))
+	// the checkpoint is behind the flush ts, and the checkpoint of ch1 is missing
+	assert.False(t, IsExportFlushed(context.TODO(), meta, job))
+	meta.channelCPs.checkpoints["ch0"] = &msgpb.MsgPosition{ChannelName: "ch0", Timestamp: 100}
+	assert.False(t, IsExportFlushed(context.TODO(), meta, job))
+	meta.channelCPs.checkpoints["ch1"] = &msgpb.MsgPosition{ChannelName: "ch1", Timestamp: 101}
+	assert.True(t, IsExportFlushed(context.TODO(), meta, job))
+}
+
+func TestExportUtil_NewExportTasks(t *testing.T) {
+	Params.Save(Params.DataCoordCfg.MaxSegmentNumPerExportTask.Key, "2")
+	defer Params.Reset(Params.DataCoordCfg.MaxSegmentNumPerExportTask.Key)
+
+	meta := &meta{segments: NewSegmentsInfo()}
+	for id, channel := range map[int64]string{10: "ch0", 11: "ch0", 12: "ch0", 13: "ch1"} {
+		meta.segments.SetSegment(id, NewSegmentInfo(&datapb.SegmentInfo{ID: id, InsertChannel: channel, State: commonpb.SegmentState_Flushed}))
+	}
+	meta.segments.SetSegment(14, NewSegmentInfo(&datapb.SegmentInfo{ID: 14, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed, Level: datapb.SegmentLevel_L0}))
+
+	alloc := allocator.NewMockAllocator(t)
+	alloc.EXPECT().AllocN(mock.Anything).RunAndReturn(func(n int64) (int64, int64, error) {
+		id := rand.Int63()
+		return id, id + n, nil
+	})
+	job := &exportJob{
+		ExportJob: &datapb.ExportJob{
+			JobID:        1,
+			CollectionID: 2,
+			SegmentIDs:   []int64{10, 11, 12, 13, 14, 100},
+		},
+	}
+	tasks, err := NewExportTasks(job, meta, alloc, nil)
+	assert.NoError(t, err)
+	// the L0 segments and the missing segments are skipped
+	assert.Equal(t, 3, len(tasks))
+	assert.Equal(t, []int64{10, 11}, tasks[0].GetSegmentIDs())
+	assert.Equal(t, []int64{12}, tasks[1].GetSegmentIDs())
+	assert.Equal(t, []int64{13}, tasks[2].GetSegmentIDs())
+
+	job.SegmentIDs = []int64{14}
+	tasks, err = NewExportTasks(job, meta, alloc, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(tasks))
+}
+
+func TestExportUtil_GetExportJobProgress(t *testing.T) {
+	catalog := mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListExportJobs(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListExportTasks(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().SaveExportJob(mock.Anything, mock.Anything).Return(nil)
+	catalog.EXPECT().SaveExportTask(mock.Anything, mock.Anything).Return(nil)
+
+	exportMeta, err := NewExportMeta(context.TODO(), catalog, nil)
+	assert.NoError(t, err)
+
+	job := &exportJob{
+		ExportJob: &datapb.ExportJob{
+			JobID:      1,
+			SegmentIDs: []int64{10, 11, 12, 13},
+			State:      internalpb.ExportJobState_ExportPending,
+		},
+	}
+	err = exportMeta.AddJob(context.TODO(), job)
+	assert.NoError(t, err)
+
+	progress, files, rows := GetExportJobProgress(context.TODO(), job, exportMeta)
+	assert.Equal(t, int64(0), progress)
+	assert.Equal(t, 0, len(files))
+	assert.Equal(t, int64(0), rows)
+
+	t1 := &exportTask{}
+	t1.task.Store(&datapb.ExportTask{
+		JobID:        1,
+		TaskID:       2,
+		SegmentIDs:   []int64{10, 11},
+		State:        datapb.ImportTaskStateV2_Completed,
+		OutputFiles:  []string{"export/1/11_0.parquet", "export/1/10_0.parquet"},
+		ExportedRows: 100,
+	})
+	err = exportMeta.AddTask(context.TODO(), t1)
+	assert.NoError(t, err)
+	t2 := &exportTask{}
+	t2.task.Store(&datapb.ExportTask{
+		JobID:        1,
+		TaskID:       3,
+		SegmentIDs:   []int64{12, 13},
+		State:        datapb.ImportTaskStateV2_InProgress,
+		OutputFiles:  []string{"export/1/12_0.parquet"},
+		ExportedRows: 20,
+	})
+	err = exportMeta.AddTask(context.TODO(), t2)
+	assert.NoError(t, err)
+
+	err = exportMeta.UpdateJob(context.TODO(), job.GetJobID(), UpdateExportJobState(internalpb.ExportJobState_Exporting))
+	assert.NoError(t, err)
+	progress, files, rows = GetExportJobProgress(context.TODO(), exportMeta.GetJob(context.TODO(), 1), exportMeta)
+	assert.Equal(t, int64(50), progress)
+	assert.Equal(t, []string{"export/1/10_0.parquet", "export/1/11_0.parquet", "export/1/12_0.parquet"}, files)
+	assert.Equal(t, int64(120), rows)
+
+	err = exportMeta.UpdateJob(context.TODO(), job.GetJobID(), UpdateExportJobState(internalpb.ExportJobState_ExportCompleted))
+	assert.NoError(t, err)
+	progress, _, _ = GetExportJobProgress(context.TODO(), exportMeta.GetJob(context.TODO(), 1), exportMeta)
+	assert.Equal(t, int64(100), progress)
+}
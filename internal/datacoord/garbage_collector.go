@@ -37,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/conc"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/hardware"
@@ -56,6 +57,7 @@ type GcOption struct {
 	scanInterval     time.Duration        // interval for scan residue for interupted log wrttien
 
 	broker           broker.Broker
+	exportMeta       ExportMeta
 	removeObjectPool *conc.Pool[struct{}]
 }
 
@@ -503,6 +505,8 @@ func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
 		loadedSegments.Insert(segmentID)
 	}
 
+	exportingSegments := gc.getExportingSegments(ctx)
+
 	log.Info("start to GC segments", zap.Int("drop_num", len(drops)))
 	for segmentID, segment := range drops {
 		if ctx.Err() != nil {
@@ -516,6 +520,10 @@ func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
 			log.Info("skip GC segment since it is loaded", zap.Int64("segmentID", segmentID))
 			continue
 		}
+		if exportingSegments.Contain(segmentID) {
+			log.Info("skip GC segment since it is being exported", zap.Int64("segmentID", segmentID))
+			continue
+		}
 		if !gc.checkDroppedSegmentGC(segment, compactTo[segment.GetID()], indexedSet, channelCPs[segInsertChannel]) {
 			continue
 		}
@@ -554,6 +562,21 @@ func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
 	}
 }
 
+// getExportingSegments returns the segments in the snapshots of the unfinished export jobs,
+// which are read by the datanodes even if they have been compacted.
+func (gc *garbageCollector) getExportingSegments(ctx context.Context) typeutil.UniqueSet {
+	segments := make(typeutil.UniqueSet)
+	if gc.option.exportMeta == nil {
+		return segments
+	}
+	jobs := gc.option.exportMeta.GetJobBy(ctx, WithExportJobStates(internalpb.ExportJobState_ExportPending,
+		internalpb.ExportJobState_Exporting))
+	for _, job := range jobs {
+		segments.Insert(job.GetSegmentIDs()...)
+	}
+	return segments
+}
+
 func (gc *garbageCollector) recycleChannelCPMeta(ctx context.Context) {
 	log := log.Ctx(ctx)
 	channelCPs, err := gc.meta.catalog.ListChannelCheckpoint(ctx)
@@ -49,6 +49,7 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/workerpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/lock"
@@ -1758,6 +1759,47 @@ func (s *GarbageCollectorSuite) TestAvoidGCLoadedSegments() {
 	s.NotNil(seg)
 }
 
+func (s *GarbageCollectorSuite) TestAvoidGCExportingSegments() {
+	catalog := catalogmocks.NewDataCoordCatalog(s.T())
+	catalog.EXPECT().ListExportJobs(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListExportTasks(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().SaveExportJob(mock.Anything, mock.Anything).Return(nil)
+	exportMeta, err := NewExportMeta(context.TODO(), catalog, nil)
+	s.NoError(err)
+	err = exportMeta.AddJob(context.TODO(), &exportJob{
+		ExportJob: &datapb.ExportJob{
+			JobID:      1,
+			State:      internalpb.ExportJobState_Exporting,
+			SegmentIDs: []int64{2},
+		},
+	})
+	s.NoError(err)
+
+	handler := NewNMockHandler(s.T())
+	handler.EXPECT().ListLoadedSegments(mock.Anything).Return(nil, nil).Once()
+	gc := newGarbageCollector(s.meta, handler, GcOption{
+		cli:              s.cli,
+		enabled:          true,
+		checkInterval:    time.Millisecond * 10,
+		scanInterval:     time.Hour * 7 * 24,
+		missingTolerance: time.Hour * 24,
+		dropTolerance:    time.Hour * 24,
+		exportMeta:       exportMeta,
+	})
+
+	s.meta.AddSegment(context.TODO(), &SegmentInfo{
+		SegmentInfo: &datapb.SegmentInfo{
+			ID:        2,
+			State:     commonpb.SegmentState_Dropped,
+			DroppedAt: 0,
+		},
+	})
+
+	gc.recycleDroppedSegments(context.TODO())
+	seg := s.meta.GetSegment(context.TODO(), 2)
+	s.NotNil(seg)
+}
+
 func TestGarbageCollector(t *testing.T) {
 	suite.Run(t, new(GarbageCollectorSuite))
 }
@@ -274,6 +274,13 @@ func (c *importChecker) checkPreImportingJob(job ImportJob) {
 		return
 	}
 
+	if importutilv2.IsDryRun(job.GetOptions()) {
+		log.Info("dry-run import job, skip the subsequent stages, just update job state to Completed",
+			zap.Int64("totalRows", totalRows))
+		updateJobState(internalpb.ImportJobState_Completed)
+		return
+	}
+
 	lacks := c.getLackFilesForImports(job)
 	if len(lacks) == 0 {
 		return
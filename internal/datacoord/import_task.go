@@ -146,7 +146,7 @@ func UpdateStatsSegmentIDs(segmentIDs []UniqueID) UpdateAction {
 }
 
 type ImportTask interface {
-	task.Task
+	task.FairTask
 	GetJobID() int64
 	GetTaskID() int64
 	GetCollectionID() int64
@@ -128,6 +128,14 @@ func (t *importTask) GetTaskSlot() int64 {
 	return int64(CalculateTaskSlot(t, t.importMeta))
 }
 
+func (t *importTask) GetTaskGroup() string {
+	return GetTaskGroup(t, t.importMeta)
+}
+
+func (t *importTask) GetTaskWeight() int64 {
+	return GetTaskWeight(t, t.importMeta)
+}
+
 func (t *importTask) CreateTaskOnWorker(nodeID int64, cluster session.Cluster) {
 	log.Info("processing pending import task...", WrapTaskLog(t)...)
 	job := t.importMeta.GetJob(context.TODO(), t.GetJobID())
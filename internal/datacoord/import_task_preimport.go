@@ -94,6 +94,14 @@ func (p *preImportTask) GetTaskSlot() int64 {
 	return int64(CalculateTaskSlot(p, p.importMeta))
 }
 
+func (p *preImportTask) GetTaskGroup() string {
+	return GetTaskGroup(p, p.importMeta)
+}
+
+func (p *preImportTask) GetTaskWeight() int64 {
+	return GetTaskWeight(p, p.importMeta)
+}
+
 func (p *preImportTask) SetTaskTime(timeType taskcommon.TimeType, time time.Time) {
 	p.times.SetTaskTime(timeType, time)
 }
@@ -22,6 +22,7 @@ import (
 	"math"
 	"path"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -490,6 +491,15 @@ func getPreImportingProgress(ctx context.Context, jobID int64, importMeta Import
 	return float32(len(completedTasks)) / float32(len(tasks))
 }
 
+func getPreImportRows(ctx context.Context, jobID int64, importMeta ImportMeta) int64 {
+	tasks := importMeta.GetTaskBy(ctx, WithJob(jobID), WithType(PreImportTaskType))
+	return lo.SumBy(tasks, func(task ImportTask) int64 {
+		return lo.SumBy(task.GetFileStats(), func(file *datapb.ImportFileStats) int64 {
+			return file.GetTotalRows()
+		})
+	})
+}
+
 func getImportRowsInfo(ctx context.Context, jobID int64, importMeta ImportMeta, meta *meta) (importedRows, totalRows int64) {
 	tasks := importMeta.GetTaskBy(ctx, WithJob(jobID), WithType(ImportTaskType))
 	segmentIDs := make([]int64, 0)
@@ -595,6 +605,10 @@ func GetJobProgress(ctx context.Context, jobID int64,
 		return 10 + 30 + 30 + 10 + int64(progress*10), internalpb.ImportJobState_Importing, totalRows, totalRows, ""
 
 	case internalpb.ImportJobState_Completed:
+		if importutilv2.IsDryRun(job.GetOptions()) {
+			// nothing is imported in dry-run mode, only the rows validated are reported
+			return 100, internalpb.ImportJobState_Completed, 0, getPreImportRows(ctx, jobID, importMeta), ""
+		}
 		_, totalRows := getImportRowsInfo(ctx, jobID, importMeta, meta)
 		return 100, internalpb.ImportJobState_Completed, totalRows, totalRows, ""
 
@@ -605,6 +619,10 @@ func GetJobProgress(ctx context.Context, jobID int64,
 }
 
 func GetTaskProgresses(ctx context.Context, jobID int64, importMeta ImportMeta, meta *meta) []*internalpb.ImportTaskProgress {
+	job := importMeta.GetJob(ctx, jobID)
+	if job != nil && importutilv2.IsDryRun(job.GetOptions()) {
+		return getDryRunTaskProgresses(ctx, jobID, importMeta)
+	}
 	progresses := make([]*internalpb.ImportTaskProgress, 0)
 	tasks := importMeta.GetTaskBy(ctx, WithJob(jobID), WithType(ImportTaskType))
 	for _, task := range tasks {
@@ -618,14 +636,46 @@ func GetTaskProgresses(ctx context.Context, jobID int64, importMeta ImportMeta,
 		}
 		for _, fileStat := range task.GetFileStats() {
 			progresses = append(progresses, &internalpb.ImportTaskProgress{
-				FileName:     fmt.Sprintf("%v", fileStat.GetImportFile().GetPaths()),
-				FileSize:     fileStat.GetFileSize(),
-				Reason:       task.GetReason(),
-				Progress:     progress,
-				CompleteTime: task.(*importTask).GetCompleteTime(),
-				State:        task.GetState().String(),
-				ImportedRows: progress * fileStat.GetTotalRows() / 100,
-				TotalRows:    fileStat.GetTotalRows(),
+				FileName:        fmt.Sprintf("%v", fileStat.GetImportFile().GetPaths()),
+				FileSize:        fileStat.GetFileSize(),
+				Reason:          task.GetReason(),
+				Progress:        progress,
+				CompleteTime:    task.(*importTask).GetCompleteTime(),
+				State:           task.GetState().String(),
+				ImportedRows:    progress * fileStat.GetTotalRows() / 100,
+				TotalRows:       fileStat.GetTotalRows(),
+				ErrorRows:       fileStat.GetErrorRows(),
+				ErrorReportPath: fileStat.GetErrorReportPath(),
+				SampleErrors:    fileStat.GetSampleErrors(),
+			})
+		}
+	}
+	return progresses
+}
+
+// getDryRunTaskProgresses reports the validation result of each file in dry-run mode,
+// which comes from the preimport tasks since there are no import tasks.
+func getDryRunTaskProgresses(ctx context.Context, jobID int64, importMeta ImportMeta) []*internalpb.ImportTaskProgress {
+	progresses := make([]*internalpb.ImportTaskProgress, 0)
+	tasks := importMeta.GetTaskBy(ctx, WithJob(jobID), WithType(PreImportTaskType))
+	for _, task := range tasks {
+		progress := int64(0)
+		if task.GetState() == datapb.ImportTaskStateV2_Completed {
+			progress = 100
+		}
+		for _, fileStat := range task.GetFileStats() {
+			progresses = append(progresses, &internalpb.ImportTaskProgress{
+				FileName:        fmt.Sprintf("%v", fileStat.GetImportFile().GetPaths()),
+				FileSize:        fileStat.GetFileSize(),
+				Reason:          task.GetReason(),
+				Progress:        progress,
+				CompleteTime:    task.(*preImportTask).GetCompleteTime(),
+				State:           task.GetState().String(),
+				TotalRows:       fileStat.GetTotalRows(),
+				ErrorRows:       fileStat.GetErrorRows(),
+				ErrorReportPath: fileStat.GetErrorReportPath(),
+				SampleErrors:    fileStat.GetSampleErrors(),
+				TotalMemorySize: fileStat.GetTotalMemorySize(),
 			})
 		}
 	}
@@ -790,6 +840,121 @@ func ListBinlogImportRequestFiles(ctx context.Context, cm storage.ChunkManager,
 	return resFiles, nil
 }
 
+// ExpandPatternImportRequest validates the import request which contains prefixes or glob patterns,
+// and returns the expanded files, nil if there is no pattern in the request.
+func ExpandPatternImportRequest(ctx context.Context, cm storage.ChunkManager,
+	reqFiles []*msgpb.ImportFile, options []*commonpb.KeyValuePair,
+) ([]*internalpb.ImportFile, error) {
+	files := lo.Map(reqFiles, func(file *msgpb.ImportFile, _ int) *internalpb.ImportFile {
+		return &internalpb.ImportFile{Id: file.GetId(), Paths: file.GetPaths()}
+	})
+	if importutilv2.IsBackup(options) || importutilv2.IsL0Import(options) ||
+		!lo.ContainsBy(files, importutilv2.HasPatternPath) {
+		return nil, nil
+	}
+	return ExpandImportRequestFiles(ctx, cm, files, options)
+}
+
+// ExpandImportRequestFiles expands the prefixes and glob patterns in the request into files,
+// each matched object is imported as a single file. The objects whose names start with
+// "_" or "." (such as the _SUCCESS marker) are skipped.
+func ExpandImportRequestFiles(ctx context.Context, cm storage.ChunkManager,
+	reqFiles []*internalpb.ImportFile, options []*commonpb.KeyValuePair,
+) ([]*internalpb.ImportFile, error) {
+	if importutilv2.IsBackup(options) || importutilv2.IsL0Import(options) ||
+		!lo.ContainsBy(reqFiles, importutilv2.HasPatternPath) {
+		return reqFiles, nil
+	}
+	var (
+		maxNum    = paramtable.Get().DataCoordCfg.MaxExpandedFilesPerImportReq.GetAsInt()
+		maxSize   = paramtable.Get().DataCoordCfg.MaxExpandedSizeInGBPerImportReq.GetAsInt64() * 1024 * 1024 * 1024
+		totalSize int64
+		resFiles  = make([]*internalpb.ImportFile, 0, len(reqFiles))
+		visited   = typeutil.NewSet[string]()
+	)
+	// the files listed explicitly are not imported again if they are matched by a pattern
+	for _, importFile := range reqFiles {
+		if !importutilv2.HasPatternPath(importFile) && len(importFile.GetPaths()) == 1 {
+			visited.Insert(importFile.GetPaths()[0])
+		}
+	}
+	for _, importFile := range reqFiles {
+		if !importutilv2.HasPatternPath(importFile) {
+			resFiles = append(resFiles, importFile)
+			continue
+		}
+		if len(importFile.GetPaths()) != 1 {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("prefix or glob pattern should be the only path of "+
+				"an import file, but got paths=%v", importFile.GetPaths()))
+		}
+		pattern := importFile.GetPaths()[0]
+		// brackets are literal in import patterns
+		matchPattern := strings.NewReplacer("[", "\\[", "]", "\\]").Replace(pattern)
+		if _, err := path.Match(matchPattern, ""); err != nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("invalid glob pattern '%s', err=%s", pattern, err))
+		}
+		// walk from the literal part of the pattern
+		prefix := pattern
+		if i := strings.IndexAny(pattern, importutilv2.GlobChars); i >= 0 {
+			prefix = pattern[:i]
+		}
+		var (
+			matchedNum int
+			walkErr    error
+		)
+		err := cm.WalkWithPrefix(ctx, prefix, true, func(info *storage.ChunkObjectInfo) bool {
+			filePath := info.FilePath
+			if name := path.Base(filePath); strings.HasPrefix(name, "_") || strings.HasPrefix(name, ".") {
+				return true
+			}
+			if prefix != pattern {
+				if matched, _ := path.Match(matchPattern, filePath); !matched {
+					return true
+				}
+			}
+			matchedNum++
+			if visited.Contain(filePath) {
+				return true
+			}
+			visited.Insert(filePath)
+			file := &internalpb.ImportFile{Paths: []string{filePath}}
+			fileType, err := importutilv2.GetFileType(file)
+			if err != nil {
+				walkErr = err
+				return false
+			}
+			if fileType == importutilv2.Numpy {
+				walkErr = merr.WrapErrImportFailed(fmt.Sprintf("prefix or glob pattern is not supported for Numpy files, "+
+					"pattern=%s", pattern))
+				return false
+			}
+			totalSize += info.Size
+			resFiles = append(resFiles, file)
+			if len(resFiles) > maxNum {
+				walkErr = merr.WrapErrImportFailed(fmt.Sprintf("The max number of expanded import files should not exceed %d", maxNum))
+				return false
+			}
+			if totalSize > maxSize {
+				walkErr = merr.WrapErrImportFailed(fmt.Sprintf("The total size of expanded import files should not exceed %d GB",
+					paramtable.Get().DataCoordCfg.MaxExpandedSizeInGBPerImportReq.GetAsInt64()))
+				return false
+			}
+			return true
+		})
+		if err != nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("list files failed, pattern=%s, err=%s", pattern, err))
+		}
+		if walkErr != nil {
+			return nil, walkErr
+		}
+		if matchedNum == 0 {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("no file matches the prefix or glob pattern '%s'", pattern))
+		}
+	}
+	log.Ctx(ctx).Info("expand import files done", zap.Int("num", len(resFiles)), zap.Int64("totalSize", totalSize))
+	return resFiles, nil
+}
+
 // ValidateMaxImportJobExceed checks if the number of import jobs exceeds the limit.
 func ValidateMaxImportJobExceed(ctx context.Context, importMeta ImportMeta) error {
 	maxNum := paramtable.Get().DataCoordCfg.MaxImportJobNum.GetAsInt()
@@ -843,6 +1008,24 @@ func CalculateTaskSlot(task ImportTask, importMeta ImportMeta) int {
 	return memoryBasedSlots
 }
 
+// GetTaskGroup returns the group of import task in the fair scheduling, which is the database of the job.
+func GetTaskGroup(task ImportTask, importMeta ImportMeta) string {
+	job := importMeta.GetJob(context.TODO(), task.GetJobID())
+	if job == nil {
+		return ""
+	}
+	return fmt.Sprint(job.GetDbID())
+}
+
+// GetTaskWeight returns the weight of import task in the fair scheduling, which is decided by the job priority.
+func GetTaskWeight(task ImportTask, importMeta ImportMeta) int64 {
+	job := importMeta.GetJob(context.TODO(), task.GetJobID())
+	if job == nil {
+		return importutilv2.GetPriorityWeight(nil)
+	}
+	return importutilv2.GetPriorityWeight(job.GetOptions())
+}
+
 func createSortCompactionTask(ctx context.Context,
 	originSegment *SegmentInfo,
 	targetSegmentID int64,
@@ -880,6 +880,83 @@ func TestImportUtil_GetImportProgress(t *testing.T) {
 	assert.Equal(t, "", reason)
 }
 
+func TestImportUtil_GetDryRunProgress(t *testing.T) {
+	ctx := context.Background()
+
+	catalog := mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListImportJobs(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPreImportTasks(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListImportTasks(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().SaveImportJob(mock.Anything, mock.Anything).Return(nil)
+	catalog.EXPECT().SavePreImportTask(mock.Anything, mock.Anything).Return(nil)
+
+	importMeta, err := NewImportMeta(context.TODO(), catalog, nil, nil)
+	assert.NoError(t, err)
+
+	file1 := &internalpb.ImportFile{
+		Id:    1,
+		Paths: []string{"a.json"},
+	}
+	file2 := &internalpb.ImportFile{
+		Id:    2,
+		Paths: []string{"b.csv"},
+	}
+	job := &importJob{
+		ImportJob: &datapb.ImportJob{
+			JobID:   0,
+			Files:   []*internalpb.ImportFile{file1, file2},
+			Options: []*commonpb.KeyValuePair{{Key: importutilv2.DryRun, Value: "true"}},
+			State:   internalpb.ImportJobState_Completed,
+		},
+	}
+	err = importMeta.AddJob(context.TODO(), job)
+	assert.NoError(t, err)
+
+	preImportTaskProto := &datapb.PreImportTask{
+		JobID:  job.GetJobID(),
+		TaskID: 1,
+		State:  datapb.ImportTaskStateV2_Completed,
+		FileStats: []*datapb.ImportFileStats{
+			{
+				ImportFile:      file1,
+				FileSize:        1024,
+				TotalRows:       100,
+				TotalMemorySize: 2048,
+			},
+			{
+				ImportFile:      file2,
+				FileSize:        512,
+				TotalRows:       50,
+				TotalMemorySize: 1024,
+				ErrorRows:       2,
+				ErrorReportPath: "import_error_reports/0/1_1.jsonl",
+				SampleErrors:    []string{"file: b.csv, row: 3, field: vec, reason: invalid dim"},
+			},
+		},
+	}
+	pit := &preImportTask{}
+	pit.task.Store(preImportTaskProto)
+	err = importMeta.AddTask(context.TODO(), pit)
+	assert.NoError(t, err)
+
+	progress, state, importedRows, totalRows, reason := GetJobProgress(ctx, job.GetJobID(), importMeta, nil)
+	assert.Equal(t, int64(100), progress)
+	assert.Equal(t, internalpb.ImportJobState_Completed, state)
+	assert.Equal(t, int64(0), importedRows)
+	assert.Equal(t, int64(150), totalRows)
+	assert.Equal(t, "", reason)
+
+	progresses := GetTaskProgresses(ctx, job.GetJobID(), importMeta, nil)
+	assert.Equal(t, 2, len(progresses))
+	assert.Equal(t, int64(100), progresses[0].GetTotalRows())
+	assert.Equal(t, int64(2048), progresses[0].GetTotalMemorySize())
+	assert.Equal(t, int64(0), progresses[0].GetErrorRows())
+	assert.Equal(t, int64(50), progresses[1].GetTotalRows())
+	assert.Equal(t, int64(2), progresses[1].GetErrorRows())
+	assert.Equal(t, "import_error_reports/0/1_1.jsonl", progresses[1].GetErrorReportPath())
+	assert.Equal(t, 1, len(progresses[1].GetSampleErrors()))
+}
+
 func TestPreImportTask_MarshalJSON(t *testing.T) {
 	taskProto := &datapb.PreImportTask{
 		JobID:        1,
@@ -1209,6 +1286,120 @@ func TestImportUtil_ListBinlogImportRequestFiles(t *testing.T) {
 	})
 }
 
+func TestImportUtil_ExpandImportRequestFiles(t *testing.T) {
+	ctx := context.Background()
+	objects := []*storage.ChunkObjectInfo{
+		{FilePath: "data/dt=20250101/_SUCCESS"},
+		{FilePath: "data/dt=20250101/part-0.parquet", Size: 100},
+		{FilePath: "data/dt=20250101/part-1.parquet", Size: 200},
+		{FilePath: "data/dt=20250102/part-0.parquet", Size: 300},
+		{FilePath: "data/dt=20250102/part-0.json", Size: 400},
+		{FilePath: "logs/[2025]/part-0.parquet", Size: 500},
+	}
+	newMockCM := func() *mocks2.ChunkManager {
+		mockCM := mocks2.NewChunkManager(t)
+		mockCM.EXPECT().WalkWithPrefix(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, prefix string, recursive bool, walkFunc storage.ChunkObjectWalkFunc) error {
+				for _, object := range objects {
+					if strings.HasPrefix(object.FilePath, prefix) && !walkFunc(object) {
+						return nil
+					}
+				}
+				return nil
+			})
+		return mockCM
+	}
+
+	t.Run("no pattern", func(t *testing.T) {
+		reqFiles := []*internalpb.ImportFile{{Paths: []string{"a.json"}}}
+		files, err := ExpandImportRequestFiles(ctx, nil, reqFiles, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, reqFiles, files)
+	})
+
+	t.Run("prefix and glob", func(t *testing.T) {
+		reqFiles := []*internalpb.ImportFile{
+			{Paths: []string{"a.json"}},
+			{Paths: []string{"data/dt=20250101/"}},
+			{Paths: []string{"data/dt=*/part-*.parquet"}},
+		}
+		files, err := ExpandImportRequestFiles(ctx, newMockCM(), reqFiles, nil)
+		assert.NoError(t, err)
+		paths := lo.Map(files, func(file *internalpb.ImportFile, _ int) string {
+			assert.Equal(t, 1, len(file.GetPaths()))
+			return file.GetPaths()[0]
+		})
+		assert.Equal(t, []string{
+			"a.json",
+			"data/dt=20250101/part-0.parquet",
+			"data/dt=20250101/part-1.parquet",
+			"data/dt=20250102/part-0.parquet",
+		}, paths)
+	})
+
+	t.Run("explicit file matched by pattern", func(t *testing.T) {
+		reqFiles := []*internalpb.ImportFile{
+			{Paths: []string{"data/dt=*/part-0.parquet"}},
+			{Paths: []string{"data/dt=20250102/part-0.parquet"}},
+		}
+		files, err := ExpandImportRequestFiles(ctx, newMockCM(), reqFiles, nil)
+		assert.NoError(t, err)
+		paths := lo.Map(files, func(file *internalpb.ImportFile, _ int) string {
+			return file.GetPaths()[0]
+		})
+		assert.Equal(t, []string{
+			"data/dt=20250101/part-0.parquet",
+			"data/dt=20250102/part-0.parquet",
+		}, paths)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		reqFiles := []*internalpb.ImportFile{{Paths: []string{"data/dt=2024*/*.parquet"}}}
+		_, err := ExpandImportRequestFiles(ctx, newMockCM(), reqFiles, nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "no file matches")
+	})
+
+	t.Run("brackets are literal", func(t *testing.T) {
+		reqFiles := []*internalpb.ImportFile{{Paths: []string{"data/[a-.parquet"}}}
+		files, err := ExpandImportRequestFiles(ctx, nil, reqFiles, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, reqFiles, files)
+
+		reqFiles = []*internalpb.ImportFile{{Paths: []string{"logs/[2025]/*.parquet"}}}
+		files, err = ExpandImportRequestFiles(ctx, newMockCM(), reqFiles, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(files))
+		assert.Equal(t, []string{"logs/[2025]/part-0.parquet"}, files[0].GetPaths())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		reqFiles := []*internalpb.ImportFile{{Paths: []string{"data/*\\"}}}
+		_, err := ExpandImportRequestFiles(ctx, nil, reqFiles, nil)
+		assert.Error(t, err)
+
+		reqFiles = []*internalpb.ImportFile{{Paths: []string{"data/*.npy", "data/b.npy"}}}
+		_, err = ExpandImportRequestFiles(ctx, nil, reqFiles, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("exceed limits", func(t *testing.T) {
+		reqFiles := []*internalpb.ImportFile{{Paths: []string{"data/"}}}
+		paramtable.Get().Save(paramtable.Get().DataCoordCfg.MaxExpandedFilesPerImportReq.Key, "2")
+		_, err := ExpandImportRequestFiles(ctx, newMockCM(), reqFiles, nil)
+		paramtable.Get().Reset(paramtable.Get().DataCoordCfg.MaxExpandedFilesPerImportReq.Key)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "The max number of expanded import files should not exceed 2")
+
+		objects = append(objects, &storage.ChunkObjectInfo{FilePath: "data/big.parquet", Size: 2 * 1024 * 1024 * 1024})
+		paramtable.Get().Save(paramtable.Get().DataCoordCfg.MaxExpandedSizeInGBPerImportReq.Key, "1")
+		_, err = ExpandImportRequestFiles(ctx, newMockCM(), reqFiles, nil)
+		paramtable.Get().Reset(paramtable.Get().DataCoordCfg.MaxExpandedSizeInGBPerImportReq.Key)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "The total size of expanded import files should not exceed 1 GB")
+	})
+}
+
 // TestImportUtil_ValidateMaxImportJobExceed tests validation of maximum import jobs
 func TestImportUtil_ValidateMaxImportJobExceed(t *testing.T) {
 	ctx := context.Background()
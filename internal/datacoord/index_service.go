@@ -20,7 +20,6 @@ import (
 	"context"
 	"fmt"
 	"math"
-	"strings"
 
 	"github.com/samber/lo"
 	"go.uber.org/zap"
@@ -114,19 +113,7 @@ func (s *Server) parseAndVerifyNestedPath(identifier string, schema *schemapb.Co
 		return "", fmt.Errorf("field parsed from json path (%v) not match with field specified in request (%v)", identifierExpr.GetColumnExpr().GetInfo().GetFieldId(), fieldID)
 	}
 
-	nestedPath := identifierExpr.GetColumnExpr().GetInfo().GetNestedPath()
-	// escape the nested path to avoid the path being interpreted as a JSON Pointer
-	nestedPath = lo.Map(nestedPath, func(path string, _ int) string {
-		s := strings.ReplaceAll(path, "~", "~0")
-		s = strings.ReplaceAll(s, "/", "~1")
-		return s
-	})
-	if len(nestedPath) == 0 {
-		// if nested path is empty, it means the json path is the field name.
-		// Dont return "/" here, it not a valid json path for simdjson.
-		return "", nil
-	}
-	return "/" + strings.Join(nestedPath, "/"), nil
+	return planparserv2.NestedPathToJSONPointer(identifierExpr.GetColumnExpr().GetInfo().GetNestedPath()), nil
 }
 
 // CreateIndex create an index on collection.
@@ -887,6 +887,22 @@ func (s *mockMixCoord) ListImports(ctx context.Context, req *internalpb.ListImpo
 	panic("implement me")
 }
 
+func (s *mockMixCoord) ExportV2(ctx context.Context, req *internalpb.ExportRequestInternal) (*internalpb.ExportResponse, error) {
+	panic("implement me")
+}
+
+func (s *mockMixCoord) GetExportProgress(ctx context.Context, req *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error) {
+	panic("implement me")
+}
+
+func (s *mockMixCoord) ListExports(ctx context.Context, req *internalpb.ListExportsRequestInternal) (*internalpb.ListExportsResponse, error) {
+	panic("implement me")
+}
+
+func (s *mockMixCoord) CancelExport(ctx context.Context, req *internalpb.CancelExportRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
 func (s *mockMixCoord) ListIndexes(ctx context.Context, req *indexpb.ListIndexesRequest) (*indexpb.ListIndexesResponse, error) {
 	panic("implement me")
 }
@@ -58,6 +58,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/messagespb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v2/util"
@@ -132,6 +133,8 @@ type Server struct {
 	importMeta       ImportMeta
 	importInspector  ImportInspector
 	importChecker    ImportChecker
+	exportMeta       ExportMeta
+	exportChecker    ExportChecker
 
 	compactionTrigger        trigger
 	compactionInspector      CompactionInspector
@@ -306,6 +309,10 @@ func (s *Server) initDataCoord() error {
 	if err != nil {
 		return err
 	}
+	s.exportMeta, err = NewExportMeta(s.ctx, s.meta.catalog, s.meta)
+	if err != nil {
+		return err
+	}
 	s.initCompaction()
 	log.Info("init compaction done")
 
@@ -329,6 +336,8 @@ func (s *Server) initDataCoord() error {
 
 	s.importChecker = NewImportChecker(s.ctx, s.meta, s.broker, s.allocator, s.importMeta, s.compactionInspector, s.handler, s.compactionTriggerManager)
 
+	s.exportChecker = NewExportChecker(s.ctx, s.meta, s.allocator, s.exportMeta, s.globalScheduler)
+
 	s.serverLoopCtx, s.serverLoopCancel = context.WithCancel(s.ctx)
 
 	log.Info("init datacoord done", zap.Int64("nodeID", paramtable.GetNodeID()), zap.String("Address", s.address))
@@ -363,6 +372,9 @@ func (s *Server) initMessageCallback() {
 			Options:       funcutil.Map2KeyValuePair(body.GetOptions()),
 			DataTimestamp: body.GetBase().GetTimestamp(),
 			JobID:         body.GetJobID(),
+			ExpandedFiles: lo.Map(importMsg.Header().GetExpandedFiles(), func(file *messagespb.ImportFile, _ int) *internalpb.ImportFile {
+				return &internalpb.ImportFile{Paths: file.GetPaths()}
+			}),
 		})
 		err = merr.CheckRPCCall(importResp, err)
 		if errors.Is(err, merr.ErrCollectionNotFound) {
@@ -392,10 +404,23 @@ func (s *Server) initMessageCallback() {
 		if err != nil {
 			return err
 		}
+		expandedFiles, err := ExpandPatternImportRequest(ctx, s.meta.chunkManager, b.GetFiles(), options)
+		if err != nil {
+			return err
+		}
 		err = ValidateMaxImportJobExceed(ctx, s.importMeta)
 		if err != nil {
 			return err
 		}
+		if expandedFiles != nil {
+			// the expanded files are persisted with the broadcast message before it is acked,
+			// so the same files are imported by ImportV2 without listing the objects again
+			importMsg.OverwriteHeader(&message.ImportMessageHeader{
+				ExpandedFiles: lo.Map(expandedFiles, func(file *internalpb.ImportFile, _ int) *messagespb.ImportFile {
+					return &messagespb.ImportFile{Paths: file.GetPaths()}
+				}),
+			})
+		}
 		return nil
 	})
 }
@@ -499,6 +524,7 @@ func (s *Server) initGarbageCollection(cli storage.ChunkManager) {
 	s.garbageCollector = newGarbageCollector(s.meta, s.handler, GcOption{
 		cli:              cli,
 		broker:           s.broker,
+		exportMeta:       s.exportMeta,
 		enabled:          Params.DataCoordCfg.EnableGarbageCollection.GetAsBool(),
 		checkInterval:    Params.DataCoordCfg.GCInterval.GetAsDuration(time.Second),
 		scanInterval:     Params.DataCoordCfg.GCScanIntervalInHour.GetAsDuration(time.Hour),
@@ -709,6 +735,7 @@ func (s *Server) startServerLoop() {
 	s.globalScheduler.Start()
 	go s.importInspector.Start()
 	go s.importChecker.Start()
+	go s.exportChecker.Start()
 	s.garbageCollector.start()
 }
 
@@ -1041,6 +1068,7 @@ func (s *Server) Stop() error {
 	s.globalScheduler.Stop()
 	s.importInspector.Close()
 	s.importChecker.Close()
+	s.exportChecker.Close()
 
 	s.stopCompaction()
 	log.Info("datacoord compaction stopped")
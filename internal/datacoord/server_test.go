@@ -31,6 +31,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -49,6 +50,7 @@ import (
 	mocks2 "github.com/milvus-io/milvus/internal/metastore/mocks"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/broadcaster/registry"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/dependency"
@@ -58,6 +60,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/messagespb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/workerpb"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v2/util/etcd"
@@ -2758,6 +2761,35 @@ func TestServer_InitMessageCallback(t *testing.T) {
 	err = registry.CallMessageCheckCallback(ctx, msg)
 	assert.NoError(t, err)
 
+	// the prefixes and glob patterns are expanded into the header of import message
+	mockChunkManager.EXPECT().WalkWithPrefix(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, prefix string, recursive bool, walkFunc storage.ChunkObjectWalkFunc) error {
+			for _, path := range []string{"data/a.json", "data/b.json"} {
+				if !walkFunc(&storage.ChunkObjectInfo{FilePath: path, Size: 10}) {
+					return nil
+				}
+			}
+			return nil
+		})
+	msg, err = message.NewImportMessageBuilderV1().
+		WithHeader(&message.ImportMessageHeader{}).
+		WithBody(&msgpb.ImportMsg{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_Import,
+			},
+			Files: []*msgpb.ImportFile{{Paths: []string{"data/"}}},
+		}).
+		WithBroadcast([]string{"ch-0"}, resourceKey).
+		BuildBroadcast()
+	assert.NoError(t, err)
+	err = registry.CallMessageCheckCallback(ctx, msg)
+	assert.NoError(t, err)
+	header := message.MustAsMutableImportMessageV1(msg.WithBroadcastID(1).SplitIntoMutableMessage()[0]).Header()
+	assert.Equal(t, [][]string{{"data/a.json"}, {"data/b.json"}}, lo.Map(header.GetExpandedFiles(),
+		func(file *messagespb.ImportFile, _ int) []string {
+			return file.GetPaths()
+		}))
+
 	// Test Import message ack callback
 	importMsg, err := message.NewImportMessageBuilderV1().
 		WithVChannel("test_channel").
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"time"
 
@@ -1874,6 +1875,7 @@ func (s *Server) ImportV2(ctx context.Context, in *internalpb.ImportRequestInter
 	}
 
 	files := in.GetFiles()
+	var requestedFiles []*internalpb.ImportFile
 	isBackup := importutilv2.IsBackup(in.GetOptions())
 	if isBackup {
 		files, err = ListBinlogImportRequestFiles(ctx, s.meta.chunkManager, files, in.GetOptions())
@@ -1881,6 +1883,16 @@ func (s *Server) ImportV2(ctx context.Context, in *internalpb.ImportRequestInter
 			resp.Status = merr.Status(err)
 			return resp, nil
 		}
+	} else if !importutilv2.IsL0Import(in.GetOptions()) && lo.ContainsBy(files, importutilv2.HasPatternPath) {
+		requestedFiles = lo.Map(files, func(file *internalpb.ImportFile, _ int) *internalpb.ImportFile {
+			return &internalpb.ImportFile{Paths: file.GetPaths()}
+		})
+		// expanded when the import message was checked, listing the objects again may get different files
+		if len(in.GetExpandedFiles()) == 0 {
+			resp.Status = merr.Status(merr.WrapErrImportFailed("the prefixes and glob patterns of import files are not expanded"))
+			return resp, nil
+		}
+		files = in.GetExpandedFiles()
 	}
 
 	// Allocate file ids.
@@ -1915,6 +1927,7 @@ func (s *Server) ImportV2(ctx context.Context, in *internalpb.ImportRequestInter
 	job := &importJob{
 		ImportJob: &datapb.ImportJob{
 			JobID:          jobID,
+			DbID:           importCollectionInfo.DatabaseID,
 			CollectionID:   in.GetCollectionID(),
 			CollectionName: in.GetCollectionName(),
 			PartitionIDs:   in.GetPartitionIDs(),
@@ -1924,6 +1937,7 @@ func (s *Server) ImportV2(ctx context.Context, in *internalpb.ImportRequestInter
 			CleanupTs:      math.MaxUint64,
 			State:          internalpb.ImportJobState_Pending,
 			Files:          files,
+			RequestedFiles: requestedFiles,
 			Options:        in.GetOptions(),
 			StartTime:      startTime.Format("2006-01-02T15:04:05Z07:00"),
 			ReadyVchannels: in.GetChannelNames(),
@@ -1979,6 +1993,9 @@ func (s *Server) GetImportProgress(ctx context.Context, in *internalpb.GetImport
 	resp.ImportedRows = importedRows
 	resp.TotalRows = totalRows
 	resp.TaskProgresses = GetTaskProgresses(ctx, jobID, s.importMeta, s.meta)
+	resp.ErrorRows = lo.SumBy(resp.GetTaskProgresses(), func(progress *internalpb.ImportTaskProgress) int64 {
+		return progress.GetErrorRows()
+	})
 	log.Info("GetImportProgress done", zap.String("jobState", job.GetState().String()), zap.Any("resp", resp))
 	return resp, nil
 }
@@ -2012,10 +2029,238 @@ func (s *Server) ListImports(ctx context.Context, req *internalpb.ListImportsReq
 		resp.Reasons = append(resp.Reasons, reason)
 		resp.Progresses = append(resp.Progresses, progress)
 		resp.CollectionNames = append(resp.CollectionNames, job.GetCollectionName())
+		priority, err := importutilv2.GetPriority(job.GetOptions())
+		if err != nil {
+			priority = importutilv2.PriorityNormal
+		}
+		resp.Priorities = append(resp.Priorities, priority)
 	}
 	return resp, nil
 }
 
+// ExportV2 creates an export job, which dumps the rows of the collection visible at the export timestamp into parquet files.
+// The collection is flushed by proxy before export, the export checker takes the snapshot of the flushed segments
+// once the flush is done.
+func (s *Server) ExportV2(ctx context.Context, in *internalpb.ExportRequestInternal) (*internalpb.ExportResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &internalpb.ExportResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	resp := &internalpb.ExportResponse{
+		Status: merr.Success(),
+	}
+
+	log := log.Ctx(ctx).With(zap.Int64("collection", in.GetCollectionID()),
+		zap.Int64s("partitions", in.GetPartitionIDs()))
+	log.Info("receive export request", zap.String("expr", in.GetExpr()), zap.Strings("outputFields", in.GetOutputFields()),
+		zap.Uint64("exportTs", in.GetExportTimestamp()), zap.Any("options", in.GetOptions()))
+
+	if err := ValidateMaxExportJobExceed(ctx, s.exportMeta); err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+	if _, err := GetExportMaxFileSize(in.GetOptions()); err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+	startTs, err := GetExportStartTs(in.GetOptions())
+	if err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+
+	currentTs, err := s.allocator.AllocTimestamp(ctx)
+	if err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+	exportTs := in.GetExportTimestamp()
+	if exportTs == 0 {
+		exportTs = currentTs
+		if in.GetFlushTs() != 0 {
+			exportTs = in.GetFlushTs()
+		}
+	} else if exportTs > currentTs {
+		resp.Status = merr.Status(merr.WrapErrParameterInvalidMsg(
+			fmt.Sprintf("export timestamp %d is in the future, current timestamp is %d", exportTs, currentTs)))
+		return resp, nil
+	} else if in.GetFlushTs() != 0 && exportTs > in.GetFlushTs() {
+		resp.Status = merr.Status(merr.WrapErrParameterInvalidMsg(
+			fmt.Sprintf("export timestamp %d is later than the flush timestamp %d", exportTs, in.GetFlushTs())))
+		return resp, nil
+	}
+	if startTs >= exportTs {
+		resp.Status = merr.Status(merr.WrapErrParameterInvalidMsg(
+			fmt.Sprintf("export option %s=%d should be less than the export timestamp %d", ExportStartTs, startTs, exportTs)))
+		return resp, nil
+	}
+
+	jobID := in.GetJobID()
+	if jobID == 0 {
+		jobID, err = s.allocator.AllocID(ctx)
+		if err != nil {
+			resp.Status = merr.Status(err)
+			return resp, nil
+		}
+	}
+
+	coll, err := s.handler.GetCollection(ctx, in.GetCollectionID())
+	if err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+	if coll == nil {
+		resp.Status = merr.Status(merr.WrapErrCollectionNotFound(in.GetCollectionID()))
+		return resp, nil
+	}
+
+	outputPath, err := GetExportOutputPath(s.meta.chunkManager.RootPath(), in.GetOutputPath(), jobID)
+	if err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+
+	job := &exportJob{
+		ExportJob: &datapb.ExportJob{
+			JobID:            jobID,
+			DbID:             in.GetDbID(),
+			CollectionID:     in.GetCollectionID(),
+			CollectionName:   in.GetCollectionName(),
+			PartitionIDs:     in.GetPartitionIDs(),
+			Schema:           in.GetSchema(),
+			Expr:             in.GetExpr(),
+			OutputFields:     in.GetOutputFields(),
+			ExportTimestamp:  exportTs,
+			OutputPath:       outputPath,
+			Options:          in.GetOptions(),
+			FlushTs:          in.GetFlushTs(),
+			SealedSegmentIDs: in.GetSealedSegmentIDs(),
+			Vchannels:        coll.VChannelNames,
+			State:            internalpb.ExportJobState_ExportPending,
+			StartTime:        time.Now().Format("2006-01-02T15:04:05Z07:00"),
+			CleanupTs:        math.MaxUint64,
+		},
+		tr: timerecord.NewTimeRecorder("export job"),
+	}
+	if _, err = getExportFieldIDs(job); err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+	err = s.exportMeta.AddJob(ctx, job)
+	if err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+
+	resp.JobID = fmt.Sprint(job.GetJobID())
+	log.Info("add export job done", zap.Int64("jobID", job.GetJobID()), zap.Uint64("exportTs", exportTs),
+		zap.String("outputPath", outputPath), zap.Uint64("flushTs", in.GetFlushTs()))
+	return resp, nil
+}
+
+func (s *Server) GetExportProgress(ctx context.Context, in *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error) {
+	log := log.Ctx(ctx).With(zap.String("jobID", in.GetJobID()))
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &internalpb.GetExportProgressResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	resp := &internalpb.GetExportProgressResponse{
+		Status: merr.Success(),
+	}
+	jobID, err := strconv.ParseInt(in.GetJobID(), 10, 64)
+	if err != nil {
+		resp.Status = merr.Status(merr.WrapErrParameterInvalidMsg(fmt.Sprintf("parse job id failed, err=%s", err)))
+		return resp, nil
+	}
+
+	job := s.exportMeta.GetJob(ctx, jobID)
+	if job == nil {
+		resp.Status = merr.Status(merr.WrapErrParameterInvalidMsg(fmt.Sprintf("export job does not exist, jobID=%d", jobID)))
+		return resp, nil
+	}
+	progress, outputFiles, exportedRows := GetExportJobProgress(ctx, job, s.exportMeta)
+	resp.State = job.GetState()
+	resp.Reason = job.GetReason()
+	resp.Progress = progress
+	resp.CollectionName = job.GetCollectionName()
+	resp.StartTime = job.GetStartTime()
+	resp.CompleteTime = job.GetCompleteTime()
+	resp.ExportTimestamp = job.GetExportTimestamp()
+	resp.OutputPath = job.GetOutputPath()
+	resp.OutputFiles = outputFiles
+	resp.ExportedRows = exportedRows
+	log.Info("GetExportProgress done", zap.String("jobState", job.GetState().String()), zap.Int64("progress", progress))
+	return resp, nil
+}
+
+func (s *Server) ListExports(ctx context.Context, req *internalpb.ListExportsRequestInternal) (*internalpb.ListExportsResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &internalpb.ListExportsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	resp := &internalpb.ListExportsResponse{
+		Status:     merr.Success(),
+		JobIDs:     make([]string, 0),
+		States:     make([]internalpb.ExportJobState, 0),
+		Reasons:    make([]string, 0),
+		Progresses: make([]int64, 0),
+	}
+
+	var jobs []ExportJob
+	if req.GetCollectionID() != 0 {
+		jobs = s.exportMeta.GetJobBy(ctx, WithExportCollectionID(req.GetCollectionID()))
+	} else {
+		jobs = s.exportMeta.GetJobBy(ctx, WithDbID(req.GetDbID()))
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].GetJobID() < jobs[j].GetJobID()
+	})
+
+	for _, job := range jobs {
+		progress, _, _ := GetExportJobProgress(ctx, job, s.exportMeta)
+		resp.JobIDs = append(resp.JobIDs, fmt.Sprintf("%d", job.GetJobID()))
+		resp.States = append(resp.States, job.GetState())
+		resp.Reasons = append(resp.Reasons, job.GetReason())
+		resp.Progresses = append(resp.Progresses, progress)
+		resp.CollectionNames = append(resp.CollectionNames, job.GetCollectionName())
+	}
+	return resp, nil
+}
+
+// CancelExport marks the unfinished export job as failed, the running tasks are aborted by the export checker,
+// and the files which have been exported are left in the output path.
+func (s *Server) CancelExport(ctx context.Context, in *internalpb.CancelExportRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	log := log.Ctx(ctx).With(zap.String("jobID", in.GetJobID()))
+	jobID, err := strconv.ParseInt(in.GetJobID(), 10, 64)
+	if err != nil {
+		return merr.Status(merr.WrapErrParameterInvalidMsg(fmt.Sprintf("parse job id failed, err=%s", err))), nil
+	}
+	job := s.exportMeta.GetJob(ctx, jobID)
+	if job == nil {
+		return merr.Status(merr.WrapErrParameterInvalidMsg(fmt.Sprintf("export job does not exist, jobID=%d", jobID))), nil
+	}
+	if job.GetState() == internalpb.ExportJobState_ExportCompleted || job.GetState() == internalpb.ExportJobState_ExportFailed {
+		return merr.Status(merr.WrapErrParameterInvalidMsg(fmt.Sprintf("export job has finished, jobID=%d, state=%s",
+			jobID, job.GetState().String()))), nil
+	}
+	err = s.exportMeta.UpdateJob(ctx, jobID, UpdateExportJobState(internalpb.ExportJobState_ExportFailed),
+		UpdateExportJobReason("canceled by user"))
+	if err != nil {
+		return merr.Status(err), nil
+	}
+	log.Info("export job canceled")
+	return merr.Success(), nil
+}
+
 // NotifyDropPartition notifies DataCoord to drop segments of specified partition
 func (s *Server) NotifyDropPartition(ctx context.Context, channel string, partitionIDs []int64) error {
 	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
@@ -1594,6 +1594,41 @@ func TestImportV2(t *testing.T) {
 		assert.Equal(t, int32(0), resp.GetStatus().GetCode())
 		jobs = s.importMeta.GetJobBy(context.TODO())
 		assert.Equal(t, 1, len(jobs))
+
+		// the patterns are not expanded when checking the import message
+		resp, err = s.ImportV2(ctx, &internalpb.ImportRequestInternal{
+			Files: []*internalpb.ImportFile{
+				{
+					Id:    1,
+					Paths: []string{"data/"},
+				},
+			},
+			ChannelNames: []string{"foo_1v1"},
+			JobID:        100,
+		})
+		assert.NoError(t, err)
+		assert.True(t, errors.Is(merr.Error(resp.GetStatus()), merr.ErrImportFailed))
+
+		// the files expanded when checking the import message are imported without listing again
+		resp, err = s.ImportV2(ctx, &internalpb.ImportRequestInternal{
+			Files: []*internalpb.ImportFile{
+				{
+					Id:    1,
+					Paths: []string{"data/"},
+				},
+			},
+			ChannelNames: []string{"foo_1v1"},
+			JobID:        100,
+			ExpandedFiles: []*internalpb.ImportFile{
+				{Paths: []string{"data/a.json"}},
+				{Paths: []string{"data/b.json"}},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), resp.GetStatus().GetCode())
+		job := s.importMeta.GetJob(context.TODO(), 100)
+		assert.Equal(t, 2, len(job.GetFiles()))
+		assert.Equal(t, []string{"data/"}, job.(*importJob).GetRequestedFiles()[0].GetPaths())
 	})
 
 	t.Run("GetImportProgress", func(t *testing.T) {
@@ -1696,6 +1731,7 @@ func TestImportV2(t *testing.T) {
 		assert.Equal(t, 1, len(resp.GetStates()))
 		assert.Equal(t, 1, len(resp.GetReasons()))
 		assert.Equal(t, 1, len(resp.GetProgresses()))
+		assert.Equal(t, []string{"normal"}, resp.GetPriorities())
 	})
 }
 
@@ -63,6 +63,13 @@ type Cluster interface {
 	// DropImport drops an import task
 	DropImport(nodeID int64, taskID int64) error
 
+	// CreateExport creates an export task
+	CreateExport(nodeID int64, in *datapb.ExportRequest, taskSlot int64) error
+	// QueryExport queries the status of an export task
+	QueryExport(nodeID int64, in *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error)
+	// DropExport drops an export task
+	DropExport(nodeID int64, taskID int64) error
+
 	// CreateIndex creates an index building task
 	CreateIndex(nodeID int64, in *workerpb.CreateJobRequest) error
 	// QueryIndex queries the status of index building tasks
@@ -343,6 +350,54 @@ func (c *cluster) DropImport(nodeID int64, taskID int64) error {
 	return c.dropTask(nodeID, properties)
 }
 
+func (c *cluster) CreateExport(nodeID int64, in *datapb.ExportRequest, taskSlot int64) error {
+	properties := taskcommon.NewProperties(nil)
+	properties.AppendClusterID(paramtable.Get().CommonCfg.ClusterPrefix.GetValue())
+	properties.AppendTaskID(in.GetTaskID())
+	properties.AppendType(taskcommon.Export)
+	properties.AppendTaskSlot(taskSlot)
+	return c.createTask(nodeID, in, properties)
+}
+
+func (c *cluster) QueryExport(nodeID int64, in *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error) {
+	reqProperties := taskcommon.NewProperties(nil)
+	reqProperties.AppendClusterID(paramtable.Get().CommonCfg.ClusterPrefix.GetValue())
+	reqProperties.AppendTaskID(in.GetTaskID())
+	reqProperties.AppendType(taskcommon.Export)
+	resp, err := c.queryTask(nodeID, reqProperties)
+	if err != nil {
+		return nil, err
+	}
+
+	resProperties := taskcommon.NewProperties(resp.GetProperties())
+	state, err := resProperties.GetTaskState()
+	if err != nil {
+		return nil, err
+	}
+	reason := resProperties.GetTaskReason()
+	switch state {
+	case taskcommon.None, taskcommon.Init, taskcommon.Retry:
+		return &datapb.QueryExportResponse{State: taskcommon.ToImportState(state), Reason: reason}, nil
+	case taskcommon.InProgress, taskcommon.Finished, taskcommon.Failed:
+		result := &datapb.QueryExportResponse{}
+		err = proto.Unmarshal(resp.GetPayload(), result)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		panic("should not happen")
+	}
+}
+
+func (c *cluster) DropExport(nodeID int64, taskID int64) error {
+	properties := taskcommon.NewProperties(nil)
+	properties.AppendClusterID(paramtable.Get().CommonCfg.ClusterPrefix.GetValue())
+	properties.AppendTaskID(taskID)
+	properties.AppendType(taskcommon.Export)
+	return c.dropTask(nodeID, properties)
+}
+
 func (c *cluster) CreateIndex(nodeID int64, in *workerpb.CreateJobRequest) error {
 	properties := taskcommon.NewProperties(nil)
 	properties.AppendClusterID(paramtable.Get().CommonCfg.ClusterPrefix.GetValue())
@@ -116,6 +116,54 @@ func (_c *MockCluster_CreateCompaction_Call) RunAndReturn(run func(int64, *datap
 	return _c
 }
 
+// CreateExport provides a mock function with given fields: nodeID, in, taskSlot
+func (_m *MockCluster) CreateExport(nodeID int64, in *datapb.ExportRequest, taskSlot int64) error {
+	ret := _m.Called(nodeID, in, taskSlot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExport")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, *datapb.ExportRequest, int64) error); ok {
+		r0 = rf(nodeID, in, taskSlot)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCluster_CreateExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExport'
+type MockCluster_CreateExport_Call struct {
+	*mock.Call
+}
+
+// CreateExport is a helper method to define mock.On call
+//   - nodeID int64
+//   - in *datapb.ExportRequest
+//   - taskSlot int64
+func (_e *MockCluster_Expecter) CreateExport(nodeID interface{}, in interface{}, taskSlot interface{}) *MockCluster_CreateExport_Call {
+	return &MockCluster_CreateExport_Call{Call: _e.mock.On("CreateExport", nodeID, in, taskSlot)}
+}
+
+func (_c *MockCluster_CreateExport_Call) Run(run func(nodeID int64, in *datapb.ExportRequest, taskSlot int64)) *MockCluster_CreateExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(*datapb.ExportRequest), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCluster_CreateExport_Call) Return(_a0 error) *MockCluster_CreateExport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCluster_CreateExport_Call) RunAndReturn(run func(int64, *datapb.ExportRequest, int64) error) *MockCluster_CreateExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateImport provides a mock function with given fields: nodeID, in, taskSlot
 func (_m *MockCluster) CreateImport(nodeID int64, in *datapb.ImportRequest, taskSlot int64) error {
 	ret := _m.Called(nodeID, in, taskSlot)
@@ -400,6 +448,53 @@ func (_c *MockCluster_DropCompaction_Call) RunAndReturn(run func(int64, int64) e
 	return _c
 }
 
+// DropExport provides a mock function with given fields: nodeID, taskID
+func (_m *MockCluster) DropExport(nodeID int64, taskID int64) error {
+	ret := _m.Called(nodeID, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropExport")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(nodeID, taskID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCluster_DropExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropExport'
+type MockCluster_DropExport_Call struct {
+	*mock.Call
+}
+
+// DropExport is a helper method to define mock.On call
+//   - nodeID int64
+//   - taskID int64
+func (_e *MockCluster_Expecter) DropExport(nodeID interface{}, taskID interface{}) *MockCluster_DropExport_Call {
+	return &MockCluster_DropExport_Call{Call: _e.mock.On("DropExport", nodeID, taskID)}
+}
+
+func (_c *MockCluster_DropExport_Call) Run(run func(nodeID int64, taskID int64)) *MockCluster_DropExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCluster_DropExport_Call) Return(_a0 error) *MockCluster_DropExport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCluster_DropExport_Call) RunAndReturn(run func(int64, int64) error) *MockCluster_DropExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropImport provides a mock function with given fields: nodeID, taskID
 func (_m *MockCluster) DropImport(nodeID int64, taskID int64) error {
 	ret := _m.Called(nodeID, taskID)
@@ -659,6 +754,65 @@ func (_c *MockCluster_QueryCompaction_Call) RunAndReturn(run func(int64, *datapb
 	return _c
 }
 
+// QueryExport provides a mock function with given fields: nodeID, in
+func (_m *MockCluster) QueryExport(nodeID int64, in *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error) {
+	ret := _m.Called(nodeID, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryExport")
+	}
+
+	var r0 *datapb.QueryExportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error)); ok {
+		return rf(nodeID, in)
+	}
+	if rf, ok := ret.Get(0).(func(int64, *datapb.QueryExportRequest) *datapb.QueryExportResponse); ok {
+		r0 = rf(nodeID, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.QueryExportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, *datapb.QueryExportRequest) error); ok {
+		r1 = rf(nodeID, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCluster_QueryExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryExport'
+type MockCluster_QueryExport_Call struct {
+	*mock.Call
+}
+
+// QueryExport is a helper method to define mock.On call
+//   - nodeID int64
+//   - in *datapb.QueryExportRequest
+func (_e *MockCluster_Expecter) QueryExport(nodeID interface{}, in interface{}) *MockCluster_QueryExport_Call {
+	return &MockCluster_QueryExport_Call{Call: _e.mock.On("QueryExport", nodeID, in)}
+}
+
+func (_c *MockCluster_QueryExport_Call) Run(run func(nodeID int64, in *datapb.QueryExportRequest)) *MockCluster_QueryExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(*datapb.QueryExportRequest))
+	})
+	return _c
+}
+
+func (_c *MockCluster_QueryExport_Call) Return(_a0 *datapb.QueryExportResponse, _a1 error) *MockCluster_QueryExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCluster_QueryExport_Call) RunAndReturn(run func(int64, *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error)) *MockCluster_QueryExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // QueryImport provides a mock function with given fields: nodeID, in
 func (_m *MockCluster) QueryImport(nodeID int64, in *datapb.QueryImportRequest) (*datapb.QueryImportResponse, error) {
 	ret := _m.Called(nodeID, in)
@@ -0,0 +1,291 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"container/heap"
+	"sync"
+)
+
+// FairTask is the task scheduled fairly across groups, such as the import tasks
+// which are grouped by databases, so that a huge job of one group cannot starve the others.
+type FairTask interface {
+	Task
+	// GetTaskGroup returns the group of task, the groups share the worker slots by weights.
+	GetTaskGroup() string
+	// GetTaskWeight returns the weight of task, the tasks of higher weight are scheduled
+	// first in the group, and take more share of the worker slots.
+	GetTaskWeight() int64
+}
+
+// GroupFilter returns false if the tasks of group cannot be scheduled for now,
+// e.g. the number of running tasks of the group reaches the limit.
+type GroupFilter func(group string) bool
+
+// dispatchCommitter is implemented by the queues which charge the popped tasks tentatively,
+// the scheduler commits the charge once the task is dispatched, the task pushed back before
+// that is refunded.
+type dispatchCommitter interface {
+	Commit(taskID int64)
+}
+
+var (
+	_ PriorityQueue     = &fairQueuePolicy{}
+	_ dispatchCommitter = &fairQueuePolicy{}
+)
+
+// fairQueuePolicy implements a weighted fair queue based on start-time fair queuing.
+// The FairTasks are grouped, each group is charged with virtual time of slot/weight
+// when a task of it is popped, and the group with the smallest virtual time is served first.
+// The charge is refunded if the task is pushed back before it's committed, e.g. no worker
+// has free slots, so that the group isn't penalized for the tasks which never ran.
+// The other tasks are sorted by taskID as priorityQueuePolicy does.
+type fairQueuePolicy struct {
+	lock   sync.RWMutex
+	tasks  map[int64]Task
+	heap   *taskHeap
+	items  map[int64]*fairTask
+	groups map[string]*fairGroup
+	// charges are the uncommitted charges of the popped FairTasks.
+	charges map[int64]*fairCharge
+	// virtualTime is the start time of the last popped FairTask, which is monotone increasing
+	// unless the charge of the last popped FairTask is refunded.
+	virtualTime float64
+	lastPopped  int64
+	filter      GroupFilter
+}
+
+// fairCharge records the virtual time charged to the group by a popped FairTask.
+type fairCharge struct {
+	group           string
+	cost            float64
+	prevVirtualTime float64
+}
+
+type fairGroup struct {
+	name       string
+	finishTime float64
+	heap       *fairTaskHeap
+}
+
+// fairTask caches the group and weight of FairTask, which are fixed once the task is queued.
+type fairTask struct {
+	task   FairTask
+	group  string
+	weight int64
+}
+
+// fairTaskHeap sorts tasks by weight (higher first), then by taskID (smaller first).
+type fairTaskHeap []*fairTask
+
+func (h fairTaskHeap) Len() int { return len(h) }
+func (h fairTaskHeap) Less(i, j int) bool {
+	if h[i].weight != h[j].weight {
+		return h[i].weight > h[j].weight
+	}
+	return h[i].task.GetTaskID() < h[j].task.GetTaskID()
+}
+func (h fairTaskHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *fairTaskHeap) Push(x interface{}) {
+	*h = append(*h, x.(*fairTask))
+}
+
+func (h *fairTaskHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[0 : n-1]
+	return item
+}
+
+// NewFairQueuePolicy creates a new weighted fair queue policy, the groups rejected by the filter are skipped on Pop.
+func NewFairQueuePolicy(filter GroupFilter) *fairQueuePolicy {
+	h := &taskHeap{}
+	heap.Init(h)
+	return &fairQueuePolicy{
+		tasks:   make(map[int64]Task),
+		heap:    h,
+		items:   make(map[int64]*fairTask),
+		groups:  make(map[string]*fairGroup),
+		charges: make(map[int64]*fairCharge),
+		filter:  filter,
+	}
+}
+
+func (fqp *fairQueuePolicy) Push(task Task) {
+	fqp.lock.Lock()
+	defer fqp.lock.Unlock()
+
+	taskID := task.GetTaskID()
+	if _, exists := fqp.tasks[taskID]; exists {
+		return
+	}
+	fqp.tasks[taskID] = task
+	t, ok := task.(FairTask)
+	if !ok {
+		heap.Push(fqp.heap, task)
+		return
+	}
+	item := &fairTask{task: t, group: t.GetTaskGroup(), weight: max(t.GetTaskWeight(), 1)}
+	fqp.items[taskID] = item
+	group, ok := fqp.groups[item.group]
+	if !ok {
+		group = &fairGroup{name: item.group, heap: &fairTaskHeap{}}
+		fqp.groups[group.name] = group
+	}
+	fqp.refund(taskID, group)
+	heap.Push(group.heap, item)
+}
+
+// refund gives back the uncommitted charge of the task which is pushed back without being dispatched.
+func (fqp *fairQueuePolicy) refund(taskID int64, group *fairGroup) {
+	charge, ok := fqp.charges[taskID]
+	if !ok {
+		return
+	}
+	delete(fqp.charges, taskID)
+	if group.name == charge.group {
+		group.finishTime = max(group.finishTime-charge.cost, 0)
+	}
+	if fqp.lastPopped == taskID {
+		fqp.virtualTime = charge.prevVirtualTime
+		fqp.lastPopped = 0
+	}
+}
+
+// Commit makes the charge of the popped task final, it's called once the task is dispatched
+// or dropped by the scheduler.
+func (fqp *fairQueuePolicy) Commit(taskID int64) {
+	fqp.lock.Lock()
+	defer fqp.lock.Unlock()
+
+	delete(fqp.charges, taskID)
+	if fqp.lastPopped == taskID {
+		fqp.lastPopped = 0
+	}
+}
+
+// Pop returns the next task to run. A FairTask is compared with the other tasks by taskID,
+// which keeps the scheduling order between the different kinds of tasks as it was.
+func (fqp *fairQueuePolicy) Pop() Task {
+	fqp.lock.Lock()
+	defer fqp.lock.Unlock()
+
+	group := fqp.pickGroup()
+	if fqp.heap.Len() > 0 && (group == nil || (*fqp.heap)[0].GetTaskID() < (*group.heap)[0].task.GetTaskID()) {
+		task := heap.Pop(fqp.heap).(Task)
+		delete(fqp.tasks, task.GetTaskID())
+		return task
+	}
+	if group == nil {
+		return nil
+	}
+
+	item := heap.Pop(group.heap).(*fairTask)
+	delete(fqp.tasks, item.task.GetTaskID())
+	delete(fqp.items, item.task.GetTaskID())
+	startTime := max(group.finishTime, fqp.virtualTime)
+	cost := float64(max(item.task.GetTaskSlot(), 1)) / float64(item.weight)
+	fqp.charges[item.task.GetTaskID()] = &fairCharge{group: group.name, cost: cost, prevVirtualTime: fqp.virtualTime}
+	group.finishTime = startTime + cost
+	fqp.virtualTime = startTime
+	fqp.lastPopped = item.task.GetTaskID()
+	if group.heap.Len() == 0 {
+		// the idle group earns no credit, it starts from the current virtual time when it's active again
+		delete(fqp.groups, group.name)
+	}
+	return item.task
+}
+
+// pickGroup returns the group with the smallest start time, nil if no group can be scheduled.
+func (fqp *fairQueuePolicy) pickGroup() *fairGroup {
+	var (
+		picked    *fairGroup
+		pickedKey float64
+	)
+	for _, group := range fqp.groups {
+		if fqp.filter != nil && !fqp.filter(group.name) {
+			continue
+		}
+		key := max(group.finishTime, fqp.virtualTime)
+		if picked == nil || key < pickedKey ||
+			(key == pickedKey && (*group.heap)[0].task.GetTaskID() < (*picked.heap)[0].task.GetTaskID()) {
+			picked, pickedKey = group, key
+		}
+	}
+	return picked
+}
+
+func (fqp *fairQueuePolicy) Get(taskID int64) Task {
+	fqp.lock.RLock()
+	defer fqp.lock.RUnlock()
+
+	return fqp.tasks[taskID]
+}
+
+func (fqp *fairQueuePolicy) TaskIDs() []int64 {
+	fqp.lock.RLock()
+	defer fqp.lock.RUnlock()
+
+	taskIDs := make([]int64, 0, len(fqp.tasks))
+	for _, t := range *fqp.heap {
+		taskIDs = append(taskIDs, t.GetTaskID())
+	}
+	for _, group := range fqp.groups {
+		for _, item := range *group.heap {
+			taskIDs = append(taskIDs, item.task.GetTaskID())
+		}
+	}
+	return taskIDs
+}
+
+func (fqp *fairQueuePolicy) Remove(taskID int64) {
+	fqp.lock.Lock()
+	defer fqp.lock.Unlock()
+
+	delete(fqp.charges, taskID)
+	if _, exists := fqp.tasks[taskID]; !exists {
+		return
+	}
+	delete(fqp.tasks, taskID)
+
+	item, ok := fqp.items[taskID]
+	if !ok {
+		for i, t := range *fqp.heap {
+			if t.GetTaskID() == taskID {
+				heap.Remove(fqp.heap, i)
+				break
+			}
+		}
+		return
+	}
+	delete(fqp.items, taskID)
+	group, ok := fqp.groups[item.group]
+	if !ok {
+		return
+	}
+	for i, t := range *group.heap {
+		if t == item {
+			heap.Remove(group.heap, i)
+			break
+		}
+	}
+	if group.heap.Len() == 0 {
+		delete(fqp.groups, group.name)
+	}
+}
@@ -0,0 +1,157 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testFairTask struct {
+	*MockTask
+	group  string
+	weight int64
+}
+
+func (t *testFairTask) GetTaskGroup() string {
+	return t.group
+}
+
+func (t *testFairTask) GetTaskWeight() int64 {
+	return t.weight
+}
+
+func newTestFairTask(t *testing.T, taskID int64, group string, weight int64) *testFairTask {
+	task := NewMockTask(t)
+	task.EXPECT().GetTaskID().Return(taskID).Maybe()
+	task.EXPECT().GetTaskSlot().Return(1).Maybe()
+	return &testFairTask{MockTask: task, group: group, weight: weight}
+}
+
+func popTaskIDs(queue PriorityQueue) []int64 {
+	taskIDs := make([]int64, 0)
+	for task := queue.Pop(); task != nil; task = queue.Pop() {
+		taskIDs = append(taskIDs, task.GetTaskID())
+	}
+	return taskIDs
+}
+
+func TestFairQueue_Fairness(t *testing.T) {
+	queue := NewFairQueuePolicy(nil)
+
+	// a huge job of group "a" and a small job of group "b"
+	for i := int64(1); i <= 4; i++ {
+		queue.Push(newTestFairTask(t, i, "a", 2))
+	}
+	queue.Push(newTestFairTask(t, 10, "b", 2))
+	queue.Push(newTestFairTask(t, 11, "b", 2))
+	assert.Equal(t, 6, len(queue.TaskIDs()))
+
+	assert.Equal(t, []int64{1, 10, 2, 11, 3, 4}, popTaskIDs(queue))
+	assert.Equal(t, 0, len(queue.TaskIDs()))
+}
+
+func TestFairQueue_Weight(t *testing.T) {
+	queue := NewFairQueuePolicy(nil)
+
+	for i := int64(1); i <= 4; i++ {
+		queue.Push(newTestFairTask(t, i, "a", 1))
+	}
+	for i := int64(10); i <= 14; i++ {
+		queue.Push(newTestFairTask(t, i, "b", 4))
+	}
+	// the higher priority task goes first in the group
+	queue.Push(newTestFairTask(t, 20, "a", 4))
+
+	// group "b" takes 4 times share of group "a" after the high priority task of "a"
+	assert.Equal(t, []int64{10, 20, 1, 11, 12, 13, 14, 2, 3, 4}, popTaskIDs(queue))
+}
+
+func TestFairQueue_MixedTasks(t *testing.T) {
+	queue := NewFairQueuePolicy(nil)
+
+	task1 := NewMockTask(t)
+	task1.EXPECT().GetTaskID().Return(int64(5))
+	task2 := NewMockTask(t)
+	task2.EXPECT().GetTaskID().Return(int64(15))
+	queue.Push(task1)
+	queue.Push(task2)
+	queue.Push(newTestFairTask(t, 1, "a", 2))
+	queue.Push(newTestFairTask(t, 10, "b", 2))
+
+	assert.Equal(t, []int64{1, 5, 10, 15}, popTaskIDs(queue))
+}
+
+func TestFairQueue_Filter(t *testing.T) {
+	full := map[string]bool{"a": true}
+	queue := NewFairQueuePolicy(func(group string) bool {
+		return !full[group]
+	})
+
+	queue.Push(newTestFairTask(t, 1, "a", 2))
+	queue.Push(newTestFairTask(t, 2, "a", 2))
+	queue.Push(newTestFairTask(t, 10, "b", 2))
+
+	assert.Equal(t, []int64{10}, popTaskIDs(queue))
+	assert.Equal(t, 2, len(queue.TaskIDs()))
+
+	full["a"] = false
+	assert.Equal(t, []int64{1, 2}, popTaskIDs(queue))
+}
+
+func TestFairQueue_GetAndRemove(t *testing.T) {
+	queue := NewFairQueuePolicy(nil)
+
+	task1 := NewMockTask(t)
+	task1.EXPECT().GetTaskID().Return(int64(5))
+	queue.Push(task1)
+	queue.Push(newTestFairTask(t, 1, "a", 2))
+	queue.Push(newTestFairTask(t, 2, "a", 2))
+	// duplicated task is ignored
+	queue.Push(newTestFairTask(t, 2, "a", 2))
+	assert.Equal(t, 3, len(queue.TaskIDs()))
+
+	assert.NotNil(t, queue.Get(1))
+	assert.NotNil(t, queue.Get(5))
+	assert.Nil(t, queue.Get(100))
+
+	queue.Remove(1)
+	queue.Remove(5)
+	queue.Remove(100)
+	assert.Nil(t, queue.Get(1))
+	assert.Equal(t, []int64{2}, popTaskIDs(queue))
+}
+
+func TestFairQueue_Refund(t *testing.T) {
+	queue := NewFairQueuePolicy(nil)
+
+	task1 := newTestFairTask(t, 1, "a", 2)
+	queue.Push(task1)
+	queue.Push(newTestFairTask(t, 2, "a", 2))
+	queue.Push(newTestFairTask(t, 10, "b", 2))
+
+	// the task pushed back without being dispatched doesn't charge its group
+	assert.Equal(t, int64(1), queue.Pop().GetTaskID())
+	queue.Push(task1)
+	assert.Equal(t, int64(1), queue.Pop().GetTaskID())
+
+	// the committed charge isn't refunded
+	queue.Commit(1)
+	queue.Push(task1)
+	assert.Equal(t, []int64{10, 1, 2}, popTaskIDs(queue))
+}
@@ -56,6 +56,10 @@ type globalTaskScheduler struct {
 	execPool     *conc.Pool[struct{}]
 	checkPool    *conc.Pool[struct{}]
 	cluster      session.Cluster
+
+	// groupRunningNum is the number of running FairTasks of each group,
+	// only accessed by the schedule loop.
+	groupRunningNum map[string]int
 }
 
 func (s *globalTaskScheduler) Enqueue(task Task) {
@@ -155,6 +159,13 @@ func (s *globalTaskScheduler) pickNode(workerSlots map[int64]*session.WorkerSlot
 	return NullNodeID
 }
 
+// commitTask makes the charge of the popped task final if the pending queue charges the tasks.
+func (s *globalTaskScheduler) commitTask(task Task) {
+	if committer, ok := s.pendingTasks.(dispatchCommitter); ok {
+		committer.Commit(task.GetTaskID())
+	}
+}
+
 func (s *globalTaskScheduler) schedule() {
 	pendingNum := len(s.pendingTasks.TaskIDs())
 	if pendingNum == 0 {
@@ -163,6 +174,13 @@ func (s *globalTaskScheduler) schedule() {
 	nodeSlots := s.cluster.QuerySlot()
 	log.Ctx(s.ctx).Info("scheduling pending tasks...", zap.Int("num", pendingNum), zap.Any("nodeSlots", nodeSlots))
 
+	s.groupRunningNum = make(map[string]int)
+	for _, task := range s.runningTasks.Values() {
+		if fairTask, ok := task.(FairTask); ok {
+			s.groupRunningNum[fairTask.GetTaskGroup()]++
+		}
+	}
+
 	futures := make([]*conc.Future[struct{}], 0)
 	for {
 		task := s.pendingTasks.Pop()
@@ -175,6 +193,9 @@ func (s *globalTaskScheduler) schedule() {
 			s.pendingTasks.Push(task)
 			break
 		}
+		if fairTask, ok := task.(FairTask); ok {
+			s.groupRunningNum[fairTask.GetTaskGroup()]++
+		}
 		future := s.execPool.Submit(func() (struct{}, error) {
 			s.mu.RLock(task.GetTaskID())
 			defer s.mu.RUnlock(task.GetTaskID())
@@ -183,12 +204,15 @@ func (s *globalTaskScheduler) schedule() {
 				task.CreateTaskOnWorker(nodeID, s.cluster)
 				switch task.GetTaskState() {
 				case taskcommon.Init, taskcommon.Retry:
+					// the task isn't dispatched, pushing it back refunds its charge
 					s.pendingTasks.Push(task)
+					return struct{}{}, nil
 				case taskcommon.InProgress:
 					task.SetTaskTime(taskcommon.TimeStart, time.Now())
 					s.runningTasks.Insert(task.GetTaskID(), task)
 				}
 			}
+			s.commitTask(task)
 			return struct{}{}, nil
 		})
 		futures = append(futures, future)
@@ -196,6 +220,13 @@ func (s *globalTaskScheduler) schedule() {
 	_ = conc.AwaitAll(futures...)
 }
 
+// isGroupSchedulable checks whether the number of running tasks of the group reaches the limit.
+// Only import tasks are FairTasks for now, which are grouped by databases.
+func (s *globalTaskScheduler) isGroupSchedulable(group string) bool {
+	limit := paramtable.Get().DataCoordCfg.MaxConcurrentImportTaskNumPerDB.GetAsInt()
+	return limit <= 0 || s.groupRunningNum[group] < limit
+}
+
 func (s *globalTaskScheduler) check() {
 	if s.runningTasks.Len() <= 0 {
 		return
@@ -315,15 +346,17 @@ func NewGlobalTaskScheduler(ctx context.Context, cluster session.Cluster) Global
 	execPool := conc.NewPool[struct{}](128)
 	checkPool := conc.NewPool[struct{}](128)
 	ctx1, cancel := context.WithCancel(ctx)
-	return &globalTaskScheduler{
-		ctx:          ctx1,
-		cancel:       cancel,
-		wg:           sync.WaitGroup{},
-		mu:           lock.NewKeyLock[int64](),
-		pendingTasks: NewPriorityQueuePolicy(),
-		runningTasks: typeutil.NewConcurrentMap[int64, Task](),
-		execPool:     execPool,
-		checkPool:    checkPool,
-		cluster:      cluster,
+	s := &globalTaskScheduler{
+		ctx:             ctx1,
+		cancel:          cancel,
+		wg:              sync.WaitGroup{},
+		mu:              lock.NewKeyLock[int64](),
+		runningTasks:    typeutil.NewConcurrentMap[int64, Task](),
+		execPool:        execPool,
+		checkPool:       checkPool,
+		cluster:         cluster,
+		groupRunningNum: make(map[string]int),
 	}
+	s.pendingTasks = NewFairQueuePolicy(s.isGroupSchedulable)
+	return s
 }
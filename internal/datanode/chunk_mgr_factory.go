@@ -19,10 +19,16 @@ package datanode
 import (
 	"context"
 	"fmt"
+	"path"
+	"sync"
+
+	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/objectstorage"
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
@@ -32,6 +38,10 @@ type StorageFactory interface {
 
 type chunkMgrFactory struct {
 	cached *typeutil.ConcurrentMap[string, storage.ChunkManager]
+
+	// diskCache is shared by all the chunk managers created by the factory
+	diskCacheOnce sync.Once
+	diskCache     *storage.DiskCache
 }
 
 func NewChunkMgrFactory() *chunkMgrFactory {
@@ -58,7 +68,37 @@ func (m *chunkMgrFactory) NewChunkManager(ctx context.Context, config *indexpb.S
 		objectstorage.CreateBucket(true),
 		objectstorage.GcpCredentialJSON(config.GetGcpCredentialJSON()),
 	)
-	return chunkManagerFactory.NewPersistentStorageChunkManager(ctx)
+	cm, err := chunkManagerFactory.NewPersistentStorageChunkManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+	diskCacheCfg := &paramtable.Get().DataNodeCfg.DiskCache
+	if !diskCacheCfg.Enabled.GetAsBool() {
+		return cm, nil
+	}
+	diskCache := m.getDiskCache()
+	if diskCache == nil {
+		return cm, nil
+	}
+	namespace := m.cacheKey(config.GetStorageType(), config.GetBucketName(), config.GetAddress())
+	return storage.NewDiskCacheChunkManager(cm, diskCache, namespace, diskCacheCfg.Prefixes.GetAsStrings()), nil
+}
+
+// getDiskCache returns the shared disk cache, the chunk managers are not cached
+// on the local disk if it fails to be created.
+func (m *chunkMgrFactory) getDiskCache() *storage.DiskCache {
+	m.diskCacheOnce.Do(func() {
+		diskCacheCfg := &paramtable.Get().DataNodeCfg.DiskCache
+		dir := path.Join(paramtable.Get().LocalStorageCfg.Path.GetValue(), "disk_cache", typeutil.DataNodeRole)
+		diskCache, err := storage.NewDiskCache(typeutil.DataNodeRole, dir,
+			diskCacheCfg.Capacity.GetAsInt64(), diskCacheCfg.MaxObjectSize.GetAsInt64())
+		if err != nil {
+			log.Warn("failed to create disk cache, the remote objects are not cached", zap.String("dir", dir), zap.Error(err))
+			return
+		}
+		m.diskCache = diskCache
+	})
+	return m.diskCache
 }
 
 func (m *chunkMgrFactory) cacheKey(storageType, bucket, address string) string {
@@ -32,6 +32,7 @@ const (
 	ImportTaskType      TaskType = 1
 	L0PreImportTaskType TaskType = 2
 	L0ImportTaskType    TaskType = 3
+	ExportTaskType      TaskType = 4
 )
 
 var ImportTaskTypeName = map[TaskType]string{
@@ -39,6 +40,7 @@ var ImportTaskTypeName = map[TaskType]string{
 	1: "ImportTask",
 	2: "L0PreImportTaskType",
 	3: "L0ImportTaskType",
+	4: "ExportTask",
 }
 
 func (t TaskType) String() string {
@@ -77,6 +79,8 @@ func UpdateState(state datapb.ImportTaskStateV2) UpdateAction {
 			t.(*L0PreImportTask).PreImportTask.State = state
 		case L0ImportTaskType:
 			t.(*L0ImportTask).ImportTaskV2.State = state
+		case ExportTaskType:
+			t.(*ExportTask).ExportTask.State = state
 		}
 	}
 }
@@ -92,6 +96,8 @@ func UpdateReason(reason string) UpdateAction {
 			t.(*L0PreImportTask).PreImportTask.Reason = reason
 		case L0ImportTaskType:
 			t.(*L0ImportTask).ImportTaskV2.Reason = reason
+		case ExportTaskType:
+			t.(*ExportTask).ExportTask.Reason = reason
 		}
 	}
 }
@@ -110,6 +116,9 @@ func UpdateFileStat(idx int, fileStat *datapb.ImportFileStats) UpdateAction {
 			t.FileStats[idx].TotalRows = fileStat.GetTotalRows()
 			t.FileStats[idx].TotalMemorySize = fileStat.GetTotalMemorySize()
 			t.FileStats[idx].HashedStats = fileStat.GetHashedStats()
+			t.FileStats[idx].ErrorRows = fileStat.GetErrorRows()
+			t.FileStats[idx].ErrorReportPath = fileStat.GetErrorReportPath()
+			t.FileStats[idx].SampleErrors = fileStat.GetSampleErrors()
 		}
 	}
 }
@@ -151,6 +160,15 @@ func UpdateSegmentInfo(info *datapb.ImportSegmentInfo) UpdateAction {
 	}
 }
 
+func UpdateExportResult(outputFiles []string, exportedRows int64) UpdateAction {
+	return func(task Task) {
+		if t, ok := task.(*ExportTask); ok {
+			t.ExportTask.OutputFiles = append(t.ExportTask.OutputFiles, outputFiles...)
+			t.ExportTask.ExportedRows += exportedRows
+		}
+	}
+}
+
 type Task interface {
 	Execute() []*conc.Future[any]
 	GetJobID() int64
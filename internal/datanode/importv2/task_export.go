@@ -0,0 +1,440 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importv2
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/apache/arrow/go/v17/parquet"
+	"github.com/apache/arrow/go/v17/parquet/compress"
+	"github.com/apache/arrow/go/v17/parquet/pqarrow"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/exprutil"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/conc"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// ExportTask dumps the rows of the flushed segments which are visible at the export timestamp
+// into parquet files, the deletions of the segments and the L0 segments are applied.
+type ExportTask struct {
+	*datapb.ExportTask
+	ctx    context.Context
+	cancel context.CancelFunc
+	req    *datapb.ExportRequest
+
+	manager TaskManager
+	cm      storage.ChunkManager
+}
+
+func NewExportTask(req *datapb.ExportRequest,
+	manager TaskManager,
+	cm storage.ChunkManager,
+) Task {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &ExportTask{
+		ExportTask: &datapb.ExportTask{
+			JobID:        req.GetJobID(),
+			TaskID:       req.GetTaskID(),
+			CollectionID: req.GetCollectionID(),
+			SegmentIDs: lo.Map(req.GetSegments(), func(segment *datapb.ExportSegment, _ int) int64 {
+				return segment.GetSegmentID()
+			}),
+			State: datapb.ImportTaskStateV2_Pending,
+		},
+		ctx:     ctx,
+		cancel:  cancel,
+		req:     req,
+		manager: manager,
+		cm:      cm,
+	}
+}
+
+func (t *ExportTask) GetType() TaskType {
+	return ExportTaskType
+}
+
+func (t *ExportTask) GetPartitionIDs() []int64 {
+	return lo.Uniq(lo.Map(t.req.GetSegments(), func(segment *datapb.ExportSegment, _ int) int64 {
+		return segment.GetPartitionID()
+	}))
+}
+
+func (t *ExportTask) GetVchannels() []string {
+	return lo.Uniq(lo.Map(t.req.GetSegments(), func(segment *datapb.ExportSegment, _ int) string {
+		return segment.GetVchannel()
+	}))
+}
+
+func (t *ExportTask) GetSchema() *schemapb.CollectionSchema {
+	return t.req.GetSchema()
+}
+
+func (t *ExportTask) GetSlots() int64 {
+	return t.req.GetTaskSlot()
+}
+
+func (t *ExportTask) GetBufferSize() int64 {
+	return paramtable.Get().DataNodeCfg.ExportBufferSize.GetAsInt64()
+}
+
+func (t *ExportTask) Cancel() {
+	t.cancel()
+}
+
+func (t *ExportTask) Clone() Task {
+	ctx, cancel := context.WithCancel(t.ctx)
+	return &ExportTask{
+		ExportTask: typeutil.Clone(t.ExportTask),
+		ctx:        ctx,
+		cancel:     cancel,
+		req:        t.req,
+		manager:    t.manager,
+		cm:         t.cm,
+	}
+}
+
+func (t *ExportTask) Execute() []*conc.Future[any] {
+	log.Info("start to export", WrapLogFields(t,
+		zap.Int64("bufferSize", t.GetBufferSize()),
+		zap.Int64("taskSlot", t.GetSlots()),
+		zap.Int64s("segmentIDs", t.GetSegmentIDs()),
+		zap.Uint64("exportTs", t.req.GetExportTimestamp()),
+		zap.String("outputPath", t.req.GetOutputPath()),
+	)...)
+	t.manager.Update(t.GetTaskID(), UpdateState(datapb.ImportTaskStateV2_InProgress))
+
+	fn := func() (err error) {
+		defer func() {
+			if err != nil {
+				log.Warn("export task execute failed", WrapLogFields(t, zap.Error(err))...)
+				t.manager.Update(t.GetTaskID(), UpdateState(datapb.ImportTaskStateV2_Failed), UpdateReason(err.Error()))
+			}
+		}()
+		exporter, err := newSegmentExporter(t.ctx, t.req, t.cm, int(t.GetBufferSize()))
+		if err != nil {
+			return err
+		}
+		for _, segment := range t.req.GetSegments() {
+			start := time.Now()
+			outputFiles, rows, err := exporter.export(segment)
+			if err != nil {
+				return err
+			}
+			t.manager.Update(t.GetTaskID(), UpdateExportResult(outputFiles, rows))
+			log.Info("export segment done", WrapLogFields(t,
+				zap.Int64("segmentID", segment.GetSegmentID()),
+				zap.Int64("exportedRows", rows),
+				zap.Strings("outputFiles", outputFiles),
+				zap.Duration("dur", time.Since(start)))...)
+		}
+		return nil
+	}
+
+	f := GetExecPool().Submit(func() (any, error) {
+		err := fn()
+		return err, err
+	})
+	return []*conc.Future[any]{f}
+}
+
+// segmentExporter reads the rows of a segment, filters them and writes the output fields into parquet files.
+type segmentExporter struct {
+	ctx        context.Context
+	req        *datapb.ExportRequest
+	cm         storage.ChunkManager
+	bufferSize int
+
+	readSchema   *schemapb.CollectionSchema
+	outputSchema *schemapb.CollectionSchema
+	arrowSchema  *arrow.Schema
+	rowFilter    exprutil.RowFilter
+}
+
+func newSegmentExporter(ctx context.Context, req *datapb.ExportRequest, cm storage.ChunkManager, bufferSize int) (*segmentExporter, error) {
+	schema := req.GetSchema()
+	readSchema := schema
+	if !lo.ContainsBy(schema.GetFields(), func(field *schemapb.FieldSchema) bool {
+		return common.IsSystemField(field.GetFieldID())
+	}) {
+		readSchema = typeutil.AppendSystemFields(schema)
+	}
+
+	outputFieldIDs := typeutil.NewSet(req.GetOutputFieldIDs()...)
+	outputSchema := &schemapb.CollectionSchema{
+		Name: schema.GetName(),
+		Fields: lo.Filter(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+			if outputFieldIDs.Len() > 0 {
+				return outputFieldIDs.Contain(field.GetFieldID())
+			}
+			return !common.IsSystemField(field.GetFieldID())
+		}),
+	}
+	arrowSchema, err := storage.ConvertToArrowSchema(outputSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	rowFilter := exprutil.RowFilter(func(map[int64]any) bool { return true })
+	if req.GetExpr() != "" {
+		helper, err := typeutil.CreateSchemaHelper(schema)
+		if err != nil {
+			return nil, err
+		}
+		expr, err := planparserv2.ParseExpr(helper, req.GetExpr(), nil)
+		if err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg(fmt.Sprintf("failed to parse export expression: %s", err.Error()))
+		}
+		rowFilter, err = exprutil.NewRowFilter(expr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &segmentExporter{
+		ctx:          ctx,
+		req:          req,
+		cm:           cm,
+		bufferSize:   bufferSize,
+		readSchema:   readSchema,
+		outputSchema: outputSchema,
+		arrowSchema:  arrowSchema,
+		rowFilter:    rowFilter,
+	}, nil
+}
+
+// export dumps the segment into one or more parquet files, returns the output files and the exported rows.
+func (e *segmentExporter) export(segment *datapb.ExportSegment) ([]string, int64, error) {
+	deletes, err := e.readDeletes(segment)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rr, err := storage.NewBinlogRecordReader(e.ctx, segment.GetBinlogs(), e.readSchema,
+		storage.WithVersion(segment.GetStorageVersion()),
+		storage.WithBufferSize(32*1024*1024),
+		storage.WithDownloader(func(ctx context.Context, paths []string) ([][]byte, error) {
+			return e.cm.MultiRead(ctx, paths)
+		}),
+		storage.WithStorageConfig(e.req.GetStorageConfig()),
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	reader := storage.NewDeserializeReader(rr, func(record storage.Record, v []*storage.Value) error {
+		return storage.ValueDeserializerWithSchema(record, v, e.readSchema, true)
+	})
+	defer reader.Close()
+
+	var (
+		startTs  = e.req.GetStartTimestamp()
+		exportTs = e.req.GetExportTimestamp()
+		writer   = newParquetFileWriter(e, segment.GetSegmentID())
+		data     *storage.InsertData
+		rows     int64
+	)
+	for {
+		if err = e.ctx.Err(); err != nil {
+			return nil, 0, err
+		}
+		v, err := reader.NextValue()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		ts := uint64((*v).Timestamp)
+		if ts <= startTs || ts > exportTs {
+			continue
+		}
+		if deleteTs, ok := deletes[(*v).PK.GetValue()]; ok && deleteTs > ts {
+			continue
+		}
+		row := (*v).Value.(map[int64]any)
+		if !e.rowFilter(row) {
+			continue
+		}
+
+		if data == nil {
+			data, err = storage.NewInsertDataWithFunctionOutputField(e.outputSchema)
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+		err = data.Append(lo.PickBy(row, func(fieldID int64, _ any) bool {
+			_, ok := data.Data[fieldID]
+			return ok
+		}))
+		if err != nil {
+			return nil, 0, err
+		}
+		rows++
+		if rows%100 == 0 && data.GetMemorySize() >= e.bufferSize {
+			if err = writer.write(data); err != nil {
+				return nil, 0, err
+			}
+			data = nil
+		}
+	}
+	if data != nil {
+		if err = writer.write(data); err != nil {
+			return nil, 0, err
+		}
+	}
+	if err = writer.close(); err != nil {
+		return nil, 0, err
+	}
+	return writer.outputFiles, rows, nil
+}
+
+// readDeletes reads the deletions of the segment which happened before the export timestamp,
+// includes the ones in the L0 segments of the same partition.
+func (e *segmentExporter) readDeletes(segment *datapb.ExportSegment) (map[any]typeutil.Timestamp, error) {
+	paths := make([]string, 0)
+	collectPaths := func(fieldBinlogs []*datapb.FieldBinlog) {
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				paths = append(paths, binlog.GetLogPath())
+			}
+		}
+	}
+	collectPaths(segment.GetDeltalogs())
+	for _, l0 := range e.req.GetL0Segments() {
+		if l0.GetVchannel() == segment.GetVchannel() &&
+			(l0.GetPartitionID() == segment.GetPartitionID() || l0.GetPartitionID() == common.AllPartitionsID) {
+			collectPaths(l0.GetDeltalogs())
+		}
+	}
+
+	deletes := make(map[any]typeutil.Timestamp)
+	if len(paths) == 0 {
+		return deletes, nil
+	}
+	binaries, err := e.cm.MultiRead(e.ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+	blobs := lo.Map(binaries, func(value []byte, _ int) *storage.Blob {
+		return &storage.Blob{Value: value}
+	})
+	reader, err := storage.CreateDeltalogReader(blobs)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	for {
+		dl, err := reader.NextValue()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if (*dl).Ts > e.req.GetExportTimestamp() {
+			continue
+		}
+		pk := (*dl).Pk.GetValue()
+		if ts, ok := deletes[pk]; !ok || ts < (*dl).Ts {
+			deletes[pk] = (*dl).Ts
+		}
+	}
+	return deletes, nil
+}
+
+// parquetFileWriter writes the rows of a segment into parquet files named by {segmentID}_{seq}.parquet,
+// a new file is rolled once the size of the current file reaches the max file size.
+type parquetFileWriter struct {
+	exporter    *segmentExporter
+	segmentID   int64
+	seq         int
+	buf         *bytes.Buffer
+	fw          *pqarrow.FileWriter
+	outputFiles []string
+}
+
+func newParquetFileWriter(exporter *segmentExporter, segmentID int64) *parquetFileWriter {
+	return &parquetFileWriter{
+		exporter:  exporter,
+		segmentID: segmentID,
+	}
+}
+
+func (w *parquetFileWriter) write(data *storage.InsertData) error {
+	if w.fw == nil {
+		w.buf = &bytes.Buffer{}
+		fw, err := pqarrow.NewFileWriter(w.exporter.arrowSchema, w.buf,
+			parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Zstd)),
+			pqarrow.DefaultWriterProps())
+		if err != nil {
+			return err
+		}
+		w.fw = fw
+	}
+
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, w.exporter.arrowSchema)
+	defer builder.Release()
+	if err := storage.BuildRecord(builder, data, w.exporter.outputSchema); err != nil {
+		return err
+	}
+	record := builder.NewRecord()
+	defer record.Release()
+	if err := w.fw.Write(record); err != nil {
+		return err
+	}
+
+	maxFileSize := w.exporter.req.GetMaxFileSize()
+	if maxFileSize > 0 && int64(w.buf.Len()) >= maxFileSize {
+		return w.close()
+	}
+	return nil
+}
+
+func (w *parquetFileWriter) close() error {
+	if w.fw == nil {
+		return nil
+	}
+	if err := w.fw.Close(); err != nil {
+		return err
+	}
+	filePath := path.Join(w.exporter.req.GetOutputPath(), fmt.Sprintf("%d_%d.parquet", w.segmentID, w.seq))
+	if err := w.exporter.cm.Write(w.exporter.ctx, filePath, w.buf.Bytes()); err != nil {
+		return err
+	}
+	w.outputFiles = append(w.outputFiles, filePath)
+	w.seq++
+	w.fw = nil
+	w.buf = nil
+	return nil
+}
@@ -198,11 +198,13 @@ func (t *ImportTask) Execute() []*conc.Future[any] {
 	futures := make([]*conc.Future[any], 0, len(req.GetFiles()))
 	for _, file := range req.GetFiles() {
 		file := file
+		// compressed files need extra memory for the decompressors
+		memorySize := bufferSize + importutilv2.EstimateDecompressionMemory(file)
 		f := GetExecPool().Submit(func() (any, error) {
 			// Use blocking allocation - this will wait until memory is available
-			GetMemoryAllocator().BlockingAllocate(t.GetTaskID(), bufferSize)
+			GetMemoryAllocator().BlockingAllocate(t.GetTaskID(), memorySize)
 			defer func() {
-				GetMemoryAllocator().Release(t.GetTaskID(), bufferSize)
+				GetMemoryAllocator().Release(t.GetTaskID(), memorySize)
 				debug.FreeOSMemory()
 			}()
 			err := fn(file)
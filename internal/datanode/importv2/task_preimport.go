@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"path"
 	"runtime/debug"
 	"time"
 
@@ -31,10 +32,12 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/importutilv2"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/conc"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
@@ -217,12 +220,22 @@ func (t *PreImportTask) readFileStat(reader importutilv2.Reader, fileIdx int) er
 			return err
 		}
 		MergeHashedStats(rowsCount, hashedStats)
+		// the size limit also applies to the expanded content of compressed files
+		if decompressedSize, ok := importutilv2.GetDecompressedSize(reader); ok && decompressedSize > int64(maxSize) {
+			return errors.New(fmt.Sprintf(
+				"The decompressed size of the import file has reached the maximum limit allowed for importing, "+
+					"decompressedSize=%d, maxSize=%d", decompressedSize, int64(maxSize)))
+		}
 		rows := data.GetRowNum()
 		size := data.GetMemorySize()
 		totalRows += rows
 		totalSize += size
 		log.Info("reading file stat...", WrapLogFields(t, zap.Int("readRows", rows), zap.Int("readSize", size))...)
 	}
+	if decompressedSize, ok := importutilv2.GetDecompressedSize(reader); ok {
+		log.Info("read compressed file stat done", WrapLogFields(t, zap.Int64("fileSize", fileSize),
+			zap.Int64("decompressedSize", decompressedSize))...)
+	}
 
 	stat := &datapb.ImportFileStats{
 		FileSize:        fileSize,
@@ -230,6 +243,46 @@ func (t *PreImportTask) readFileStat(reader importutilv2.Reader, fileIdx int) er
 		TotalMemorySize: int64(totalSize),
 		HashedStats:     hashedStats,
 	}
+	if collector := importutilv2.GetRowErrorCollector(reader); collector != nil {
+		err = t.reportRowErrors(collector, fileIdx, stat)
+		if err != nil {
+			return err
+		}
+	}
 	t.manager.Update(t.GetTaskID(), UpdateFileStat(fileIdx, stat))
 	return nil
 }
+
+// reportRowErrors checks the bad rows skipped in tolerant or dry-run mode against max_error_ratio,
+// and writes the bad rows into an error report in the object storage.
+func (t *PreImportTask) reportRowErrors(collector *common.RowErrorCollector, fileIdx int, stat *datapb.ImportFileStats) error {
+	errorRows := collector.Count()
+	if errorRows == 0 {
+		return nil
+	}
+	maxErrorRatio, err := importutilv2.GetMaxErrorRatio(t.options)
+	if err != nil {
+		return err
+	}
+	ratio := float64(errorRows) / float64(errorRows+stat.GetTotalRows())
+	if maxErrorRatio > 0 && ratio > maxErrorRatio {
+		return merr.WrapErrImportFailed(fmt.Sprintf("the ratio of bad rows exceeds max_error_ratio(%v), "+
+			"errorRows=%d, totalRows=%d", maxErrorRatio, errorRows, errorRows+stat.GetTotalRows()))
+	}
+	report, err := collector.Report()
+	if err != nil {
+		return err
+	}
+	reportPath := path.Join(t.cm.RootPath(), ErrorReportDir,
+		fmt.Sprint(t.GetJobID()), fmt.Sprintf("%d_%d.jsonl", t.GetTaskID(), fileIdx))
+	err = t.cm.Write(t.ctx, reportPath, report)
+	if err != nil {
+		return err
+	}
+	stat.ErrorRows = errorRows
+	stat.ErrorReportPath = reportPath
+	stat.SampleErrors = collector.SampleErrors(common.MaxSampleRowErrors)
+	log.Warn("bad rows skipped", WrapLogFields(t, zap.Int64("errorRows", errorRows),
+		zap.String("errorReportPath", reportPath))...)
+	return nil
+}
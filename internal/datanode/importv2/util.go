@@ -43,6 +43,9 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
+// ErrorReportDir is the directory under the storage root path where the bad row reports of tolerant import are written.
+const ErrorReportDir = "import_error_reports"
+
 func WrapTaskNotFoundError(taskID int64) error {
 	return merr.WrapErrImportFailed(fmt.Sprintf("cannot find import task with id %d", taskID))
 }
@@ -472,6 +472,73 @@ func (node *DataNode) DropImport(ctx context.Context, req *datapb.DropImportRequ
 	return merr.Success(), nil
 }
 
+func (node *DataNode) createExportTask(ctx context.Context, req *datapb.ExportRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("taskID", req.GetTaskID()),
+		zap.Int64("jobID", req.GetJobID()),
+		zap.Int64("taskSlot", req.GetTaskSlot()),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int("segmentNum", len(req.GetSegments())),
+		zap.Int("l0SegmentNum", len(req.GetL0Segments())),
+		zap.Uint64("exportTs", req.GetExportTimestamp()),
+		zap.String("outputPath", req.GetOutputPath()))
+
+	log.Info("datanode receive export request")
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	cm, err := node.storageFactory.NewChunkManager(node.ctx, req.GetStorageConfig())
+	if err != nil {
+		log.Error("create chunk manager failed", zap.String("bucket", req.GetStorageConfig().GetBucketName()),
+			zap.String("accessKey", req.GetStorageConfig().GetAccessKeyID()),
+			zap.Error(err),
+		)
+		return merr.Status(err), nil
+	}
+	task := importv2.NewExportTask(req, node.importTaskMgr, cm)
+	node.importTaskMgr.Add(task)
+
+	log.Info("datanode added export task")
+	return merr.Success(), nil
+}
+
+func (node *DataNode) queryExportTask(ctx context.Context, req *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error) {
+	log := log.Ctx(ctx).WithRateGroup("datanode.QueryExport", 1, 60)
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &datapb.QueryExportResponse{Status: merr.Status(err)}, nil
+	}
+	task := node.importTaskMgr.Get(req.GetTaskID())
+	if task == nil || task.GetType() != importv2.ExportTaskType {
+		return &datapb.QueryExportResponse{
+			Status: merr.Status(importv2.WrapTaskNotFoundError(req.GetTaskID())),
+		}, nil
+	}
+	exportTask := task.(*importv2.ExportTask)
+	logFields := []zap.Field{
+		zap.Int64("taskID", task.GetTaskID()),
+		zap.Int64("jobID", task.GetJobID()),
+		zap.String("state", task.GetState().String()),
+		zap.String("reason", task.GetReason()),
+		zap.Int64("nodeID", node.GetNodeID()),
+		zap.Int64("exportedRows", exportTask.GetExportedRows()),
+	}
+	if task.GetState() == datapb.ImportTaskStateV2_InProgress {
+		log.RatedInfo(30, "datanode query export", logFields...)
+	} else {
+		log.Info("datanode query export", logFields...)
+	}
+	return &datapb.QueryExportResponse{
+		Status:       merr.Success(),
+		TaskID:       task.GetTaskID(),
+		State:        task.GetState(),
+		Reason:       task.GetReason(),
+		ExportedRows: exportTask.GetExportedRows(),
+		OutputFiles:  exportTask.GetOutputFiles(),
+	}, nil
+}
+
 func (node *DataNode) QuerySlot(ctx context.Context, req *datapb.QuerySlotRequest) (*datapb.QuerySlotResponse, error) {
 	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
 		return &datapb.QuerySlotResponse{
@@ -547,6 +614,13 @@ func (node *DataNode) CreateTask(ctx context.Context, request *workerpb.CreateTa
 			return merr.Status(err), nil
 		}
 		return node.ImportV2(ctx, req)
+	case taskcommon.Export:
+		req := &datapb.ExportRequest{}
+		err := proto.Unmarshal(request.GetPayload(), req)
+		if err != nil {
+			return merr.Status(err), nil
+		}
+		return node.createExportTask(ctx, req)
 	case taskcommon.Compaction:
 		req := &datapb.CompactionPlan{}
 		err := proto.Unmarshal(request.GetPayload(), req)
@@ -639,6 +713,15 @@ func (node *DataNode) QueryTask(ctx context.Context, request *workerpb.QueryTask
 		resProperties.AppendTaskState(taskcommon.FromImportState(resp.GetState()))
 		resProperties.AppendReason(resp.GetReason())
 		return wrapQueryTaskResult(resp, resProperties)
+	case taskcommon.Export:
+		resp, err := node.queryExportTask(ctx, &datapb.QueryExportRequest{ClusterID: clusterID, TaskID: taskID})
+		if err != nil {
+			return nil, err
+		}
+		resProperties := taskcommon.NewProperties(nil)
+		resProperties.AppendTaskState(taskcommon.FromImportState(resp.GetState()))
+		resProperties.AppendReason(resp.GetReason())
+		return wrapQueryTaskResult(resp, resProperties)
 	case taskcommon.Compaction:
 		resp, err := node.GetCompactionState(ctx, &datapb.CompactionStateRequest{PlanID: taskID})
 		if err != nil {
@@ -710,7 +793,7 @@ func (node *DataNode) DropTask(ctx context.Context, request *workerpb.DropTaskRe
 		return merr.Status(err), nil
 	}
 	switch taskType {
-	case taskcommon.PreImport, taskcommon.Import:
+	case taskcommon.PreImport, taskcommon.Import, taskcommon.Export:
 		return node.DropImport(ctx, &datapb.DropImportRequest{TaskID: taskID})
 	case taskcommon.Compaction:
 		return node.DropCompactionPlan(ctx, &datapb.DropCompactionPlanRequest{PlanID: taskID})
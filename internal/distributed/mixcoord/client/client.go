@@ -1447,6 +1447,30 @@ func (c *Client) ListImports(ctx context.Context, in *internalpb.ListImportsRequ
 	})
 }
 
+func (c *Client) ExportV2(ctx context.Context, in *internalpb.ExportRequestInternal, opts ...grpc.CallOption) (*internalpb.ExportResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*internalpb.ExportResponse, error) {
+		return client.ExportV2(ctx, in)
+	})
+}
+
+func (c *Client) GetExportProgress(ctx context.Context, in *internalpb.GetExportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetExportProgressResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*internalpb.GetExportProgressResponse, error) {
+		return client.GetExportProgress(ctx, in)
+	})
+}
+
+func (c *Client) ListExports(ctx context.Context, in *internalpb.ListExportsRequestInternal, opts ...grpc.CallOption) (*internalpb.ListExportsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*internalpb.ListExportsResponse, error) {
+		return client.ListExports(ctx, in)
+	})
+}
+
+func (c *Client) CancelExport(ctx context.Context, in *internalpb.CancelExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.CancelExport(ctx, in)
+	})
+}
+
 func (c *Client) ListIndexes(ctx context.Context, in *indexpb.ListIndexesRequest, opts ...grpc.CallOption) (*indexpb.ListIndexesResponse, error) {
 	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*indexpb.ListIndexesResponse, error) {
 		return client.ListIndexes(ctx, in)
@@ -908,6 +908,22 @@ func (s *Server) ListImports(ctx context.Context, in *internalpb.ListImportsRequ
 	return s.mixCoord.ListImports(ctx, in)
 }
 
+func (s *Server) ExportV2(ctx context.Context, in *internalpb.ExportRequestInternal) (*internalpb.ExportResponse, error) {
+	return s.mixCoord.ExportV2(ctx, in)
+}
+
+func (s *Server) GetExportProgress(ctx context.Context, in *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error) {
+	return s.mixCoord.GetExportProgress(ctx, in)
+}
+
+func (s *Server) ListExports(ctx context.Context, in *internalpb.ListExportsRequestInternal) (*internalpb.ListExportsResponse, error) {
+	return s.mixCoord.ListExports(ctx, in)
+}
+
+func (s *Server) CancelExport(ctx context.Context, in *internalpb.CancelExportRequest) (*commonpb.Status, error) {
+	return s.mixCoord.CancelExport(ctx, in)
+}
+
 func (s *Server) ListIndexes(ctx context.Context, in *indexpb.ListIndexesRequest) (*indexpb.ListIndexesResponse, error) {
 	return s.mixCoord.ListIndexes(ctx, in)
 }
@@ -229,6 +229,36 @@ func (c *Client) ListImports(ctx context.Context, req *internalpb.ListImportsReq
 	})
 }
 
+func (c *Client) ExportV2(ctx context.Context, req *internalpb.ExportRequest, opts ...grpc.CallOption) (*internalpb.ExportResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*internalpb.ExportResponse, error) {
+		return client.ExportV2(ctx, req)
+	})
+}
+
+func (c *Client) GetExportProgress(ctx context.Context, req *internalpb.GetExportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetExportProgressResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*internalpb.GetExportProgressResponse, error) {
+		return client.GetExportProgress(ctx, req)
+	})
+}
+
+func (c *Client) ListExports(ctx context.Context, req *internalpb.ListExportsRequest, opts ...grpc.CallOption) (*internalpb.ListExportsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*internalpb.ListExportsResponse, error) {
+		return client.ListExports(ctx, req)
+	})
+}
+
+func (c *Client) CancelExport(ctx context.Context, req *internalpb.CancelExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
+		return client.CancelExport(ctx, req)
+	})
+}
+
+func (c *Client) Explain(ctx context.Context, req *internalpb.ExplainRequest, opts ...grpc.CallOption) (*internalpb.ExplainResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*internalpb.ExplainResponse, error) {
+		return client.Explain(ctx, req)
+	})
+}
+
 func (c *Client) InvalidateShardLeaderCache(ctx context.Context, req *proxypb.InvalidateShardLeaderCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
 		return client.InvalidateShardLeaderCache(ctx, req)
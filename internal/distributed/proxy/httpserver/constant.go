@@ -33,6 +33,7 @@ const (
 	IndexCategory           = "/indexes/"
 	AliasCategory           = "/aliases/"
 	ImportJobCategory       = "/jobs/import/"
+	ExportJobCategory       = "/jobs/export/"
 	PrivilegeGroupCategory  = "/privilege_groups/"
 	CollectionFieldCategory = "/collections/fields/"
 	ResourceGroupCategory   = "/resource_groups/"
@@ -51,6 +52,7 @@ const (
 	RefreshLoadAction    = "refresh_load"
 	ReleaseAction        = "release"
 	QueryAction          = "query"
+	QueryIteratorAction  = "query_iterator"
 	GetAction            = "get"
 	DeleteAction         = "delete"
 	InsertAction         = "insert"
@@ -58,6 +60,7 @@ const (
 	SearchAction         = "search"
 	AdvancedSearchAction = "advanced_search"
 	HybridSearchAction   = "hybrid_search"
+	ExplainAction        = "explain"
 
 	UpdatePasswordAction            = "update_password"
 	GrantRoleAction                 = "grant_role"
@@ -77,6 +80,7 @@ const (
 	AddPrivilegesToGroupAction      = "add_privileges_to_group"
 	RemovePrivilegesFromGroupAction = "remove_privileges_from_group"
 	TransferReplicaAction           = "transfer_replica"
+	CancelAction                    = "cancel"
 )
 
 const (
@@ -129,6 +133,7 @@ const (
 	HTTPReturnLoadState      = "loadState"
 	HTTPReturnLoadProgress   = "loadProgress"
 	HTTPReturnTopks          = "topks"
+	HTTPReturnCursor         = "cursor"
 
 	HTTPReturnHas = "has"
 
@@ -48,6 +48,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util"
 	"github.com/milvus-io/milvus/pkg/v2/util/crypto"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
@@ -105,6 +106,12 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 			OutputFields: []string{DefaultOutputFields},
 		}
 	}, wrapperTraceLog(h.query))), true))
+	router.POST(EntityCategory+QueryIteratorAction, restfulSizeMiddleware(timeoutMiddleware(wrapperPost(func() any {
+		return &QueryIteratorReqV2{
+			BatchSize:    100,
+			OutputFields: []string{DefaultOutputFields},
+		}
+	}, wrapperTraceLog(h.queryIterator))), true))
 	// Get
 	router.POST(EntityCategory+GetAction, restfulSizeMiddleware(timeoutMiddleware(wrapperPost(func() any {
 		return &CollectionIDReq{
@@ -141,6 +148,8 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 			Limit: 100,
 		}
 	}, wrapperTraceLog(h.advancedSearch))), true))
+	// Explain
+	router.POST(EntityCategory+ExplainAction, timeoutMiddleware(wrapperPost(func() any { return &ExplainReqV2{} }, wrapperTraceLog(h.explain))))
 
 	router.POST(PartitionCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.listPartitions))))
 	router.POST(PartitionCategory+HasAction, timeoutMiddleware(wrapperPost(func() any { return &PartitionReq{} }, wrapperTraceLog(h.hasPartitions))))
@@ -198,6 +207,11 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 	router.POST(ImportJobCategory+GetProgressAction, timeoutMiddleware(wrapperPost(func() any { return &JobIDReq{} }, wrapperTraceLog(h.getImportJobProcess))))
 	router.POST(ImportJobCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &JobIDReq{} }, wrapperTraceLog(h.getImportJobProcess))))
 
+	router.POST(ExportJobCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &OptionalCollectionNameReq{} }, wrapperTraceLog(h.listExportJob))))
+	router.POST(ExportJobCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &ExportReq{} }, wrapperTraceLog(h.createExportJob))))
+	router.POST(ExportJobCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &JobIDReq{} }, wrapperTraceLog(h.describeExportJob))))
+	router.POST(ExportJobCategory+CancelAction, timeoutMiddleware(wrapperPost(func() any { return &JobIDReq{} }, wrapperTraceLog(h.cancelExportJob))))
+
 	// resource group
 	router.POST(ResourceGroupCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &ResourceGroupReq{} }, wrapperTraceLog(h.createResourceGroup))))
 	router.POST(ResourceGroupCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &ResourceGroupReq{} }, wrapperTraceLog(h.dropResourceGroup))))
@@ -310,6 +324,21 @@ func wrapperTraceLog(v2 handlerFuncV2) handlerFuncV2 {
 }
 
 func checkAuthorizationV2(ctx context.Context, c *gin.Context, ignoreErr bool, req interface{}) error {
+	return checkAuthorizationBy(ctx, c, ignoreErr, req, func(ctx context.Context) error {
+		_, err := proxy.PrivilegeInterceptor(ctx, req)
+		return err
+	})
+}
+
+// checkExportAuthorization checks the export privilege on the collection,
+// which isn't declared in the request messages of export.
+func checkExportAuthorization(ctx context.Context, c *gin.Context, req interface{}, collectionName string) error {
+	return checkAuthorizationBy(ctx, c, false, req, func(ctx context.Context) error {
+		return proxy.CheckPrivilege(ctx, commonpb.ObjectType_Collection.String(), collectionName, util.PrivilegeExport)
+	})
+}
+
+func checkAuthorizationBy(ctx context.Context, c *gin.Context, ignoreErr bool, req interface{}, checkPrivilege func(ctx context.Context) error) error {
 	username, ok := c.Get(ContextUsername)
 	if !ok || username.(string) == "" {
 		if !ignoreErr {
@@ -318,7 +347,7 @@ func checkAuthorizationV2(ctx context.Context, c *gin.Context, ignoreErr bool, r
 		hookutil.GetExtension().ReportRefused(ctx, req, WrapErrorToResponse(merr.ErrNeedAuthenticate), nil, c.FullPath())
 		return merr.ErrNeedAuthenticate
 	}
-	_, authErr := proxy.PrivilegeInterceptor(ctx, req)
+	authErr := checkPrivilege(ctx)
 	if authErr != nil {
 		if !ignoreErr {
 			HTTPReturn(c, http.StatusForbidden, gin.H{HTTPReturnCode: merr.Code(authErr), HTTPReturnMessage: authErr.Error()})
@@ -810,6 +839,21 @@ func (h *HandlersV2) addCollectionField(ctx context.Context, c *gin.Context, any
 }
 
 // copy from internal/proxy/task_query.go
+// generateExprTemplateValues generates the template values of the filter from the exprParams,
+// and aborts the request if the exprParams are invalid.
+func generateExprTemplateValues(ctx context.Context, c *gin.Context, apiName string, exprParams map[string]interface{}) (map[string]*schemapb.TemplateValue, error) {
+	templateValues, err := generateExpressionTemplate(exprParams)
+	if err != nil {
+		log.Ctx(ctx).Warn("high level restful api, "+apiName+" with exprParams invalid", zap.Error(err))
+		HTTPAbortReturn(c, http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
+			HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error() + ", error: " + err.Error(),
+		})
+		return nil, err
+	}
+	return templateValues, nil
+}
+
 func matchCountRule(outputs []string) bool {
 	return len(outputs) == 1 && strings.ToLower(strings.TrimSpace(outputs[0])) == "count(*)"
 }
@@ -838,7 +882,10 @@ func (h *HandlersV2) query(ctx context.Context, c *gin.Context, anyReq any, dbNa
 		})
 		return nil, err
 	}
-	req.ExprTemplateValues = generateExpressionTemplate(httpReq.ExprParams)
+	req.ExprTemplateValues, err = generateExprTemplateValues(ctx, c, "query", httpReq.ExprParams)
+	if err != nil {
+		return nil, err
+	}
 	c.Set(ContextRequest, req)
 	if httpReq.Offset > 0 {
 		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: proxy.OffsetKey, Value: strconv.FormatInt(int64(httpReq.Offset), 10)})
@@ -846,6 +893,12 @@ func (h *HandlersV2) query(ctx context.Context, c *gin.Context, anyReq any, dbNa
 	if httpReq.Limit > 0 && !matchCountRule(httpReq.OutputFields) {
 		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: proxy.LimitKey, Value: strconv.FormatInt(int64(httpReq.Limit), 10)})
 	}
+	if httpReq.OrderBy != "" {
+		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: proxy.OrderByKey, Value: httpReq.OrderBy})
+	}
+	if len(httpReq.GroupByFields) > 0 {
+		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: proxy.GroupByFieldsKey, Value: strings.Join(httpReq.GroupByFields, ",")})
+	}
 	resp, err := wrapperProxyWithLimit(ctx, c, req, h.checkAuth, false, "/milvus.proto.milvus.MilvusService/Query", true, h.proxy, func(reqCtx context.Context, req any) (interface{}, error) {
 		return h.proxy.Query(reqCtx, req.(*milvuspb.QueryRequest))
 	})
@@ -870,6 +923,152 @@ func (h *HandlersV2) query(ctx context.Context, c *gin.Context, anyReq any, dbNa
 	return resp, err
 }
 
+// queryIterator returns a batch of entities in primary key order with a cursor for the next batch,
+// all batches of an iteration are pinned to the collection and mvcc timestamp of the first batch.
+func (h *HandlersV2) queryIterator(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*QueryIteratorReqV2)
+	if httpReq.BatchSize <= 0 || httpReq.BatchSize > int32(proxy.Params.QuotaConfig.TopKLimit.GetAsInt()) {
+		err := merr.WrapErrParameterInvalidRange(1, proxy.Params.QuotaConfig.TopKLimit.GetAsInt(), int(httpReq.BatchSize), "invalid batch size")
+		HTTPAbortReturn(c, http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(err),
+			HTTPReturnMessage: err.Error(),
+		})
+		return nil, err
+	}
+	collSchema, err := h.GetCollectionSchema(ctx, c, dbName, httpReq.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+	primaryField, ok := getPrimaryField(collSchema)
+	if !ok {
+		err := merr.WrapErrCollectionIllegalSchema(httpReq.CollectionName, "primary key field not found")
+		HTTPAbortReturn(c, http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(err),
+			HTTPReturnMessage: err.Error(),
+		})
+		return nil, err
+	}
+
+	requestDigest := queryIteratorRequestDigest(dbName, httpReq)
+	cursor := &queryIteratorCursor{RequestDigest: requestDigest}
+	if httpReq.Cursor != "" {
+		cursor, err = decodeQueryIteratorCursor(httpReq.Cursor)
+		if err != nil {
+			HTTPAbortReturn(c, http.StatusOK, gin.H{
+				HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
+				HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error() + ", error: " + err.Error(),
+			})
+			return nil, err
+		}
+		if cursor.RequestDigest != requestDigest {
+			err := merr.WrapErrParameterInvalidMsg("query iterator cursor doesn't match the request, database, collection, filter, exprParams and partitionNames can't change during iteration")
+			HTTPAbortReturn(c, http.StatusOK, gin.H{
+				HTTPReturnCode:    merr.Code(err),
+				HTTPReturnMessage: err.Error(),
+			})
+			return nil, err
+		}
+	} else {
+		// pin the collection id for following batches
+		descReq := &milvuspb.DescribeCollectionRequest{
+			DbName:         dbName,
+			CollectionName: httpReq.CollectionName,
+		}
+		descResp, err := wrapperProxy(ctx, c, descReq, h.checkAuth, false, "/milvus.proto.milvus.MilvusService/DescribeCollection", func(reqCtx context.Context, req any) (interface{}, error) {
+			return h.proxy.DescribeCollection(reqCtx, req.(*milvuspb.DescribeCollectionRequest))
+		})
+		if err != nil {
+			return nil, err
+		}
+		cursor.CollectionID = descResp.(*milvuspb.DescribeCollectionResponse).GetCollectionID()
+	}
+
+	cursorExpr, err := cursor.expr(primaryField)
+	if err != nil {
+		HTTPAbortReturn(c, http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
+			HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error() + ", error: " + err.Error(),
+		})
+		return nil, err
+	}
+	expr := httpReq.Filter
+	if cursorExpr != "" {
+		if expr == "" {
+			expr = cursorExpr
+		} else {
+			expr = fmt.Sprintf("(%s) and %s", expr, cursorExpr)
+		}
+	}
+	req := &milvuspb.QueryRequest{
+		DbName:             dbName,
+		CollectionName:     httpReq.CollectionName,
+		Expr:               expr,
+		OutputFields:       httpReq.OutputFields,
+		PartitionNames:     httpReq.PartitionNames,
+		GuaranteeTimestamp: cursor.SessionTs,
+		QueryParams: []*commonpb.KeyValuePair{
+			{Key: proxy.LimitKey, Value: strconv.FormatInt(int64(httpReq.BatchSize), 10)},
+			{Key: proxy.IteratorField, Value: "true"},
+			{Key: proxy.CollectionID, Value: strconv.FormatInt(cursor.CollectionID, 10)},
+		},
+	}
+	req.ConsistencyLevel, req.UseDefaultConsistency, err = convertConsistencyLevel(httpReq.ConsistencyLevel)
+	if err != nil {
+		log.Ctx(ctx).Warn("high level restful api, query iterator with consistency_level invalid", zap.Error(err))
+		HTTPAbortReturn(c, http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(err),
+			HTTPReturnMessage: "consistencyLevel can only be [Strong, Session, Bounded, Eventually, Customized], default: Bounded, err:" + err.Error(),
+		})
+		return nil, err
+	}
+	req.ExprTemplateValues, err = generateExprTemplateValues(ctx, c, "query iterator", httpReq.ExprParams)
+	if err != nil {
+		return nil, err
+	}
+	c.Set(ContextRequest, req)
+	resp, err := wrapperProxyWithLimit(ctx, c, req, h.checkAuth, false, "/milvus.proto.milvus.MilvusService/Query", true, h.proxy, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.Query(reqCtx, req.(*milvuspb.QueryRequest))
+	})
+	if err == nil {
+		queryResp := resp.(*milvuspb.QueryResults)
+		allowJS, _ := strconv.ParseBool(c.Request.Header.Get(HTTPHeaderAllowInt64))
+		outputData, err := buildQueryResp(int64(0), queryResp.OutputFields, queryResp.FieldsData, nil, nil, allowJS, collSchema)
+		if err != nil {
+			log.Ctx(ctx).Warn("high level restful api, fail to deal with query iterator result", zap.Any("response", resp), zap.Error(err))
+			HTTPReturn(c, http.StatusOK, gin.H{
+				HTTPReturnCode:    merr.Code(merr.ErrInvalidSearchResult),
+				HTTPReturnMessage: merr.ErrInvalidSearchResult.Error() + ", error: " + err.Error(),
+			})
+			return resp, err
+		}
+
+		nextCursor := ""
+		// a batch less than batch size means the iteration is done
+		if len(outputData) == int(httpReq.BatchSize) {
+			if cursor.SessionTs == 0 {
+				cursor.SessionTs = queryResp.GetSessionTs()
+			}
+			cursor.HasLastPK = true
+			cursor.LastPK, err = getLastPrimaryKey(queryResp.FieldsData, primaryField)
+			if err != nil {
+				HTTPReturn(c, http.StatusOK, gin.H{
+					HTTPReturnCode:    merr.Code(merr.ErrInvalidSearchResult),
+					HTTPReturnMessage: merr.ErrInvalidSearchResult.Error() + ", error: " + err.Error(),
+				})
+				return resp, err
+			}
+			nextCursor = cursor.encode()
+		}
+		HTTPReturnStream(c, http.StatusOK, gin.H{
+			HTTPReturnCode:   merr.Code(nil),
+			HTTPReturnData:   outputData,
+			HTTPReturnCursor: nextCursor,
+			HTTPReturnCost:   proxy.GetCostValue(queryResp.GetStatus()),
+		})
+	}
+	return resp, err
+}
+
 func (h *HandlersV2) get(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
 	httpReq := anyReq.(*CollectionIDReq)
 	collSchema, err := h.GetCollectionSchema(ctx, c, dbName, httpReq.CollectionName)
@@ -938,7 +1137,10 @@ func (h *HandlersV2) delete(ctx context.Context, c *gin.Context, anyReq any, dbN
 		PartitionName:  httpReq.PartitionName,
 		Expr:           httpReq.Filter,
 	}
-	req.ExprTemplateValues = generateExpressionTemplate(httpReq.ExprParams)
+	req.ExprTemplateValues, err = generateExprTemplateValues(ctx, c, "delete", httpReq.ExprParams)
+	if err != nil {
+		return nil, err
+	}
 	c.Set(ContextRequest, req)
 	if req.Expr == "" {
 		body, _ := c.Get(gin.BodyBytesKey)
@@ -1233,7 +1435,10 @@ func (h *HandlersV2) search(ctx context.Context, c *gin.Context, anyReq any, dbN
 	}
 	req.SearchParams = searchParams
 	req.PlaceholderGroup = placeholderGroup
-	req.ExprTemplateValues = generateExpressionTemplate(httpReq.ExprParams)
+	req.ExprTemplateValues, err = generateExprTemplateValues(ctx, c, "search", httpReq.ExprParams)
+	if err != nil {
+		return nil, err
+	}
 	resp, err := wrapperProxyWithLimit(ctx, c, req, h.checkAuth, false, "/milvus.proto.milvus.MilvusService/Search", true, h.proxy, func(reqCtx context.Context, req any) (interface{}, error) {
 		return h.proxy.Search(reqCtx, req.(*milvuspb.SearchRequest))
 	})
@@ -1322,7 +1527,10 @@ func (h *HandlersV2) advancedSearch(ctx context.Context, c *gin.Context, anyReq
 			PartitionNames:   httpReq.PartitionNames,
 			SearchParams:     searchParams,
 		}
-		searchReq.ExprTemplateValues = generateExpressionTemplate(subReq.ExprParams)
+		searchReq.ExprTemplateValues, err = generateExprTemplateValues(ctx, c, "hybrid search", subReq.ExprParams)
+		if err != nil {
+			return nil, err
+		}
 		req.Requests = append(req.Requests, searchReq)
 	}
 
@@ -2559,6 +2767,9 @@ func (h *HandlersV2) listImportJob(ctx context.Context, c *gin.Context, anyReq a
 			jobDetail["collectionName"] = response.GetCollectionNames()[i]
 			jobDetail["state"] = response.GetStates()[i].String()
 			jobDetail["progress"] = response.GetProgresses()[i]
+			if i < len(response.GetPriorities()) {
+				jobDetail["priority"] = response.GetPriorities()[i]
+			}
 			reason := response.GetReasons()[i]
 			if reason != "" {
 				jobDetail["reason"] = reason
@@ -2639,6 +2850,9 @@ func (h *HandlersV2) getImportJobProcess(ctx context.Context, c *gin.Context, an
 		returnData["progress"] = response.GetProgress()
 		returnData["importedRows"] = response.GetImportedRows()
 		returnData["totalRows"] = response.GetTotalRows()
+		if response.GetErrorRows() > 0 {
+			returnData["errorRows"] = response.GetErrorRows()
+		}
 		reason := response.GetReason()
 		if reason != "" {
 			returnData["reason"] = reason
@@ -2654,6 +2868,14 @@ func (h *HandlersV2) getImportJobProcess(ctx context.Context, c *gin.Context, an
 			detail["state"] = taskProgress.GetState()
 			detail["importedRows"] = taskProgress.GetImportedRows()
 			detail["totalRows"] = taskProgress.GetTotalRows()
+			if taskProgress.GetErrorRows() > 0 {
+				detail["errorRows"] = taskProgress.GetErrorRows()
+				detail["errorReportPath"] = taskProgress.GetErrorReportPath()
+				detail["sampleErrors"] = taskProgress.GetSampleErrors()
+			}
+			if taskProgress.GetTotalMemorySize() > 0 {
+				detail["totalMemorySize"] = taskProgress.GetTotalMemorySize()
+			}
 			reason = taskProgress.GetReason()
 			if reason != "" {
 				detail["reason"] = reason
@@ -2668,6 +2890,219 @@ func (h *HandlersV2) getImportJobProcess(ctx context.Context, c *gin.Context, an
 	return resp, err
 }
 
+func (h *HandlersV2) listExportJob(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	var collectionName string
+	if collectionGetter, ok := anyReq.(requestutil.CollectionNameGetter); ok {
+		collectionName = collectionGetter.GetCollectionName()
+	}
+	req := &internalpb.ListExportsRequest{
+		DbName:         dbName,
+		CollectionName: collectionName,
+	}
+	c.Set(ContextRequest, req)
+
+	if h.checkAuth {
+		err := checkAuthorizationV2(ctx, c, false, &milvuspb.ListImportsAuthPlaceholder{
+			DbName:         dbName,
+			CollectionName: collectionName,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp, err := wrapperProxy(ctx, c, req, false, false, "/milvus.proto.milvus.MilvusService/ListExports", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.ListExports(reqCtx, req.(*internalpb.ListExportsRequest))
+	})
+	if err == nil {
+		returnData := make(map[string]interface{})
+		records := make([]map[string]interface{}, 0)
+		response := resp.(*internalpb.ListExportsResponse)
+		for i, jobID := range response.GetJobIDs() {
+			jobDetail := make(map[string]interface{})
+			jobDetail["jobId"] = jobID
+			jobDetail["collectionName"] = response.GetCollectionNames()[i]
+			jobDetail["state"] = response.GetStates()[i].String()
+			jobDetail["progress"] = response.GetProgresses()[i]
+			reason := response.GetReasons()[i]
+			if reason != "" {
+				jobDetail["reason"] = reason
+			}
+			records = append(records, jobDetail)
+		}
+		returnData["records"] = records
+		HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: returnData})
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) createExportJob(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*ExportReq)
+	req := &internalpb.ExportRequest{
+		DbName:          dbName,
+		CollectionName:  httpReq.CollectionName,
+		PartitionNames:  httpReq.PartitionNames,
+		Expr:            httpReq.Filter,
+		OutputFields:    httpReq.OutputFields,
+		ExportTimestamp: httpReq.ExportTimestamp,
+		OutputPath:      httpReq.OutputPath,
+		Options:         funcutil.Map2KeyValuePair(httpReq.Options),
+	}
+	c.Set(ContextRequest, req)
+
+	if h.checkAuth {
+		err := checkExportAuthorization(ctx, c, req, httpReq.CollectionName)
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp, err := wrapperProxy(ctx, c, req, false, false, "/milvus.proto.milvus.MilvusService/Export", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.ExportV2(reqCtx, req.(*internalpb.ExportRequest))
+	})
+	if err == nil {
+		returnData := make(map[string]interface{})
+		returnData["jobId"] = resp.(*internalpb.ExportResponse).GetJobID()
+		HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: returnData})
+	}
+	return resp, err
+}
+
+// checkExportJobAuthorization checks the export privilege on the collection exported by the job.
+func (h *HandlersV2) checkExportJobAuthorization(ctx context.Context, c *gin.Context, req any, dbName string, jobID string) error {
+	resp, err := h.proxy.GetExportProgress(ctx, &internalpb.GetExportProgressRequest{
+		DbName: dbName,
+		JobID:  jobID,
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		HTTPAbortReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return err
+	}
+	return checkExportAuthorization(ctx, c, req, resp.GetCollectionName())
+}
+
+func (h *HandlersV2) describeExportJob(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	jobIDGetter := anyReq.(JobIDGetter)
+	req := &internalpb.GetExportProgressRequest{
+		DbName: dbName,
+		JobID:  jobIDGetter.GetJobID(),
+	}
+	c.Set(ContextRequest, req)
+
+	if h.checkAuth {
+		if err := h.checkExportJobAuthorization(ctx, c, req, dbName, jobIDGetter.GetJobID()); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := wrapperProxy(ctx, c, req, false, false, "/milvus.proto.milvus.MilvusService/GetExportProgress", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.GetExportProgress(reqCtx, req.(*internalpb.GetExportProgressRequest))
+	})
+	if err == nil {
+		response := resp.(*internalpb.GetExportProgressResponse)
+		returnData := make(map[string]interface{})
+		returnData["jobId"] = jobIDGetter.GetJobID()
+		returnData["collectionName"] = response.GetCollectionName()
+		returnData["startTime"] = response.GetStartTime()
+		returnData["completeTime"] = response.GetCompleteTime()
+		returnData["state"] = response.GetState().String()
+		returnData["progress"] = response.GetProgress()
+		returnData["exportTimestamp"] = response.GetExportTimestamp()
+		returnData["outputPath"] = response.GetOutputPath()
+		returnData["outputFiles"] = response.GetOutputFiles()
+		returnData["exportedRows"] = response.GetExportedRows()
+		reason := response.GetReason()
+		if reason != "" {
+			returnData["reason"] = reason
+		}
+		HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: returnData})
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) cancelExportJob(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	jobIDGetter := anyReq.(JobIDGetter)
+	req := &internalpb.CancelExportRequest{
+		DbName: dbName,
+		JobID:  jobIDGetter.GetJobID(),
+	}
+	c.Set(ContextRequest, req)
+
+	if h.checkAuth {
+		if err := h.checkExportJobAuthorization(ctx, c, req, dbName, jobIDGetter.GetJobID()); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := wrapperProxy(ctx, c, req, false, false, "/milvus.proto.milvus.MilvusService/CancelExport", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.CancelExport(reqCtx, req.(*internalpb.CancelExportRequest))
+	})
+	if err == nil {
+		HTTPReturn(c, http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) explain(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*ExplainReqV2)
+	searchParams, err := generateSearchParams(httpReq.SearchParams)
+	if err != nil {
+		HTTPAbortReturn(c, http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(err),
+			HTTPReturnMessage: err.Error(),
+		})
+		return nil, err
+	}
+	req := &internalpb.ExplainRequest{
+		DbName:         dbName,
+		CollectionName: httpReq.CollectionName,
+		PartitionNames: httpReq.PartitionNames,
+		Expr:           httpReq.Filter,
+		SearchParams:   searchParams,
+	}
+	c.Set(ContextRequest, req)
+
+	if h.checkAuth {
+		// explaining exposes how the data is filtered, which requires the same privilege as query
+		err := checkAuthorizationV2(ctx, c, false, &milvuspb.QueryRequest{
+			DbName:         dbName,
+			CollectionName: httpReq.CollectionName,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp, err := wrapperProxy(ctx, c, req, false, false, "/milvus.proto.milvus.MilvusService/Explain", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.Explain(reqCtx, req.(*internalpb.ExplainRequest))
+	})
+	if err == nil {
+		response := resp.(*internalpb.ExplainResponse)
+		returnData := make(map[string]interface{})
+		returnData["plan"] = json.RawMessage(response.GetPlan())
+		returnData["foldedFilter"] = response.GetFoldedExpr()
+		scalarIndexes := make([]map[string]interface{}, 0, len(response.GetScalarIndexes()))
+		for _, index := range response.GetScalarIndexes() {
+			scalarIndexes = append(scalarIndexes, map[string]interface{}{
+				"fieldName": index.GetFieldName(),
+				"indexName": index.GetIndexName(),
+				"indexType": index.GetIndexType(),
+			})
+		}
+		returnData["scalarIndexes"] = scalarIndexes
+		returnData["partitionKeyPruned"] = response.GetPartitionKeyPruned()
+		returnData["partitionNames"] = response.GetPartitionNames()
+		shards := make([]map[string]interface{}, 0, len(response.GetShards()))
+		for _, shard := range response.GetShards() {
+			shards = append(shards, map[string]interface{}{
+				"channel":           shard.GetChannel(),
+				"nodeId":            shard.GetNodeID(),
+				"sealedSegmentNum":  shard.GetSealedSegmentNum(),
+				"prunedSegmentNum":  shard.GetPrunedSegmentNum(),
+				"growingSegmentNum": shard.GetGrowingSegmentNum(),
+			})
+		}
+		returnData["shards"] = shards
+		HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: returnData})
+	}
+	return resp, err
+}
+
 func (h *HandlersV2) GetCollectionSchema(ctx context.Context, c *gin.Context, dbName, collectionName string) (*schemapb.CollectionSchema, error) {
 	collSchema, err := proxy.GetCachedCollectionSchema(ctx, dbName, collectionName)
 	if err == nil {
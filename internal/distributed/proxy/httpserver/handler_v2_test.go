@@ -22,11 +22,13 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -41,6 +43,7 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/util"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
@@ -1935,6 +1938,14 @@ func TestMethodPost(t *testing.T) {
 		Reason:   "",
 		Progress: 100,
 	}, nil).Twice()
+	mp.EXPECT().Explain(mock.Anything, mock.Anything).Return(&internalpb.ExplainResponse{
+		Status:     &StatusSuccess,
+		Plan:       `{"expr":{"expr_type":"always_true"}}`,
+		FoldedExpr: "true",
+		Shards: []*internalpb.ExplainedShard{
+			{Channel: "ch-1", NodeID: 1, SealedSegmentNum: 3, PrunedSegmentNum: 1},
+		},
+	}, nil).Once()
 	mp.EXPECT().GetSegmentsInfo(mock.Anything, mock.Anything).Return(&internalpb.GetSegmentsInfoResponse{
 		Status: &StatusSuccess,
 		SegmentInfos: []*internalpb.SegmentInfo{
@@ -2071,6 +2082,9 @@ func TestMethodPost(t *testing.T) {
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(SegmentCategory, DescribeAction),
 	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(EntityCategory, ExplainAction),
+	})
 
 	for _, testcase := range queryTestCases {
 		t.Run(testcase.path, func(t *testing.T) {
@@ -2872,3 +2886,136 @@ func (s *AddCollectionFieldSuite) TestAddCollectionFieldFail() {
 func TestAddCollectionFieldSuite(t *testing.T) {
 	suite.Run(t, new(AddCollectionFieldSuite))
 }
+
+func TestQueryIterator(t *testing.T) {
+	paramtable.Init()
+	// disable rate limit
+	paramtable.Get().Save(paramtable.Get().QuotaConfig.QuotaAndLimitsEnabled.Key, "false")
+	defer paramtable.Get().Reset(paramtable.Get().QuotaConfig.QuotaAndLimitsEnabled.Key)
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		CollectionID:   1,
+		CollectionName: DefaultCollectionName,
+		Schema:         generateCollectionSchema(schemapb.DataType_Int64, false, true),
+		ShardsNum:      ShardNumDefault,
+		Status:         &StatusSuccess,
+	}, nil)
+	pks := []int64{1, 2, 3, 4, 5}
+	mp.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+		params := funcutil.KeyValuePair2Map(req.GetQueryParams())
+		assert.Equal(t, "1", params[proxy.CollectionID])
+		assert.Equal(t, "true", params[proxy.IteratorField])
+		limit, _ := strconv.Atoi(params[proxy.LimitKey])
+		lastPK := int64(0)
+		if req.GetGuaranteeTimestamp() > 0 {
+			assert.EqualValues(t, 100, req.GetGuaranteeTimestamp())
+			_, err := fmt.Sscanf(req.GetExpr(), "(book_id > 0) and book_id > %d", &lastPK)
+			assert.NoError(t, err)
+		} else {
+			assert.Equal(t, "book_id > 0", req.GetExpr())
+		}
+		data := lo.Filter(pks, func(pk int64, _ int) bool { return pk > lastPK })
+		data = data[:min(limit, len(data))]
+		return &milvuspb.QueryResults{
+			Status:       commonSuccessStatus,
+			OutputFields: []string{FieldBookID},
+			FieldsData: []*schemapb.FieldData{{
+				Type:      schemapb.DataType_Int64,
+				FieldName: FieldBookID,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}},
+				}},
+			}},
+			SessionTs: 100,
+		}, nil
+	})
+	testEngine := initHTTPServerV2(mp, false)
+
+	type iteratorResp struct {
+		Code   int32            `json:"code"`
+		Data   []map[string]any `json:"data"`
+		Cursor string           `json:"cursor"`
+	}
+	iterate := func(cursor string) *iteratorResp {
+		body := fmt.Sprintf(`{"collectionName": "book", "filter": "book_id > 0", "outputFields": ["book_id"], "batchSize": 2, "cursor": "%s"}`, cursor)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(EntityCategory, QueryIteratorAction), bytes.NewReader([]byte(body)))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		resp := &iteratorResp{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		return resp
+	}
+
+	result := []string{}
+	cursor := ""
+	for i := 0; i < 3; i++ {
+		resp := iterate(cursor)
+		assert.EqualValues(t, 0, resp.Code)
+		for _, row := range resp.Data {
+			result = append(result, fmt.Sprint(row[FieldBookID]))
+		}
+		cursor = resp.Cursor
+		if cursor == "" {
+			break
+		}
+	}
+	assert.Equal(t, "", cursor)
+	assert.Equal(t, []string{"1", "2", "3", "4", "5"}, result)
+
+	resp := iterate("invalid")
+	assert.EqualValues(t, merr.Code(merr.ErrIncorrectParameterFormat), resp.Code)
+
+	// the cursor can't be reused with a different filter
+	cursor = iterate("").Cursor
+	assert.NotEmpty(t, cursor)
+	body := fmt.Sprintf(`{"collectionName": "book", "filter": "book_id > 1", "batchSize": 2, "cursor": "%s"}`, cursor)
+	req := httptest.NewRequest(http.MethodPost, versionalV2(EntityCategory, QueryIteratorAction), bytes.NewReader([]byte(body)))
+	w := httptest.NewRecorder()
+	testEngine.ServeHTTP(w, req)
+	returnBody := &ReturnErrMsg{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+	assert.EqualValues(t, merr.Code(merr.ErrParameterInvalid), returnBody.Code)
+
+	req = httptest.NewRequest(http.MethodPost, versionalV2(EntityCategory, QueryIteratorAction),
+		bytes.NewReader([]byte(`{"collectionName": "book", "batchSize": -1}`)))
+	w = httptest.NewRecorder()
+	testEngine.ServeHTTP(w, req)
+	returnBody = &ReturnErrMsg{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+	assert.EqualValues(t, merr.Code(merr.ErrParameterInvalid), returnBody.Code)
+}
+
+func TestQueryIteratorCursor(t *testing.T) {
+	cursor := &queryIteratorCursor{CollectionID: 1, SessionTs: 100, RequestDigest: "d", HasLastPK: true, LastPK: "a\"b"}
+	decoded, err := decodeQueryIteratorCursor(cursor.encode())
+	assert.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+
+	expr, err := decoded.expr(&schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_VarChar})
+	assert.NoError(t, err)
+	assert.Equal(t, `pk > "a\"b"`, expr)
+	_, err = decoded.expr(&schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_Int64})
+	assert.Error(t, err)
+
+	// empty varchar is a valid last primary key
+	cursor = &queryIteratorCursor{CollectionID: 1, SessionTs: 100, RequestDigest: "d", HasLastPK: true}
+	expr, err = cursor.expr(&schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_VarChar})
+	assert.NoError(t, err)
+	assert.Equal(t, `pk > ""`, expr)
+	expr, err = (&queryIteratorCursor{}).expr(&schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_VarChar})
+	assert.NoError(t, err)
+	assert.Equal(t, "", expr)
+
+	_, err = decodeQueryIteratorCursor((&queryIteratorCursor{HasLastPK: true, LastPK: "1"}).encode())
+	assert.Error(t, err)
+	_, err = decodeQueryIteratorCursor((&queryIteratorCursor{CollectionID: 1, SessionTs: 100}).encode())
+	assert.Error(t, err)
+
+	req := &QueryIteratorReqV2{CollectionName: "book", Filter: "id > {min}", ExprParams: map[string]interface{}{"min": float64(1), "list": []interface{}{float64(1), float64(2)}}}
+	digest := queryIteratorRequestDigest("db", req)
+	assert.Equal(t, digest, queryIteratorRequestDigest("db", req))
+	assert.NotEqual(t, digest, queryIteratorRequestDigest("db2", req))
+	req.ExprParams["min"] = float64(2)
+	assert.NotEqual(t, digest, queryIteratorRequestDigest("db", req))
+}
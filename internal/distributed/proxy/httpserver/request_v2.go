@@ -17,6 +17,7 @@
 package httpserver
 
 import (
+	"bytes"
 	"context"
 	"strconv"
 
@@ -25,10 +26,26 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/json"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 )
 
+// ExprParams are the values of the placeholders of the filter. The numbers are decoded as json.Number,
+// so that the integers beyond 2^53 are not rounded by float64.
+type ExprParams map[string]interface{}
+
+func (params *ExprParams) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	values := make(map[string]interface{})
+	if err := decoder.Decode(&values); err != nil {
+		return err
+	}
+	*params = values
+	return nil
+}
+
 type EmptyReq struct{}
 
 func (req *EmptyReq) GetDbName() string { return "" }
@@ -231,20 +248,64 @@ type JobIDReq struct {
 
 func (req *JobIDReq) GetJobID() string { return req.JobID }
 
+type ExportReq struct {
+	DbName          string            `json:"dbName"`
+	CollectionName  string            `json:"collectionName" binding:"required"`
+	PartitionNames  []string          `json:"partitionNames"`
+	Filter          string            `json:"filter"`
+	OutputFields    []string          `json:"outputFields"`
+	ExportTimestamp uint64            `json:"exportTimestamp"`
+	OutputPath      string            `json:"outputPath"`
+	Options         map[string]string `json:"options"`
+}
+
+func (req *ExportReq) GetDbName() string { return req.DbName }
+
+func (req *ExportReq) GetCollectionName() string { return req.CollectionName }
+
+type ExplainReqV2 struct {
+	DbName         string                 `json:"dbName"`
+	CollectionName string                 `json:"collectionName" binding:"required"`
+	PartitionNames []string               `json:"partitionNames"`
+	Filter         string                 `json:"filter"`
+	SearchParams   map[string]interface{} `json:"searchParams"`
+}
+
+func (req *ExplainReqV2) GetDbName() string { return req.DbName }
+
+func (req *ExplainReqV2) GetCollectionName() string { return req.CollectionName }
+
 type QueryReqV2 struct {
-	DbName           string                 `json:"dbName"`
-	CollectionName   string                 `json:"collectionName" binding:"required"`
-	PartitionNames   []string               `json:"partitionNames"`
-	OutputFields     []string               `json:"outputFields"`
-	Filter           string                 `json:"filter"`
-	Limit            int32                  `json:"limit"`
-	Offset           int32                  `json:"offset"`
-	ExprParams       map[string]interface{} `json:"exprParams"`
-	ConsistencyLevel string                 `json:"consistencyLevel"`
+	DbName           string     `json:"dbName"`
+	CollectionName   string     `json:"collectionName" binding:"required"`
+	PartitionNames   []string   `json:"partitionNames"`
+	OutputFields     []string   `json:"outputFields"`
+	Filter           string     `json:"filter"`
+	Limit            int32      `json:"limit"`
+	Offset           int32      `json:"offset"`
+	OrderBy          string     `json:"orderBy"`
+	GroupByFields    []string   `json:"groupByFields"`
+	ExprParams       ExprParams `json:"exprParams"`
+	ConsistencyLevel string     `json:"consistencyLevel"`
 }
 
 func (req *QueryReqV2) GetDbName() string { return req.DbName }
 
+type QueryIteratorReqV2 struct {
+	DbName           string     `json:"dbName"`
+	CollectionName   string     `json:"collectionName" binding:"required"`
+	PartitionNames   []string   `json:"partitionNames"`
+	OutputFields     []string   `json:"outputFields"`
+	Filter           string     `json:"filter"`
+	BatchSize        int32      `json:"batchSize"`
+	ExprParams       ExprParams `json:"exprParams"`
+	ConsistencyLevel string     `json:"consistencyLevel"`
+	// Cursor is the continuation token returned by previous batch, empty for the first batch
+	Cursor string `json:"cursor"`
+}
+
+func (req *QueryIteratorReqV2) GetDbName() string { return req.DbName }
+
 type CollectionIDReq struct {
 	DbName           string      `json:"dbName"`
 	CollectionName   string      `json:"collectionName" binding:"required"`
@@ -258,11 +319,11 @@ type CollectionIDReq struct {
 func (req *CollectionIDReq) GetDbName() string { return req.DbName }
 
 type CollectionFilterReq struct {
-	DbName         string                 `json:"dbName"`
-	CollectionName string                 `json:"collectionName" binding:"required"`
-	PartitionName  string                 `json:"partitionName"`
-	Filter         string                 `json:"filter" binding:"required"`
-	ExprParams     map[string]interface{} `json:"exprParams"`
+	DbName         string     `json:"dbName"`
+	CollectionName string     `json:"collectionName" binding:"required"`
+	PartitionName  string     `json:"partitionName"`
+	Filter         string     `json:"filter" binding:"required"`
+	ExprParams     ExprParams `json:"exprParams"`
 }
 
 func (req *CollectionFilterReq) GetDbName() string { return req.DbName }
@@ -291,7 +352,7 @@ type SearchReqV2 struct {
 	OutputFields     []string               `json:"outputFields"`
 	SearchParams     map[string]interface{} `json:"searchParams"`
 	ConsistencyLevel string                 `json:"consistencyLevel"`
-	ExprParams       map[string]interface{} `json:"exprParams"`
+	ExprParams       ExprParams             `json:"exprParams"`
 	FunctionScore    FunctionScore          `json:"functionScore"`
 	// not use Params any more, just for compatibility
 	Params map[string]float64 `json:"params"`
@@ -313,7 +374,7 @@ type SubSearchReq struct {
 	Limit        int32                  `json:"limit"`
 	Offset       int32                  `json:"offset"`
 	SearchParams map[string]interface{} `json:"params"`
-	ExprParams   map[string]interface{} `json:"exprParams"`
+	ExprParams   ExprParams             `json:"exprParams"`
 }
 
 type HybridSearchReq struct {
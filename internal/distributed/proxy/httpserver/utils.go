@@ -19,6 +19,9 @@ package httpserver
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"math"
 	"reflect"
@@ -109,6 +112,97 @@ func getPrimaryField(schema *schemapb.CollectionSchema) (*schemapb.FieldSchema,
 	return nil, false
 }
 
+// queryIteratorCursor is the continuation token of restful query iterator,
+// it binds the iteration to a collection, mvcc timestamp, request and the last returned primary key.
+type queryIteratorCursor struct {
+	CollectionID int64  `json:"collectionID"`
+	SessionTs    uint64 `json:"sessionTs"`
+	// RequestDigest is the digest of the request params which define the iterated entities,
+	// the cursor can't be used with a different filter, expr params or partitions
+	RequestDigest string `json:"requestDigest"`
+	// HasLastPK is false for the first batch, LastPK is the string form of last primary key
+	// which may be an empty VarChar
+	HasLastPK bool   `json:"hasLastPK"`
+	LastPK    string `json:"lastPK"`
+}
+
+func (cursor *queryIteratorCursor) encode() string {
+	bs, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(bs)
+}
+
+func decodeQueryIteratorCursor(token string) (*queryIteratorCursor, error) {
+	bs, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid query iterator cursor: %s", err.Error())
+	}
+	cursor := &queryIteratorCursor{}
+	if err := json.Unmarshal(bs, cursor); err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid query iterator cursor: %s", err.Error())
+	}
+	if cursor.CollectionID <= 0 || cursor.SessionTs == 0 || cursor.RequestDigest == "" {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid query iterator cursor: collection, timestamp or request digest missing")
+	}
+	return cursor, nil
+}
+
+// queryIteratorRequestDigest returns the digest of the params of query iterator request
+// which must stay the same during an iteration.
+// Invalid expr params are rejected before query, they are ignored here.
+func queryIteratorRequestDigest(dbName string, req *QueryIteratorReqV2) string {
+	templateValues, _ := generateExpressionTemplate(req.ExprParams)
+	bs, _ := proto.MarshalOptions{Deterministic: true}.Marshal(&milvuspb.QueryRequest{
+		DbName:             dbName,
+		CollectionName:     req.CollectionName,
+		Expr:               req.Filter,
+		PartitionNames:     req.PartitionNames,
+		ExprTemplateValues: templateValues,
+	})
+	digest := sha256.Sum256(bs)
+	return hex.EncodeToString(digest[:])
+}
+
+// expr returns the expression to filter entities after the last primary key
+func (cursor *queryIteratorCursor) expr(primaryField *schemapb.FieldSchema) (string, error) {
+	if !cursor.HasLastPK {
+		return "", nil
+	}
+	switch primaryField.GetDataType() {
+	case schemapb.DataType_Int64:
+		pk, err := strconv.ParseInt(cursor.LastPK, 10, 64)
+		if err != nil {
+			return "", merr.WrapErrParameterInvalidMsg("invalid query iterator cursor: %s", err.Error())
+		}
+		return fmt.Sprintf("%s > %d", primaryField.GetName(), pk), nil
+	case schemapb.DataType_VarChar:
+		return fmt.Sprintf("%s > %s", primaryField.GetName(), strconv.Quote(cursor.LastPK)), nil
+	default:
+		return "", merr.WrapErrParameterInvalidMsg("unsupported primary key type %s", primaryField.GetDataType().String())
+	}
+}
+
+// getLastPrimaryKey returns the string form of the last primary key in query result
+func getLastPrimaryKey(fieldsData []*schemapb.FieldData, primaryField *schemapb.FieldSchema) (string, error) {
+	for _, fieldData := range fieldsData {
+		if fieldData.GetFieldName() != primaryField.GetName() {
+			continue
+		}
+		switch primaryField.GetDataType() {
+		case schemapb.DataType_Int64:
+			data := fieldData.GetScalars().GetLongData().GetData()
+			if len(data) > 0 {
+				return strconv.FormatInt(data[len(data)-1], 10), nil
+			}
+		case schemapb.DataType_VarChar:
+			data := fieldData.GetScalars().GetStringData().GetData()
+			if len(data) > 0 {
+				return data[len(data)-1], nil
+			}
+		}
+	}
+	return "", merr.WrapErrParameterInvalidMsg("primary key field %s not found in query result", primaryField.GetName())
+}
+
 func joinArray(data interface{}) string {
 	var buffer bytes.Buffer
 	arr := reflect.ValueOf(data)
@@ -1782,8 +1876,11 @@ func RequestHandlerFunc(c *gin.Context) {
 	c.Next()
 }
 
-func generateTemplateArrayData(list []interface{}) *schemapb.TemplateArrayValue {
-	dtype := getTemplateArrayType(list)
+func generateTemplateArrayData(list []interface{}) (*schemapb.TemplateArrayValue, error) {
+	dtype, err := getTemplateArrayType(list)
+	if err != nil {
+		return nil, err
+	}
 	var data *schemapb.TemplateArrayValue
 	switch dtype {
 	case schemapb.DataType_Bool:
@@ -1813,7 +1910,7 @@ func generateTemplateArrayData(list []interface{}) *schemapb.TemplateArrayValue
 	case schemapb.DataType_Int64:
 		result := make([]int64, len(list))
 		for i, item := range list {
-			result[i] = int64(item.(float64))
+			result[i] = getTemplateInt64(item)
 		}
 		data = &schemapb.TemplateArrayValue{
 			Data: &schemapb.TemplateArrayValue_LongData{
@@ -1825,7 +1922,7 @@ func generateTemplateArrayData(list []interface{}) *schemapb.TemplateArrayValue
 	case schemapb.DataType_Float:
 		result := make([]float64, len(list))
 		for i, item := range list {
-			result[i] = item.(float64)
+			result[i] = getTemplateFloat(item)
 		}
 		data = &schemapb.TemplateArrayValue{
 			Data: &schemapb.TemplateArrayValue_DoubleData{
@@ -1837,7 +1934,10 @@ func generateTemplateArrayData(list []interface{}) *schemapb.TemplateArrayValue
 	case schemapb.DataType_Array:
 		result := make([]*schemapb.TemplateArrayValue, len(list))
 		for i, item := range list {
-			result[i] = generateTemplateArrayData(item.([]interface{}))
+			result[i], err = generateTemplateArrayData(item.([]interface{}))
+			if err != nil {
+				return nil, err
+			}
 		}
 		data = &schemapb.TemplateArrayValue{
 			Data: &schemapb.TemplateArrayValue_ArrayData{
@@ -1850,9 +1950,8 @@ func generateTemplateArrayData(list []interface{}) *schemapb.TemplateArrayValue
 		result := make([][]byte, len(list))
 		for i, item := range list {
 			bytes, err := json.Marshal(item)
-			// won't happen
 			if err != nil {
-				panic(fmt.Sprintf("marshal data(%v) fail, please check it!", item))
+				return nil, fmt.Errorf("marshal data(%v) fail, err: %w", item, err)
 			}
 			result[i] = bytes
 		}
@@ -1863,52 +1962,101 @@ func generateTemplateArrayData(list []interface{}) *schemapb.TemplateArrayValue
 				},
 			},
 		}
-	// won't happen
 	default:
-		panic(fmt.Sprintf("Unexpected data(%v) type when generateTemplateArrayData, please check it!", list))
+		return nil, fmt.Errorf("unexpected data(%v) type when generateTemplateArrayData", list)
 	}
-	return data
+	return data, nil
 }
 
-func getTemplateArrayType(value []interface{}) schemapb.DataType {
-	dtype := getTemplateType(value[0])
-
-	for _, v := range value {
-		if getTemplateType(v) != dtype {
-			return schemapb.DataType_JSON
+// getTemplateArrayType infers the element type of the array:
+// integers mixed with floats are all floats, objects, other mixed types or an empty array are json.
+func getTemplateArrayType(value []interface{}) (schemapb.DataType, error) {
+	if len(value) == 0 {
+		return schemapb.DataType_JSON, nil
+	}
+	dtype, err := getTemplateType(value[0])
+	if err != nil {
+		return schemapb.DataType_None, err
+	}
+	for _, v := range value[1:] {
+		vtype, err := getTemplateType(v)
+		if err != nil {
+			return schemapb.DataType_None, err
+		}
+		switch {
+		case vtype == dtype:
+		case isTemplateNumberType(vtype) && isTemplateNumberType(dtype):
+			dtype = schemapb.DataType_Float
+		default:
+			dtype = schemapb.DataType_JSON
 		}
 	}
-	return dtype
+	return dtype, nil
+}
+
+func isTemplateNumberType(dtype schemapb.DataType) bool {
+	return dtype == schemapb.DataType_Int64 || dtype == schemapb.DataType_Float
 }
 
-func getTemplateType(value interface{}) schemapb.DataType {
+func getTemplateType(value interface{}) (schemapb.DataType, error) {
 	switch v := value.(type) {
 	case bool:
-		return schemapb.DataType_Bool
+		return schemapb.DataType_Bool, nil
 	case string:
-		return schemapb.DataType_String
+		return schemapb.DataType_String, nil
+	case json.Number:
+		if _, err := strconv.ParseInt(v.String(), 10, 64); err == nil {
+			return schemapb.DataType_Int64, nil
+		}
+		if _, err := v.Float64(); err != nil {
+			return schemapb.DataType_None, fmt.Errorf("invalid number %s: %w", v, err)
+		}
+		return schemapb.DataType_Float, nil
 	case float64:
-		// note: all passed number is float64 type
+		// note: the numbers are float64 if the params are not decoded from the request body
 		// if field type is float64, but value in ExpressionTemplate is int64, it's ok to use TemplateValue_Int64Val to store it
 		// it will convert to float64 in ./internal/parser/planparserv2/utils.go, Line 233
-		if v == math.Trunc(v) && v >= math.MinInt64 && v <= math.MaxInt64 {
-			return schemapb.DataType_Int64
+		if v == math.Trunc(v) && v >= math.MinInt64 && v < math.MaxInt64 {
+			return schemapb.DataType_Int64, nil
 		}
-		return schemapb.DataType_Float
+		return schemapb.DataType_Float, nil
 	// it won't happen
 	// case int64:
 	case []interface{}:
-		return schemapb.DataType_Array
+		return schemapb.DataType_Array, nil
+	case map[string]interface{}:
+		return schemapb.DataType_JSON, nil
 	default:
-		panic(fmt.Sprintf("Unexpected data(%v) when getTemplateType, please check it!", value))
+		return schemapb.DataType_None, fmt.Errorf("unsupported value(%v) of type %T, only bool, number, string, array and object are supported", value, value)
+	}
+}
+
+// getTemplateInt64 converts the number which is checked to be an integer by getTemplateType.
+func getTemplateInt64(value interface{}) int64 {
+	if number, ok := value.(json.Number); ok {
+		result, _ := strconv.ParseInt(number.String(), 10, 64)
+		return result
 	}
+	return int64(value.(float64))
 }
 
-func generateExpressionTemplate(params map[string]interface{}) map[string]*schemapb.TemplateValue {
+// getTemplateFloat converts the number which is checked to be valid by getTemplateType.
+func getTemplateFloat(value interface{}) float64 {
+	if number, ok := value.(json.Number); ok {
+		result, _ := number.Float64()
+		return result
+	}
+	return value.(float64)
+}
+
+func generateExpressionTemplate(params map[string]interface{}) (map[string]*schemapb.TemplateValue, error) {
 	expressionTemplate := make(map[string]*schemapb.TemplateValue, len(params))
 
 	for name, value := range params {
-		dtype := getTemplateType(value)
+		dtype, err := getTemplateType(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exprParams {%s}: %w", name, err)
+		}
 		var data *schemapb.TemplateValue
 		switch dtype {
 		case schemapb.DataType_Bool:
@@ -1926,27 +2074,32 @@ func generateExpressionTemplate(params map[string]interface{}) map[string]*schem
 		case schemapb.DataType_Int64:
 			data = &schemapb.TemplateValue{
 				Val: &schemapb.TemplateValue_Int64Val{
-					Int64Val: int64(value.(float64)),
+					Int64Val: getTemplateInt64(value),
 				},
 			}
 		case schemapb.DataType_Float:
 			data = &schemapb.TemplateValue{
 				Val: &schemapb.TemplateValue_FloatVal{
-					FloatVal: value.(float64),
+					FloatVal: getTemplateFloat(value),
 				},
 			}
 		case schemapb.DataType_Array:
+			arrayData, err := generateTemplateArrayData(value.([]interface{}))
+			if err != nil {
+				return nil, fmt.Errorf("invalid exprParams {%s}: %w", name, err)
+			}
 			data = &schemapb.TemplateValue{
 				Val: &schemapb.TemplateValue_ArrayVal{
-					ArrayVal: generateTemplateArrayData(value.([]interface{})),
+					ArrayVal: arrayData,
 				},
 			}
-		default:
-			panic(fmt.Sprintf("Unexpected data(%v) when generateExpressionTemplate, please check it!", data))
+		case schemapb.DataType_JSON:
+			// the template value has no json type, objects are only supported as the elements of arrays
+			return nil, fmt.Errorf("invalid exprParams {%s}: object is only supported as the element of array", name)
 		}
 		expressionTemplate[name] = data
 	}
-	return expressionTemplate
+	return expressionTemplate, nil
 }
 
 func WrapErrorToResponse(err error) *milvuspb.BoolResponse {
@@ -2329,6 +2329,11 @@ func TestGenerateExpressionTemplate(t *testing.T) {
 		{"list_of_float": []interface{}{1.1, 10.1, 100.1}},
 		{"list_of_int": []interface{}{float64(1), float64(10), float64(100)}},
 		{"list_of_json": mixedList},
+		{"list_of_number": []interface{}{float64(1), 1.5}},
+		{"list_of_list": []interface{}{[]interface{}{float64(1)}, []interface{}{"a"}}},
+		{"empty_list": []interface{}{}},
+		{"list_of_object": []interface{}{map[string]interface{}{"a": float64(1)}, map[string]interface{}{}}},
+		{"list_of_list_of_object": []interface{}{[]interface{}{map[string]interface{}{"b": "c"}}}},
 	}
 	ans := []map[string]*schemapb.TemplateValue{
 		{
@@ -2436,11 +2441,110 @@ func TestGenerateExpressionTemplate(t *testing.T) {
 				},
 			},
 		},
+		{
+			"list_of_number": &schemapb.TemplateValue{
+				Val: &schemapb.TemplateValue_ArrayVal{
+					ArrayVal: &schemapb.TemplateArrayValue{
+						Data: &schemapb.TemplateArrayValue_DoubleData{
+							DoubleData: &schemapb.DoubleArray{
+								Data: []float64{1, 1.5},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"list_of_list": &schemapb.TemplateValue{
+				Val: &schemapb.TemplateValue_ArrayVal{
+					ArrayVal: &schemapb.TemplateArrayValue{
+						Data: &schemapb.TemplateArrayValue_ArrayData{
+							ArrayData: &schemapb.TemplateArrayValueArray{
+								Data: []*schemapb.TemplateArrayValue{
+									{Data: &schemapb.TemplateArrayValue_LongData{LongData: &schemapb.LongArray{Data: []int64{1}}}},
+									{Data: &schemapb.TemplateArrayValue_StringData{StringData: &schemapb.StringArray{Data: []string{"a"}}}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"empty_list": &schemapb.TemplateValue{
+				Val: &schemapb.TemplateValue_ArrayVal{
+					ArrayVal: &schemapb.TemplateArrayValue{
+						Data: &schemapb.TemplateArrayValue_JsonData{
+							JsonData: &schemapb.JSONArray{
+								Data: [][]byte{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"list_of_object": &schemapb.TemplateValue{
+				Val: &schemapb.TemplateValue_ArrayVal{
+					ArrayVal: &schemapb.TemplateArrayValue{
+						Data: &schemapb.TemplateArrayValue_JsonData{
+							JsonData: &schemapb.JSONArray{
+								Data: [][]byte{[]byte(`{"a":1}`), []byte(`{}`)},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"list_of_list_of_object": &schemapb.TemplateValue{
+				Val: &schemapb.TemplateValue_ArrayVal{
+					ArrayVal: &schemapb.TemplateArrayValue{
+						Data: &schemapb.TemplateArrayValue_ArrayData{
+							ArrayData: &schemapb.TemplateArrayValueArray{
+								Data: []*schemapb.TemplateArrayValue{
+									{Data: &schemapb.TemplateArrayValue_JsonData{JsonData: &schemapb.JSONArray{Data: [][]byte{[]byte(`{"b":"c"}`)}}}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for i, template := range expressionTemplates {
-		actual := generateExpressionTemplate(template)
+		actual, err := generateExpressionTemplate(template)
+		assert.NoError(t, err)
 		assert.Equal(t, actual, ans[i])
 	}
+
+	invalidTemplates := []map[string]interface{}{
+		{"null": nil},
+		// the template value has no json type
+		{"object": map[string]interface{}{"a": float64(1)}},
+		{"list_of_null": []interface{}{float64(1), nil}},
+		{"list_of_list_of_null": []interface{}{[]interface{}{nil}}},
+	}
+	for _, template := range invalidTemplates {
+		_, err := generateExpressionTemplate(template)
+		assert.Error(t, err)
+	}
+}
+
+func TestGenerateExpressionTemplateFromBody(t *testing.T) {
+	req := &QueryReqV2{}
+	err := json.Unmarshal([]byte(`{"collectionName": "book", "exprParams": {
+		"big": 9007199254740993, "max": 9223372036854775807, "overflow": 9223372036854775808,
+		"float": 1.5, "list": [9007199254740993, -9007199254740993], "mixed": [1, 2.5]}}`), req)
+	assert.NoError(t, err)
+	actual, err := generateExpressionTemplate(req.ExprParams)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9007199254740993), actual["big"].GetInt64Val())
+	assert.Equal(t, int64(math.MaxInt64), actual["max"].GetInt64Val())
+	assert.Equal(t, float64(9223372036854775808), actual["overflow"].GetFloatVal())
+	assert.Equal(t, 1.5, actual["float"].GetFloatVal())
+	assert.Equal(t, []int64{9007199254740993, -9007199254740993}, actual["list"].GetArrayVal().GetLongData().GetData())
+	assert.Equal(t, []float64{1, 2.5}, actual["mixed"].GetArrayVal().GetDoubleData().GetData())
 }
 
 func TestGenerateSearchParams(t *testing.T) {
@@ -1105,6 +1105,26 @@ func (s *Server) ListImports(ctx context.Context, req *internalpb.ListImportsReq
 	return s.proxy.ListImports(ctx, req)
 }
 
+func (s *Server) ExportV2(ctx context.Context, req *internalpb.ExportRequest) (*internalpb.ExportResponse, error) {
+	return s.proxy.ExportV2(ctx, req)
+}
+
+func (s *Server) GetExportProgress(ctx context.Context, req *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error) {
+	return s.proxy.GetExportProgress(ctx, req)
+}
+
+func (s *Server) ListExports(ctx context.Context, req *internalpb.ListExportsRequest) (*internalpb.ListExportsResponse, error) {
+	return s.proxy.ListExports(ctx, req)
+}
+
+func (s *Server) CancelExport(ctx context.Context, req *internalpb.CancelExportRequest) (*commonpb.Status, error) {
+	return s.proxy.CancelExport(ctx, req)
+}
+
+func (s *Server) Explain(ctx context.Context, req *internalpb.ExplainRequest) (*internalpb.ExplainResponse, error) {
+	return s.proxy.Explain(ctx, req)
+}
+
 func (s *Server) AlterDatabase(ctx context.Context, req *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error) {
 	return s.proxy.AlterDatabase(ctx, req)
 }
@@ -383,3 +383,14 @@ func (c *Client) RunAnalyzer(ctx context.Context, req *querypb.RunAnalyzerReques
 		return client.RunAnalyzer(ctx, req)
 	})
 }
+
+func (c *Client) ExplainSegments(ctx context.Context, req *querypb.ExplainSegmentsRequest, _ ...grpc.CallOption) (*querypb.ExplainSegmentsResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(c.nodeID),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryNodeClient) (*querypb.ExplainSegmentsResponse, error) {
+		return client.ExplainSegments(ctx, req)
+	})
+}
@@ -402,3 +402,7 @@ func (s *Server) UpdateSchema(ctx context.Context, req *querypb.UpdateSchemaRequ
 func (s *Server) RunAnalyzer(ctx context.Context, req *querypb.RunAnalyzerRequest) (*milvuspb.RunAnalyzerResponse, error) {
 	return s.querynode.RunAnalyzer(ctx, req)
 }
+
+func (s *Server) ExplainSegments(ctx context.Context, req *querypb.ExplainSegmentsRequest) (*querypb.ExplainSegmentsResponse, error) {
+	return s.querynode.ExplainSegments(ctx, req)
+}
@@ -159,6 +159,13 @@ type DataCoordCatalog interface {
 	ListImportTasks(ctx context.Context) ([]*datapb.ImportTaskV2, error)
 	DropImportTask(ctx context.Context, taskID int64) error
 
+	SaveExportJob(ctx context.Context, job *datapb.ExportJob) error
+	ListExportJobs(ctx context.Context) ([]*datapb.ExportJob, error)
+	DropExportJob(ctx context.Context, jobID int64) error
+	SaveExportTask(ctx context.Context, task *datapb.ExportTask) error
+	ListExportTasks(ctx context.Context) ([]*datapb.ExportTask, error)
+	DropExportTask(ctx context.Context, taskID int64) error
+
 	GcConfirm(ctx context.Context, collectionID, partitionID typeutil.UniqueID) bool
 
 	ListCompactionTask(ctx context.Context) ([]*datapb.CompactionTask, error)
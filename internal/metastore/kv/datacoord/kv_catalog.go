@@ -766,6 +766,73 @@ func (kc *Catalog) DropImportTask(ctx context.Context, taskID int64) error {
 	return kc.MetaKv.Remove(ctx, key)
 }
 
+func (kc *Catalog) SaveExportJob(ctx context.Context, job *datapb.ExportJob) error {
+	key := buildExportJobKey(job.GetJobID())
+	value, err := proto.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(ctx, key, string(value))
+}
+
+func (kc *Catalog) ListExportJobs(ctx context.Context) ([]*datapb.ExportJob, error) {
+	jobs := make([]*datapb.ExportJob, 0)
+	applyFn := func(key []byte, value []byte) error {
+		job := &datapb.ExportJob{}
+		err := proto.Unmarshal(value, job)
+		if err != nil {
+			return err
+		}
+		jobs = append(jobs, job)
+		return nil
+	}
+
+	err := kc.MetaKv.WalkWithPrefix(ctx, ExportJobPrefix, kc.paginationSize, applyFn)
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (kc *Catalog) DropExportJob(ctx context.Context, jobID int64) error {
+	key := buildExportJobKey(jobID)
+	return kc.MetaKv.Remove(ctx, key)
+}
+
+func (kc *Catalog) SaveExportTask(ctx context.Context, task *datapb.ExportTask) error {
+	key := buildExportTaskKey(task.GetTaskID())
+	value, err := proto.Marshal(task)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(ctx, key, string(value))
+}
+
+func (kc *Catalog) ListExportTasks(ctx context.Context) ([]*datapb.ExportTask, error) {
+	tasks := make([]*datapb.ExportTask, 0)
+
+	applyFn := func(key []byte, value []byte) error {
+		task := &datapb.ExportTask{}
+		err := proto.Unmarshal(value, task)
+		if err != nil {
+			return err
+		}
+		tasks = append(tasks, task)
+		return nil
+	}
+
+	err := kc.MetaKv.WalkWithPrefix(ctx, ExportTaskPrefix, kc.paginationSize, applyFn)
+	if err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+func (kc *Catalog) DropExportTask(ctx context.Context, taskID int64) error {
+	key := buildExportTaskKey(taskID)
+	return kc.MetaKv.Remove(ctx, key)
+}
+
 // GcConfirm returns true if related collection/partition is not found.
 // DataCoord will remove all the meta eventually after GC is finished.
 func (kc *Catalog) GcConfirm(ctx context.Context, collectionID, partitionID typeutil.UniqueID) bool {
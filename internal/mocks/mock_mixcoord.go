@@ -811,6 +811,65 @@ func (_c *MixCoord_BroadcastAlteredCollection_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// CancelExport provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) CancelExport(_a0 context.Context, _a1 *internalpb.CancelExportRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelExport")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CancelExportRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CancelExportRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CancelExportRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_CancelExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelExport'
+type MixCoord_CancelExport_Call struct {
+	*mock.Call
+}
+
+// CancelExport is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.CancelExportRequest
+func (_e *MixCoord_Expecter) CancelExport(_a0 interface{}, _a1 interface{}) *MixCoord_CancelExport_Call {
+	return &MixCoord_CancelExport_Call{Call: _e.mock.On("CancelExport", _a0, _a1)}
+}
+
+func (_c *MixCoord_CancelExport_Call) Run(run func(_a0 context.Context, _a1 *internalpb.CancelExportRequest)) *MixCoord_CancelExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.CancelExportRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_CancelExport_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_CancelExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_CancelExport_Call) RunAndReturn(run func(context.Context, *internalpb.CancelExportRequest) (*commonpb.Status, error)) *MixCoord_CancelExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckBalanceStatus provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) CheckBalanceStatus(_a0 context.Context, _a1 *querypb.CheckBalanceStatusRequest) (*querypb.CheckBalanceStatusResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2522,6 +2581,65 @@ func (_c *MixCoord_DropVirtualChannel_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// ExportV2 provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) ExportV2(_a0 context.Context, _a1 *internalpb.ExportRequestInternal) (*internalpb.ExportResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportV2")
+	}
+
+	var r0 *internalpb.ExportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ExportRequestInternal) (*internalpb.ExportResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ExportRequestInternal) *internalpb.ExportResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ExportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ExportRequestInternal) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_ExportV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportV2'
+type MixCoord_ExportV2_Call struct {
+	*mock.Call
+}
+
+// ExportV2 is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ExportRequestInternal
+func (_e *MixCoord_Expecter) ExportV2(_a0 interface{}, _a1 interface{}) *MixCoord_ExportV2_Call {
+	return &MixCoord_ExportV2_Call{Call: _e.mock.On("ExportV2", _a0, _a1)}
+}
+
+func (_c *MixCoord_ExportV2_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ExportRequestInternal)) *MixCoord_ExportV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ExportRequestInternal))
+	})
+	return _c
+}
+
+func (_c *MixCoord_ExportV2_Call) Return(_a0 *internalpb.ExportResponse, _a1 error) *MixCoord_ExportV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_ExportV2_Call) RunAndReturn(run func(context.Context, *internalpb.ExportRequestInternal) (*internalpb.ExportResponse, error)) *MixCoord_ExportV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Flush provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) Flush(_a0 context.Context, _a1 *datapb.FlushRequest) (*datapb.FlushResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3171,6 +3289,65 @@ func (_c *MixCoord_GetDcMetrics_Call) RunAndReturn(run func(context.Context, *mi
 	return _c
 }
 
+// GetExportProgress provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) GetExportProgress(_a0 context.Context, _a1 *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExportProgress")
+	}
+
+	var r0 *internalpb.GetExportProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetExportProgressRequest) *internalpb.GetExportProgressResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetExportProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetExportProgressRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_GetExportProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExportProgress'
+type MixCoord_GetExportProgress_Call struct {
+	*mock.Call
+}
+
+// GetExportProgress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.GetExportProgressRequest
+func (_e *MixCoord_Expecter) GetExportProgress(_a0 interface{}, _a1 interface{}) *MixCoord_GetExportProgress_Call {
+	return &MixCoord_GetExportProgress_Call{Call: _e.mock.On("GetExportProgress", _a0, _a1)}
+}
+
+func (_c *MixCoord_GetExportProgress_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetExportProgressRequest)) *MixCoord_GetExportProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.GetExportProgressRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_GetExportProgress_Call) Return(_a0 *internalpb.GetExportProgressResponse, _a1 error) *MixCoord_GetExportProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_GetExportProgress_Call) RunAndReturn(run func(context.Context, *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error)) *MixCoord_GetExportProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFlushAllState provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) GetFlushAllState(_a0 context.Context, _a1 *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -5372,6 +5549,65 @@ func (_c *MixCoord_ListDatabases_Call) RunAndReturn(run func(context.Context, *m
 	return _c
 }
 
+// ListExports provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) ListExports(_a0 context.Context, _a1 *internalpb.ListExportsRequestInternal) (*internalpb.ListExportsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExports")
+	}
+
+	var r0 *internalpb.ListExportsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListExportsRequestInternal) (*internalpb.ListExportsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListExportsRequestInternal) *internalpb.ListExportsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListExportsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListExportsRequestInternal) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_ListExports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExports'
+type MixCoord_ListExports_Call struct {
+	*mock.Call
+}
+
+// ListExports is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ListExportsRequestInternal
+func (_e *MixCoord_Expecter) ListExports(_a0 interface{}, _a1 interface{}) *MixCoord_ListExports_Call {
+	return &MixCoord_ListExports_Call{Call: _e.mock.On("ListExports", _a0, _a1)}
+}
+
+func (_c *MixCoord_ListExports_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListExportsRequestInternal)) *MixCoord_ListExports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ListExportsRequestInternal))
+	})
+	return _c
+}
+
+func (_c *MixCoord_ListExports_Call) Return(_a0 *internalpb.ListExportsResponse, _a1 error) *MixCoord_ListExports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_ListExports_Call) RunAndReturn(run func(context.Context, *internalpb.ListExportsRequestInternal) (*internalpb.ListExportsResponse, error)) *MixCoord_ListExports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListImports provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) ListImports(_a0 context.Context, _a1 *internalpb.ListImportsRequestInternal) (*internalpb.ListImportsResponse, error) {
 	ret := _m.Called(_a0, _a1)
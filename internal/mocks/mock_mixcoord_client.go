@@ -1001,6 +1001,80 @@ func (_c *MockMixCoordClient_BroadcastAlteredCollection_Call) RunAndReturn(run f
 	return _c
 }
 
+// CancelExport provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) CancelExport(ctx context.Context, in *internalpb.CancelExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelExport")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CancelExportRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CancelExportRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CancelExportRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_CancelExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelExport'
+type MockMixCoordClient_CancelExport_Call struct {
+	*mock.Call
+}
+
+// CancelExport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.CancelExportRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) CancelExport(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_CancelExport_Call {
+	return &MockMixCoordClient_CancelExport_Call{Call: _e.mock.On("CancelExport",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_CancelExport_Call) Run(run func(ctx context.Context, in *internalpb.CancelExportRequest, opts ...grpc.CallOption)) *MockMixCoordClient_CancelExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.CancelExportRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_CancelExport_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_CancelExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_CancelExport_Call) RunAndReturn(run func(context.Context, *internalpb.CancelExportRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_CancelExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckBalanceStatus provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) CheckBalanceStatus(ctx context.Context, in *querypb.CheckBalanceStatusRequest, opts ...grpc.CallOption) (*querypb.CheckBalanceStatusResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -3192,6 +3266,80 @@ func (_c *MockMixCoordClient_DropVirtualChannel_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// ExportV2 provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) ExportV2(ctx context.Context, in *internalpb.ExportRequestInternal, opts ...grpc.CallOption) (*internalpb.ExportResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportV2")
+	}
+
+	var r0 *internalpb.ExportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ExportRequestInternal, ...grpc.CallOption) (*internalpb.ExportResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ExportRequestInternal, ...grpc.CallOption) *internalpb.ExportResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ExportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ExportRequestInternal, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_ExportV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportV2'
+type MockMixCoordClient_ExportV2_Call struct {
+	*mock.Call
+}
+
+// ExportV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.ExportRequestInternal
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) ExportV2(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_ExportV2_Call {
+	return &MockMixCoordClient_ExportV2_Call{Call: _e.mock.On("ExportV2",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_ExportV2_Call) Run(run func(ctx context.Context, in *internalpb.ExportRequestInternal, opts ...grpc.CallOption)) *MockMixCoordClient_ExportV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.ExportRequestInternal), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_ExportV2_Call) Return(_a0 *internalpb.ExportResponse, _a1 error) *MockMixCoordClient_ExportV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_ExportV2_Call) RunAndReturn(run func(context.Context, *internalpb.ExportRequestInternal, ...grpc.CallOption) (*internalpb.ExportResponse, error)) *MockMixCoordClient_ExportV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Flush provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) Flush(ctx context.Context, in *datapb.FlushRequest, opts ...grpc.CallOption) (*datapb.FlushResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -3932,6 +4080,80 @@ func (_c *MockMixCoordClient_GetCredential_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetExportProgress provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) GetExportProgress(ctx context.Context, in *internalpb.GetExportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetExportProgressResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExportProgress")
+	}
+
+	var r0 *internalpb.GetExportProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetExportProgressRequest, ...grpc.CallOption) (*internalpb.GetExportProgressResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetExportProgressRequest, ...grpc.CallOption) *internalpb.GetExportProgressResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetExportProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetExportProgressRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_GetExportProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExportProgress'
+type MockMixCoordClient_GetExportProgress_Call struct {
+	*mock.Call
+}
+
+// GetExportProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.GetExportProgressRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) GetExportProgress(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_GetExportProgress_Call {
+	return &MockMixCoordClient_GetExportProgress_Call{Call: _e.mock.On("GetExportProgress",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_GetExportProgress_Call) Run(run func(ctx context.Context, in *internalpb.GetExportProgressRequest, opts ...grpc.CallOption)) *MockMixCoordClient_GetExportProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.GetExportProgressRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_GetExportProgress_Call) Return(_a0 *internalpb.GetExportProgressResponse, _a1 error) *MockMixCoordClient_GetExportProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_GetExportProgress_Call) RunAndReturn(run func(context.Context, *internalpb.GetExportProgressRequest, ...grpc.CallOption) (*internalpb.GetExportProgressResponse, error)) *MockMixCoordClient_GetExportProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFlushAllState provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) GetFlushAllState(ctx context.Context, in *milvuspb.GetFlushAllStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushAllStateResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -6522,6 +6744,80 @@ func (_c *MockMixCoordClient_ListDatabases_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// ListExports provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) ListExports(ctx context.Context, in *internalpb.ListExportsRequestInternal, opts ...grpc.CallOption) (*internalpb.ListExportsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExports")
+	}
+
+	var r0 *internalpb.ListExportsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListExportsRequestInternal, ...grpc.CallOption) (*internalpb.ListExportsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListExportsRequestInternal, ...grpc.CallOption) *internalpb.ListExportsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListExportsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListExportsRequestInternal, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_ListExports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExports'
+type MockMixCoordClient_ListExports_Call struct {
+	*mock.Call
+}
+
+// ListExports is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.ListExportsRequestInternal
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) ListExports(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_ListExports_Call {
+	return &MockMixCoordClient_ListExports_Call{Call: _e.mock.On("ListExports",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_ListExports_Call) Run(run func(ctx context.Context, in *internalpb.ListExportsRequestInternal, opts ...grpc.CallOption)) *MockMixCoordClient_ListExports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.ListExportsRequestInternal), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_ListExports_Call) Return(_a0 *internalpb.ListExportsResponse, _a1 error) *MockMixCoordClient_ListExports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_ListExports_Call) RunAndReturn(run func(context.Context, *internalpb.ListExportsRequestInternal, ...grpc.CallOption) (*internalpb.ListExportsResponse, error)) *MockMixCoordClient_ListExports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListImports provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) ListImports(ctx context.Context, in *internalpb.ListImportsRequestInternal, opts ...grpc.CallOption) (*internalpb.ListImportsResponse, error) {
 	_va := make([]interface{}, len(opts))
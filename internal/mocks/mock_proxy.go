@@ -624,6 +624,65 @@ func (_c *MockProxy_CalcDistance_Call) RunAndReturn(run func(context.Context, *m
 	return _c
 }
 
+// CancelExport provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) CancelExport(_a0 context.Context, _a1 *internalpb.CancelExportRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelExport")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CancelExportRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CancelExportRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CancelExportRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_CancelExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelExport'
+type MockProxy_CancelExport_Call struct {
+	*mock.Call
+}
+
+// CancelExport is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.CancelExportRequest
+func (_e *MockProxy_Expecter) CancelExport(_a0 interface{}, _a1 interface{}) *MockProxy_CancelExport_Call {
+	return &MockProxy_CancelExport_Call{Call: _e.mock.On("CancelExport", _a0, _a1)}
+}
+
+func (_c *MockProxy_CancelExport_Call) Run(run func(_a0 context.Context, _a1 *internalpb.CancelExportRequest)) *MockProxy_CancelExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.CancelExportRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_CancelExport_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_CancelExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_CancelExport_Call) RunAndReturn(run func(context.Context, *internalpb.CancelExportRequest) (*commonpb.Status, error)) *MockProxy_CancelExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckHealth provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) CheckHealth(_a0 context.Context, _a1 *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2453,6 +2512,124 @@ func (_c *MockProxy_Dummy_Call) RunAndReturn(run func(context.Context, *milvuspb
 	return _c
 }
 
+// Explain provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) Explain(_a0 context.Context, _a1 *internalpb.ExplainRequest) (*internalpb.ExplainResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Explain")
+	}
+
+	var r0 *internalpb.ExplainResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ExplainRequest) (*internalpb.ExplainResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ExplainRequest) *internalpb.ExplainResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ExplainResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ExplainRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_Explain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Explain'
+type MockProxy_Explain_Call struct {
+	*mock.Call
+}
+
+// Explain is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ExplainRequest
+func (_e *MockProxy_Expecter) Explain(_a0 interface{}, _a1 interface{}) *MockProxy_Explain_Call {
+	return &MockProxy_Explain_Call{Call: _e.mock.On("Explain", _a0, _a1)}
+}
+
+func (_c *MockProxy_Explain_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ExplainRequest)) *MockProxy_Explain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ExplainRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_Explain_Call) Return(_a0 *internalpb.ExplainResponse, _a1 error) *MockProxy_Explain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_Explain_Call) RunAndReturn(run func(context.Context, *internalpb.ExplainRequest) (*internalpb.ExplainResponse, error)) *MockProxy_Explain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportV2 provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ExportV2(_a0 context.Context, _a1 *internalpb.ExportRequest) (*internalpb.ExportResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportV2")
+	}
+
+	var r0 *internalpb.ExportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ExportRequest) (*internalpb.ExportResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ExportRequest) *internalpb.ExportResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ExportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ExportRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_ExportV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportV2'
+type MockProxy_ExportV2_Call struct {
+	*mock.Call
+}
+
+// ExportV2 is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ExportRequest
+func (_e *MockProxy_Expecter) ExportV2(_a0 interface{}, _a1 interface{}) *MockProxy_ExportV2_Call {
+	return &MockProxy_ExportV2_Call{Call: _e.mock.On("ExportV2", _a0, _a1)}
+}
+
+func (_c *MockProxy_ExportV2_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ExportRequest)) *MockProxy_ExportV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ExportRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_ExportV2_Call) Return(_a0 *internalpb.ExportResponse, _a1 error) *MockProxy_ExportV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_ExportV2_Call) RunAndReturn(run func(context.Context, *internalpb.ExportRequest) (*internalpb.ExportResponse, error)) *MockProxy_ExportV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Flush provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) Flush(_a0 context.Context, _a1 *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2911,6 +3088,65 @@ func (_c *MockProxy_GetDdChannel_Call) RunAndReturn(run func(context.Context, *i
 	return _c
 }
 
+// GetExportProgress provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) GetExportProgress(_a0 context.Context, _a1 *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExportProgress")
+	}
+
+	var r0 *internalpb.GetExportProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetExportProgressRequest) *internalpb.GetExportProgressResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetExportProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetExportProgressRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_GetExportProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExportProgress'
+type MockProxy_GetExportProgress_Call struct {
+	*mock.Call
+}
+
+// GetExportProgress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.GetExportProgressRequest
+func (_e *MockProxy_Expecter) GetExportProgress(_a0 interface{}, _a1 interface{}) *MockProxy_GetExportProgress_Call {
+	return &MockProxy_GetExportProgress_Call{Call: _e.mock.On("GetExportProgress", _a0, _a1)}
+}
+
+func (_c *MockProxy_GetExportProgress_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetExportProgressRequest)) *MockProxy_GetExportProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.GetExportProgressRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_GetExportProgress_Call) Return(_a0 *internalpb.GetExportProgressResponse, _a1 error) *MockProxy_GetExportProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_GetExportProgress_Call) RunAndReturn(run func(context.Context, *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error)) *MockProxy_GetExportProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFlushAllState provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) GetFlushAllState(_a0 context.Context, _a1 *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -4960,6 +5196,65 @@ func (_c *MockProxy_ListDatabases_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// ListExports provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ListExports(_a0 context.Context, _a1 *internalpb.ListExportsRequest) (*internalpb.ListExportsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExports")
+	}
+
+	var r0 *internalpb.ListExportsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListExportsRequest) (*internalpb.ListExportsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListExportsRequest) *internalpb.ListExportsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListExportsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListExportsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_ListExports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExports'
+type MockProxy_ListExports_Call struct {
+	*mock.Call
+}
+
+// ListExports is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ListExportsRequest
+func (_e *MockProxy_Expecter) ListExports(_a0 interface{}, _a1 interface{}) *MockProxy_ListExports_Call {
+	return &MockProxy_ListExports_Call{Call: _e.mock.On("ListExports", _a0, _a1)}
+}
+
+func (_c *MockProxy_ListExports_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListExportsRequest)) *MockProxy_ListExports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ListExportsRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_ListExports_Call) Return(_a0 *internalpb.ListExportsResponse, _a1 error) *MockProxy_ListExports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_ListExports_Call) RunAndReturn(run func(context.Context, *internalpb.ListExportsRequest) (*internalpb.ListExportsResponse, error)) *MockProxy_ListExports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListImportTasks provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) ListImportTasks(_a0 context.Context, _a1 *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -31,6 +31,80 @@ func (_m *MockProxyClient) EXPECT() *MockProxyClient_Expecter {
 	return &MockProxyClient_Expecter{mock: &_m.Mock}
 }
 
+// CancelExport provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) CancelExport(ctx context.Context, in *internalpb.CancelExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelExport")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CancelExportRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CancelExportRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CancelExportRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_CancelExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelExport'
+type MockProxyClient_CancelExport_Call struct {
+	*mock.Call
+}
+
+// CancelExport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.CancelExportRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) CancelExport(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_CancelExport_Call {
+	return &MockProxyClient_CancelExport_Call{Call: _e.mock.On("CancelExport",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_CancelExport_Call) Run(run func(ctx context.Context, in *internalpb.CancelExportRequest, opts ...grpc.CallOption)) *MockProxyClient_CancelExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.CancelExportRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_CancelExport_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_CancelExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_CancelExport_Call) RunAndReturn(run func(context.Context, *internalpb.CancelExportRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_CancelExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with no fields
 func (_m *MockProxyClient) Close() error {
 	ret := _m.Called()
@@ -76,6 +150,154 @@ func (_c *MockProxyClient_Close_Call) RunAndReturn(run func() error) *MockProxyC
 	return _c
 }
 
+// Explain provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) Explain(ctx context.Context, in *internalpb.ExplainRequest, opts ...grpc.CallOption) (*internalpb.ExplainResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Explain")
+	}
+
+	var r0 *internalpb.ExplainResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ExplainRequest, ...grpc.CallOption) (*internalpb.ExplainResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ExplainRequest, ...grpc.CallOption) *internalpb.ExplainResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ExplainResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ExplainRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_Explain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Explain'
+type MockProxyClient_Explain_Call struct {
+	*mock.Call
+}
+
+// Explain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.ExplainRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) Explain(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_Explain_Call {
+	return &MockProxyClient_Explain_Call{Call: _e.mock.On("Explain",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_Explain_Call) Run(run func(ctx context.Context, in *internalpb.ExplainRequest, opts ...grpc.CallOption)) *MockProxyClient_Explain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.ExplainRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_Explain_Call) Return(_a0 *internalpb.ExplainResponse, _a1 error) *MockProxyClient_Explain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_Explain_Call) RunAndReturn(run func(context.Context, *internalpb.ExplainRequest, ...grpc.CallOption) (*internalpb.ExplainResponse, error)) *MockProxyClient_Explain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportV2 provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) ExportV2(ctx context.Context, in *internalpb.ExportRequest, opts ...grpc.CallOption) (*internalpb.ExportResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportV2")
+	}
+
+	var r0 *internalpb.ExportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ExportRequest, ...grpc.CallOption) (*internalpb.ExportResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ExportRequest, ...grpc.CallOption) *internalpb.ExportResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ExportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ExportRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_ExportV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportV2'
+type MockProxyClient_ExportV2_Call struct {
+	*mock.Call
+}
+
+// ExportV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.ExportRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) ExportV2(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_ExportV2_Call {
+	return &MockProxyClient_ExportV2_Call{Call: _e.mock.On("ExportV2",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_ExportV2_Call) Run(run func(ctx context.Context, in *internalpb.ExportRequest, opts ...grpc.CallOption)) *MockProxyClient_ExportV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.ExportRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_ExportV2_Call) Return(_a0 *internalpb.ExportResponse, _a1 error) *MockProxyClient_ExportV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_ExportV2_Call) RunAndReturn(run func(context.Context, *internalpb.ExportRequest, ...grpc.CallOption) (*internalpb.ExportResponse, error)) *MockProxyClient_ExportV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetComponentStates provides a mock function with given fields: ctx, in, opts
 func (_m *MockProxyClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
 	_va := make([]interface{}, len(opts))
@@ -224,6 +446,80 @@ func (_c *MockProxyClient_GetDdChannel_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetExportProgress provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) GetExportProgress(ctx context.Context, in *internalpb.GetExportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetExportProgressResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExportProgress")
+	}
+
+	var r0 *internalpb.GetExportProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetExportProgressRequest, ...grpc.CallOption) (*internalpb.GetExportProgressResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetExportProgressRequest, ...grpc.CallOption) *internalpb.GetExportProgressResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetExportProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetExportProgressRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_GetExportProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExportProgress'
+type MockProxyClient_GetExportProgress_Call struct {
+	*mock.Call
+}
+
+// GetExportProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.GetExportProgressRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) GetExportProgress(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_GetExportProgress_Call {
+	return &MockProxyClient_GetExportProgress_Call{Call: _e.mock.On("GetExportProgress",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_GetExportProgress_Call) Run(run func(ctx context.Context, in *internalpb.GetExportProgressRequest, opts ...grpc.CallOption)) *MockProxyClient_GetExportProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.GetExportProgressRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_GetExportProgress_Call) Return(_a0 *internalpb.GetExportProgressResponse, _a1 error) *MockProxyClient_GetExportProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_GetExportProgress_Call) RunAndReturn(run func(context.Context, *internalpb.GetExportProgressRequest, ...grpc.CallOption) (*internalpb.GetExportProgressResponse, error)) *MockProxyClient_GetExportProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetImportProgress provides a mock function with given fields: ctx, in, opts
 func (_m *MockProxyClient) GetImportProgress(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -964,6 +1260,80 @@ func (_c *MockProxyClient_ListClientInfos_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// ListExports provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) ListExports(ctx context.Context, in *internalpb.ListExportsRequest, opts ...grpc.CallOption) (*internalpb.ListExportsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExports")
+	}
+
+	var r0 *internalpb.ListExportsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListExportsRequest, ...grpc.CallOption) (*internalpb.ListExportsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListExportsRequest, ...grpc.CallOption) *internalpb.ListExportsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListExportsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListExportsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_ListExports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExports'
+type MockProxyClient_ListExports_Call struct {
+	*mock.Call
+}
+
+// ListExports is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.ListExportsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) ListExports(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_ListExports_Call {
+	return &MockProxyClient_ListExports_Call{Call: _e.mock.On("ListExports",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_ListExports_Call) Run(run func(ctx context.Context, in *internalpb.ListExportsRequest, opts ...grpc.CallOption)) *MockProxyClient_ListExports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.ListExportsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_ListExports_Call) Return(_a0 *internalpb.ListExportsResponse, _a1 error) *MockProxyClient_ListExports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_ListExports_Call) RunAndReturn(run func(context.Context, *internalpb.ListExportsRequest, ...grpc.CallOption) (*internalpb.ListExportsResponse, error)) *MockProxyClient_ListExports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListImports provides a mock function with given fields: ctx, in, opts
 func (_m *MockProxyClient) ListImports(ctx context.Context, in *internalpb.ListImportsRequest, opts ...grpc.CallOption) (*internalpb.ListImportsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -224,6 +224,80 @@ func (_c *MockQueryNodeClient_DeleteBatch_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// ExplainSegments provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryNodeClient) ExplainSegments(ctx context.Context, in *querypb.ExplainSegmentsRequest, opts ...grpc.CallOption) (*querypb.ExplainSegmentsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExplainSegments")
+	}
+
+	var r0 *querypb.ExplainSegmentsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ExplainSegmentsRequest, ...grpc.CallOption) (*querypb.ExplainSegmentsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ExplainSegmentsRequest, ...grpc.CallOption) *querypb.ExplainSegmentsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.ExplainSegmentsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ExplainSegmentsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryNodeClient_ExplainSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExplainSegments'
+type MockQueryNodeClient_ExplainSegments_Call struct {
+	*mock.Call
+}
+
+// ExplainSegments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.ExplainSegmentsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryNodeClient_Expecter) ExplainSegments(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryNodeClient_ExplainSegments_Call {
+	return &MockQueryNodeClient_ExplainSegments_Call{Call: _e.mock.On("ExplainSegments",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryNodeClient_ExplainSegments_Call) Run(run func(ctx context.Context, in *querypb.ExplainSegmentsRequest, opts ...grpc.CallOption)) *MockQueryNodeClient_ExplainSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.ExplainSegmentsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryNodeClient_ExplainSegments_Call) Return(_a0 *querypb.ExplainSegmentsResponse, _a1 error) *MockQueryNodeClient_ExplainSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryNodeClient_ExplainSegments_Call) RunAndReturn(run func(context.Context, *querypb.ExplainSegmentsRequest, ...grpc.CallOption) (*querypb.ExplainSegmentsResponse, error)) *MockQueryNodeClient_ExplainSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetComponentStates provides a mock function with given fields: ctx, in, opts
 func (_m *MockQueryNodeClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
 	_va := make([]interface{}, len(opts))
@@ -16,11 +16,11 @@ func TestCheckIdentical(t *testing.T) {
 
 	exprStr1Arr := []string{
 		`not (((Int64Field > 0) and (FloatField <= 20.0)) or ((Int32Field in [1, 2, 3]) and (VarCharField < "str")))`,
-		`f1()`,
+		`empty(VarCharField)`,
 	}
 	exprStr2Arr := []string{
 		`Int32Field in [1, 2, 3]`,
-		`f2(Int32Field, Int64Field)`,
+		`starts_with(VarCharField, StringField)`,
 	}
 	for i := range exprStr1Arr {
 		exprStr1 := exprStr1Arr[i]
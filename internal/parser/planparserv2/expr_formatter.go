@@ -0,0 +1,412 @@
+package planparserv2
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+var identifierPattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z_0-9]*$`)
+
+var compareOperators = map[planpb.OpType]string{
+	planpb.OpType_GreaterThan:  ">",
+	planpb.OpType_GreaterEqual: ">=",
+	planpb.OpType_LessThan:     "<",
+	planpb.OpType_LessEqual:    "<=",
+	planpb.OpType_Equal:        "==",
+	planpb.OpType_NotEqual:     "!=",
+}
+
+var arithOperators = map[planpb.ArithOpType]string{
+	planpb.ArithOpType_Add: "+",
+	planpb.ArithOpType_Sub: "-",
+	planpb.ArithOpType_Mul: "*",
+	planpb.ArithOpType_Div: "/",
+	planpb.ArithOpType_Mod: "%",
+}
+
+// exprFormatter prints a parsed expr back to the filter expression text. As the parser folds constants
+// and rewrites the expression while building the plan, the printed text is the normalized form of the
+// original expression, which is what the segments actually evaluate.
+type exprFormatter struct {
+	schema *typeutil.SchemaHelper
+}
+
+// FormatExpr formats the plan expr into filter expression text.
+func FormatExpr(schema *typeutil.SchemaHelper, expr *planpb.Expr) (string, error) {
+	f := &exprFormatter{schema: schema}
+	return f.format(expr)
+}
+
+func (f *exprFormatter) format(expr *planpb.Expr) (string, error) {
+	switch realExpr := expr.GetExpr().(type) {
+	case *planpb.Expr_TermExpr:
+		return f.formatTerm(realExpr.TermExpr)
+	case *planpb.Expr_UnaryExpr:
+		child, err := f.format(realExpr.UnaryExpr.GetChild())
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("not (%s)", child), nil
+	case *planpb.Expr_BinaryExpr:
+		return f.formatBinary(realExpr.BinaryExpr)
+	case *planpb.Expr_CompareExpr:
+		return f.formatCompare(realExpr.CompareExpr)
+	case *planpb.Expr_UnaryRangeExpr:
+		return f.formatUnaryRange(realExpr.UnaryRangeExpr)
+	case *planpb.Expr_BinaryRangeExpr:
+		return f.formatBinaryRange(realExpr.BinaryRangeExpr)
+	case *planpb.Expr_BinaryArithOpEvalRangeExpr:
+		return f.formatBinaryArithOpEvalRange(realExpr.BinaryArithOpEvalRangeExpr)
+	case *planpb.Expr_BinaryArithExpr:
+		return f.formatBinaryArith(realExpr.BinaryArithExpr)
+	case *planpb.Expr_ValueExpr:
+		if realExpr.ValueExpr.GetValue() == nil && realExpr.ValueExpr.GetTemplateVariableName() != "" {
+			return "{" + realExpr.ValueExpr.GetTemplateVariableName() + "}", nil
+		}
+		return formatGenericValue(realExpr.ValueExpr.GetValue())
+	case *planpb.Expr_ColumnExpr:
+		return f.formatColumn(realExpr.ColumnExpr.GetInfo())
+	case *planpb.Expr_ExistsExpr:
+		column, err := f.formatColumn(realExpr.ExistsExpr.GetInfo())
+		if err != nil {
+			return "", err
+		}
+		return "exists " + column, nil
+	case *planpb.Expr_AlwaysTrueExpr:
+		return "true", nil
+	case *planpb.Expr_JsonContainsExpr:
+		return f.formatJSONContains(realExpr.JsonContainsExpr)
+	case *planpb.Expr_CallExpr:
+		return f.formatCall(realExpr.CallExpr)
+	case *planpb.Expr_NullExpr:
+		return f.formatNull(realExpr.NullExpr)
+	case *planpb.Expr_RandomSampleExpr:
+		sample := fmt.Sprintf("random_sample(%s)", formatFloat(float64(realExpr.RandomSampleExpr.GetSampleFactor())))
+		if realExpr.RandomSampleExpr.GetPredicate() == nil {
+			return sample, nil
+		}
+		predicate, err := f.format(realExpr.RandomSampleExpr.GetPredicate())
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(%s) and %s", predicate, sample), nil
+	default:
+		return "", fmt.Errorf("unsupported expr to format: %T", expr.GetExpr())
+	}
+}
+
+func (f *exprFormatter) formatColumn(info *planpb.ColumnInfo) (string, error) {
+	field, err := f.schema.GetFieldFromID(info.GetFieldId())
+	if err != nil {
+		return "", err
+	}
+	path := info.GetNestedPath()
+	var b strings.Builder
+	if field.GetIsDynamic() && len(path) > 0 && identifierPattern.MatchString(path[0]) {
+		// keys of the dynamic field are referred to directly
+		b.WriteString(path[0])
+		path = path[1:]
+	} else {
+		b.WriteString(field.GetName())
+	}
+	for _, key := range path {
+		if _, err := strconv.ParseInt(key, 10, 64); err == nil {
+			b.WriteString("[" + key + "]")
+		} else {
+			b.WriteString("[" + strconv.Quote(key) + "]")
+		}
+	}
+	return b.String(), nil
+}
+
+func (f *exprFormatter) formatTerm(expr *planpb.TermExpr) (string, error) {
+	column, err := f.formatColumn(expr.GetColumnInfo())
+	if err != nil {
+		return "", err
+	}
+	if len(expr.GetValues()) == 0 && expr.GetTemplateVariableName() != "" {
+		return fmt.Sprintf("%s in {%s}", column, expr.GetTemplateVariableName()), nil
+	}
+	values, err := formatGenericValues(expr.GetValues())
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s in [%s]", column, values), nil
+}
+
+func (f *exprFormatter) formatBinary(expr *planpb.BinaryExpr) (string, error) {
+	var op string
+	switch expr.GetOp() {
+	case planpb.BinaryExpr_LogicalAnd:
+		op = "and"
+	case planpb.BinaryExpr_LogicalOr:
+		op = "or"
+	default:
+		return "", fmt.Errorf("unsupported binary op to format: %s", expr.GetOp().String())
+	}
+	operand := func(child *planpb.Expr) (string, error) {
+		s, err := f.format(child)
+		if err != nil {
+			return "", err
+		}
+		// keep the children of a different logical op grouped
+		if child.GetBinaryExpr() != nil && child.GetBinaryExpr().GetOp() != expr.GetOp() {
+			return "(" + s + ")", nil
+		}
+		return s, nil
+	}
+	left, err := operand(expr.GetLeft())
+	if err != nil {
+		return "", err
+	}
+	right, err := operand(expr.GetRight())
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s %s", left, op, right), nil
+}
+
+func (f *exprFormatter) formatCompare(expr *planpb.CompareExpr) (string, error) {
+	op, ok := compareOperators[expr.GetOp()]
+	if !ok {
+		return "", fmt.Errorf("unsupported compare op to format: %s", expr.GetOp().String())
+	}
+	left, err := f.formatColumn(expr.GetLeftColumnInfo())
+	if err != nil {
+		return "", err
+	}
+	right, err := f.formatColumn(expr.GetRightColumnInfo())
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s %s", left, op, right), nil
+}
+
+func (f *exprFormatter) formatUnaryRange(expr *planpb.UnaryRangeExpr) (string, error) {
+	column, err := f.formatColumn(expr.GetColumnInfo())
+	if err != nil {
+		return "", err
+	}
+	var value string
+	if expr.GetValue() == nil && expr.GetTemplateVariableName() != "" {
+		value = "{" + expr.GetTemplateVariableName() + "}"
+	} else if value, err = formatGenericValue(expr.GetValue()); err != nil {
+		return "", err
+	}
+	if op, ok := compareOperators[expr.GetOp()]; ok {
+		return fmt.Sprintf("%s %s %s", column, op, value), nil
+	}
+
+	str := escapeLikeWildcards(expr.GetValue().GetStringVal())
+	switch expr.GetOp() {
+	case planpb.OpType_PrefixMatch:
+		return fmt.Sprintf("%s like %s", column, strconv.Quote(str+"%")), nil
+	case planpb.OpType_PostfixMatch:
+		return fmt.Sprintf("%s like %s", column, strconv.Quote("%"+str)), nil
+	case planpb.OpType_InnerMatch:
+		return fmt.Sprintf("%s like %s", column, strconv.Quote("%"+str+"%")), nil
+	case planpb.OpType_Match:
+		return fmt.Sprintf("%s like %s", column, value), nil
+	case planpb.OpType_TextMatch:
+		return fmt.Sprintf("text_match(%s, %s)", column, value), nil
+	case planpb.OpType_PhraseMatch:
+		if len(expr.GetExtraValues()) == 0 {
+			return fmt.Sprintf("phrase_match(%s, %s)", column, value), nil
+		}
+		slop, err := formatGenericValue(expr.GetExtraValues()[0])
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("phrase_match(%s, %s, %s)", column, value, slop), nil
+	default:
+		return "", fmt.Errorf("unsupported unary range op to format: %s", expr.GetOp().String())
+	}
+}
+
+func (f *exprFormatter) formatBinaryRange(expr *planpb.BinaryRangeExpr) (string, error) {
+	column, err := f.formatColumn(expr.GetColumnInfo())
+	if err != nil {
+		return "", err
+	}
+	lower, err := formatGenericValue(expr.GetLowerValue())
+	if err != nil {
+		return "", err
+	}
+	upper, err := formatGenericValue(expr.GetUpperValue())
+	if err != nil {
+		return "", err
+	}
+	lowerOp, upperOp := "<", "<"
+	if expr.GetLowerInclusive() {
+		lowerOp = "<="
+	}
+	if expr.GetUpperInclusive() {
+		upperOp = "<="
+	}
+	return fmt.Sprintf("%s %s %s %s %s", lower, lowerOp, column, upperOp, upper), nil
+}
+
+func (f *exprFormatter) formatBinaryArithOpEvalRange(expr *planpb.BinaryArithOpEvalRangeExpr) (string, error) {
+	op, ok := compareOperators[expr.GetOp()]
+	if !ok {
+		return "", fmt.Errorf("unsupported compare op to format: %s", expr.GetOp().String())
+	}
+	column, err := f.formatColumn(expr.GetColumnInfo())
+	if err != nil {
+		return "", err
+	}
+	value, err := formatGenericValue(expr.GetValue())
+	if err != nil {
+		return "", err
+	}
+	if expr.GetArithOp() == planpb.ArithOpType_ArrayLength {
+		return fmt.Sprintf("array_length(%s) %s %s", column, op, value), nil
+	}
+	arithOp, ok := arithOperators[expr.GetArithOp()]
+	if !ok {
+		return "", fmt.Errorf("unsupported arith op to format: %s", expr.GetArithOp().String())
+	}
+	operand, err := formatGenericValue(expr.GetRightOperand())
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s %s %s %s", column, arithOp, operand, op, value), nil
+}
+
+func (f *exprFormatter) formatBinaryArith(expr *planpb.BinaryArithExpr) (string, error) {
+	op, ok := arithOperators[expr.GetOp()]
+	if !ok {
+		return "", fmt.Errorf("unsupported arith op to format: %s", expr.GetOp().String())
+	}
+	left, err := f.format(expr.GetLeft())
+	if err != nil {
+		return "", err
+	}
+	right, err := f.format(expr.GetRight())
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("(%s %s %s)", left, op, right), nil
+}
+
+func (f *exprFormatter) formatJSONContains(expr *planpb.JSONContainsExpr) (string, error) {
+	prefix := "json"
+	if expr.GetColumnInfo().GetDataType() == schemapb.DataType_Array {
+		prefix = "array"
+	}
+	column, err := f.formatColumn(expr.GetColumnInfo())
+	if err != nil {
+		return "", err
+	}
+	var elements string
+	if len(expr.GetElements()) == 0 && expr.GetTemplateVariableName() != "" {
+		elements = "{" + expr.GetTemplateVariableName() + "}"
+	} else if expr.GetOp() == planpb.JSONContainsExpr_Contains && len(expr.GetElements()) == 1 {
+		if elements, err = formatGenericValue(expr.GetElements()[0]); err != nil {
+			return "", err
+		}
+	} else {
+		values, err := formatGenericValues(expr.GetElements())
+		if err != nil {
+			return "", err
+		}
+		elements = "[" + values + "]"
+	}
+	switch expr.GetOp() {
+	case planpb.JSONContainsExpr_Contains:
+		return fmt.Sprintf("%s_contains(%s, %s)", prefix, column, elements), nil
+	case planpb.JSONContainsExpr_ContainsAll:
+		return fmt.Sprintf("%s_contains_all(%s, %s)", prefix, column, elements), nil
+	case planpb.JSONContainsExpr_ContainsAny:
+		return fmt.Sprintf("%s_contains_any(%s, %s)", prefix, column, elements), nil
+	default:
+		return "", fmt.Errorf("unsupported json contains op to format: %s", expr.GetOp().String())
+	}
+}
+
+func (f *exprFormatter) formatCall(expr *planpb.CallExpr) (string, error) {
+	params := make([]string, 0, len(expr.GetFunctionParameters()))
+	for _, param := range expr.GetFunctionParameters() {
+		s, err := f.format(param)
+		if err != nil {
+			return "", err
+		}
+		params = append(params, s)
+	}
+	return fmt.Sprintf("%s(%s)", expr.GetFunctionName(), strings.Join(params, ", ")), nil
+}
+
+func (f *exprFormatter) formatNull(expr *planpb.NullExpr) (string, error) {
+	column, err := f.formatColumn(expr.GetColumnInfo())
+	if err != nil {
+		return "", err
+	}
+	switch expr.GetOp() {
+	case planpb.NullExpr_IsNull:
+		return column + " is null", nil
+	case planpb.NullExpr_IsNotNull:
+		return column + " is not null", nil
+	default:
+		return "", fmt.Errorf("unsupported null op to format: %s", expr.GetOp().String())
+	}
+}
+
+func formatGenericValue(value *planpb.GenericValue) (string, error) {
+	switch realValue := value.GetVal().(type) {
+	case *planpb.GenericValue_BoolVal:
+		return strconv.FormatBool(realValue.BoolVal), nil
+	case *planpb.GenericValue_Int64Val:
+		return strconv.FormatInt(realValue.Int64Val, 10), nil
+	case *planpb.GenericValue_FloatVal:
+		return formatFloat(realValue.FloatVal), nil
+	case *planpb.GenericValue_StringVal:
+		return strconv.Quote(realValue.StringVal), nil
+	case *planpb.GenericValue_ArrayVal:
+		values, err := formatGenericValues(realValue.ArrayVal.GetArray())
+		if err != nil {
+			return "", err
+		}
+		return "[" + values + "]", nil
+	default:
+		return "", fmt.Errorf("unsupported value to format: %T", value.GetVal())
+	}
+}
+
+func formatGenericValues(values []*planpb.GenericValue) (string, error) {
+	elements := make([]string, 0, len(values))
+	for _, value := range values {
+		s, err := formatGenericValue(value)
+		if err != nil {
+			return "", err
+		}
+		elements = append(elements, s)
+	}
+	return strings.Join(elements, ", "), nil
+}
+
+// formatFloat keeps the decimal point of integral floats, so that the value is still parsed as a float.
+func formatFloat(v float64) string {
+	s := strconv.FormatFloat(v, 'g', -1, 64)
+	if !math.IsInf(v, 0) && !math.IsNaN(v) && !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+	return s
+}
+
+func escapeLikeWildcards(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if _, ok := wildcards[s[i]]; ok {
+			b.WriteByte(escapeCharacter)
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,84 @@
+package planparserv2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func TestFormatExpr(t *testing.T) {
+	schema := newTestSchema(true)
+	enableMatch(schema)
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	require.NoError(t, err)
+
+	exprStrs := []string{
+		`Int64Field > 10`,
+		`Int64Field in [1, 2, 3]`,
+		`Int64Field not in [1, 2, 3]`,
+		`1 < Int64Field <= 10`,
+		`DoubleField >= 1.5 && Int8Field != 3`,
+		`(Int64Field == 1 || Int64Field == 2) and Int32Field < 0`,
+		`not (Int64Field == 1)`,
+		`Int64Field < Int32Field`,
+		`Int64Field + 1 == 10`,
+		`VarCharField like "prefix%"`,
+		`VarCharField like "%suffix"`,
+		`VarCharField like "%inner%"`,
+		`VarCharField like "a_b%"`,
+		`VarCharField like "100\\%%"`,
+		`VarCharField == "quoted \"value\""`,
+		`text_match(VarCharField, "query")`,
+		`phrase_match(VarCharField, "query words", 2)`,
+		`JSONField["A"]["B"] == 1`,
+		`JSONField["A"][0] > 1`,
+		`A > 1 and B["C"] == "D"`,
+		`exists JSONField["A"]`,
+		`json_contains(JSONField["A"], 1)`,
+		`json_contains_all(JSONField["A"], [1, 2])`,
+		`array_contains_any(ArrayField, [1, 2])`,
+		`array_length(ArrayField) == 3`,
+		`ArrayField[0] == 1`,
+		`VarCharField is null`,
+		`VarCharField is not null`,
+		`starts_with(VarCharField, "a")`,
+		`regex_match(VarCharField, "^a+$")`,
+	}
+	for _, exprStr := range exprStrs {
+		expr, err := ParseExpr(helper, exprStr, nil)
+		require.NoError(t, err, exprStr)
+		formatted, err := FormatExpr(helper, expr)
+		require.NoError(t, err, exprStr)
+		reparsed, err := ParseExpr(helper, formatted, nil)
+		require.NoError(t, err, formatted)
+		assert.True(t, CheckPredicatesIdentical(expr, reparsed), "%s => %s", exprStr, formatted)
+	}
+
+	// constants are folded by the parser
+	expr, err := ParseExpr(helper, `Int64Field > 1 + 2 * 3 and DoubleField < 2 ** 3`, nil)
+	require.NoError(t, err)
+	formatted, err := FormatExpr(helper, expr)
+	require.NoError(t, err)
+	assert.Equal(t, `Int64Field > 7 and DoubleField < 8.0`, formatted)
+
+	_, err = FormatExpr(helper, &planpb.Expr{
+		Expr: &planpb.Expr_ColumnExpr{ColumnExpr: &planpb.ColumnExpr{Info: &planpb.ColumnInfo{FieldId: 10000}}},
+	})
+	assert.Error(t, err)
+	_, err = FormatExpr(helper, &planpb.Expr{})
+	assert.Error(t, err)
+}
+
+func TestShowExprJSON(t *testing.T) {
+	helper := newTestSchemaHelper(t)
+	expr, err := ParseExpr(helper, `Int64Field > 10 and json_contains(JSONField["A"], 1)`, nil)
+	require.NoError(t, err)
+	js, err := ShowExprJSON(expr)
+	assert.NoError(t, err)
+	assert.Contains(t, js, `"expr_type":"LogicalAnd"`)
+	assert.Contains(t, js, `"expr_type":"json_contains"`)
+}
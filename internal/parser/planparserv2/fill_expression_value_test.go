@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
@@ -499,3 +500,36 @@ func (s *FillExpressionValueSuite) TestBinaryExpression() {
 		}
 	})
 }
+
+func (s *FillExpressionValueSuite) TestTemplateExprCache() {
+	schemaH := newTestSchemaHelper(s.T())
+	exprStr := `Int64Field > {min} and VarCharField in {names}`
+	parse := func(min int64, names ...string) *planpb.Expr {
+		expr, err := ParseExpr(schemaH, exprStr, map[string]*schemapb.TemplateValue{
+			"min":   generateTemplateValue(schemapb.DataType_Int64, min),
+			"names": generateTemplateValue(schemapb.DataType_Array, generateTemplateArrayValue(schemapb.DataType_VarChar, names)),
+		})
+		s.Require().NoError(err)
+		return expr
+	}
+
+	first := parse(1, "a")
+	s.True(templateExprCache.Contains(templateExprKey{schema: schemaH, exprStr: exprStr}))
+	second := parse(2, "b", "c")
+	expected, err := ParseExpr(schemaH, `Int64Field > 2 and VarCharField in ["b", "c"]`, nil)
+	s.NoError(err)
+	s.True(CheckPredicatesIdentical(expected, second))
+	// the expr returned before is not affected by filling the cached template again
+	expected, err = ParseExpr(schemaH, `Int64Field > 1 and VarCharField in ["a"]`, nil)
+	s.NoError(err)
+	s.True(CheckPredicatesIdentical(expected, first))
+
+	// values are still required for cached templates
+	_, err = ParseExpr(schemaH, exprStr, nil)
+	s.Error(err)
+
+	// expressions without templates are not cached
+	_, err = ParseExpr(schemaH, `Int64Field > 1`, nil)
+	s.NoError(err)
+	s.False(templateExprCache.Contains(templateExprKey{schema: schemaH, exprStr: `Int64Field > 1`}))
+}
@@ -0,0 +1,495 @@
+package planparserv2
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+const (
+	emptyFunction      = "empty"
+	startsWithFunction = "starts_with"
+	endsWithFunction   = "ends_with"
+	regexMatchFunction = "regex_match"
+	lowerFunction      = "lower"
+	upperFunction      = "upper"
+	lengthFunction     = "length"
+	datetimeFunction   = "datetime"
+
+	// maxComparedLength is the max length that can be compared with the length of strings,
+	// which is the max length of VarChar fields.
+	maxComparedLength = 65535
+)
+
+type builtinFunction struct {
+	minArgs int
+	maxArgs int
+	handle  func(name string, args []*ExprWithType) (*ExprWithType, error)
+}
+
+// builtinFunctions are the functions which can be called in the filter expressions.
+// lower, upper and length produce values, they can only be used as the left operand of the comparisons.
+// The comparisons on lower and upper are executed as the case-insensitive regex_match on VarChar fields.
+// The comparisons on length are executed by the length function of segcore which counts the UTF-8 characters,
+// it also accepts the JSON fields and matches nothing on the values which are not strings.
+var builtinFunctions = map[string]builtinFunction{
+	emptyFunction:      {minArgs: 1, maxArgs: 1, handle: handleEmpty},
+	startsWithFunction: {minArgs: 2, maxArgs: 2, handle: handleStringMatch},
+	endsWithFunction:   {minArgs: 2, maxArgs: 2, handle: handleStringMatch},
+	regexMatchFunction: {minArgs: 2, maxArgs: 2, handle: handleRegexMatch},
+	lowerFunction:      {minArgs: 1, maxArgs: 1, handle: handleCaseConversion},
+	upperFunction:      {minArgs: 1, maxArgs: 1, handle: handleCaseConversion},
+	lengthFunction:     {minArgs: 1, maxArgs: 1, handle: handleLength},
+	datetimeFunction:   {minArgs: 1, maxArgs: 2, handle: handleDatetime},
+}
+
+// datetimeLayouts are the accepted ISO-8601 layouts, datetimes without time zone are in UTC.
+var datetimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+	time.DateOnly,
+}
+
+func callFunction(name string, args []*ExprWithType) (*ExprWithType, error) {
+	function, ok := builtinFunctions[name]
+	if !ok {
+		return nil, fmt.Errorf("function %s is not supported", name)
+	}
+	if len(args) < function.minArgs || len(args) > function.maxArgs {
+		if function.minArgs == function.maxArgs {
+			return nil, fmt.Errorf("function %s expects %d arguments, got %d", name, function.minArgs, len(args))
+		}
+		return nil, fmt.Errorf("function %s expects %d to %d arguments, got %d", name, function.minArgs, function.maxArgs, len(args))
+	}
+	return function.handle(name, args)
+}
+
+func getStringColumnArg(name string, arg *ExprWithType, allowJSON bool) (*planpb.ColumnInfo, error) {
+	column := toColumnInfo(arg)
+	if column != nil {
+		if typeutil.IsStringType(column.GetDataType()) || (allowJSON && typeutil.IsJSONType(column.GetDataType())) {
+			return column, nil
+		}
+	}
+	if allowJSON {
+		return nil, fmt.Errorf("the first argument of %s must be a VarChar or JSON field, got %s", name, getDataType(arg))
+	}
+	if column != nil && typeutil.IsJSONType(column.GetDataType()) {
+		return nil, fmt.Errorf("%s is not supported on JSON fields", name)
+	}
+	return nil, fmt.Errorf("the first argument of %s must be a VarChar field, got %s", name, getDataType(arg))
+}
+
+func getStringLiteralArg(name string, arg *ExprWithType, pos int) (string, error) {
+	value := arg.expr.GetValueExpr()
+	if value == nil || isTemplateExpr(value) || !IsString(value.GetValue()) {
+		return "", fmt.Errorf("argument %d of %s must be a string literal", pos, name)
+	}
+	return value.GetValue().GetStringVal(), nil
+}
+
+func newCallExpr(name string, params ...*planpb.Expr) *planpb.Expr {
+	return &planpb.Expr{
+		Expr: &planpb.Expr_CallExpr{
+			CallExpr: &planpb.CallExpr{
+				FunctionName:       name,
+				FunctionParameters: params,
+			},
+		},
+	}
+}
+
+func newUnaryRangeExpr(column *planpb.ColumnInfo, op planpb.OpType, value *planpb.GenericValue) *planpb.Expr {
+	return &planpb.Expr{
+		Expr: &planpb.Expr_UnaryRangeExpr{
+			UnaryRangeExpr: &planpb.UnaryRangeExpr{
+				ColumnInfo: column,
+				Op:         op,
+				Value:      value,
+			},
+		},
+	}
+}
+
+func newOrExpr(left, right *planpb.Expr) *planpb.Expr {
+	return &planpb.Expr{
+		Expr: &planpb.Expr_BinaryExpr{
+			BinaryExpr: &planpb.BinaryExpr{
+				Left:  left,
+				Right: right,
+				Op:    planpb.BinaryExpr_LogicalOr,
+			},
+		},
+	}
+}
+
+func newNotExpr(child *planpb.Expr) *planpb.Expr {
+	return &planpb.Expr{
+		Expr: &planpb.Expr_UnaryExpr{
+			UnaryExpr: &planpb.UnaryExpr{
+				Op:    planpb.UnaryExpr_Not,
+				Child: child,
+			},
+		},
+	}
+}
+
+func newAndExpr(left, right *planpb.Expr) *planpb.Expr {
+	return &planpb.Expr{
+		Expr: &planpb.Expr_BinaryExpr{
+			BinaryExpr: &planpb.BinaryExpr{
+				Left:  left,
+				Right: right,
+				Op:    planpb.BinaryExpr_LogicalAnd,
+			},
+		},
+	}
+}
+
+// newStringMatchAllExpr matches all the non-null strings of the column.
+func newStringMatchAllExpr(column *planpb.ColumnInfo) *planpb.Expr {
+	return newUnaryRangeExpr(column, planpb.OpType_PrefixMatch, NewString(""))
+}
+
+func toBoolExpr(expr *planpb.Expr) *ExprWithType {
+	return &ExprWithType{
+		expr:     expr,
+		dataType: schemapb.DataType_Bool,
+	}
+}
+
+func handleEmpty(name string, args []*ExprWithType) (*ExprWithType, error) {
+	column, err := getStringColumnArg(name, args[0], true)
+	if err != nil {
+		return nil, err
+	}
+	if typeutil.IsJSONType(column.GetDataType()) {
+		return toBoolExpr(newUnaryRangeExpr(column, planpb.OpType_Equal, NewString(""))), nil
+	}
+	return toBoolExpr(newCallExpr(name, args[0].expr)), nil
+}
+
+// handleStringMatch translates starts_with and ends_with to the prefix and postfix match,
+// the match on two VarChar fields is executed by the functions of segcore.
+func handleStringMatch(name string, args []*ExprWithType) (*ExprWithType, error) {
+	column, err := getStringColumnArg(name, args[0], true)
+	if err != nil {
+		return nil, err
+	}
+	op := planpb.OpType_PrefixMatch
+	if name == endsWithFunction {
+		op = planpb.OpType_PostfixMatch
+	}
+
+	if other := toColumnInfo(args[1]); other != nil {
+		if !typeutil.IsStringType(column.GetDataType()) || !typeutil.IsStringType(other.GetDataType()) {
+			return nil, fmt.Errorf("%s between two fields is only supported on VarChar fields", name)
+		}
+		return toBoolExpr(newCallExpr(name, args[0].expr, args[1].expr)), nil
+	}
+
+	value := args[1].expr.GetValueExpr()
+	if isTemplateExpr(value) {
+		return &ExprWithType{
+			expr: &planpb.Expr{
+				Expr: &planpb.Expr_UnaryRangeExpr{
+					UnaryRangeExpr: &planpb.UnaryRangeExpr{
+						ColumnInfo:           column,
+						Op:                   op,
+						TemplateVariableName: value.GetTemplateVariableName(),
+					},
+				},
+				IsTemplate: true,
+			},
+			dataType: schemapb.DataType_Bool,
+		}, nil
+	}
+	operand, err := getStringLiteralArg(name, args[1], 2)
+	if err != nil {
+		return nil, err
+	}
+	return toBoolExpr(newUnaryRangeExpr(column, op, NewString(operand))), nil
+}
+
+// handleRegexMatch checks the pattern with the RE2 syntax which is also used by segcore.
+func handleRegexMatch(name string, args []*ExprWithType) (*ExprWithType, error) {
+	if _, err := getStringColumnArg(name, args[0], false); err != nil {
+		return nil, err
+	}
+	pattern, err := getStringLiteralArg(name, args[1], 2)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := regexp.Compile(pattern); err != nil {
+		return nil, fmt.Errorf("invalid regular expression %q of %s: %w", pattern, name, err)
+	}
+	return toBoolExpr(newCallExpr(name, args[0].expr, toValueExpr(NewString(pattern)).expr)), nil
+}
+
+func handleCaseConversion(name string, args []*ExprWithType) (*ExprWithType, error) {
+	if _, err := getStringColumnArg(name, args[0], false); err != nil {
+		return nil, err
+	}
+	return &ExprWithType{
+		expr:          newCallExpr(name, args[0].expr),
+		dataType:      schemapb.DataType_VarChar,
+		nodeDependent: true,
+	}, nil
+}
+
+func handleLength(name string, args []*ExprWithType) (*ExprWithType, error) {
+	if _, err := getStringColumnArg(name, args[0], true); err != nil {
+		return nil, err
+	}
+	return &ExprWithType{
+		expr:          newCallExpr(name, args[0].expr),
+		dataType:      schemapb.DataType_Int64,
+		nodeDependent: true,
+	}, nil
+}
+
+// handleDatetime converts the ISO-8601 datetime to the epoch in the given unit, seconds by default.
+func handleDatetime(name string, args []*ExprWithType) (*ExprWithType, error) {
+	datetime, err := getStringLiteralArg(name, args[0], 1)
+	if err != nil {
+		return nil, err
+	}
+	unit := "s"
+	if len(args) > 1 {
+		if unit, err = getStringLiteralArg(name, args[1], 2); err != nil {
+			return nil, err
+		}
+	}
+
+	var t time.Time
+	for _, layout := range datetimeLayouts {
+		if t, err = time.Parse(layout, datetime); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid ISO-8601 datetime of %s: %s", name, datetime)
+	}
+
+	var epoch int64
+	switch strings.ToLower(unit) {
+	case "s":
+		epoch = t.Unix()
+	case "ms":
+		epoch = t.UnixMilli()
+	case "us":
+		epoch = t.UnixMicro()
+	case "ns":
+		epoch = t.UnixNano()
+	default:
+		return nil, fmt.Errorf("invalid time unit of %s: %s, only s, ms, us and ns are supported", name, unit)
+	}
+	expr := toValueExpr(NewInt(epoch))
+	expr.datetime = true
+	return expr, nil
+}
+
+func isDatetime(node interface{}) bool {
+	expr := getExpr(node)
+	return expr != nil && expr.datetime
+}
+
+// checkDatetimeCompare checks the comparison between the epoch of datetime and the other operand.
+func checkDatetimeCompare(left, right interface{}) error {
+	if !isDatetime(left) && !isDatetime(right) {
+		return nil
+	}
+	other := getExpr(right)
+	if isDatetime(right) {
+		other = getExpr(left)
+	}
+	if other == nil || toColumnInfo(other) == nil {
+		return fmt.Errorf("%s can only be compared with Int64 fields", datetimeFunction)
+	}
+	return checkDatetimeColumn(toColumnInfo(other))
+}
+
+// checkDatetimeColumn checks that the epoch of datetime is compared with an Int64 field or Int64 array element,
+// the epoch cannot be casted to the other types.
+func checkDatetimeColumn(column *planpb.ColumnInfo) error {
+	dataType := column.GetDataType()
+	if typeutil.IsArrayType(dataType) && len(column.GetNestedPath()) != 0 {
+		dataType = column.GetElementType()
+	}
+	if dataType != schemapb.DataType_Int64 {
+		return fmt.Errorf("%s can only be compared with Int64 fields, got %s", datetimeFunction, dataType)
+	}
+	return nil
+}
+
+// isValueFunctionCall returns true if the expr is the call of lower, upper or length.
+func isValueFunctionCall(expr *ExprWithType) bool {
+	return expr != nil && expr.nodeDependent && expr.expr.GetCallExpr() != nil
+}
+
+// handleFunctionCompare translates the comparison between the value of function and a constant.
+func handleFunctionCompare(op planpb.OpType, left, right *ExprWithType) (*ExprWithType, error) {
+	if isValueFunctionCall(left) && isValueFunctionCall(right) {
+		return nil, errors.New("comparison between two function calls is not supported")
+	}
+	if isValueFunctionCall(right) {
+		reversed, err := reverseOrder(op)
+		if err != nil {
+			return nil, err
+		}
+		return handleFunctionCompare(reversed, right, left)
+	}
+
+	call := left.expr.GetCallExpr()
+	name := call.GetFunctionName()
+	column := call.GetFunctionParameters()[0].GetColumnExpr().GetInfo()
+	value := right.expr.GetValueExpr()
+	if value == nil || isTemplateExpr(value) {
+		return nil, fmt.Errorf("%s can only be compared with a constant", name)
+	}
+
+	switch name {
+	case lengthFunction:
+		if !IsInteger(value.GetValue()) {
+			return nil, fmt.Errorf("%s can only be compared with an integer, got %s", name, getDataType(right))
+		}
+		expr, err := lengthCompareExpr(column, op, value.GetValue().GetInt64Val())
+		if err != nil {
+			return nil, err
+		}
+		return toBoolExpr(expr), nil
+	default:
+		if op != planpb.OpType_Equal && op != planpb.OpType_NotEqual {
+			return nil, fmt.Errorf("only == and != are supported on %s", name)
+		}
+		if !IsString(value.GetValue()) {
+			return nil, fmt.Errorf("%s can only be compared with a string, got %s", name, getDataType(right))
+		}
+		literal := value.GetValue().GetStringVal()
+		expr := caseInsensitiveMatchExpr(name, column, literal, "^"+regexp.QuoteMeta(literal)+"$")
+		if op == planpb.OpType_NotEqual {
+			expr = newAndExpr(newStringMatchAllExpr(column), newNotExpr(expr))
+		}
+		return toBoolExpr(expr), nil
+	}
+}
+
+// handleFunctionLike translates the like operation on the value of lower or upper.
+func handleFunctionLike(left *ExprWithType, pattern string) (*ExprWithType, error) {
+	call := left.expr.GetCallExpr()
+	name := call.GetFunctionName()
+	if name != lowerFunction && name != upperFunction {
+		return nil, fmt.Errorf("like operation on %s is unsupported", name)
+	}
+	column := call.GetFunctionParameters()[0].GetColumnExpr().GetInfo()
+	return toBoolExpr(caseInsensitiveMatchExpr(name, column, pattern, likePatternToRegex(pattern))), nil
+}
+
+// caseInsensitiveMatchExpr matches the strings whose lower or upper case matches the regex,
+// nothing matches if the literal is not in the same case since the converted strings never contain it.
+func caseInsensitiveMatchExpr(name string, column *planpb.ColumnInfo, literal string, regex string) *planpb.Expr {
+	converted := strings.ToLower(literal)
+	if name == upperFunction {
+		converted = strings.ToUpper(literal)
+	}
+	if converted != literal {
+		return newNotExpr(alwaysTrueExpr())
+	}
+	return newCallExpr(regexMatchFunction, toColumnExpr(column).expr, toValueExpr(NewString("(?is)"+regex)).expr)
+}
+
+// likePatternToRegex translates the like pattern to the anchored regex,
+// `%` matches any sequence of characters and `_` matches a single character.
+func likePatternToRegex(pattern string) string {
+	var buf strings.Builder
+	buf.WriteString("^")
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			buf.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == rune(escapeCharacter):
+			escaped = true
+		case r == '%':
+			buf.WriteString(".*")
+		case r == '_':
+			buf.WriteString(".")
+		default:
+			buf.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	if escaped {
+		buf.WriteString(regexp.QuoteMeta(string(escapeCharacter)))
+	}
+	buf.WriteString("$")
+	return buf.String()
+}
+
+// lengthCompareExpr translates the comparison on the length of strings to the length function of segcore,
+// which checks whether the number of characters is in the range [min, max].
+// The ranges that no string can reach are dropped, nothing matches if none is left,
+// and the range covering all the lengths of a non-nullable VarChar field always matches.
+func lengthCompareExpr(column *planpb.ColumnInfo, op planpb.OpType, length int64) (*planpb.Expr, error) {
+	type lengthRange struct{ min, max int64 }
+	var ranges []lengthRange
+	switch op {
+	case planpb.OpType_Equal:
+		ranges = []lengthRange{{length, length}}
+	case planpb.OpType_NotEqual:
+		if length > 0 {
+			ranges = append(ranges, lengthRange{0, length - 1})
+		}
+		if length < math.MaxInt64 {
+			ranges = append(ranges, lengthRange{length + 1, math.MaxInt64})
+		}
+	case planpb.OpType_GreaterEqual:
+		ranges = []lengthRange{{length, math.MaxInt64}}
+	case planpb.OpType_GreaterThan:
+		if length < math.MaxInt64 {
+			ranges = []lengthRange{{length + 1, math.MaxInt64}}
+		}
+	case planpb.OpType_LessThan:
+		if length > 0 {
+			ranges = []lengthRange{{0, length - 1}}
+		}
+	case planpb.OpType_LessEqual:
+		ranges = []lengthRange{{0, length}}
+	default:
+		return nil, fmt.Errorf("unsupported op type: %s", op)
+	}
+
+	maxLength := int64(math.MaxInt64)
+	if typeutil.IsStringType(column.GetDataType()) {
+		maxLength = maxComparedLength
+	}
+	var expr *planpb.Expr
+	for _, r := range ranges {
+		r.min = max(r.min, 0)
+		if r.min > r.max || r.min > maxLength {
+			continue
+		}
+		if r.min == 0 && r.max >= maxLength && typeutil.IsStringType(column.GetDataType()) && !column.GetNullable() {
+			return alwaysTrueExpr(), nil
+		}
+		between := newCallExpr(lengthFunction, toColumnExpr(column).expr, toValueExpr(NewInt(r.min)).expr, toValueExpr(NewInt(r.max)).expr)
+		if expr == nil {
+			expr = between
+		} else {
+			expr = newOrExpr(expr, between)
+		}
+	}
+	if expr == nil {
+		return newNotExpr(alwaysTrueExpr()), nil
+	}
+	return expr, nil
+}
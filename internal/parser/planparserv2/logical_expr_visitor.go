@@ -14,4 +14,9 @@ type LogicalExprVisitor interface {
 	VisitBinaryArithExpr(expr *planpb.BinaryArithExpr) interface{}
 	VisitValueExpr(expr *planpb.ValueExpr) interface{}
 	VisitColumnExpr(expr *planpb.ColumnExpr) interface{}
+	VisitNullExpr(expr *planpb.NullExpr) interface{}
+	VisitExistsExpr(expr *planpb.ExistsExpr) interface{}
+	VisitAlwaysTrueExpr(expr *planpb.AlwaysTrueExpr) interface{}
+	VisitJSONContainsExpr(expr *planpb.JSONContainsExpr) interface{}
+	VisitRandomSampleExpr(expr *planpb.RandomSampleExpr) interface{}
 }
@@ -12,6 +12,9 @@ type ExprWithType struct {
 	// For example, a column expression or a value expression itself cannot be an expression node independently.
 	// Unless our execution backend can support them.
 	nodeDependent bool
+	// datetime is set on the epoch returned by the datetime function and the lists containing it,
+	// the epoch can only be compared with Int64 fields.
+	datetime bool
 }
 
 func getError(obj interface{}) error {
@@ -176,6 +176,10 @@ func (v *ParserVisitor) VisitAddSub(ctx *parser.AddSubContext) interface{} {
 		return err
 	}
 
+	if isDatetime(left) || isDatetime(right) {
+		return fmt.Errorf("arithmetic operations are not supported on %s", datetimeFunction)
+	}
+
 	leftValueExpr, rightValueExpr := getValueExpr(left), getValueExpr(right)
 	if leftValueExpr != nil && rightValueExpr != nil {
 		if isTemplateExpr(leftValueExpr) || isTemplateExpr(rightValueExpr) {
@@ -263,6 +267,10 @@ func (v *ParserVisitor) VisitMulDivMod(ctx *parser.MulDivModContext) interface{}
 		return err
 	}
 
+	if isDatetime(left) || isDatetime(right) {
+		return fmt.Errorf("arithmetic operations are not supported on %s", datetimeFunction)
+	}
+
 	leftValueExpr, rightValueExpr := getValueExpr(left), getValueExpr(right)
 	if leftValueExpr != nil && rightValueExpr != nil {
 		if isTemplateExpr(leftValueExpr) || isTemplateExpr(rightValueExpr) {
@@ -361,6 +369,18 @@ func (v *ParserVisitor) VisitEquality(ctx *parser.EqualityContext) interface{} {
 		return err
 	}
 
+	if err := checkDatetimeCompare(left, right); err != nil {
+		return err
+	}
+
+	if isValueFunctionCall(getExpr(left)) || isValueFunctionCall(getExpr(right)) {
+		expr, err := handleFunctionCompare(cmpOpMap[ctx.GetOp().GetTokenType()], getExpr(left), getExpr(right))
+		if err != nil {
+			return err
+		}
+		return expr
+	}
+
 	leftValueExpr, rightValueExpr := getValueExpr(left), getValueExpr(right)
 	if leftValueExpr != nil && rightValueExpr != nil {
 		if isTemplateExpr(leftValueExpr) || isTemplateExpr(rightValueExpr) {
@@ -406,6 +426,19 @@ func (v *ParserVisitor) VisitRelational(ctx *parser.RelationalContext) interface
 	if err := getError(right); err != nil {
 		return err
 	}
+
+	if err := checkDatetimeCompare(left, right); err != nil {
+		return err
+	}
+
+	if isValueFunctionCall(getExpr(left)) || isValueFunctionCall(getExpr(right)) {
+		expr, err := handleFunctionCompare(cmpOpMap[ctx.GetOp().GetTokenType()], getExpr(left), getExpr(right))
+		if err != nil {
+			return err
+		}
+		return expr
+	}
+
 	leftValueExpr, rightValueExpr := getValueExpr(left), getValueExpr(right)
 	if leftValueExpr != nil && rightValueExpr != nil {
 		if isTemplateExpr(leftValueExpr) || isTemplateExpr(rightValueExpr) {
@@ -462,6 +495,18 @@ func (v *ParserVisitor) VisitLike(ctx *parser.LikeContext) interface{} {
 		return errors.New("the left operand of like is invalid")
 	}
 
+	if isValueFunctionCall(leftExpr) {
+		pattern, err := convertEscapeSingle(ctx.StringLiteral().GetText())
+		if err != nil {
+			return err
+		}
+		expr, err := handleFunctionLike(leftExpr, pattern)
+		if err != nil {
+			return err
+		}
+		return expr
+	}
+
 	column := toColumnInfo(leftExpr)
 	if column == nil {
 		return errors.New("like operation on complicated expr is unsupported")
@@ -641,6 +686,11 @@ func (v *ParserVisitor) VisitTerm(ctx *parser.TermContext) interface{} {
 	if getError(term) != nil {
 		return term
 	}
+	if isDatetime(term) {
+		if err := checkDatetimeColumn(columnInfo); err != nil {
+			return err
+		}
+	}
 
 	valueExpr := getValueExpr(term)
 	var placeholder string
@@ -709,29 +759,26 @@ func (v *ParserVisitor) getChildColumnInfo(identifier, child antlr.TerminalNode)
 	return v.getColumnInfoFromJSONIdentifier(child.GetText())
 }
 
-// VisitCall parses the expr to call plan.
+// VisitCall parses the call of builtin functions.
 func (v *ParserVisitor) VisitCall(ctx *parser.CallContext) interface{} {
 	functionName := strings.ToLower(ctx.Identifier().GetText())
-	numParams := len(ctx.AllExpr())
-	funcParameters := make([]*planpb.Expr, 0, numParams)
+	args := make([]*ExprWithType, 0, len(ctx.AllExpr()))
 	for _, param := range ctx.AllExpr() {
-		paramExpr := param.Accept(v)
-		if err := getError(paramExpr); err != nil {
+		arg := param.Accept(v)
+		if err := getError(arg); err != nil {
 			return err
 		}
-		funcParameters = append(funcParameters, getExpr(param.Accept(v)).expr)
+		argExpr := getExpr(arg)
+		if argExpr == nil {
+			return fmt.Errorf("invalid argument of function %s: %s", functionName, param.GetText())
+		}
+		args = append(args, argExpr)
 	}
-	return &ExprWithType{
-		expr: &planpb.Expr{
-			Expr: &planpb.Expr_CallExpr{
-				CallExpr: &planpb.CallExpr{
-					FunctionName:       functionName,
-					FunctionParameters: funcParameters,
-				},
-			},
-		},
-		dataType: schemapb.DataType_Bool,
+	expr, err := callFunction(functionName, args)
+	if err != nil {
+		return err
 	}
+	return expr
 }
 
 // VisitRange translates expr to range plan.
@@ -755,6 +802,11 @@ func (v *ParserVisitor) VisitRange(ctx *parser.RangeContext) interface{} {
 	if err := getError(upper); err != nil {
 		return err
 	}
+	if isDatetime(lower) || isDatetime(upper) {
+		if err := checkDatetimeColumn(columnInfo); err != nil {
+			return err
+		}
+	}
 
 	lowerValueExpr, upperValueExpr := getValueExpr(lower), getValueExpr(upper)
 	if lowerValueExpr == nil {
@@ -837,6 +889,11 @@ func (v *ParserVisitor) VisitReverseRange(ctx *parser.ReverseRangeContext) inter
 	if err := getError(upper); err != nil {
 		return err
 	}
+	if isDatetime(lower) || isDatetime(upper) {
+		if err := checkDatetimeColumn(columnInfo); err != nil {
+			return err
+		}
+	}
 
 	lowerValueExpr, upperValueExpr := getValueExpr(lower), getValueExpr(upper)
 	if lowerValueExpr == nil {
@@ -910,6 +967,9 @@ func (v *ParserVisitor) VisitUnary(ctx *parser.UnaryContext) interface{} {
 		case parser.PlanParserADD:
 			return child
 		case parser.PlanParserSUB:
+			if isDatetime(child) {
+				return fmt.Errorf("arithmetic operations are not supported on %s", datetimeFunction)
+			}
 			return Negative(childValue)
 		case parser.PlanParserNOT:
 			n, err := Not(childValue)
@@ -1252,11 +1312,13 @@ func (v *ParserVisitor) VisitArray(ctx *parser.ArrayContext) interface{} {
 	array := make([]*planpb.GenericValue, len(allExpr))
 	dType := schemapb.DataType_None
 	sameType := true
+	datetime := false
 	for i := 0; i < len(allExpr); i++ {
 		element := allExpr[i].Accept(v)
 		if err := getError(element); err != nil {
 			return err
 		}
+		datetime = datetime || isDatetime(element)
 		elementValue := getGenericValue(element)
 		if elementValue == nil {
 			return fmt.Errorf("array element type must be generic value, but got: %s", allExpr[i].GetText())
@@ -1291,6 +1353,7 @@ func (v *ParserVisitor) VisitArray(ctx *parser.ArrayContext) interface{} {
 			},
 		},
 		nodeDependent: true,
+		datetime:      datetime,
 	}
 }
 
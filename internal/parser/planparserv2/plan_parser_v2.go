@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/golang-lru/v2/expirable"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	planparserv2 "github.com/milvus-io/milvus/internal/parser/planparserv2/generated"
@@ -17,13 +18,23 @@ import (
 )
 
 var (
-	exprCache   = expirable.NewLRU[string, any](1024, nil, time.Minute*10)
-	trueLiteral = &ExprWithType{
+	exprCache = expirable.NewLRU[string, any](1024, nil, time.Minute*10)
+	// templateExprCache caches the predicates of expression templates before their values are filled,
+	// so that hot templates skip both parsing and visiting.
+	templateExprCache = expirable.NewLRU[templateExprKey, *planpb.Expr](1024, nil, time.Minute*10)
+	trueLiteral       = &ExprWithType{
 		dataType: schemapb.DataType_Bool,
 		expr:     alwaysTrueExpr(),
 	}
 )
 
+// templateExprKey identifies an expression template, the schema helper is renewed by the meta cache
+// whenever the schema changes, so it is part of the key.
+type templateExprKey struct {
+	schema  *typeutil.SchemaHelper
+	exprStr string
+}
+
 func handleInternal(exprStr string) (ast planparserv2.IExprContext, err error) {
 	val, ok := exprCache.Get(exprStr)
 	if ok {
@@ -96,6 +107,11 @@ func handleExpr(schema *typeutil.SchemaHelper, exprStr string) (result interface
 }
 
 func ParseExpr(schema *typeutil.SchemaHelper, exprStr string, exprTemplateValues map[string]*schemapb.TemplateValue) (*planpb.Expr, error) {
+	key := templateExprKey{schema: schema, exprStr: exprStr}
+	if cached, ok := templateExprCache.Get(key); ok {
+		return fillTemplateExpr(proto.Clone(cached).(*planpb.Expr), exprTemplateValues)
+	}
+
 	ret := handleExpr(schema, exprStr)
 
 	if err := getError(ret); err != nil {
@@ -110,16 +126,24 @@ func ParseExpr(schema *typeutil.SchemaHelper, exprStr string, exprTemplateValues
 		return nil, fmt.Errorf("predicate is not a boolean expression: %s, data type: %s", exprStr, predicate.dataType)
 	}
 
+	if predicate.expr.GetIsTemplate() {
+		// filling values modifies the expr in place, keep an unfilled copy for the following requests
+		templateExprCache.Add(key, proto.Clone(predicate.expr).(*planpb.Expr))
+	}
+	return fillTemplateExpr(predicate.expr, exprTemplateValues)
+}
+
+func fillTemplateExpr(expr *planpb.Expr, exprTemplateValues map[string]*schemapb.TemplateValue) (*planpb.Expr, error) {
 	valueMap, err := UnmarshalExpressionValues(exprTemplateValues)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := FillExpressionValue(predicate.expr, valueMap); err != nil {
+	if err := FillExpressionValue(expr, valueMap); err != nil {
 		return nil, err
 	}
 
-	return predicate.expr, nil
+	return expr, nil
 }
 
 func ParseIdentifier(schema *typeutil.SchemaHelper, identifier string, checkFunc func(*planpb.Expr) error) error {
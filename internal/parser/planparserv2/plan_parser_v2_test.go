@@ -2,6 +2,7 @@ package planparserv2
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 	"strings"
 	"sync"
@@ -130,15 +131,13 @@ func TestExpr_Call(t *testing.T) {
 		FunctionName string
 		ParameterNum int
 	}{
-		{`hello123()`, "hello123", 0},
-		{`lt(Int32Field)`, "lt", 1},
+		{`empty(VarCharField)`, "empty", 1},
 		// test parens
-		{`lt((((Int32Field))))`, "lt", 1},
-		{`empty(VarCharField,)`, "empty", 1},
-		{`f2(Int64Field)`, "f2", 1},
-		{`f2(Int64Field, 4)`, "f2", 2},
-		{`f3(JSON_FIELD["A"], Int32Field)`, "f3", 2},
-		{`f5(3+3, Int32Field)`, "f5", 2},
+		{`empty((((VarCharField))),)`, "empty", 1},
+		{`EMPTY(StringField)`, "empty", 1},
+		{`starts_with(VarCharField, StringField)`, "starts_with", 2},
+		{`ends_with(VarCharField, StringField)`, "ends_with", 2},
+		{`regex_match(VarCharField, "^a.*b$")`, "regex_match", 2},
 	}
 	for _, testcase := range testcases {
 		expr, err := ParseExpr(helper, testcase.CallExpr, nil)
@@ -148,17 +147,220 @@ func TestExpr_Call(t *testing.T) {
 		ShowExpr(expr)
 	}
 
-	expr, err := ParseExpr(helper, "xxx(1+1, !true, f(10+10))", nil)
+	invalidExprs := []string{
+		`hello123()`,
+		`xxx(1+1, !true, f(10+10))`,
+		`ceil(pow(1.5*Int32Field,0.58))`,
+		`empty()`,
+		`empty(Int32Field)`,
+		`empty(VarCharField, VarCharField)`,
+		`starts_with(Int64Field, "a")`,
+		`starts_with(VarCharField, 1)`,
+		`starts_with(JSONField["A"], VarCharField)`,
+		`regex_match(JSONField["A"], "a")`,
+		`regex_match(VarCharField, VarCharField)`,
+		`regex_match(VarCharField, "a(b")`,
+		`lower(VarCharField)`,
+		`length(VarCharField)`,
+		`not length(VarCharField)`,
+		`datetime("2024-01-01")`,
+	}
+	for _, exprStr := range invalidExprs {
+		assertInvalidExpr(t, helper, exprStr)
+	}
+}
+
+func TestExpr_StringFunctions(t *testing.T) {
+	schema := newTestSchema(true)
+	helper, err := typeutil.CreateSchemaHelper(schema)
 	assert.NoError(t, err)
-	assert.Equal(t, "xxx", expr.GetCallExpr().FunctionName)
-	assert.Equal(t, 3, len(expr.GetCallExpr().FunctionParameters))
-	assert.Equal(t, int64(2), expr.GetCallExpr().GetFunctionParameters()[0].GetValueExpr().GetValue().GetInt64Val())
-	assert.Equal(t, false, expr.GetCallExpr().GetFunctionParameters()[1].GetValueExpr().GetValue().GetBoolVal())
-	assert.Equal(t, int64(20), expr.GetCallExpr().GetFunctionParameters()[2].GetCallExpr().GetFunctionParameters()[0].GetValueExpr().GetValue().GetInt64Val())
-
-	expr, err = ParseExpr(helper, "ceil(pow(1.5*Int32Field,0.58))", nil)
-	assert.Error(t, err)
-	assert.Nil(t, expr)
+
+	t.Run("starts_with and ends_with", func(t *testing.T) {
+		expr, err := ParseExpr(helper, `starts_with(VarCharField, "ab%")`, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, planpb.OpType_PrefixMatch, expr.GetUnaryRangeExpr().GetOp())
+		assert.Equal(t, "ab%", expr.GetUnaryRangeExpr().GetValue().GetStringVal())
+
+		expr, err = ParseExpr(helper, `ends_with(JSONField["A"], "ab")`, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, planpb.OpType_PostfixMatch, expr.GetUnaryRangeExpr().GetOp())
+		assert.Equal(t, []string{"A"}, expr.GetUnaryRangeExpr().GetColumnInfo().GetNestedPath())
+
+		expr, err = ParseExpr(helper, `starts_with(VarCharField, {prefix})`, map[string]*schemapb.TemplateValue{
+			"prefix": generateTemplateValue(schemapb.DataType_VarChar, "ab"),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, planpb.OpType_PrefixMatch, expr.GetUnaryRangeExpr().GetOp())
+		assert.Equal(t, "ab", expr.GetUnaryRangeExpr().GetValue().GetStringVal())
+
+		expr, err = ParseExpr(helper, `empty(JSONField["A"])`, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, planpb.OpType_Equal, expr.GetUnaryRangeExpr().GetOp())
+	})
+
+	t.Run("lower and upper", func(t *testing.T) {
+		expr, err := ParseExpr(helper, `lower(VarCharField) == "a.b"`, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "regex_match", expr.GetCallExpr().GetFunctionName())
+		assert.Equal(t, `(?is)^a\.b$`, expr.GetCallExpr().GetFunctionParameters()[1].GetValueExpr().GetValue().GetStringVal())
+
+		expr, err = ParseExpr(helper, `"ABC" != upper(VarCharField)`, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, planpb.BinaryExpr_LogicalAnd, expr.GetBinaryExpr().GetOp())
+		assert.Equal(t, "regex_match", expr.GetBinaryExpr().GetRight().GetUnaryExpr().GetChild().GetCallExpr().GetFunctionName())
+
+		expr, err = ParseExpr(helper, `lower(VarCharField) like "a\\%_%"`, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, `(?is)^a%..*$`, expr.GetCallExpr().GetFunctionParameters()[1].GetValueExpr().GetValue().GetStringVal())
+
+		// the lower case of strings never contains upper case letters
+		expr, err = ParseExpr(helper, `lower(VarCharField) == "Abc"`, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, expr.GetUnaryExpr().GetChild().GetAlwaysTrueExpr())
+
+		invalidExprs := []string{
+			`lower(JSONField["A"]) == "a"`,
+			`lower(VarCharField) > "a"`,
+			`lower(VarCharField) == 1`,
+			`lower(VarCharField) == upper(VarCharField)`,
+			`lower(VarCharField) == VarCharField`,
+			`lower(VarCharField) == {a}`,
+			`lower(VarCharField, VarCharField) == "a"`,
+		}
+		for _, exprStr := range invalidExprs {
+			assertInvalidExpr(t, helper, exprStr)
+		}
+	})
+
+	t.Run("length", func(t *testing.T) {
+		expr, err := ParseExpr(helper, `length(VarCharField) == 3`, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "length", expr.GetCallExpr().GetFunctionName())
+		params := expr.GetCallExpr().GetFunctionParameters()
+		assert.Equal(t, int64(3), params[1].GetValueExpr().GetValue().GetInt64Val())
+		assert.Equal(t, int64(3), params[2].GetValueExpr().GetValue().GetInt64Val())
+
+		expr, err = ParseExpr(helper, `2 >= length(VarCharField)`, nil)
+		assert.NoError(t, err)
+		params = expr.GetCallExpr().GetFunctionParameters()
+		assert.Equal(t, int64(0), params[1].GetValueExpr().GetValue().GetInt64Val())
+		assert.Equal(t, int64(2), params[2].GetValueExpr().GetValue().GetInt64Val())
+
+		expr, err = ParseExpr(helper, `length(VarCharField) != 0`, nil)
+		assert.NoError(t, err)
+		params = expr.GetCallExpr().GetFunctionParameters()
+		assert.Equal(t, int64(1), params[1].GetValueExpr().GetValue().GetInt64Val())
+		assert.Equal(t, int64(math.MaxInt64), params[2].GetValueExpr().GetValue().GetInt64Val())
+
+		expr, err = ParseExpr(helper, `length(VarCharField) != 3`, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, planpb.BinaryExpr_LogicalOr, expr.GetBinaryExpr().GetOp())
+		params = expr.GetBinaryExpr().GetLeft().GetCallExpr().GetFunctionParameters()
+		assert.Equal(t, int64(0), params[1].GetValueExpr().GetValue().GetInt64Val())
+		assert.Equal(t, int64(2), params[2].GetValueExpr().GetValue().GetInt64Val())
+		params = expr.GetBinaryExpr().GetRight().GetCallExpr().GetFunctionParameters()
+		assert.Equal(t, int64(4), params[1].GetValueExpr().GetValue().GetInt64Val())
+
+		expr, err = ParseExpr(helper, `length(JSONField["A"]) > 2`, nil)
+		assert.NoError(t, err)
+		params = expr.GetCallExpr().GetFunctionParameters()
+		assert.Equal(t, []string{"A"}, params[0].GetColumnExpr().GetInfo().GetNestedPath())
+		assert.Equal(t, int64(3), params[1].GetValueExpr().GetValue().GetInt64Val())
+		assert.Equal(t, int64(math.MaxInt64), params[2].GetValueExpr().GetValue().GetInt64Val())
+
+		for _, exprStr := range []string{
+			`length(VarCharField) > -1`,
+			`length(VarCharField) >= 0`,
+			`length(VarCharField) < 70000`,
+			`length(VarCharField) != -1`,
+		} {
+			expr, err = ParseExpr(helper, exprStr, nil)
+			assert.NoError(t, err, exprStr)
+			assert.NotNil(t, expr.GetAlwaysTrueExpr(), exprStr)
+		}
+		for _, exprStr := range []string{
+			`length(VarCharField) < 0`,
+			`length(VarCharField) == -1`,
+			`length(VarCharField) >= 70000`,
+			`length(VarCharField) > 9223372036854775807`,
+		} {
+			expr, err = ParseExpr(helper, exprStr, nil)
+			assert.NoError(t, err, exprStr)
+			assert.NotNil(t, expr.GetUnaryExpr().GetChild().GetAlwaysTrueExpr(), exprStr)
+		}
+
+		// the strings of JSON fields are not limited by the max length of VarChar fields.
+		expr, err = ParseExpr(helper, `length(A) > -1`, nil)
+		assert.NoError(t, err)
+		params = expr.GetCallExpr().GetFunctionParameters()
+		assert.Equal(t, int64(0), params[1].GetValueExpr().GetValue().GetInt64Val())
+		assert.Equal(t, int64(math.MaxInt64), params[2].GetValueExpr().GetValue().GetInt64Val())
+
+		validExprs := []string{
+			`length(VarCharField) < 10 && length(VarCharField) > 1`,
+			`length(A) <= 5`,
+			`length(JSONField["A"]["B"]) >= 70000`,
+		}
+		for _, exprStr := range validExprs {
+			assertValidExpr(t, helper, exprStr)
+		}
+
+		invalidExprs := []string{
+			`length(Int64Field) == 1`,
+			`length(StringArrayField) > 2`,
+			`length(VarCharField) == "a"`,
+			`length(VarCharField) == 1.5`,
+			`length(VarCharField) like "a%"`,
+			`length(VarCharField) + 1 == 2`,
+		}
+		for _, exprStr := range invalidExprs {
+			assertInvalidExpr(t, helper, exprStr)
+		}
+	})
+
+	t.Run("datetime", func(t *testing.T) {
+		expr, err := ParseExpr(helper, `Int64Field >= datetime("2024-05-01T08:00:00+08:00")`, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1714521600), expr.GetUnaryRangeExpr().GetValue().GetInt64Val())
+
+		expr, err = ParseExpr(helper, `datetime("2024-05-01", "ms") <= Int64Field < datetime("2024-05-02T00:00:00.5", "MS")`, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1714521600000), expr.GetBinaryRangeExpr().GetLowerValue().GetInt64Val())
+		assert.Equal(t, int64(1714608000500), expr.GetBinaryRangeExpr().GetUpperValue().GetInt64Val())
+
+		expr, err = ParseExpr(helper, `Int64Field in [datetime("2024-05-01 00:00:00", "us")]`, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1714521600000000), expr.GetTermExpr().GetValues()[0].GetInt64Val())
+
+		expr, err = ParseExpr(helper, `ArrayField[0] < datetime("2024-05-01")`, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1714521600), expr.GetUnaryRangeExpr().GetValue().GetInt64Val())
+
+		invalidExprs := []string{
+			`Int64Field > datetime("2024/05/01")`,
+			`Int64Field > datetime(20240501)`,
+			`Int64Field > datetime("2024-05-01", "h")`,
+			`VarCharField > datetime("2024-05-01")`,
+			`datetime("2024-05-01") == VarCharField`,
+			`FloatField > datetime("2024-05-01")`,
+			`DoubleField != datetime("2024-05-01")`,
+			`Int32Field > datetime("2024-05-01")`,
+			`JSONField["A"] > datetime("2024-05-01")`,
+			`A > datetime("2024-05-01")`,
+			`FloatField in [datetime("2024-05-01")]`,
+			`datetime("2024-05-01") < DoubleField < 10`,
+			`datetime("2024-05-02") > FloatField > 0`,
+			`StringArrayField[0] > datetime("2024-05-01")`,
+			`Int64Field + 1 > datetime("2024-05-01")`,
+			`Int64Field > datetime("2024-05-01") - 3600`,
+			`Int64Field > -datetime("2024-05-01")`,
+			`datetime("2024-05-01") > 1`,
+			`length(VarCharField) > datetime("2024-05-01")`,
+		}
+		for _, exprStr := range invalidExprs {
+			assertInvalidExpr(t, helper, exprStr)
+		}
+	})
 }
 
 func TestExpr_Compare(t *testing.T) {
@@ -1166,8 +1368,6 @@ func Test_JSONContains(t *testing.T) {
 		`json_contains(JSONField["x"], 5)`,
 		`not json_contains(JSONField["x"], 5)`,
 		`JSON_CONTAINS(JSONField["x"], 5)`,
-		`json_Contains(JSONField, 5)`,
-		`JSON_contains(JSONField, 5)`,
 		`json_contains(A, [1,2,3])`,
 		`array_contains(A, [1,2,3])`,
 		`array_contains(ArrayField, 1)`,
@@ -1191,6 +1391,9 @@ func Test_InvalidJSONContains(t *testing.T) {
 	var err error
 	exprs := []string{
 		`json_contains(10, A)`,
+		// keywords are either in lower case or in upper case
+		`json_Contains(JSONField, 5)`,
+		`JSON_contains(JSONField, 5)`,
 		`json_contains(1, [1,2,3])`,
 		`json_contains([1,2,3], 1)`,
 		`json_contains([1,2,3], [1,2,3])`,
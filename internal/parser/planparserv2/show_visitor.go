@@ -35,6 +35,12 @@ func extractGenericValue(value *planpb.GenericValue) interface{} {
 		return realValue.FloatVal
 	case *planpb.GenericValue_StringVal:
 		return realValue.StringVal
+	case *planpb.GenericValue_ArrayVal:
+		elements := make([]interface{}, 0, len(realValue.ArrayVal.GetArray()))
+		for _, e := range realValue.ArrayVal.GetArray() {
+			elements = append(elements, extractGenericValue(e))
+		}
+		return elements
 	default:
 		return nil
 	}
@@ -67,6 +73,14 @@ func (v *ShowExprVisitor) VisitExpr(expr *planpb.Expr) interface{} {
 		js["expr"] = v.VisitColumnExpr(realExpr.ColumnExpr)
 	case *planpb.Expr_NullExpr:
 		js["expr"] = v.VisitNullExpr(realExpr.NullExpr)
+	case *planpb.Expr_ExistsExpr:
+		js["expr"] = v.VisitExistsExpr(realExpr.ExistsExpr)
+	case *planpb.Expr_AlwaysTrueExpr:
+		js["expr"] = v.VisitAlwaysTrueExpr(realExpr.AlwaysTrueExpr)
+	case *planpb.Expr_JsonContainsExpr:
+		js["expr"] = v.VisitJSONContainsExpr(realExpr.JsonContainsExpr)
+	case *planpb.Expr_RandomSampleExpr:
+		js["expr"] = v.VisitRandomSampleExpr(realExpr.RandomSampleExpr)
 	default:
 		js["expr"] = ""
 	}
@@ -188,6 +202,42 @@ func (v *ShowExprVisitor) VisitNullExpr(expr *planpb.NullExpr) interface{} {
 	return js
 }
 
+func (v *ShowExprVisitor) VisitExistsExpr(expr *planpb.ExistsExpr) interface{} {
+	js := make(map[string]interface{})
+	js["expr_type"] = "exists"
+	js["column_info"] = extractColumnInfo(expr.GetInfo())
+	return js
+}
+
+func (v *ShowExprVisitor) VisitAlwaysTrueExpr(expr *planpb.AlwaysTrueExpr) interface{} {
+	js := make(map[string]interface{})
+	js["expr_type"] = "always_true"
+	return js
+}
+
+func (v *ShowExprVisitor) VisitJSONContainsExpr(expr *planpb.JSONContainsExpr) interface{} {
+	js := make(map[string]interface{})
+	js["expr_type"] = "json_contains"
+	js["op"] = expr.GetOp().String()
+	js["column_info"] = extractColumnInfo(expr.GetColumnInfo())
+	elements := make([]interface{}, 0, len(expr.GetElements()))
+	for _, e := range expr.GetElements() {
+		elements = append(elements, extractGenericValue(e))
+	}
+	js["elements"] = elements
+	return js
+}
+
+func (v *ShowExprVisitor) VisitRandomSampleExpr(expr *planpb.RandomSampleExpr) interface{} {
+	js := make(map[string]interface{})
+	js["expr_type"] = "random_sample"
+	js["sample_factor"] = expr.GetSampleFactor()
+	if expr.GetPredicate() != nil {
+		js["predicate"] = v.VisitExpr(expr.GetPredicate())
+	}
+	return js
+}
+
 func NewShowExprVisitor() LogicalExprVisitor {
 	return &ShowExprVisitor{}
 }
@@ -199,3 +249,13 @@ func ShowExpr(expr *planpb.Expr) {
 	b, _ := json.Marshal(js)
 	log.Info("[ShowExpr]", zap.String("expr", string(b)))
 }
+
+// ShowExprJSON returns the expr tree in json, which is the readable form of the plan used by explain.
+func ShowExprJSON(expr *planpb.Expr) (string, error) {
+	v := NewShowExprVisitor()
+	b, err := json.Marshal(v.VisitExpr(expr))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
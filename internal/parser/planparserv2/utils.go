@@ -787,3 +787,17 @@ func decodeUnicode(input string) string {
 		return string(rune(code))
 	})
 }
+
+// NestedPathToJSONPointer converts the nested path of a JSON column to the JSON pointer which identifies the JSON indexes,
+// the keys are escaped so that they are not interpreted as a part of the pointer.
+// The pointer of the whole JSON field is empty since "/" is not a valid JSON pointer for simdjson.
+func NestedPathToJSONPointer(nestedPath []string) string {
+	if len(nestedPath) == 0 {
+		return ""
+	}
+	escaped := make([]string, 0, len(nestedPath))
+	for _, path := range nestedPath {
+		escaped = append(escaped, strings.ReplaceAll(strings.ReplaceAll(path, "~", "~0"), "/", "~1"))
+	}
+	return "/" + strings.Join(escaped, "/")
+}
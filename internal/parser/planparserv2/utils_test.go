@@ -590,3 +590,9 @@ func Test_handleCompare(t *testing.T) {
 		assert.Equal(t, "var1", result.GetUnaryRangeExpr().GetTemplateVariableName())
 	})
 }
+
+func TestNestedPathToJSONPointer(t *testing.T) {
+	assert.Equal(t, "", NestedPathToJSONPointer(nil))
+	assert.Equal(t, "/a/b", NestedPathToJSONPointer([]string{"a", "b"}))
+	assert.Equal(t, "/a~1b/c~0d", NestedPathToJSONPointer([]string{"a/b", "c~d"}))
+}
@@ -0,0 +1,263 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proxy/accesslog/info"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+const (
+	ruleDatabasesKey = "databases"
+	ruleMethodsKey   = "methods"
+	ruleRatioKey     = "ratio"
+	ruleFieldsKey    = "fields"
+	ruleActionKey    = "action"
+
+	hashRedactAction = "hash"
+	maskRedactAction = "mask"
+)
+
+// ruleMatcher matches access info by database and method, empty means all.
+type ruleMatcher struct {
+	name      string
+	databases map[string]struct{}
+	methods   map[string]struct{}
+}
+
+func newRuleMatcher(name string, databases []string, methods []string) ruleMatcher {
+	m := ruleMatcher{
+		name:      name,
+		databases: make(map[string]struct{}),
+		methods:   make(map[string]struct{}),
+	}
+	for _, db := range databases {
+		m.databases[db] = struct{}{}
+	}
+	for _, method := range methods {
+		m.methods[method] = struct{}{}
+	}
+	return m
+}
+
+func (m ruleMatcher) match(db, method string) bool {
+	if _, ok := m.databases[db]; len(m.databases) > 0 && !ok {
+		return false
+	}
+	if _, ok := m.methods[method]; len(m.methods) > 0 && !ok {
+		return false
+	}
+	return true
+}
+
+// rule matches both database and method is more specific than rule only matches method,
+// and rule only matches method is more specific than rule only matches database.
+func (m ruleMatcher) specificity() int {
+	score := 0
+	if len(m.methods) > 0 {
+		score += 2
+	}
+	if len(m.databases) > 0 {
+		score++
+	}
+	return score
+}
+
+// parseRuleConfig groups config of <RuleName>.<option> by rule name, rules are sorted by name.
+func parseRuleConfig(group map[string]string) ([]string, map[string]map[string]string, error) {
+	configs := make(map[string]map[string]string)
+	for key, value := range group {
+		fields := strings.Split(key, ".")
+		if len(fields) != 2 {
+			return nil, nil, merr.WrapErrParameterInvalid("<RuleName>.<option>", key, "parse accesslog rule config key failed")
+		}
+		if _, ok := configs[fields[0]]; !ok {
+			configs[fields[0]] = make(map[string]string)
+		}
+		configs[fields[0]][fields[1]] = value
+	}
+	names := make([]string, 0, len(configs))
+	for name := range configs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, configs, nil
+}
+
+type samplingRule struct {
+	ruleMatcher
+	ratio float64
+}
+
+// Sampler decides whether an access log should be written,
+// by the ratio of the most specific rule matching database and method of access info.
+type Sampler struct {
+	ratio            float64
+	alwaysLogOnError bool
+	rules            []samplingRule
+}
+
+func NewSampler(ratio float64, alwaysLogOnError bool) *Sampler {
+	return &Sampler{
+		ratio:            ratio,
+		alwaysLogOnError: alwaysLogOnError,
+	}
+}
+
+func (s *Sampler) AddRule(name string, ratio float64, databases []string, methods []string) {
+	rule := samplingRule{
+		ruleMatcher: newRuleMatcher(name, databases, methods),
+		ratio:       ratio,
+	}
+	s.rules = append(s.rules, rule)
+	// keep the most specific rule first
+	sort.SliceStable(s.rules, func(i, j int) bool {
+		return s.rules[i].specificity() > s.rules[j].specificity()
+	})
+}
+
+func (s *Sampler) getRatio(db, method string) float64 {
+	for _, rule := range s.rules {
+		if rule.match(db, method) {
+			return rule.ratio
+		}
+	}
+	return s.ratio
+}
+
+func (s *Sampler) Sample(i info.AccessInfo) bool {
+	if s.alwaysLogOnError && isFailed(i) {
+		return true
+	}
+	ratio := s.getRatio(i.DbName(), i.MethodName())
+	if ratio >= 1 {
+		return true
+	}
+	if ratio <= 0 {
+		return false
+	}
+	return rand.Float64() < ratio
+}
+
+func isFailed(i info.AccessInfo) bool {
+	status := i.MethodStatus()
+	return status != "Successful" && status != info.Unknown
+}
+
+type redactionRule struct {
+	ruleMatcher
+	redactors map[string]info.RedactFunc
+}
+
+// Redactor redacts fields of access info by all rules matching database and method of access info.
+type Redactor struct {
+	rules []redactionRule
+}
+
+func NewRedactor() *Redactor {
+	return &Redactor{}
+}
+
+func (r *Redactor) AddRule(name string, action string, fields []string, databases []string, methods []string) error {
+	var redactFunc info.RedactFunc
+	switch action {
+	case hashRedactAction, "":
+		redactFunc = info.HashRedact
+	case maskRedactAction:
+		redactFunc = info.MaskRedact
+	default:
+		return merr.WrapErrParameterInvalid(fmt.Sprintf("%s or %s", hashRedactAction, maskRedactAction), action, "invalid access log redaction action")
+	}
+
+	rule := redactionRule{
+		ruleMatcher: newRuleMatcher(name, databases, methods),
+		redactors:   make(map[string]info.RedactFunc),
+	}
+	for _, field := range fields {
+		field = strings.TrimPrefix(field, "$")
+		if _, ok := info.RedactableFields[field]; !ok {
+			return merr.WrapErrParameterInvalidMsg("field %s of access log redaction rule %s not support redaction", field, name)
+		}
+		rule.redactors[field] = redactFunc
+	}
+	r.rules = append(r.rules, rule)
+	return nil
+}
+
+// Redact returns the redacted access info, or the origin access info if no rule matched.
+func (r *Redactor) Redact(i info.AccessInfo) info.AccessInfo {
+	if len(r.rules) == 0 {
+		return i
+	}
+	db, method := i.DbName(), i.MethodName()
+	redactors := make(map[string]info.RedactFunc)
+	for _, rule := range r.rules {
+		if !rule.match(db, method) {
+			continue
+		}
+		for field, redactor := range rule.redactors {
+			if _, ok := redactors[field]; !ok {
+				redactors[field] = redactor
+			}
+		}
+	}
+	if len(redactors) == 0 {
+		return i
+	}
+	return info.NewRedactedInfo(i, redactors)
+}
+
+func initSampler(logCfg *paramtable.AccessLogConfig) (*Sampler, error) {
+	sampler := NewSampler(logCfg.SampleRatio.GetAsFloat(), logCfg.AlwaysLogOnError.GetAsBool())
+	names, configs, err := parseRuleConfig(logCfg.SamplingRules.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		config := configs[name]
+		ratio, err := strconv.ParseFloat(config[ruleRatioKey], 64)
+		if err != nil {
+			return nil, merr.WrapErrParameterInvalid("float", config[ruleRatioKey], fmt.Sprintf("parse ratio of access log sampling rule %s failed", name))
+		}
+		sampler.AddRule(name, ratio, paramtable.ParseAsStings(config[ruleDatabasesKey]), paramtable.ParseAsStings(config[ruleMethodsKey]))
+	}
+	return sampler, nil
+}
+
+func initRedactor(logCfg *paramtable.AccessLogConfig) (*Redactor, error) {
+	redactor := NewRedactor()
+	names, configs, err := parseRuleConfig(logCfg.RedactionRules.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		config := configs[name]
+		err := redactor.AddRule(name, config[ruleActionKey], paramtable.ParseAsStings(config[ruleFieldsKey]),
+			paramtable.ParseAsStings(config[ruleDatabasesKey]), paramtable.ParseAsStings(config[ruleMethodsKey]))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return redactor, nil
+}
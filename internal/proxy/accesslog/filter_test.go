@@ -0,0 +1,133 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proxy/accesslog/info"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func newTestAccessInfo(method string, req any, err error) *info.GrpcAccessInfo {
+	i := info.NewGrpcAccessInfo(context.Background(), &grpc.UnaryServerInfo{FullMethod: method}, req)
+	i.SetResult(nil, err)
+	return i
+}
+
+func TestSampler(t *testing.T) {
+	sampler := NewSampler(0, true)
+	sampler.AddRule("db", 1, []string{"db1"}, nil)
+	sampler.AddRule("search", 0, nil, []string{"Search"})
+	sampler.AddRule("db_query", 0, []string{"db1"}, []string{"Query"})
+
+	assert.EqualValues(t, 1, sampler.getRatio("db1", "Insert"))
+	assert.EqualValues(t, 0, sampler.getRatio("db1", "Search"))
+	assert.EqualValues(t, 0, sampler.getRatio("db1", "Query"))
+	assert.EqualValues(t, 0, sampler.getRatio("db2", "Insert"))
+
+	assert.True(t, sampler.Sample(newTestAccessInfo("Insert", &milvuspb.InsertRequest{DbName: "db1"}, nil)))
+	assert.False(t, sampler.Sample(newTestAccessInfo("Search", &milvuspb.SearchRequest{DbName: "db1"}, nil)))
+	// always log failed requests
+	assert.True(t, sampler.Sample(newTestAccessInfo("Search", &milvuspb.SearchRequest{DbName: "db1"}, status.Error(codes.Unavailable, "unavailable"))))
+
+	sampler = NewSampler(0, false)
+	assert.False(t, sampler.Sample(newTestAccessInfo("Search", &milvuspb.SearchRequest{DbName: "db1"}, status.Error(codes.Unavailable, "unavailable"))))
+}
+
+func TestRedactor(t *testing.T) {
+	redactor := NewRedactor()
+	require.NoError(t, redactor.AddRule("expr", hashRedactAction, []string{"method_expr", "partition_name"}, []string{"tenant"}, nil))
+	require.NoError(t, redactor.AddRule("user", maskRedactAction, []string{"$user_name"}, nil, nil))
+	assert.Error(t, redactor.AddRule("invalid", "encrypt", []string{"method_expr"}, nil, nil))
+	assert.Error(t, redactor.AddRule("invalid", maskRedactAction, []string{"trace_id"}, nil, nil))
+
+	req := &milvuspb.QueryRequest{
+		DbName:         "tenant",
+		CollectionName: "test",
+		Expr:           "name == 'secret'",
+		PartitionNames: []string{"p1"},
+	}
+	i := redactor.Redact(newTestAccessInfo("Query", req, nil))
+
+	formatted := NewFormatter("$method_expr $partition_name $collection_name").Format(i)
+	assert.False(t, strings.Contains(formatted, "secret"))
+	assert.False(t, strings.Contains(formatted, "p1"))
+	assert.True(t, strings.Contains(formatted, "sha256:"))
+	assert.True(t, strings.Contains(formatted, "test"))
+
+	fields := map[string]any{}
+	require.NoError(t, json.Unmarshal([]byte(NewJSONFormatter().Format(i)), &fields))
+	assert.Equal(t, info.HashRedact("name == 'secret'"), fields["method_expr"])
+	assert.Equal(t, []any{info.HashRedact("p1")}, fields["partition_names"])
+	assert.Equal(t, "test", fields["collection_name"])
+
+	// other database only redact user
+	req.DbName = "other"
+	i = redactor.Redact(newTestAccessInfo("Query", req, nil))
+	assert.Equal(t, "name == 'secret'", i.Expression())
+
+	// restful info
+	restfulInfo := info.NewRestfulInfo()
+	restfulInfo.SetParams(&gin.LogFormatterParams{Keys: map[string]any{info.ContextUsername: "alice", info.ContextRequest: req}})
+	restfulInfo.InitReq()
+	i = redactor.Redact(restfulInfo)
+	assert.Equal(t, "***", i.UserName())
+	require.NoError(t, json.Unmarshal([]byte(NewJSONFormatter().Format(i)), &fields))
+	assert.Equal(t, "***", fields["user_name"])
+}
+
+func TestAccessLogger_SamplingAndRedaction(t *testing.T) {
+	var Params paramtable.ComponentParam
+	Params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+	Params.Save(Params.ProxyCfg.AccessLog.Enable.Key, "true")
+	Params.Save(Params.ProxyCfg.AccessLog.Filename.Key, "")
+	Params.Save(Params.ProxyCfg.AccessLog.SampleRatio.Key, "0")
+	Params.SaveGroup(map[string]string{
+		Params.ProxyCfg.AccessLog.SamplingRules.KeyPrefix + "query.ratio":   "1",
+		Params.ProxyCfg.AccessLog.SamplingRules.KeyPrefix + "query.methods": "Query",
+		Params.ProxyCfg.AccessLog.RedactionRules.KeyPrefix + "expr.fields":  "method_expr",
+	})
+
+	logger := NewAccessLogger()
+	require.NoError(t, logger.Init(&Params))
+	assert.True(t, logger.Write(newTestAccessInfo("Query", &milvuspb.QueryRequest{}, nil)))
+	assert.False(t, logger.Write(newTestAccessInfo("Search", &milvuspb.SearchRequest{}, nil)))
+	assert.True(t, logger.Write(newTestAccessInfo("Search", &milvuspb.SearchRequest{}, status.Error(codes.Unavailable, "unavailable"))))
+
+	Params.SaveGroup(map[string]string{
+		Params.ProxyCfg.AccessLog.SamplingRules.KeyPrefix + "query.ratio": "invalid",
+	})
+	assert.Error(t, NewAccessLogger().Init(&Params))
+
+	Params.SaveGroup(map[string]string{
+		Params.ProxyCfg.AccessLog.SamplingRules.KeyPrefix + "query.ratio":  "1",
+		Params.ProxyCfg.AccessLog.RedactionRules.KeyPrefix + "expr.action": "invalid",
+	})
+	assert.Error(t, NewAccessLogger().Init(&Params))
+}
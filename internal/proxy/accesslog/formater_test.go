@@ -18,6 +18,7 @@ package accesslog
 
 import (
 	"context"
+	"encoding/json"
 	"net"
 	"strings"
 	"testing"
@@ -181,6 +182,29 @@ func (s *LogFormatterSuite) TestFormatMethodResult() {
 	}
 }
 
+func (s *LogFormatterSuite) TestJSONFormatter() {
+	formatter := NewJSONFormatter()
+
+	for id, req := range s.reqs {
+		i := info.NewGrpcAccessInfo(s.ctx, s.serverinfo, req)
+		i.SetResult(s.resps[id], s.errs[id])
+		fields := map[string]any{}
+		s.NoError(json.Unmarshal([]byte(formatter.Format(i)), &fields))
+		s.Equal("test-db", fields["database_name"])
+		s.Equal("test-collection", fields["collection_name"])
+		s.NotEmpty(fields["partition_names"])
+		s.IsType(float64(0), fields["time_cost_ms"])
+		s.IsType(float64(0), fields["error_code"])
+	}
+
+	// unknown fields are omitted
+	i := info.NewGrpcAccessInfo(context.Background(), &grpc.UnaryServerInfo{}, nil)
+	fields := map[string]any{}
+	s.NoError(json.Unmarshal([]byte(formatter.Format(i)), &fields))
+	s.NotContains(fields, "database_name")
+	s.NotContains(fields, "time_cost_ms")
+}
+
 func (s *LogFormatterSuite) TestParseConfigKeyFailed() {
 	configKey := ".testf.invalidSub"
 	_, _, err := parseConfigKey(configKey)
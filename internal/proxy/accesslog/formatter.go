@@ -17,6 +17,7 @@
 package accesslog
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -27,6 +28,10 @@ import (
 const (
 	fomaterkey = "format"
 	methodKey  = "methods"
+	typeKey    = "type"
+
+	templateFormatterType = "template"
+	jsonFormatterType     = "json"
 )
 
 var BaseFormatterKey = "base"
@@ -34,13 +39,13 @@ var BaseFormatterKey = "base"
 // Formaater manager not concurrent safe
 // make sure init with Add and SetMethod before use Get
 type FormatterManger struct {
-	formatters map[string]*Formatter
+	formatters map[string]LogFormatter
 	methodMap  map[string]string
 }
 
 func NewFormatterManger() *FormatterManger {
 	return &FormatterManger{
-		formatters: make(map[string]*Formatter),
+		formatters: make(map[string]LogFormatter),
 		methodMap:  make(map[string]string),
 	}
 }
@@ -49,13 +54,22 @@ func (m *FormatterManger) Add(name, fmt string) {
 	m.formatters[name] = NewFormatter(fmt)
 }
 
+func (m *FormatterManger) AddFormatter(name string, formatter LogFormatter) {
+	m.formatters[name] = formatter
+}
+
+func (m *FormatterManger) Get(name string) (LogFormatter, bool) {
+	formatter, ok := m.formatters[name]
+	return formatter, ok
+}
+
 func (m *FormatterManger) SetMethod(name string, methods ...string) {
 	for _, method := range methods {
 		m.methodMap[method] = name
 	}
 }
 
-func (m *FormatterManger) GetByMethod(method string) (*Formatter, bool) {
+func (m *FormatterManger) GetByMethod(method string) (LogFormatter, bool) {
 	formatterName, ok := m.methodMap[method]
 	if !ok {
 		formatterName = BaseFormatterKey
@@ -68,6 +82,12 @@ func (m *FormatterManger) GetByMethod(method string) (*Formatter, bool) {
 	return formatter, true
 }
 
+// LogFormatter formats access info to a log line ending with '\n'
+type LogFormatter interface {
+	Format(i info.AccessInfo) string
+}
+
+// Formatter formats access info by template, the metrics in template will be replaced by value
 type Formatter struct {
 	base   string
 	fmt    string
@@ -124,10 +144,29 @@ func (f *Formatter) Format(i info.AccessInfo) string {
 	return fmt.Sprintf(f.fmt, fieldValues...)
 }
 
+// JSONFormatter formats access info to a json line with typed fields,
+// fields with unknown value will be omitted.
+type JSONFormatter struct{}
+
+// jsonFormatter is the default formatter of structured sinks
+var jsonFormatter = NewJSONFormatter()
+
+func NewJSONFormatter() *JSONFormatter {
+	return &JSONFormatter{}
+}
+
+func (f *JSONFormatter) Format(i info.AccessInfo) string {
+	data, err := json.Marshal(info.Fields(i))
+	if err != nil {
+		return ""
+	}
+	return string(data) + "\n"
+}
+
 func parseConfigKey(k string) (string, string, error) {
 	fields := strings.Split(k, ".")
-	if len(fields) != 2 || (fields[1] != fomaterkey && fields[1] != methodKey) {
-		return "", "", merr.WrapErrParameterInvalid("<FormatterName>.(format|methods)", k, "parse accsslog formatter config key failed")
+	if len(fields) != 2 || (fields[1] != fomaterkey && fields[1] != methodKey && fields[1] != typeKey) {
+		return "", "", merr.WrapErrParameterInvalid("<FormatterName>.(format|methods|type)", k, "parse accsslog formatter config key failed")
 	}
 	return fields[0], fields[1], nil
 }
@@ -17,6 +17,7 @@
 package accesslog
 
 import (
+	"fmt"
 	"io"
 	"strconv"
 	"sync"
@@ -28,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proxy/accesslog/info"
 	configEvent "github.com/milvus-io/milvus/pkg/v2/config"
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
@@ -40,6 +42,9 @@ type AccessLogger struct {
 	enable     atomic.Bool
 	writer     io.Writer
 	formatters *FormatterManger
+	sinks      *SinkManager
+	sampler    *Sampler
+	redactor   *Redactor
 	mu         sync.RWMutex
 }
 
@@ -47,21 +52,79 @@ func NewAccessLogger() *AccessLogger {
 	return &AccessLogger{}
 }
 
+// init builds all components of access logger from params, the current components are replaced
+// and closed only if all new components are built successfully, so the logger keeps working
+// with the old config if the new config is invalid.
 func (l *AccessLogger) init(params *paramtable.ComponentParam) error {
 	formatters, err := initFormatter(&params.ProxyCfg.AccessLog)
 	if err != nil {
 		return err
 	}
-	l.formatters = formatters
+
+	sampler, err := initSampler(&params.ProxyCfg.AccessLog)
+	if err != nil {
+		return err
+	}
+
+	redactor, err := initRedactor(&params.ProxyCfg.AccessLog)
+	if err != nil {
+		return err
+	}
+
+	sinks, err := initSinks(&params.ProxyCfg.AccessLog)
+	if err != nil {
+		return err
+	}
+	if err := checkSinkFormatters(sinks, formatters); err != nil {
+		sinks.Close()
+		return err
+	}
 
 	writer, err := initWriter(&params.ProxyCfg.AccessLog, &params.MinioCfg)
 	if err != nil {
+		sinks.Close()
 		return err
 	}
+
+	oldWriter, oldSinks := l.writer, l.sinks
+	l.formatters = formatters
+	l.sampler = sampler
+	l.redactor = redactor
 	l.writer = writer
+	l.sinks = sinks
+	closeWriter(oldWriter)
+	if oldSinks != nil {
+		oldSinks.Close()
+	}
 	return nil
 }
 
+func checkSinkFormatters(sinks *SinkManager, formatters *FormatterManger) error {
+	for _, sink := range sinks.sinks {
+		if sink.formatter == "" {
+			continue
+		}
+		formatter, ok := formatters.Get(sink.formatter)
+		if !ok {
+			return merr.WrapErrParameterInvalidMsg("formatter %s of access log sink %s not found", sink.formatter, sink.name)
+		}
+		if _, ok := formatter.(*JSONFormatter); sink.structured && !ok {
+			return merr.WrapErrParameterInvalidMsg("%s sink %s requires a %s formatter", otlpSinkType, sink.name, jsonFormatterType)
+		}
+	}
+	return nil
+}
+
+// closeWriter closes the writers created by initWriter, stdout is never closed.
+func closeWriter(writer io.Writer) {
+	switch w := writer.(type) {
+	case *RotateWriter:
+		w.Close()
+	case *CacheWriter:
+		w.Close()
+	}
+}
+
 func (l *AccessLogger) Init(params *paramtable.ComponentParam) error {
 	if params.ProxyCfg.AccessLog.Enable.GetAsBool() {
 		l.mu.Lock()
@@ -94,8 +157,9 @@ func (l *AccessLogger) SetEnable(enable bool) error {
 		}
 	} else {
 		log.Info("start close access log")
-		if write, ok := l.writer.(*RotateWriter); ok {
-			write.Close()
+		closeWriter(l.writer)
+		if l.sinks != nil {
+			l.sinks.Close()
 		}
 	}
 
@@ -111,6 +175,13 @@ func (l *AccessLogger) Write(info info.AccessInfo) bool {
 	l.mu.RLock()
 	defer l.mu.RUnlock()
 
+	if l.sampler != nil && !l.sampler.Sample(info) {
+		return false
+	}
+	if l.redactor != nil {
+		info = l.redactor.Redact(info)
+	}
+
 	method := info.MethodName()
 	formatter, ok := l.formatters.GetByMethod(method)
 	if !ok {
@@ -121,6 +192,22 @@ func (l *AccessLogger) Write(info info.AccessInfo) bool {
 		log.Warn("write access log failed", zap.Error(err))
 		return false
 	}
+
+	if l.sinks != nil {
+		for _, sink := range l.sinks.getByMethod(method) {
+			sinkFormatter := formatter
+			if sink.formatter != "" {
+				if f, ok := l.formatters.Get(sink.formatter); ok {
+					sinkFormatter = f
+				}
+			} else if sink.structured {
+				sinkFormatter = jsonFormatter
+			}
+			if _, err := sink.writer.Write([]byte(sinkFormatter.Format(info))); err != nil {
+				log.Warn("write access log to sink failed", zap.String("sink", sink.name), zap.Error(err))
+			}
+		}
+	}
 	return true
 }
 
@@ -151,6 +238,7 @@ func initFormatter(logCfg *paramtable.AccessLogConfig) (*FormatterManger, error)
 	formatterManger := NewFormatterManger()
 	formatMap := make(map[string]string)   // fommatter name -> formatter format
 	methodMap := make(map[string][]string) // fommatter name -> formatter owner method
+	typeMap := make(map[string]string)     // fommatter name -> formatter type
 	for key, value := range logCfg.Formatter.GetValue() {
 		formatterName, option, err := parseConfigKey(key)
 		if err != nil {
@@ -161,10 +249,29 @@ func initFormatter(logCfg *paramtable.AccessLogConfig) (*FormatterManger, error)
 			formatMap[formatterName] = value
 		} else if option == methodKey {
 			methodMap[formatterName] = paramtable.ParseAsStings(value)
+		} else if option == typeKey {
+			typeMap[formatterName] = value
+		}
+	}
+
+	for name, formatterType := range typeMap {
+		switch formatterType {
+		case jsonFormatterType:
+			formatterManger.AddFormatter(name, NewJSONFormatter())
+		case templateFormatterType, "":
+			continue
+		default:
+			return nil, merr.WrapErrParameterInvalid(fmt.Sprintf("%s or %s", templateFormatterType, jsonFormatterType), formatterType, "invalid access log formatter type")
+		}
+		if methods, ok := methodMap[name]; ok {
+			formatterManger.SetMethod(name, methods...)
 		}
 	}
 
 	for name, format := range formatMap {
+		if _, ok := formatterManger.Get(name); ok {
+			continue
+		}
 		formatterManger.Add(name, format)
 		if methods, ok := methodMap[name]; ok {
 			formatterManger.SetMethod(name, methods...)
@@ -174,6 +281,59 @@ func initFormatter(logCfg *paramtable.AccessLogConfig) (*FormatterManger, error)
 	return formatterManger, nil
 }
 
+// initSinks initializes the extra sinks of access log,
+// logs are batched by cache writer with the cache size of sink, or proxy.accessLog.cacheSize if not set.
+func initSinks(logCfg *paramtable.AccessLogConfig) (*SinkManager, error) {
+	sinkManager := NewSinkManager()
+	configs := make(map[string]map[string]string) // sink name -> option -> value
+	for key, value := range logCfg.Sinks.GetValue() {
+		sinkName, option, err := parseSinkConfigKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := configs[sinkName]; !ok {
+			configs[sinkName] = make(map[string]string)
+		}
+		configs[sinkName][option] = value
+	}
+
+	for name, config := range configs {
+		cacheSize, err := parseSinkInt(config, sinkCacheSizeKey)
+		if err != nil {
+			sinkManager.Close()
+			return nil, err
+		}
+		if cacheSize <= 0 {
+			cacheSize = logCfg.CacheSize.GetAsInt()
+		}
+		if cacheSize <= 0 {
+			cacheSize = defaultSinkCacheSize
+		}
+		sink, err := NewHTTPSink(name, config[sinkTypeKey], config[sinkURLKey])
+		if err != nil {
+			sinkManager.Close()
+			return nil, err
+		}
+
+		cw := NewCacheWriterWithCloser(sink, sink, cacheSize, logCfg.CacheFlushInterval.GetAsDuration(time.Second))
+		sinkManager.Add(name, cw, cw.Close, config[sinkFormatterKey], sink.Structured(), paramtable.ParseAsStings(config[sinkMethodsKey])...)
+		log.Info("access log sink added", zap.String("name", name), zap.String("type", config[sinkTypeKey]), zap.String("url", config[sinkURLKey]), zap.Int("cacheSize", cacheSize))
+	}
+	return sinkManager, nil
+}
+
+func parseSinkInt(config map[string]string, key string) (int, error) {
+	value, ok := config[key]
+	if !ok || value == "" {
+		return 0, nil
+	}
+	result, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, merr.WrapErrParameterInvalid("int", value, fmt.Sprintf("parse accesslog sink option %s failed", key))
+	}
+	return result, nil
+}
+
 // initAccessLogger initializes a zap access logger for proxy
 func initWriter(logCfg *paramtable.AccessLogConfig, minioCfg *paramtable.MinioConfig) (io.Writer, error) {
 	if len(logCfg.Filename.GetValue()) > 0 {
@@ -0,0 +1,214 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package info
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/v2/util/requestutil"
+)
+
+// requestInfo is implemented by access infos which hold the original request,
+// used to get the typed value of list fields.
+type requestInfo interface {
+	request() any
+}
+
+func (i *GrpcAccessInfo) request() any {
+	return i.req
+}
+
+func (i *RestfulInfo) request() any {
+	return i.req
+}
+
+type getFieldFunc func(i AccessInfo) (any, bool)
+
+// supported fields of structured access log, unknown fields will be omitted
+var FieldFuncMap = map[string]getFieldFunc{
+	"method_name":       stringField(AccessInfo.MethodName),
+	"method_status":     stringField(AccessInfo.MethodStatus),
+	"trace_id":          stringField(AccessInfo.TraceID),
+	"user_addr":         stringField(AccessInfo.Address),
+	"user_name":         stringField(AccessInfo.UserName),
+	"response_size":     intField(AccessInfo.ResponseSize),
+	"error_code":        intField(AccessInfo.ErrorCode),
+	"error_msg":         stringField(AccessInfo.ErrorMsg),
+	"error_type":        stringField(AccessInfo.ErrorType),
+	"database_name":     stringField(AccessInfo.DbName),
+	"collection_name":   stringField(AccessInfo.CollectionName),
+	"partition_names":   getPartitionNames,
+	"time_cost_ms":      getTimeCostMs,
+	"time_now":          timeField(AccessInfo.TimeNow),
+	"time_start":        timeField(AccessInfo.TimeStart),
+	"time_end":          timeField(AccessInfo.TimeEnd),
+	"method_expr":       getExprField,
+	"output_fields":     getOutputFieldList,
+	"sdk_version":       stringField(AccessInfo.SdkVersion),
+	"cluster_prefix":    stringField(getClusterPrefix),
+	"consistency_level": stringField(AccessInfo.ConsistencyLevel),
+	"anns_field":        getAnnsFieldField,
+	"nq":                getNqField,
+	"search_params":     getSearchParamsField,
+	"query_params":      getQueryParamsField,
+}
+
+// Fields returns all known fields of access info with typed value.
+func Fields(i AccessInfo) map[string]any {
+	if r, ok := i.(*RedactedInfo); ok {
+		result := Fields(r.AccessInfo)
+		r.redactFields(result)
+		return result
+	}
+
+	result := make(map[string]any, len(FieldFuncMap))
+	for key, getFunc := range FieldFuncMap {
+		if value, ok := getFunc(i); ok {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+func known(value string) bool {
+	return value != Unknown && value != ""
+}
+
+func stringField(getFunc getMetricFunc) getFieldFunc {
+	return func(i AccessInfo) (any, bool) {
+		value := getFunc(i)
+		return value, known(value)
+	}
+}
+
+func intField(getFunc getMetricFunc) getFieldFunc {
+	return func(i AccessInfo) (any, bool) {
+		value, err := strconv.ParseInt(getFunc(i), 10, 64)
+		return value, err == nil
+	}
+}
+
+func timeField(getFunc getMetricFunc) getFieldFunc {
+	return func(i AccessInfo) (any, bool) {
+		t, err := time.Parse(timeFormat, getFunc(i))
+		if err != nil {
+			return nil, false
+		}
+		return t.Format(time.RFC3339Nano), true
+	}
+}
+
+func getTimeCostMs(i AccessInfo) (any, bool) {
+	cost, err := time.ParseDuration(i.TimeCost())
+	if err != nil {
+		return nil, false
+	}
+	return float64(cost) / float64(time.Millisecond), true
+}
+
+func getRequest(i AccessInfo) (any, bool) {
+	r, ok := i.(requestInfo)
+	if !ok || r.request() == nil {
+		return nil, false
+	}
+	return r.request(), true
+}
+
+func getPartitionNames(i AccessInfo) (any, bool) {
+	req, ok := getRequest(i)
+	if !ok {
+		return stringField(AccessInfo.PartitionName)(i)
+	}
+	if name, ok := requestutil.GetPartitionNameFromRequest(req); ok {
+		return []string{name.(string)}, true
+	}
+	if names, ok := requestutil.GetPartitionNamesFromRequest(req); ok {
+		return names.([]string), true
+	}
+	return nil, false
+}
+
+func getOutputFieldList(i AccessInfo) (any, bool) {
+	req, ok := getRequest(i)
+	if !ok {
+		return stringField(AccessInfo.OutputFields)(i)
+	}
+	if fields, ok := requestutil.GetOutputFieldsFromRequest(req); ok {
+		return fields.([]string), true
+	}
+	return nil, false
+}
+
+// hybridField returns a list of values for hybrid search, or the string value of access info for other requests
+func hybridField[T any](getFunc getMetricFunc, subFunc func(req *milvuspb.SearchRequest) T) getFieldFunc {
+	return func(i AccessInfo) (any, bool) {
+		req, ok := getRequest(i)
+		if !ok {
+			return stringField(getFunc)(i)
+		}
+		if req, ok := req.(*milvuspb.HybridSearchRequest); ok {
+			return lo.Map(req.GetRequests(), func(req *milvuspb.SearchRequest, _ int) T { return subFunc(req) }), true
+		}
+		if req, ok := req.(*milvuspb.SearchRequest); ok {
+			return subFunc(req), true
+		}
+		return stringField(getFunc)(i)
+	}
+}
+
+var (
+	getExprField = hybridField(AccessInfo.Expression, func(req *milvuspb.SearchRequest) string { return req.GetDsl() })
+
+	getAnnsFieldField = hybridField(AccessInfo.AnnsField, func(req *milvuspb.SearchRequest) string {
+		return getAnnsFieldFromKvs(req.GetSearchParams())
+	})
+
+	getSearchParamsField = hybridField(AccessInfo.SearchParams, func(req *milvuspb.SearchRequest) map[string]string {
+		return kvsToMap(req.GetSearchParams())
+	})
+)
+
+func getNqField(i AccessInfo) (any, bool) {
+	if _, ok := getRequest(i); !ok {
+		return intField(AccessInfo.NQ)(i)
+	}
+	return hybridField(AccessInfo.NQ, func(req *milvuspb.SearchRequest) int64 { return req.GetNq() })(i)
+}
+
+func getQueryParamsField(i AccessInfo) (any, bool) {
+	req, ok := getRequest(i)
+	if !ok {
+		return stringField(AccessInfo.QueryParams)(i)
+	}
+	if req, ok := req.(*milvuspb.QueryRequest); ok {
+		return kvsToMap(req.GetQueryParams()), true
+	}
+	return nil, false
+}
+
+func kvsToMap(kvs []*commonpb.KeyValuePair) map[string]string {
+	result := make(map[string]string, len(kvs))
+	for _, kv := range kvs {
+		result[kv.GetKey()] = kv.GetValue()
+	}
+	return result
+}
@@ -0,0 +1,69 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package info
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+)
+
+func TestFields(t *testing.T) {
+	rpcInfo := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/HybridSearch"}
+	req := &milvuspb.HybridSearchRequest{
+		DbName:         "db",
+		CollectionName: "test",
+		PartitionNames: []string{"p1", "p2"},
+		OutputFields:   []string{"a b", "c"},
+		Requests: []*milvuspb.SearchRequest{
+			{Dsl: "a > 1", Nq: 1, SearchParams: []*commonpb.KeyValuePair{{Key: "anns_field", Value: "v1"}}},
+			{Dsl: "a < 1", Nq: 2, SearchParams: []*commonpb.KeyValuePair{{Key: "anns_field", Value: "v2"}}},
+		},
+	}
+	i := NewGrpcAccessInfo(context.Background(), rpcInfo, req)
+	i.SetResult(&milvuspb.SearchResults{}, nil)
+
+	fields := Fields(i)
+	assert.Equal(t, "HybridSearch", fields["method_name"])
+	assert.Equal(t, "db", fields["database_name"])
+	assert.Equal(t, []string{"p1", "p2"}, fields["partition_names"])
+	assert.Equal(t, []string{"a b", "c"}, fields["output_fields"])
+	assert.Equal(t, []string{"a > 1", "a < 1"}, fields["method_expr"])
+	assert.Equal(t, []string{"v1", "v2"}, fields["anns_field"])
+	assert.Equal(t, []int64{1, 2}, fields["nq"])
+	assert.Equal(t, []map[string]string{{"anns_field": "v1"}, {"anns_field": "v2"}}, fields["search_params"])
+	assert.EqualValues(t, 0, fields["error_code"])
+	assert.Contains(t, fields, "time_cost_ms")
+	assert.Contains(t, fields, "time_start")
+	assert.NotContains(t, fields, "query_params")
+
+	query := &milvuspb.QueryRequest{
+		CollectionName: "test",
+		Expr:           "a > 1",
+		QueryParams:    []*commonpb.KeyValuePair{{Key: "limit", Value: "10"}},
+	}
+	fields = Fields(NewGrpcAccessInfo(context.Background(), rpcInfo, query))
+	assert.Equal(t, "a > 1", fields["method_expr"])
+	assert.Equal(t, map[string]string{"limit": "10"}, fields["query_params"])
+	assert.NotContains(t, fields, "nq")
+	assert.NotContains(t, fields, "time_cost_ms")
+}
@@ -0,0 +1,137 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package info
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// RedactFunc returns the redacted value of a field
+type RedactFunc func(value string) string
+
+// HashRedact replaces value with a short sha256 digest, same values are still correlatable after redaction.
+func HashRedact(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return "sha256:" + hex.EncodeToString(sum[:8])
+}
+
+// MaskRedact replaces value with a fixed mask.
+func MaskRedact(_ string) string {
+	return "***"
+}
+
+// fields of access info which support redaction,
+// name is the metric name without '$', and value is the key of structured field
+var RedactableFields = map[string]string{
+	"user_name":       "user_name",
+	"user_addr":       "user_addr",
+	"database_name":   "database_name",
+	"collection_name": "collection_name",
+	"partition_name":  "partition_names",
+	"method_expr":     "method_expr",
+	"output_fields":   "output_fields",
+	"search_params":   "search_params",
+	"query_params":    "query_params",
+}
+
+// RedactedInfo wraps an access info and redacts the value of configured fields,
+// for both template and structured formatters.
+type RedactedInfo struct {
+	AccessInfo
+	// field name -> redact func
+	redactors map[string]RedactFunc
+}
+
+func NewRedactedInfo(i AccessInfo, redactors map[string]RedactFunc) *RedactedInfo {
+	return &RedactedInfo{
+		AccessInfo: i,
+		redactors:  redactors,
+	}
+}
+
+func (i *RedactedInfo) redact(field string, value string) string {
+	redactor, ok := i.redactors[field]
+	if !ok || value == Unknown || value == "" {
+		return value
+	}
+	return redactor(value)
+}
+
+func (i *RedactedInfo) UserName() string {
+	return i.redact("user_name", i.AccessInfo.UserName())
+}
+
+func (i *RedactedInfo) Address() string {
+	return i.redact("user_addr", i.AccessInfo.Address())
+}
+
+func (i *RedactedInfo) DbName() string {
+	return i.redact("database_name", i.AccessInfo.DbName())
+}
+
+func (i *RedactedInfo) CollectionName() string {
+	return i.redact("collection_name", i.AccessInfo.CollectionName())
+}
+
+func (i *RedactedInfo) PartitionName() string {
+	return i.redact("partition_name", i.AccessInfo.PartitionName())
+}
+
+func (i *RedactedInfo) Expression() string {
+	return i.redact("method_expr", i.AccessInfo.Expression())
+}
+
+func (i *RedactedInfo) OutputFields() string {
+	return i.redact("output_fields", i.AccessInfo.OutputFields())
+}
+
+func (i *RedactedInfo) SearchParams() string {
+	return i.redact("search_params", i.AccessInfo.SearchParams())
+}
+
+func (i *RedactedInfo) QueryParams() string {
+	return i.redact("query_params", i.AccessInfo.QueryParams())
+}
+
+// redactFields redacts the structured fields of wrapped access info,
+// each element is redacted for list value.
+func (i *RedactedInfo) redactFields(fields map[string]any) {
+	for name, redactor := range i.redactors {
+		key, ok := RedactableFields[name]
+		if !ok {
+			continue
+		}
+		value, ok := fields[key]
+		if !ok {
+			continue
+		}
+		switch v := value.(type) {
+		case string:
+			fields[key] = redactor(v)
+		case []string:
+			redacted := make([]string, 0, len(v))
+			for _, s := range v {
+				redacted = append(redacted, redactor(s))
+			}
+			fields[key] = redacted
+		default:
+			fields[key] = redactor(fmt.Sprint(v))
+		}
+	}
+}
@@ -0,0 +1,404 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proxy/accesslog/info"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+const (
+	sinkTypeKey      = "type"
+	sinkURLKey       = "url"
+	sinkMethodsKey   = "methods"
+	sinkFormatterKey = "formatter"
+	// config keys are lower cased by config manager
+	sinkCacheSizeKey = "cachesize"
+
+	jsonLinesSinkType = "jsonl"
+	otlpSinkType      = "otlp"
+
+	defaultSinkCacheSize = megabyte
+	sinkRequestTimeout   = 10 * time.Second
+)
+
+// Sink is an extra destination of access logs besides local file or stdout.
+type Sink interface {
+	io.Writer
+	io.Closer
+}
+
+type sinkEntry struct {
+	name string
+	// all methods if empty
+	methods   map[string]struct{}
+	formatter string
+	// structured sinks take the json lines of JSONFormatter
+	structured bool
+	writer     io.Writer
+	closer     func()
+}
+
+// SinkManager not concurrent safe
+// make sure init with Add before use getByMethod
+type SinkManager struct {
+	sinks []*sinkEntry
+}
+
+func NewSinkManager() *SinkManager {
+	return &SinkManager{}
+}
+
+// Add registers a sink which receives access logs of methods, all methods if methods is empty.
+// Access logs are formatted by formatter with name formatter, or the formatter of method if empty.
+// Structured sinks use JSONFormatter if formatter is empty.
+func (m *SinkManager) Add(name string, writer io.Writer, closer func(), formatter string, structured bool, methods ...string) {
+	entry := &sinkEntry{
+		name:       name,
+		methods:    make(map[string]struct{}),
+		formatter:  formatter,
+		structured: structured,
+		writer:     writer,
+		closer:     closer,
+	}
+	for _, method := range methods {
+		entry.methods[method] = struct{}{}
+	}
+	m.sinks = append(m.sinks, entry)
+}
+
+func (m *SinkManager) getByMethod(method string) []*sinkEntry {
+	result := []*sinkEntry{}
+	for _, sink := range m.sinks {
+		if len(sink.methods) == 0 {
+			result = append(result, sink)
+			continue
+		}
+		if _, ok := sink.methods[method]; ok {
+			result = append(result, sink)
+		}
+	}
+	return result
+}
+
+func (m *SinkManager) Close() {
+	for _, sink := range m.sinks {
+		if sink.closer != nil {
+			sink.closer()
+		}
+	}
+}
+
+// HTTPSink exports access logs to a http endpoint,
+// as json lines or OTLP/HTTP logs in json encoding.
+// It sends the complete lines of each write in one request and is expected to be wrapped by CacheWriter,
+// which batches the logs and bounds the pending bytes.
+// Logs failed to export are dropped and counted, the error is not returned to keep the cache writer usable.
+type HTTPSink struct {
+	name     string
+	url      string
+	sinkType string
+	client   *http.Client
+
+	mu      sync.Mutex
+	partial []byte
+	closed  bool
+
+	dropped atomic.Int64
+}
+
+func NewHTTPSink(name, sinkType, url string) (*HTTPSink, error) {
+	if sinkType != jsonLinesSinkType && sinkType != otlpSinkType {
+		return nil, merr.WrapErrParameterInvalid(fmt.Sprintf("%s or %s", jsonLinesSinkType, otlpSinkType), sinkType, "invalid access log sink type")
+	}
+	if url == "" {
+		return nil, merr.WrapErrParameterInvalidMsg("url of access log sink %s is empty", name)
+	}
+
+	return &HTTPSink{
+		name:     name,
+		url:      url,
+		sinkType: sinkType,
+		client:   &http.Client{Timeout: sinkRequestTimeout},
+	}, nil
+}
+
+// Write splits p into log lines and exports the complete lines,
+// the incomplete line is kept until the next write.
+func (s *HTTPSink) Write(p []byte) (n int, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return 0, errors.New("write to closed sink")
+	}
+
+	s.partial = append(s.partial, p...)
+	idx := bytes.LastIndexByte(s.partial, '\n')
+	if idx < 0 {
+		return len(p), nil
+	}
+	batch := lo.Filter(bytes.Split(s.partial[:idx], []byte{'\n'}), func(line []byte, _ int) bool {
+		return len(line) > 0
+	})
+	// release memory of exported lines
+	s.partial = bytes.Clone(s.partial[idx+1:])
+
+	if len(batch) > 0 {
+		if err := s.send(batch); err != nil {
+			log.RatedWarn(10, "export access log failed", zap.String("sink", s.name), zap.Int("num", len(batch)), zap.Error(err))
+			s.drop(len(batch))
+		}
+	}
+	return len(p), nil
+}
+
+// Structured returns whether the sink exports the fields of access log as typed attributes,
+// which requires the logs formatted by JSONFormatter.
+func (s *HTTPSink) Structured() bool {
+	return s.sinkType == otlpSinkType
+}
+
+// Dropped returns the number of logs dropped because of export failure.
+func (s *HTTPSink) Dropped() int64 {
+	return s.dropped.Load()
+}
+
+func (s *HTTPSink) drop(n int) {
+	s.dropped.Add(int64(n))
+	metrics.ProxyAccessLogSinkDroppedCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), s.name).Add(float64(n))
+}
+
+func (s *HTTPSink) send(batch [][]byte) error {
+	var body []byte
+	var contentType string
+	switch s.sinkType {
+	case otlpSinkType:
+		data, err := json.Marshal(newOTLPLogs(batch))
+		if err != nil {
+			return err
+		}
+		body, contentType = data, "application/json"
+	default:
+		body, contentType = append(bytes.Join(batch, []byte{'\n'}), '\n'), "application/x-ndjson"
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sinkRequestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Close stops the sink, the incomplete line is dropped.
+func (s *HTTPSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	return nil
+}
+
+// otlp logs in json encoding, see https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding
+type otlpLogs struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+type otlpResourceLogs struct {
+	Resource  otlpResource    `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeLogs struct {
+	Scope      otlpScope       `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano         string         `json:"timeUnixNano"`
+	ObservedTimeUnixNano string         `json:"observedTimeUnixNano"`
+	SeverityText         string         `json:"severityText"`
+	Body                 otlpAnyValue   `json:"body"`
+	Attributes           []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+// otlpAnyValue is one of the typed values, int64 is encoded as decimal string in OTLP json.
+type otlpAnyValue struct {
+	StringValue *string         `json:"stringValue,omitempty"`
+	BoolValue   *bool           `json:"boolValue,omitempty"`
+	IntValue    *string         `json:"intValue,omitempty"`
+	DoubleValue *float64        `json:"doubleValue,omitempty"`
+	ArrayValue  *otlpArrayValue `json:"arrayValue,omitempty"`
+	KvlistValue *otlpKvlist     `json:"kvlistValue,omitempty"`
+}
+
+type otlpArrayValue struct {
+	Values []otlpAnyValue `json:"values"`
+}
+
+type otlpKvlist struct {
+	Values []otlpKeyValue `json:"values"`
+}
+
+func otlpString(s string) otlpAnyValue {
+	return otlpAnyValue{StringValue: &s}
+}
+
+// newOTLPValue converts a value decoded from json with UseNumber to OTLP typed value.
+func newOTLPValue(value any) otlpAnyValue {
+	switch v := value.(type) {
+	case string:
+		return otlpString(v)
+	case bool:
+		return otlpAnyValue{BoolValue: &v}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			s := strconv.FormatInt(i, 10)
+			return otlpAnyValue{IntValue: &s}
+		}
+		if f, err := v.Float64(); err == nil {
+			return otlpAnyValue{DoubleValue: &f}
+		}
+		return otlpString(v.String())
+	case []any:
+		values := make([]otlpAnyValue, 0, len(v))
+		for _, item := range v {
+			values = append(values, newOTLPValue(item))
+		}
+		return otlpAnyValue{ArrayValue: &otlpArrayValue{Values: values}}
+	case map[string]any:
+		return otlpAnyValue{KvlistValue: &otlpKvlist{Values: newOTLPAttributes(v)}}
+	case nil:
+		return otlpAnyValue{}
+	default:
+		return otlpString(fmt.Sprint(v))
+	}
+}
+
+func newOTLPAttributes(fields map[string]any) []otlpKeyValue {
+	keys := lo.Keys(fields)
+	sort.Strings(keys)
+	attrs := make([]otlpKeyValue, 0, len(keys))
+	for _, key := range keys {
+		attrs = append(attrs, otlpKeyValue{Key: key, Value: newOTLPValue(fields[key])})
+	}
+	return attrs
+}
+
+// newOTLPLogRecord converts a json line of JSONFormatter to a log record,
+// the access log fields are exported as typed attributes and the method name is the body.
+// Lines which are not json objects are exported as string body.
+func newOTLPLogRecord(line []byte, now string) otlpLogRecord {
+	record := otlpLogRecord{
+		TimeUnixNano:         now,
+		ObservedTimeUnixNano: now,
+		SeverityText:         "INFO",
+	}
+	fields := make(map[string]any)
+	decoder := json.NewDecoder(bytes.NewReader(line))
+	decoder.UseNumber()
+	if err := decoder.Decode(&fields); err != nil {
+		record.Body = otlpString(string(line))
+		return record
+	}
+	if start, ok := fields["time_start"].(string); ok {
+		if t, err := time.Parse(time.RFC3339Nano, start); err == nil {
+			record.TimeUnixNano = strconv.FormatInt(t.UnixNano(), 10)
+		}
+	}
+	if status, ok := fields["method_status"].(string); ok && status != "Successful" {
+		record.SeverityText = "ERROR"
+	}
+	method, _ := fields["method_name"].(string)
+	record.Body = otlpString(method)
+	record.Attributes = newOTLPAttributes(fields)
+	return record
+}
+
+func newOTLPLogs(batch [][]byte) *otlpLogs {
+	now := strconv.FormatInt(time.Now().UnixNano(), 10)
+	records := make([]otlpLogRecord, 0, len(batch))
+	for _, line := range batch {
+		records = append(records, newOTLPLogRecord(line, now))
+	}
+	hostname, _ := os.Hostname()
+	return &otlpLogs{
+		ResourceLogs: []otlpResourceLogs{{
+			Resource: otlpResource{Attributes: []otlpKeyValue{
+				{Key: "service.name", Value: otlpString("milvus-proxy")},
+				{Key: "host.name", Value: otlpString(hostname)},
+				{Key: "milvus.cluster_prefix", Value: otlpString(info.ClusterPrefix.Load())},
+			}},
+			ScopeLogs: []otlpScopeLogs{{
+				Scope:      otlpScope{Name: "milvus.accesslog"},
+				LogRecords: records,
+			}},
+		}},
+	}
+}
+
+func parseSinkConfigKey(k string) (string, string, error) {
+	fields := strings.Split(k, ".")
+	if len(fields) != 2 {
+		return "", "", merr.WrapErrParameterInvalid("<SinkName>.<option>", k, "parse accsslog sink config key failed")
+	}
+	return fields[0], strings.ToLower(fields[1]), nil
+}
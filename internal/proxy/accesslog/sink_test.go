@@ -0,0 +1,277 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proxy/accesslog/info"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+type mockCollector struct {
+	mu     sync.Mutex
+	bodies []string
+	status int
+}
+
+func (c *mockCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.bodies = append(c.bodies, string(body))
+	if c.status != 0 {
+		w.WriteHeader(c.status)
+	}
+}
+
+func (c *mockCollector) Bodies() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string{}, c.bodies...)
+}
+
+func TestHTTPSink_JSONLines(t *testing.T) {
+	collector := &mockCollector{}
+	server := httptest.NewServer(collector)
+	defer server.Close()
+
+	sink, err := NewHTTPSink("test", jsonLinesSinkType, server.URL)
+	require.NoError(t, err)
+
+	// incomplete line kept until next write
+	_, err = sink.Write([]byte("{\"a\":1}\n{\"b\""))
+	require.NoError(t, err)
+	_, err = sink.Write([]byte(":2}\n"))
+	require.NoError(t, err)
+	sink.Close()
+
+	assert.Equal(t, "{\"a\":1}\n{\"b\":2}\n", strings.Join(collector.Bodies(), ""))
+	assert.EqualValues(t, 0, sink.Dropped())
+
+	_, err = sink.Write([]byte("{}\n"))
+	assert.Error(t, err)
+}
+
+func TestHTTPSink_OTLP(t *testing.T) {
+	collector := &mockCollector{}
+	server := httptest.NewServer(collector)
+	defer server.Close()
+
+	sink, err := NewHTTPSink("test", otlpSinkType, server.URL)
+	require.NoError(t, err)
+	assert.True(t, sink.Structured())
+	line := `{"method_name":"Search","method_status":"Failed","nq":2,"time_cost_ms":1.5,"partition_names":["p1"],"search_params":{"limit":10},"time_start":"2024-01-02T03:04:05Z"}`
+	_, err = sink.Write([]byte(line + "\nlog2\n"))
+	require.NoError(t, err)
+	sink.Close()
+
+	records := []otlpLogRecord{}
+	for _, body := range collector.Bodies() {
+		logs := &otlpLogs{}
+		require.NoError(t, json.Unmarshal([]byte(body), logs))
+		records = append(records, logs.ResourceLogs[0].ScopeLogs[0].LogRecords...)
+	}
+	require.Len(t, records, 2)
+
+	record := records[0]
+	assert.Equal(t, "Search", *record.Body.StringValue)
+	assert.Equal(t, "ERROR", record.SeverityText)
+	assert.Equal(t, "1704164645000000000", record.TimeUnixNano)
+	attrs := lo.SliceToMap(record.Attributes, func(kv otlpKeyValue) (string, otlpAnyValue) {
+		return kv.Key, kv.Value
+	})
+	assert.Equal(t, "2", *attrs["nq"].IntValue)
+	assert.Equal(t, 1.5, *attrs["time_cost_ms"].DoubleValue)
+	assert.Equal(t, "p1", *attrs["partition_names"].ArrayValue.Values[0].StringValue)
+	assert.Equal(t, "limit", attrs["search_params"].KvlistValue.Values[0].Key)
+	assert.Equal(t, "10", *attrs["search_params"].KvlistValue.Values[0].Value.IntValue)
+
+	// not a json line
+	assert.Equal(t, "log2", *records[1].Body.StringValue)
+	assert.Empty(t, records[1].Attributes)
+}
+
+func TestHTTPSink_Drop(t *testing.T) {
+	collector := &mockCollector{status: http.StatusInternalServerError}
+	server := httptest.NewServer(collector)
+	defer server.Close()
+
+	sink, err := NewHTTPSink("test", jsonLinesSinkType, server.URL)
+	require.NoError(t, err)
+	_, err = sink.Write([]byte("log1\nlog2\n"))
+	require.NoError(t, err)
+	sink.Close()
+	assert.EqualValues(t, 2, sink.Dropped())
+
+	// failed export does not break the cache writer
+	sink, err = NewHTTPSink("test", jsonLinesSinkType, server.URL)
+	require.NoError(t, err)
+	cw := NewCacheWriterWithCloser(sink, sink, 1024, 0)
+	_, err = cw.Write([]byte("log1\n"))
+	require.NoError(t, err)
+	require.NoError(t, cw.Flush())
+	_, err = cw.Write([]byte("log2\nlog3\n"))
+	require.NoError(t, err)
+	cw.Close()
+	assert.EqualValues(t, 3, sink.Dropped())
+	assert.Len(t, collector.Bodies(), 3)
+}
+
+func TestHTTPSink_InvalidConfig(t *testing.T) {
+	_, err := NewHTTPSink("test", "kafka", "http://localhost")
+	assert.Error(t, err)
+
+	_, err = NewHTTPSink("test", otlpSinkType, "")
+	assert.Error(t, err)
+}
+
+func TestSinkManager(t *testing.T) {
+	m := NewSinkManager()
+	m.Add("all", io.Discard, nil, "", false)
+	m.Add("search", io.Discard, nil, "", false, "Search", "HybridSearch")
+
+	assert.Len(t, m.getByMethod("Search"), 2)
+	assert.Len(t, m.getByMethod("Query"), 1)
+}
+
+func TestAccessLogger_Sink(t *testing.T) {
+	collector := &mockCollector{}
+	server := httptest.NewServer(collector)
+	defer server.Close()
+
+	var Params paramtable.ComponentParam
+	Params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+	Params.Save(Params.ProxyCfg.AccessLog.Enable.Key, "true")
+	Params.Save(Params.ProxyCfg.AccessLog.Filename.Key, "")
+	Params.Save(Params.ProxyCfg.AccessLog.CacheSize.Key, "1024")
+	Params.Save(Params.ProxyCfg.AccessLog.CacheFlushInterval.Key, "0")
+	Params.SaveGroup(map[string]string{
+		Params.ProxyCfg.AccessLog.Formatter.KeyPrefix + "structured.type": "json",
+		Params.ProxyCfg.AccessLog.Sinks.KeyPrefix + "siem.type":           "jsonl",
+		Params.ProxyCfg.AccessLog.Sinks.KeyPrefix + "siem.url":            server.URL,
+		Params.ProxyCfg.AccessLog.Sinks.KeyPrefix + "siem.methods":        "Search",
+		Params.ProxyCfg.AccessLog.Sinks.KeyPrefix + "siem.formatter":      "structured",
+	})
+
+	logger := NewAccessLogger()
+	require.NoError(t, logger.Init(&Params))
+
+	req := &milvuspb.SearchRequest{DbName: "db", CollectionName: "test", Nq: 2}
+	for _, method := range []string{"Search", "Query"} {
+		accessInfo := info.NewGrpcAccessInfo(context.Background(), &grpc.UnaryServerInfo{FullMethod: method}, req)
+		accessInfo.SetResult(nil, nil)
+		assert.True(t, logger.Write(accessInfo))
+	}
+	require.NoError(t, logger.SetEnable(false))
+
+	bodies := collector.Bodies()
+	require.Len(t, bodies, 1)
+	fields := map[string]any{}
+	require.NoError(t, json.Unmarshal([]byte(bodies[0]), &fields))
+	assert.Equal(t, "Search", fields["method_name"])
+	assert.Equal(t, "test", fields["collection_name"])
+	assert.EqualValues(t, 2, fields["nq"])
+}
+
+func TestAccessLogger_SinkInitFailed(t *testing.T) {
+	var Params paramtable.ComponentParam
+	Params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+	Params.Save(Params.ProxyCfg.AccessLog.Enable.Key, "true")
+	Params.Save(Params.ProxyCfg.AccessLog.Filename.Key, "")
+	Params.SaveGroup(map[string]string{
+		Params.ProxyCfg.AccessLog.Sinks.KeyPrefix + "siem.type":      "jsonl",
+		Params.ProxyCfg.AccessLog.Sinks.KeyPrefix + "siem.url":       "http://localhost",
+		Params.ProxyCfg.AccessLog.Sinks.KeyPrefix + "siem.cacheSize": "invalid",
+	})
+
+	logger := NewAccessLogger()
+	assert.Error(t, logger.Init(&Params))
+
+	Params.SaveGroup(map[string]string{
+		Params.ProxyCfg.AccessLog.Sinks.KeyPrefix + "siem.cacheSize": "10",
+		Params.ProxyCfg.AccessLog.Sinks.KeyPrefix + "invalid":        "jsonl",
+	})
+	assert.Error(t, logger.Init(&Params))
+
+	// otlp sink requires json formatter
+	otlpParams := newOTLPSinkParams("http://localhost")
+	otlpParams.SaveGroup(map[string]string{
+		otlpParams.ProxyCfg.AccessLog.Formatter.KeyPrefix + "text.format": "$method_name",
+		otlpParams.ProxyCfg.AccessLog.Sinks.KeyPrefix + "siem.formatter":  "text",
+	})
+	assert.Error(t, logger.Init(otlpParams))
+}
+
+func newOTLPSinkParams(url string) *paramtable.ComponentParam {
+	Params := &paramtable.ComponentParam{}
+	Params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+	Params.Save(Params.ProxyCfg.AccessLog.Enable.Key, "true")
+	Params.Save(Params.ProxyCfg.AccessLog.Filename.Key, "")
+	Params.SaveGroup(map[string]string{
+		Params.ProxyCfg.AccessLog.Sinks.KeyPrefix + "siem.type": "otlp",
+		Params.ProxyCfg.AccessLog.Sinks.KeyPrefix + "siem.url":  url,
+	})
+	return Params
+}
+
+func TestAccessLogger_ReloadFailedKeepsOld(t *testing.T) {
+	collector := &mockCollector{}
+	server := httptest.NewServer(collector)
+	defer server.Close()
+
+	params := newOTLPSinkParams(server.URL)
+	logger := NewAccessLogger()
+	require.NoError(t, logger.Init(params))
+	writer, sinks := logger.writer, logger.sinks
+
+	// invalid sink config, the logger keeps the old writer and sinks
+	invalidParams := newOTLPSinkParams(server.URL)
+	invalidParams.SaveGroup(map[string]string{invalidParams.ProxyCfg.AccessLog.Sinks.KeyPrefix + "siem.cacheSize": "invalid"})
+	assert.Error(t, logger.Init(invalidParams))
+	assert.Same(t, sinks, logger.sinks)
+	assert.Equal(t, writer, logger.writer)
+
+	require.NoError(t, logger.Init(params))
+	assert.NotSame(t, sinks, logger.sinks)
+
+	accessInfo := info.NewGrpcAccessInfo(context.Background(), &grpc.UnaryServerInfo{FullMethod: "Search"}, &milvuspb.SearchRequest{CollectionName: "test"})
+	accessInfo.SetResult(nil, nil)
+	assert.True(t, logger.Write(accessInfo))
+	logger.sinks.Close()
+
+	bodies := collector.Bodies()
+	require.Len(t, bodies, 1)
+	logs := &otlpLogs{}
+	require.NoError(t, json.Unmarshal([]byte(bodies[0]), logs))
+	record := logs.ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+	assert.Equal(t, "Search", *record.Body.StringValue)
+	assert.Contains(t, lo.Map(record.Attributes, func(kv otlpKeyValue, _ int) string { return kv.Key }), "collection_name")
+}
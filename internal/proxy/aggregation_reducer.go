@@ -0,0 +1,46 @@
+package proxy
+
+import (
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/reduce"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// aggregationReducer merges the partial aggregation results of the shards into the aggregates
+// of each group, and applies the offset and limit of the query to the groups.
+type aggregationReducer struct {
+	params         *queryParams
+	req            *internalpb.RetrieveRequest
+	schema         *schemapb.CollectionSchema
+	collectionName string
+}
+
+func (r *aggregationReducer) Reduce(results []*internalpb.RetrieveResults) (*milvuspb.QueryResults, error) {
+	aggregator, err := reduce.NewAggregator(r.schema, r.req.GetGroupByFieldIDs(), r.req.GetAggregates(),
+		paramtable.Get().QuotaConfig.MaxAggregationGroupNum.GetAsInt64())
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range results {
+		if err := aggregator.Merge(result.GetFieldsData()); err != nil {
+			return nil, err
+		}
+	}
+	return &milvuspb.QueryResults{
+		Status:         merr.Success(),
+		CollectionName: r.collectionName,
+		FieldsData:     aggregator.Results(r.params.offset, r.params.limit),
+	}, nil
+}
+
+func newAggregationReducer(params *queryParams, req *internalpb.RetrieveRequest, schema *schemapb.CollectionSchema, collectionName string) *aggregationReducer {
+	return &aggregationReducer{
+		params:         params,
+		req:            req,
+		schema:         schema,
+		collectionName: collectionName,
+	}
+}
@@ -42,9 +42,11 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	"github.com/milvus-io/milvus/internal/proxy/connection"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/ctokenizer"
+	"github.com/milvus-io/milvus/internal/util/exprutil"
 	"github.com/milvus-io/milvus/internal/util/hookutil"
 	"github.com/milvus-io/milvus/internal/util/streamingutil"
 	"github.com/milvus-io/milvus/pkg/v2/common"
@@ -6741,6 +6743,267 @@ func (node *Proxy) ListImports(ctx context.Context, req *internalpb.ListImportsR
 	return resp, nil
 }
 
+func (node *Proxy) ExportV2(ctx context.Context, req *internalpb.ExportRequest) (*internalpb.ExportResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.ExportResponse{Status: merr.Status(err)}, nil
+	}
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("collectionName", req.GetCollectionName()),
+		zap.Strings("partitionNames", req.GetPartitionNames()),
+		zap.String("expr", req.GetExpr()),
+		zap.Strings("outputFields", req.GetOutputFields()),
+		zap.Any("options", req.GetOptions()),
+	)
+	method := "ExportV2"
+	tr := timerecord.NewTimeRecorder(method)
+	log.Info(rpcReceived(method))
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+
+	in, err := node.assembleExportRequest(ctx, req)
+	if err != nil {
+		log.Warn("invalid export request", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+		return &internalpb.ExportResponse{Status: merr.Status(err)}, nil
+	}
+	// flush the collection, so that the rows visible at the export timestamp are in the flushed segments
+	flushResp, err := node.Flush(ctx, &milvuspb.FlushRequest{
+		DbName:          req.GetDbName(),
+		CollectionNames: []string{req.GetCollectionName()},
+	})
+	if err = merr.CheckRPCCall(flushResp, err); err != nil {
+		log.Warn("failed to flush collection before export", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+		return &internalpb.ExportResponse{Status: merr.Status(err)}, nil
+	}
+	in.FlushTs = flushResp.GetCollFlushTs()[req.GetCollectionName()]
+	in.SealedSegmentIDs = flushResp.GetCollSegIDs()[req.GetCollectionName()].GetData()
+	resp, err := node.mixCoord.ExportV2(ctx, in)
+	if resp.GetStatus().GetCode() != 0 || err != nil {
+		log.Warn("export failed", zap.String("reason", resp.GetStatus().GetReason()), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+	} else {
+		log.Info("export job created", zap.String("jobID", resp.GetJobID()))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+	}
+	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return resp, err
+}
+
+// assembleExportRequest resolves the names of the export request into IDs,
+// and validates the output fields and the filter expression against the collection schema.
+func (node *Proxy) assembleExportRequest(ctx context.Context, req *internalpb.ExportRequest) (*internalpb.ExportRequestInternal, error) {
+	if req.GetCollectionName() == "" {
+		return nil, merr.WrapErrParameterMissing("collection_name")
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		return nil, err
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		return nil, err
+	}
+	dbInfo, err := globalMetaCache.GetDatabaseInfo(ctx, req.GetDbName())
+	if err != nil {
+		return nil, err
+	}
+	partitionIDs := make([]int64, 0, len(req.GetPartitionNames()))
+	for _, partitionName := range req.GetPartitionNames() {
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, req.GetDbName(), req.GetCollectionName(), partitionName)
+		if err != nil {
+			return nil, err
+		}
+		partitionIDs = append(partitionIDs, partitionID)
+	}
+	for _, name := range req.GetOutputFields() {
+		if _, err = schema.schemaHelper.GetFieldFromName(name); err != nil {
+			return nil, merr.WrapErrFieldNotFound(name)
+		}
+	}
+	if req.GetExpr() != "" {
+		expr, err := planparserv2.ParseExpr(schema.schemaHelper, req.GetExpr(), nil)
+		if err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg(fmt.Sprintf("failed to parse export expression: %s", err.Error()))
+		}
+		// the datanodes filter the exported rows by the row filter, reject the expressions
+		// it doesn't support before the collection is flushed
+		if _, err = exprutil.NewRowFilter(expr); err != nil {
+			return nil, err
+		}
+	}
+	return &internalpb.ExportRequestInternal{
+		DbID:            dbInfo.dbID,
+		CollectionID:    collectionID,
+		CollectionName:  req.GetCollectionName(),
+		PartitionIDs:    partitionIDs,
+		Schema:          schema.CollectionSchema,
+		Expr:            req.GetExpr(),
+		OutputFields:    req.GetOutputFields(),
+		ExportTimestamp: req.GetExportTimestamp(),
+		OutputPath:      req.GetOutputPath(),
+		Options:         req.GetOptions(),
+	}, nil
+}
+
+func (node *Proxy) GetExportProgress(ctx context.Context, req *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.GetExportProgressResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	log := log.Ctx(ctx).With(
+		zap.String("jobID", req.GetJobID()),
+	)
+	method := "GetExportProgress"
+	tr := timerecord.NewTimeRecorder(method)
+	log.Info(rpcReceived(method))
+
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	resp, err := node.mixCoord.GetExportProgress(ctx, req)
+	if resp.GetStatus().GetCode() != 0 || err != nil {
+		log.Warn("get export progress failed", zap.String("reason", resp.GetStatus().GetReason()), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), "").Inc()
+	} else {
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, req.GetDbName(), "").Inc()
+	}
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, req.GetDbName(), "").Inc()
+	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return resp, err
+}
+
+func (node *Proxy) ListExports(ctx context.Context, req *internalpb.ListExportsRequest) (*internalpb.ListExportsResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.ListExportsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	resp := &internalpb.ListExportsResponse{
+		Status: merr.Success(),
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("dbName", req.GetDbName()),
+		zap.String("collectionName", req.GetCollectionName()),
+	)
+	method := "ListExports"
+	tr := timerecord.NewTimeRecorder(method)
+	log.Info(rpcReceived(method))
+
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+
+	var (
+		err          error
+		dbID         UniqueID
+		collectionID UniqueID
+	)
+	if req.GetCollectionName() != "" {
+		collectionID, err = globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
+	} else {
+		var dbInfo *databaseInfo
+		dbInfo, err = globalMetaCache.GetDatabaseInfo(ctx, req.GetDbName())
+		if err == nil {
+			dbID = dbInfo.dbID
+		}
+	}
+	if err != nil {
+		resp.Status = merr.Status(err)
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+		return resp, nil
+	}
+	resp, err = node.mixCoord.ListExports(ctx, &internalpb.ListExportsRequestInternal{
+		DbID:         dbID,
+		CollectionID: collectionID,
+	})
+	if resp.GetStatus().GetCode() != 0 || err != nil {
+		log.Warn("list exports", zap.String("reason", resp.GetStatus().GetReason()), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+	} else {
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+	}
+	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return resp, nil
+}
+
+func (node *Proxy) CancelExport(ctx context.Context, req *internalpb.CancelExportRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	log := log.Ctx(ctx).With(
+		zap.String("jobID", req.GetJobID()),
+	)
+	method := "CancelExport"
+	tr := timerecord.NewTimeRecorder(method)
+	log.Info(rpcReceived(method))
+
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	status, err := node.mixCoord.CancelExport(ctx, req)
+	if err = merr.CheckRPCCall(status, err); err != nil {
+		log.Warn("cancel export failed", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), "").Inc()
+		return merr.Status(err), nil
+	}
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, req.GetDbName(), "").Inc()
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, req.GetDbName(), "").Inc()
+	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return status, nil
+}
+
+// Explain describes how the filter expression would be executed on the collection, without executing it.
+func (node *Proxy) Explain(ctx context.Context, req *internalpb.ExplainRequest) (*internalpb.ExplainResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-Explain")
+	defer sp.End()
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.ExplainResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	method := "Explain"
+	tr := timerecord.NewTimeRecorder(method)
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()),
+		zap.String("expr", req.GetExpr()),
+	)
+	log.Debug(rpcReceived(method))
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+
+	task := &explainTask{
+		ctx:            ctx,
+		Condition:      NewTaskCondition(ctx),
+		ExplainRequest: req,
+		mixCoord:       node.mixCoord,
+		lb:             node.lbPolicy,
+	}
+
+	if err := node.sched.dqQueue.Enqueue(task); err != nil {
+		log.Warn(rpcFailedToEnqueue(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.AbandonLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+		return &internalpb.ExplainResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	if err := task.WaitToFinish(); err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+		return &internalpb.ExplainResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	log.Debug(rpcDone(method))
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return task.result, nil
+}
+
 // DeregisterSubLabel must add the sub-labels here if using other labels for the sub-labels
 func DeregisterSubLabel(subLabel string) {
 	rateCol.DeregisterSubLabel(internalpb.RateType_DQLQuery.String(), subLabel)
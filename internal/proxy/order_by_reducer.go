@@ -0,0 +1,89 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/reduce"
+	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// orderByReducer merges the results of the shards ordered by the order by fields,
+// and applies the offset and limit of the query.
+type orderByReducer struct {
+	*defaultLimitReducer
+}
+
+func (r *orderByReducer) Reduce(results []*internalpb.RetrieveResults) (*milvuspb.QueryResults, error) {
+	res, err := reduceRetrieveResultsByOrder(r.ctx, results, r.params, r.req.GetOrderByFields())
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := filterSystemFields(r.req.GetOutputFieldsId())
+	if err := typeutil2.FillRetrieveResultIfEmpty(typeutil2.NewMilvusResult(res), filtered, r.schema); err != nil {
+		return nil, fmt.Errorf("failed to fill retrieve results: %s", err.Error())
+	}
+
+	if err := r.afterReduce(res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func reduceRetrieveResultsByOrder(ctx context.Context, retrieveResults []*internalpb.RetrieveResults, queryParams *queryParams,
+	orderByFields []*internalpb.OrderByField,
+) (*milvuspb.QueryResults, error) {
+	log.Ctx(ctx).Debug("reduceRetrieveResultsByOrder", zap.Int("len(retrieveResults)", len(retrieveResults)))
+	ret := &milvuspb.QueryResults{}
+
+	validRetrieveResults := make([]*internalpb.RetrieveResults, 0, len(retrieveResults))
+	for _, r := range retrieveResults {
+		if len(r.GetFieldsData()) == 0 || typeutil.GetSizeOfIDs(r.GetIds()) == 0 {
+			continue
+		}
+		validRetrieveResults = append(validRetrieveResults, r)
+	}
+	if len(validRetrieveResults) == 0 {
+		return ret, nil
+	}
+
+	limit := typeutil.Unlimited
+	if queryParams.limit != typeutil.Unlimited {
+		limit = queryParams.limit + queryParams.offset
+	}
+	rows, err := reduce.SelectOrderedRows(validRetrieveResults, orderByFields, limit)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(rows)) <= queryParams.offset {
+		return ret, nil
+	}
+	rows = rows[queryParams.offset:]
+
+	ret.FieldsData = typeutil.PrepareResultFieldData(validRetrieveResults[0].GetFieldsData(), int64(len(rows)))
+	var retSize int64
+	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
+	for _, row := range rows {
+		retSize += typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[row.Result].GetFieldsData(), row.Offset)
+		// limit retrieve result to avoid oom
+		if retSize > maxOutputSize {
+			return nil, fmt.Errorf("query results exceed the maxOutputSize Limit %d", maxOutputSize)
+		}
+	}
+	return ret, nil
+}
+
+func newOrderByReducer(ctx context.Context, params *queryParams, req *internalpb.RetrieveRequest, schema *schemapb.CollectionSchema, collectionName string) *orderByReducer {
+	return &orderByReducer{
+		defaultLimitReducer: newDefaultLimitReducer(ctx, params, req, schema, collectionName),
+	}
+}
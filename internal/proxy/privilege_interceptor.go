@@ -160,20 +160,9 @@ func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context
 		zap.Int32("object_index", objectNameIndex), zap.String("object_name", objectName),
 		zap.Int32("object_indexs", objectNameIndexs), zap.Strings("object_names", objectNames))
 
-	e := getEnforcer()
 	for _, roleName := range roleNames {
 		permitFunc := func(objectName string) (bool, error) {
-			object := funcutil.PolicyForResource(dbName, objectType, objectName)
-			isPermit, cached, version := GetPrivilegeCache(roleName, object, objectPrivilege)
-			if cached {
-				return isPermit, nil
-			}
-			isPermit, err := e.Enforce(roleName, object, objectPrivilege)
-			if err != nil {
-				return false, err
-			}
-			SetPrivilegeCache(roleName, object, objectPrivilege, isPermit, version)
-			return isPermit, nil
+			return permitPrivilege(roleName, dbName, objectType, objectName, objectPrivilege)
 		}
 
 		if objectNameIndex != 0 {
@@ -218,6 +207,65 @@ func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context
 		fmt.Sprintf("%s: permission deny to %s in the `%s` database", objectPrivilege, username, dbName))
 }
 
+// permitPrivilege checks if the role is granted the privilege on the object.
+func permitPrivilege(roleName, dbName, objectType, objectName, objectPrivilege string) (bool, error) {
+	object := funcutil.PolicyForResource(dbName, objectType, objectName)
+	isPermit, cached, version := GetPrivilegeCache(roleName, object, objectPrivilege)
+	if cached {
+		return isPermit, nil
+	}
+	isPermit, err := getEnforcer().Enforce(roleName, object, objectPrivilege)
+	if err != nil {
+		return false, err
+	}
+	SetPrivilegeCache(roleName, object, objectPrivilege, isPermit, version)
+	return isPermit, nil
+}
+
+// CheckPrivilege checks if the current user is granted the privilege on the object,
+// it's used by the apis whose privilege isn't declared in the request message, such as export.
+func CheckPrivilege(ctx context.Context, objectType string, objectName string, objectPrivilege string) error {
+	if !Params.CommonCfg.AuthorizationEnabled.GetAsBool() {
+		return nil
+	}
+	username, password, err := contextutil.GetAuthInfoFromContext(ctx)
+	if err != nil {
+		log.Ctx(ctx).Warn("GetCurUserFromContext fail", zap.Error(err))
+		return err
+	}
+	if !Params.CommonCfg.RootShouldBindRole.GetAsBool() && username == util.UserRoot {
+		return nil
+	}
+	roleNames, err := GetRole(username)
+	if err != nil {
+		log.Ctx(ctx).Warn("GetRole fail", zap.String("username", username), zap.Error(err))
+		return err
+	}
+	roleNames = append(roleNames, util.RolePublic)
+	dbName := GetCurDBNameFromContextOrDefault(ctx)
+	for _, roleName := range roleNames {
+		permit, err := permitPrivilege(roleName, dbName, objectType, objectName, objectPrivilege)
+		if err != nil {
+			log.Ctx(ctx).Warn("fail to execute permit func", zap.String("name", objectName), zap.Error(err))
+			return err
+		}
+		if permit {
+			return nil
+		}
+	}
+
+	log.Ctx(ctx).Info("permission deny", zap.String("username", username), zap.Strings("roles", roleNames),
+		zap.String("object_type", objectType), zap.String("object_name", objectName),
+		zap.String("object_privilege", objectPrivilege), zap.String("db_name", dbName))
+
+	if password == util.PasswordHolder {
+		username = "apikey user"
+	}
+
+	return status.Error(codes.PermissionDenied,
+		fmt.Sprintf("%s: permission deny to %s in the `%s` database", objectPrivilege, username, dbName))
+}
+
 // isCurUserObject Determine whether it is an Object of type User that operates on its own user information,
 // like updating password or viewing your own role information.
 // make users operate their own user information when the related privileges are not granted.
@@ -630,3 +630,31 @@ func TestBuiltinPrivilegeGroup(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestCheckPrivilege(t *testing.T) {
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+	ctx := GetContext(context.Background(), "fooo:123456")
+	client := &MockMixCoordClientInterface{}
+	mgr := newShardClientMgr()
+	client.listPolicy = func(ctx context.Context, in *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+		return &internalpb.ListPolicyResponse{
+			Status: merr.Success(),
+			PolicyInfos: []string{
+				funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), "col1", util.PrivilegeExport, "default"),
+				funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), "col2", commonpb.ObjectPrivilege_PrivilegeQuery.String(), "default"),
+			},
+			UserRoles: []string{
+				funcutil.EncodeUserRoleCache("fooo", "role1"),
+			},
+		}, nil
+	}
+	InitMetaCache(ctx, client, mgr)
+
+	err := CheckPrivilege(ctx, commonpb.ObjectType_Collection.String(), "col1", util.PrivilegeExport)
+	assert.NoError(t, err)
+	// the query privilege doesn't grant export
+	err = CheckPrivilege(ctx, commonpb.ObjectType_Collection.String(), "col2", util.PrivilegeExport)
+	assert.Error(t, err)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
 )
 
@@ -24,6 +25,16 @@ func Test_createMilvusReducer(t *testing.T) {
 	_, ok := r.(*defaultLimitReducer)
 	assert.True(t, ok)
 
+	req := &internalpb.RetrieveRequest{OrderByFields: []*internalpb.OrderByField{{FieldID: 100}}}
+	r = createMilvusReducer(ctx, nil, req, nil, n, "")
+	_, ok = r.(*orderByReducer)
+	assert.True(t, ok)
+
+	req = &internalpb.RetrieveRequest{GroupByFieldIDs: []int64{100}}
+	r = createMilvusReducer(ctx, nil, req, nil, n, "")
+	_, ok = r.(*aggregationReducer)
+	assert.True(t, ok)
+
 	n.Node.(*planpb.PlanNode_Query).Query.IsCount = true
 	r = createMilvusReducer(ctx, nil, nil, nil, n, "")
 	_, ok = r.(*cntReducer)
@@ -1379,6 +1379,29 @@ func (coord *MixCoordMock) ListImports(ctx context.Context, in *internalpb.ListI
 	}, nil
 }
 
+// exportV2
+func (coord *MixCoordMock) ExportV2(ctx context.Context, in *internalpb.ExportRequestInternal, opts ...grpc.CallOption) (*internalpb.ExportResponse, error) {
+	return &internalpb.ExportResponse{
+		Status: merr.Success(),
+	}, nil
+}
+
+func (coord *MixCoordMock) GetExportProgress(ctx context.Context, in *internalpb.GetExportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetExportProgressResponse, error) {
+	return &internalpb.GetExportProgressResponse{
+		Status: merr.Success(),
+	}, nil
+}
+
+func (coord *MixCoordMock) ListExports(ctx context.Context, in *internalpb.ListExportsRequestInternal, opts ...grpc.CallOption) (*internalpb.ListExportsResponse, error) {
+	return &internalpb.ListExportsResponse{
+		Status: merr.Success(),
+	}, nil
+}
+
+func (coord *MixCoordMock) CancelExport(ctx context.Context, in *internalpb.CancelExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
 func (coord *MixCoordMock) DropIndex(ctx context.Context, req *indexpb.DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return merr.Success(), nil
 }
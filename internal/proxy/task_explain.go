@@ -0,0 +1,307 @@
+package proxy
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/exprutil"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+const ExplainTaskName = "ExplainTask"
+
+// explainTask describes how a filter expression would be executed on a collection, without executing it:
+// the parsed plan, the scalar indexes it can use, the partitions left by partition key pruning and
+// the segments left by the segment pruning of each shard delegator.
+type explainTask struct {
+	baseTask
+	Condition
+	*internalpb.ExplainRequest
+
+	ctx      context.Context
+	mixCoord types.MixCoordClient
+	lb       LBPolicy
+
+	collectionID  UniqueID
+	schema        *schemaInfo
+	plan          *planpb.PlanNode
+	partitionIDs  []UniqueID
+	ignoreGrowing bool
+
+	mu     sync.Mutex
+	result *internalpb.ExplainResponse
+}
+
+func (t *explainTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *explainTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *explainTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *explainTask) Name() string {
+	return ExplainTaskName
+}
+
+func (t *explainTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *explainTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *explainTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *explainTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *explainTask) OnEnqueue() error {
+	if t.Base == nil {
+		t.Base = commonpbutil.NewMsgBase()
+	}
+	t.Base.MsgType = commonpb.MsgType_Retrieve
+	t.Base.SourceID = paramtable.GetNodeID()
+	return nil
+}
+
+func (t *explainTask) PreExecute(ctx context.Context) error {
+	dbName, collectionName := t.GetDbName(), t.GetCollectionName()
+	if err := validateCollectionName(collectionName); err != nil {
+		return err
+	}
+	collID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	if err != nil {
+		return merr.WrapErrAsInputErrorWhen(err, merr.ErrCollectionNotFound, merr.ErrDatabaseNotFound)
+	}
+	t.collectionID = collID
+	t.schema, err = globalMetaCache.GetCollectionSchema(ctx, dbName, collectionName)
+	if err != nil {
+		return err
+	}
+	if t.ignoreGrowing, err = isIgnoreGrowing(t.GetSearchParams()); err != nil {
+		return err
+	}
+
+	t.plan, err = planparserv2.CreateRetrievePlan(t.schema.schemaHelper, t.GetExpr(), nil)
+	if err != nil {
+		return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("failed to create query plan: %v", err))
+	}
+	expr := t.plan.GetQuery().GetPredicates()
+
+	t.result = &internalpb.ExplainResponse{
+		Status: merr.Success(),
+	}
+	if t.result.Plan, err = planparserv2.ShowExprJSON(expr); err != nil {
+		return err
+	}
+	if t.result.FoldedExpr, err = planparserv2.FormatExpr(t.schema.schemaHelper, expr); err != nil {
+		return err
+	}
+	if t.result.ScalarIndexes, err = t.explainScalarIndexes(ctx, expr); err != nil {
+		return err
+	}
+	return t.explainPartitions(ctx, expr)
+}
+
+// explainScalarIndexes returns the scalar indexes which can be used by the exprs of the filter.
+// Indexes of json fields are used only if the json path of the index is the one filtered on.
+func (t *explainTask) explainScalarIndexes(ctx context.Context, expr *planpb.Expr) ([]*internalpb.ExplainedIndex, error) {
+	columns := make([]*planpb.ColumnInfo, 0)
+	collectIndexedColumns(expr, &columns)
+	if len(columns) == 0 {
+		return nil, nil
+	}
+
+	resp, err := t.mixCoord.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{
+		CollectionID: t.collectionID,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		if errors.Is(err, merr.ErrIndexNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	indexes := make([]*internalpb.ExplainedIndex, 0)
+	for _, info := range resp.GetIndexInfos() {
+		field, err := t.schema.schemaHelper.GetFieldFromID(info.GetFieldID())
+		if err != nil || typeutil.IsVectorType(field.GetDataType()) {
+			continue
+		}
+		used := lo.ContainsBy(columns, func(column *planpb.ColumnInfo) bool {
+			if column.GetFieldId() != info.GetFieldID() {
+				return false
+			}
+			if !typeutil.IsJSONType(field.GetDataType()) {
+				return true
+			}
+			jsonPath, _ := funcutil.GetAttrByKeyFromRepeatedKV(common.JSONPathKey, info.GetIndexParams())
+			return jsonPath == planparserv2.NestedPathToJSONPointer(column.GetNestedPath())
+		})
+		if !used {
+			continue
+		}
+		indexType, _ := funcutil.GetAttrByKeyFromRepeatedKV(common.IndexTypeKey, info.GetIndexParams())
+		indexes = append(indexes, &internalpb.ExplainedIndex{
+			FieldName: field.GetName(),
+			FieldID:   field.GetFieldID(),
+			IndexName: info.GetIndexName(),
+			IndexType: indexType,
+		})
+	}
+	return indexes, nil
+}
+
+// explainPartitions resolves the partitions to filter on, which are hashed from the partition keys
+// of the filter in partition key mode.
+func (t *explainTask) explainPartitions(ctx context.Context, expr *planpb.Expr) error {
+	partitionNames := t.GetPartitionNames()
+	partitionKeyMode, err := isPartitionKeyMode(ctx, t.GetDbName(), t.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	if partitionKeyMode {
+		if len(partitionNames) != 0 {
+			return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("not support manually specifying the partition names if partition key mode is used"))
+		}
+		partitionKeys := exprutil.ParseKeys(expr, exprutil.PartitionKey)
+		if len(partitionKeys) > 0 {
+			partitionNames, err = assignPartitionKeys(ctx, t.GetDbName(), t.GetCollectionName(), partitionKeys)
+			if err != nil {
+				return err
+			}
+			partitionNames = lo.Uniq(partitionNames)
+			sort.Strings(partitionNames)
+			t.result.PartitionKeyPruned = true
+		}
+	}
+	t.result.PartitionNames = partitionNames
+	t.partitionIDs, err = getPartitionIDs(ctx, t.GetDbName(), t.GetCollectionName(), partitionNames)
+	return err
+}
+
+func (t *explainTask) Execute(ctx context.Context) error {
+	err := t.lb.Execute(ctx, CollectionWorkLoad{
+		db:             t.GetDbName(),
+		collectionName: t.GetCollectionName(),
+		collectionID:   t.collectionID,
+		nq:             1,
+		exec:           t.explainOnShardLeader,
+	})
+	if errors.Is(err, merr.ErrCollectionNotLoaded) {
+		// the plan is still worth explaining, there are just no segments to estimate on
+		log.Ctx(ctx).Info("collection not loaded, skip explaining segments", zap.Int64("collectionID", t.collectionID))
+		return nil
+	}
+	return err
+}
+
+func (t *explainTask) explainOnShardLeader(ctx context.Context, nodeID int64, qn types.QueryNodeClient, channel string) error {
+	serializedPlan, err := proto.Marshal(t.plan)
+	if err != nil {
+		return err
+	}
+	resp, err := qn.ExplainSegments(ctx, &querypb.ExplainSegmentsRequest{
+		Base:               commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+		Channel:            channel,
+		CollectionID:       t.collectionID,
+		PartitionIDs:       t.partitionIDs,
+		SerializedExprPlan: serializedPlan,
+		IgnoreGrowing:      t.ignoreGrowing,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	// the shard may be retried on another node, keep the latest result only
+	t.result.Shards = lo.Filter(t.result.Shards, func(shard *internalpb.ExplainedShard, _ int) bool {
+		return shard.GetChannel() != channel
+	})
+	t.result.Shards = append(t.result.Shards, &internalpb.ExplainedShard{
+		Channel:           channel,
+		NodeID:            nodeID,
+		SealedSegmentNum:  resp.GetSealedSegmentNum(),
+		PrunedSegmentNum:  resp.GetPrunedSegmentNum(),
+		GrowingSegmentNum: resp.GetGrowingSegmentNum(),
+	})
+	return nil
+}
+
+func (t *explainTask) PostExecute(ctx context.Context) error {
+	sort.Slice(t.result.Shards, func(i, j int) bool {
+		return t.result.Shards[i].GetChannel() < t.result.Shards[j].GetChannel()
+	})
+	return nil
+}
+
+// collectIndexedColumns collects the columns of the exprs which segcore may execute with the scalar indexes.
+// The exprs comparing two columns, on arithmetic results or function calls, and the pattern matches
+// other than prefix match are always executed by brute force.
+func collectIndexedColumns(expr *planpb.Expr, columns *[]*planpb.ColumnInfo) {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_UnaryExpr:
+		collectIndexedColumns(e.UnaryExpr.GetChild(), columns)
+	case *planpb.Expr_BinaryExpr:
+		collectIndexedColumns(e.BinaryExpr.GetLeft(), columns)
+		collectIndexedColumns(e.BinaryExpr.GetRight(), columns)
+	case *planpb.Expr_UnaryRangeExpr:
+		if indexedOps.Contain(e.UnaryRangeExpr.GetOp()) {
+			appendIndexedColumn(e.UnaryRangeExpr.GetColumnInfo(), columns)
+		}
+	case *planpb.Expr_BinaryRangeExpr:
+		appendIndexedColumn(e.BinaryRangeExpr.GetColumnInfo(), columns)
+	case *planpb.Expr_TermExpr:
+		appendIndexedColumn(e.TermExpr.GetColumnInfo(), columns)
+	case *planpb.Expr_JsonContainsExpr:
+		appendIndexedColumn(e.JsonContainsExpr.GetColumnInfo(), columns)
+	}
+}
+
+// indexedOps are the ops of unary range exprs which can be executed by the scalar indexes.
+var indexedOps = typeutil.NewSet(
+	planpb.OpType_GreaterThan,
+	planpb.OpType_GreaterEqual,
+	planpb.OpType_LessThan,
+	planpb.OpType_LessEqual,
+	planpb.OpType_Equal,
+	planpb.OpType_NotEqual,
+	planpb.OpType_PrefixMatch,
+)
+
+// appendIndexedColumn skips the elements of array fields, which are not indexed.
+func appendIndexedColumn(column *planpb.ColumnInfo, columns *[]*planpb.ColumnInfo) {
+	if typeutil.IsArrayType(column.GetDataType()) && len(column.GetNestedPath()) != 0 {
+		return
+	}
+	*columns = append(*columns, column)
+}
@@ -0,0 +1,45 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func TestCollectIndexedColumns(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "meta", DataType: schemapb.DataType_JSON},
+			{FieldID: 103, Name: "name", DataType: schemapb.DataType_VarChar},
+			{FieldID: 104, Name: "tags", DataType: schemapb.DataType_Array, ElementType: schemapb.DataType_Int64},
+		},
+	}
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	require.NoError(t, err)
+
+	collect := func(exprStr string) []int64 {
+		expr, err := planparserv2.ParseExpr(helper, exprStr, nil)
+		require.NoError(t, err, exprStr)
+		columns := make([]*planpb.ColumnInfo, 0)
+		collectIndexedColumns(expr, &columns)
+		fieldIDs := typeutil.NewSet[int64]()
+		for _, column := range columns {
+			fieldIDs.Insert(column.GetFieldId())
+		}
+		return fieldIDs.Collect()
+	}
+
+	assert.ElementsMatch(t, []int64{101, 102, 103}, collect(`(age > 10 or not (name like "a%")) and json_contains(meta["tags"], "x") and pk < age`))
+	assert.ElementsMatch(t, []int64{100, 101}, collect(`pk in [1, 2] and 1 < age <= 10`))
+	assert.ElementsMatch(t, []int64{104}, collect(`array_contains(tags, 1)`))
+	assert.Empty(t, collect(`name like "%a%" or name like "%a" or name like "a_b"`))
+	assert.Empty(t, collect(`pk < age or age + 1 > 10 or length(name) > 3 or tags[0] == 1`))
+}
@@ -185,14 +185,47 @@ func (it *importTask) PreExecute(ctx context.Context) error {
 		return merr.WrapErrImportFailed(fmt.Sprintf("The max number of import files should not exceed %d, but got %d",
 			Params.DataCoordCfg.MaxFilesPerImportReq.GetAsInt(), len(req.Files)))
 	}
+	if _, err = importutilv2.GetPriority(req.GetOptions()); err != nil {
+		return err
+	}
 	if !isBackup && !isL0Import {
+		isTolerant := importutilv2.IsTolerant(req.GetOptions())
+		if isTolerant {
+			if _, err = importutilv2.GetMaxErrorRows(req.GetOptions()); err != nil {
+				return err
+			}
+			if _, err = importutilv2.GetMaxErrorRatio(req.GetOptions()); err != nil {
+				return err
+			}
+		}
+		if importutilv2.HasColumnOptions(req.GetOptions()) {
+			if _, err = importutilv2.ApplyColumnOptions(schema.CollectionSchema, req.GetOptions()); err != nil {
+				return err
+			}
+		}
 		// check file type
 		for _, file := range req.GetFiles() {
-			_, err = importutilv2.GetFileType(file)
+			if importutilv2.HasPatternPath(file) {
+				// prefixes and glob patterns are expanded and checked by datacoord
+				if len(file.GetPaths()) != 1 {
+					return merr.WrapErrImportFailed(fmt.Sprintf("prefix or glob pattern should be the only path of "+
+						"an import file, but got paths=%v", file.GetPaths()))
+				}
+				continue
+			}
+			fileType, err := importutilv2.GetFileType(file)
 			if err != nil {
 				return err
 			}
+			if isTolerant && fileType != importutilv2.JSON && fileType != importutilv2.JSONL && fileType != importutilv2.CSV {
+				return merr.WrapErrImportFailed(fmt.Sprintf("tolerant import only supports JSON, JSON Lines and CSV files, "+
+					"but got %s", fileType))
+			}
 		}
+	} else if importutilv2.IsTolerant(req.GetOptions()) {
+		return merr.WrapErrImportFailed("tolerant import is not supported in backup or l0 import")
+	} else if importutilv2.IsDryRun(req.GetOptions()) {
+		return merr.WrapErrImportFailed("dry-run import is not supported in backup or l0 import")
 	}
 	it.partitionIDs = partitionIDs
 	return nil
@@ -3,6 +3,7 @@ package proxy
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -75,14 +76,26 @@ type queryTask struct {
 	allQueryCnt          int64
 	totalRelatedDataSize int64
 	mustUsePartitionKey  bool
+
+	// the order by fields not in the output fields, which are removed from the results
+	orderByOnlyFieldIDs []int64
 }
 
 type queryParams struct {
-	limit        int64
-	offset       int64
-	reduceType   reduce.IReduceType
-	isIterator   bool
-	collectionID int64
+	limit         int64
+	offset        int64
+	reduceType    reduce.IReduceType
+	isIterator    bool
+	collectionID  int64
+	orderBy       []*orderByItem
+	groupByFields []string
+}
+
+// orderByItem is an item of the order_by query param, e.g. "age desc nulls last".
+type orderByItem struct {
+	fieldName  string
+	ascending  bool
+	nullsFirst bool
 }
 
 // translateToOutputFieldIDs translates output fields name to output fields id.
@@ -204,10 +217,31 @@ func parseQueryParams(queryParamsPair []*commonpb.KeyValuePair) (*queryParams, e
 		}
 	}
 
+	var orderBy []*orderByItem
+	orderByStr, err := funcutil.GetAttrByKeyFromRepeatedKV(OrderByKey, queryParamsPair)
+	if err == nil {
+		orderBy, err = parseOrderBy(orderByStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var groupByFields []string
+	groupByFieldsStr, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupByFieldsKey, queryParamsPair)
+	if err == nil {
+		groupByFields = lo.FilterMap(strings.Split(groupByFieldsStr, ","), func(field string, _ int) (string, bool) {
+			field = strings.TrimSpace(field)
+			return field, field != ""
+		})
+		if len(groupByFields) == 0 {
+			return nil, merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("%s should not be empty", GroupByFieldsKey))
+		}
+	}
+
 	limitStr, err := funcutil.GetAttrByKeyFromRepeatedKV(LimitKey, queryParamsPair)
 	// if limit is not provided
 	if err != nil {
-		return &queryParams{limit: typeutil.Unlimited, reduceType: reduceType, isIterator: isIterator}, nil
+		return &queryParams{limit: typeutil.Unlimited, reduceType: reduceType, isIterator: isIterator, orderBy: orderBy, groupByFields: groupByFields}, nil
 	}
 	limit, err = strconv.ParseInt(limitStr, 0, 64)
 	if err != nil {
@@ -229,18 +263,83 @@ func parseQueryParams(queryParamsPair []*commonpb.KeyValuePair) (*queryParams, e
 	}
 
 	return &queryParams{
-		limit:        limit,
-		offset:       offset,
-		reduceType:   reduceType,
-		isIterator:   isIterator,
-		collectionID: collectionID,
+		limit:         limit,
+		offset:        offset,
+		reduceType:    reduceType,
+		isIterator:    isIterator,
+		collectionID:  collectionID,
+		orderBy:       orderBy,
+		groupByFields: groupByFields,
 	}, nil
 }
 
+// parseOrderBy parses the order_by query param, which is a comma separated list of
+// "field [asc|desc] [nulls first|nulls last]". The results are in ascending order by default,
+// and the nulls are considered larger than any value if the nulls order is not specified,
+// i.e. they are placed last in ascending order and first in descending order.
+func parseOrderBy(value string) ([]*orderByItem, error) {
+	invalidErr := func(item string) error {
+		return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg(
+			"invalid %s item \"%s\", should be \"field [asc|desc] [nulls first|nulls last]\"", OrderByKey, item))
+	}
+	items := make([]*orderByItem, 0)
+	for _, itemStr := range strings.Split(value, ",") {
+		tokens := strings.Fields(itemStr)
+		if len(tokens) == 0 {
+			continue
+		}
+		item := &orderByItem{fieldName: tokens[0], ascending: true}
+		tokens = tokens[1:]
+		if len(tokens) > 0 && (strings.EqualFold(tokens[0], "asc") || strings.EqualFold(tokens[0], "desc")) {
+			item.ascending = strings.EqualFold(tokens[0], "asc")
+			tokens = tokens[1:]
+		}
+		item.nullsFirst = !item.ascending
+		if len(tokens) > 0 {
+			if len(tokens) != 2 || !strings.EqualFold(tokens[0], "nulls") {
+				return nil, invalidErr(itemStr)
+			}
+			switch strings.ToLower(tokens[1]) {
+			case "first":
+				item.nullsFirst = true
+			case "last":
+				item.nullsFirst = false
+			default:
+				return nil, invalidErr(itemStr)
+			}
+		}
+		items = append(items, item)
+	}
+	if len(items) == 0 {
+		return nil, merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("%s should not be empty", OrderByKey))
+	}
+	return items, nil
+}
+
 func matchCountRule(outputs []string) bool {
 	return len(outputs) == 1 && strings.ToLower(strings.TrimSpace(outputs[0])) == "count(*)"
 }
 
+var aggregateRegex = regexp.MustCompile(`^(\w+)\s*\(\s*(\*|[^()\s]+)\s*\)$`)
+
+// parseAggregate parses the aggregate output field, e.g. "sum(price)", ok is false if it's not an aggregate.
+func parseAggregate(outputField string) (op internalpb.AggregateOp, fieldName string, ok bool, err error) {
+	matches := aggregateRegex.FindStringSubmatch(strings.TrimSpace(outputField))
+	if matches == nil {
+		return 0, "", false, nil
+	}
+	op, ok = reduce.ParseAggregateOp(matches[1])
+	if !ok {
+		return 0, "", false, merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("unsupported aggregate function %s", matches[1]))
+	}
+	return op, matches[2], true, nil
+}
+
+// isAggregation returns whether the query groups the results or contains aggregates other than the plain count(*).
+func (t *queryTask) isAggregation() bool {
+	return len(t.RetrieveRequest.GetGroupByFieldIDs()) > 0 || len(t.RetrieveRequest.GetAggregates()) > 0
+}
+
 func createCntPlan(expr string, schemaHelper *typeutil.SchemaHelper, exprTemplateValues map[string]*schemapb.TemplateValue) (*planpb.PlanNode, error) {
 	if expr == "" {
 		return &planpb.PlanNode{
@@ -268,7 +367,7 @@ func (t *queryTask) createPlan(ctx context.Context) error {
 	schema := t.schema
 
 	cntMatch := matchCountRule(t.request.GetOutputFields())
-	if cntMatch {
+	if cntMatch && (t.queryParams == nil || len(t.queryParams.groupByFields) == 0) {
 		var err error
 		t.plan, err = createCntPlan(t.request.GetExpr(), schema.schemaHelper, t.request.GetExprTemplateValues())
 		t.userOutputFields = []string{"count(*)"}
@@ -286,6 +385,18 @@ func (t *queryTask) createPlan(ctx context.Context) error {
 		metrics.ProxyParseExpressionLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), "query", metrics.SuccessLabel).Observe(float64(time.Since(start).Milliseconds()))
 	}
 
+	isAggregation := t.queryParams != nil && len(t.queryParams.groupByFields) > 0
+	for _, outputField := range t.request.GetOutputFields() {
+		_, _, ok, err := parseAggregate(outputField)
+		if err != nil {
+			return err
+		}
+		isAggregation = isAggregation || ok
+	}
+	if isAggregation {
+		return t.resolveAggregation(ctx)
+	}
+
 	t.translatedOutputFields, t.userOutputFields, t.userDynamicFields, _, err = translateOutputFields(t.request.OutputFields, t.schema, false)
 	if err != nil {
 		return err
@@ -306,6 +417,146 @@ func (t *queryTask) createPlan(ctx context.Context) error {
 	return nil
 }
 
+// resolveAggregation resolves the group by fields and the aggregates in the output fields, the output
+// fields other than the aggregates must be the group by fields. Only the group by fields and the
+// aggregate fields are retrieved from the segments, which are aggregated by the querynodes.
+func (t *queryTask) resolveAggregation(ctx context.Context) error {
+	var groupByFields []string
+	if t.queryParams != nil {
+		if t.queryParams.isIterator {
+			return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("aggregation is not supported in query iterator"))
+		}
+		groupByFields = t.queryParams.groupByFields
+	}
+	schemaHelper := t.schema.schemaHelper
+	outputFieldIDs := make([]int64, 0)
+	addOutputField := func(fieldID int64) {
+		if !lo.Contains(outputFieldIDs, fieldID) {
+			outputFieldIDs = append(outputFieldIDs, fieldID)
+		}
+	}
+
+	groupByFieldIDs := make([]int64, 0, len(groupByFields))
+	for _, fieldName := range groupByFields {
+		field, err := schemaHelper.GetFieldFromName(fieldName)
+		if err != nil {
+			return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("group by field %s not found", fieldName))
+		}
+		if !reduce.IsGroupBySupported(field.GetDataType()) || field.GetIsDynamic() {
+			return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("field %s of type %s could not be used to group by",
+				fieldName, field.GetDataType().String()))
+		}
+		if lo.Contains(groupByFieldIDs, field.GetFieldID()) {
+			return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("duplicate group by field %s", fieldName))
+		}
+		groupByFieldIDs = append(groupByFieldIDs, field.GetFieldID())
+		addOutputField(field.GetFieldID())
+	}
+
+	aggregates := make([]*internalpb.Aggregate, 0)
+	userOutputFields := make([]string, 0, len(t.request.GetOutputFields()))
+	for _, outputField := range t.request.GetOutputFields() {
+		op, fieldName, ok, err := parseAggregate(outputField)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fieldName = strings.TrimSpace(outputField)
+			if !lo.Contains(groupByFields, fieldName) {
+				return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg(
+					"output field %s must be a group by field or an aggregate", fieldName))
+			}
+			if !lo.Contains(userOutputFields, fieldName) {
+				userOutputFields = append(userOutputFields, fieldName)
+			}
+			continue
+		}
+
+		aggregate := &internalpb.Aggregate{Op: op}
+		if fieldName != "*" {
+			field, err := schemaHelper.GetFieldFromName(fieldName)
+			if err != nil {
+				return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("aggregate field %s not found", fieldName))
+			}
+			if !reduce.IsAggregateSupported(op, field.GetDataType()) || field.GetIsDynamic() {
+				return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("%s is not supported on field %s of type %s",
+					reduce.AggregateName(op, fieldName), fieldName, field.GetDataType().String()))
+			}
+			aggregate.FieldID = field.GetFieldID()
+			addOutputField(field.GetFieldID())
+		} else if op != internalpb.AggregateOp_AggregateCount {
+			return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("%s is not supported", reduce.AggregateName(op, fieldName)))
+		}
+		name := reduce.AggregateName(op, fieldName)
+		if lo.Contains(userOutputFields, name) {
+			continue
+		}
+		userOutputFields = append(userOutputFields, name)
+		aggregates = append(aggregates, aggregate)
+	}
+	if len(userOutputFields) == 0 {
+		userOutputFields = groupByFields
+	}
+
+	t.userOutputFields = userOutputFields
+	t.RetrieveRequest.OutputFieldsId = outputFieldIDs
+	t.RetrieveRequest.GroupByFieldIDs = groupByFieldIDs
+	t.RetrieveRequest.Aggregates = aggregates
+	t.plan.OutputFieldIds = outputFieldIDs
+	log.Ctx(ctx).Debug("resolve aggregation of query",
+		zap.Int64s("OutputFieldsID", outputFieldIDs),
+		zap.Int64s("groupByFieldIDs", groupByFieldIDs),
+		zap.Strings("userOutputFields", userOutputFields))
+	return nil
+}
+
+// resolveOrderBy resolves the order by fields of the query, the ones not in the output fields
+// are retrieved as well and removed from the results after reduce.
+func (t *queryTask) resolveOrderBy() error {
+	orderBy := t.queryParams.orderBy
+	if len(orderBy) == 0 {
+		return nil
+	}
+	if t.plan.GetQuery().GetIsCount() {
+		return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("order by is not supported in count(*) query"))
+	}
+	if t.isAggregation() {
+		return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("order by is not supported in aggregation query"))
+	}
+	if t.queryParams.isIterator {
+		return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("order by is not supported in query iterator"))
+	}
+
+	orderByFields := make([]*internalpb.OrderByField, 0, len(orderBy))
+	outputFieldIDs := t.RetrieveRequest.GetOutputFieldsId()
+	for _, item := range orderBy {
+		field, err := t.schema.schemaHelper.GetFieldFromName(item.fieldName)
+		if err != nil {
+			return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("order by field %s not found", item.fieldName))
+		}
+		if !reduce.IsOrderBySupported(field.GetDataType()) || field.GetIsDynamic() {
+			return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("field %s of type %s could not be used to order by",
+				item.fieldName, field.GetDataType().String()))
+		}
+		if lo.ContainsBy(orderByFields, func(f *internalpb.OrderByField) bool { return f.GetFieldID() == field.GetFieldID() }) {
+			return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("duplicate order by field %s", item.fieldName))
+		}
+		orderByFields = append(orderByFields, &internalpb.OrderByField{
+			FieldID:    field.GetFieldID(),
+			Ascending:  item.ascending,
+			NullsFirst: item.nullsFirst,
+		})
+		if !lo.Contains(outputFieldIDs, field.GetFieldID()) {
+			outputFieldIDs = append(outputFieldIDs, field.GetFieldID())
+			t.orderByOnlyFieldIDs = append(t.orderByOnlyFieldIDs, field.GetFieldID())
+		}
+	}
+	t.RetrieveRequest.OutputFieldsId = outputFieldIDs
+	t.plan.OutputFieldIds = outputFieldIDs
+	t.RetrieveRequest.OrderByFields = orderByFields
+	return nil
+}
+
 func (t *queryTask) CanSkipAllocTimestamp() bool {
 	var consistencyLevel commonpb.ConsistencyLevel
 	useDefaultConsistency := t.request.GetUseDefaultConsistency()
@@ -421,7 +672,16 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	if err := t.createPlan(ctx); err != nil {
 		return err
 	}
+	if err := t.resolveOrderBy(); err != nil {
+		return err
+	}
 	t.plan.Node.(*planpb.PlanNode_Query).Query.Limit = t.RetrieveRequest.Limit
+	if len(t.RetrieveRequest.GetOrderByFields()) > 0 || t.isAggregation() {
+		// all the matched rows are retrieved from the segments in batches, the order by queries keep the top
+		// rows of each segment and retrieve the other output fields of the top rows by offsets, and the
+		// aggregation queries aggregate the rows of each segment and merge the group states by the reducers
+		t.plan.Node.(*planpb.PlanNode_Query).Query.Limit = typeutil.Unlimited
+	}
 
 	if planparserv2.IsAlwaysTruePlan(t.plan) && t.RetrieveRequest.Limit == typeutil.Unlimited {
 		return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("empty expression should be used with limit"))
@@ -649,8 +909,24 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 		log.Warn("fail to reduce query result", zap.Error(err))
 		return err
 	}
+	if len(t.orderByOnlyFieldIDs) > 0 {
+		t.result.FieldsData = lo.Filter(t.result.FieldsData, func(fieldData *schemapb.FieldData, _ int) bool {
+			return !lo.Contains(t.orderByOnlyFieldIDs, fieldData.GetFieldId())
+		})
+	}
+	if t.isAggregation() {
+		// return the columns in the order of the output fields
+		fieldsData := lo.SliceToMap(t.result.FieldsData, func(fieldData *schemapb.FieldData) (string, *schemapb.FieldData) {
+			return fieldData.GetFieldName(), fieldData
+		})
+		t.result.FieldsData = lo.Map(t.userOutputFields, func(name string, _ int) *schemapb.FieldData {
+			return fieldsData[name]
+		})
+	}
 	t.result.OutputFields = t.userOutputFields
-	reconstructStructFieldData(t.result, t.schema.CollectionSchema)
+	if !t.isAggregation() {
+		reconstructStructFieldData(t.result, t.schema.CollectionSchema)
+	}
 
 	primaryFieldSchema, err := t.schema.GetPkField()
 	if err != nil {
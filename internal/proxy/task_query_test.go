@@ -637,6 +637,128 @@ func TestTaskQuery_functions(t *testing.T) {
 		}
 	})
 
+	t.Run("test parseQueryParams for order by", func(t *testing.T) {
+		ret, err := parseQueryParams([]*commonpb.KeyValuePair{
+			{Key: OrderByKey, Value: "age DESC, name, score asc nulls first,ts desc nulls last"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []*orderByItem{
+			{fieldName: "age", ascending: false, nullsFirst: true},
+			{fieldName: "name", ascending: true, nullsFirst: false},
+			{fieldName: "score", ascending: true, nullsFirst: true},
+			{fieldName: "ts", ascending: false, nullsFirst: false},
+		}, ret.orderBy)
+
+		ret, err = parseQueryParams([]*commonpb.KeyValuePair{
+			{Key: LimitKey, Value: "10"},
+			{Key: OrderByKey, Value: "age"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []*orderByItem{{fieldName: "age", ascending: true}}, ret.orderBy)
+
+		for _, invalid := range []string{"", " , ", "age up", "age desc nulls", "age nulls middle", "age desc nulls first x"} {
+			_, err = parseQueryParams([]*commonpb.KeyValuePair{{Key: OrderByKey, Value: invalid}})
+			assert.ErrorIs(t, err, merr.ErrParameterInvalid, invalid)
+		}
+	})
+
+	t.Run("test reduceRetrieveResultsByOrder", func(t *testing.T) {
+		newResult := func(pks []int64, ages []int64, timestamps []int64) *internalpb.RetrieveResults {
+			return &internalpb.RetrieveResults{
+				Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+				FieldsData: []*schemapb.FieldData{
+					getFieldData("pk", common.StartOfUserFieldID, schemapb.DataType_Int64, pks, 1),
+					getFieldData("age", common.StartOfUserFieldID+1, schemapb.DataType_Int64, ages, 1),
+					getFieldData("ts", common.TimeStampField, schemapb.DataType_Int64, timestamps, 1),
+				},
+			}
+		}
+		results := []*internalpb.RetrieveResults{
+			newResult([]int64{1, 3, 5}, []int64{50, 30, 10}, []int64{1, 1, 1}),
+			newResult([]int64{2, 4}, []int64{40, 20}, []int64{1, 1}),
+		}
+		orderBy := []*internalpb.OrderByField{{FieldID: common.StartOfUserFieldID + 1, Ascending: true}}
+
+		ret, err := reduceRetrieveResultsByOrder(context.Background(), results, &queryParams{limit: 2, offset: 1}, orderBy)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{4, 3}, ret.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []int64{20, 30}, ret.GetFieldsData()[1].GetScalars().GetLongData().GetData())
+
+		ret, err = reduceRetrieveResultsByOrder(context.Background(), results, &queryParams{limit: typeutil.Unlimited}, orderBy)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{5, 4, 3, 2, 1}, ret.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+
+		ret, err = reduceRetrieveResultsByOrder(context.Background(), results, &queryParams{limit: 2, offset: 5}, orderBy)
+		assert.NoError(t, err)
+		assert.Empty(t, ret.GetFieldsData())
+	})
+
+	t.Run("test parseQueryParams for group by", func(t *testing.T) {
+		ret, err := parseQueryParams([]*commonpb.KeyValuePair{
+			{Key: GroupByFieldsKey, Value: "tenant, category"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"tenant", "category"}, ret.groupByFields)
+
+		_, err = parseQueryParams([]*commonpb.KeyValuePair{{Key: GroupByFieldsKey, Value: " , "}})
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("test parseAggregate", func(t *testing.T) {
+		op, fieldName, ok, err := parseAggregate(" SUM( price ) ")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, internalpb.AggregateOp_AggregateSum, op)
+		assert.Equal(t, "price", fieldName)
+
+		op, fieldName, ok, err = parseAggregate("count(*)")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, internalpb.AggregateOp_AggregateCount, op)
+		assert.Equal(t, "*", fieldName)
+
+		_, _, ok, err = parseAggregate("price")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+
+		_, _, _, err = parseAggregate("median(price)")
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("test aggregationReducer", func(t *testing.T) {
+		schema := &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: common.StartOfUserFieldID, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: common.StartOfUserFieldID + 1, Name: "category", DataType: schemapb.DataType_Int64},
+				{FieldID: common.StartOfUserFieldID + 2, Name: "price", DataType: schemapb.DataType_Double},
+			},
+		}
+		req := &internalpb.RetrieveRequest{
+			GroupByFieldIDs: []int64{common.StartOfUserFieldID + 1},
+			Aggregates:      []*internalpb.Aggregate{{Op: internalpb.AggregateOp_AggregateAvg, FieldID: common.StartOfUserFieldID + 2}},
+		}
+		newPartialResult := func(categories []int64, prices []float64) *internalpb.RetrieveResults {
+			aggregator, err := reduce.NewAggregator(schema, req.GetGroupByFieldIDs(), req.GetAggregates(), 10)
+			require.NoError(t, err)
+			require.NoError(t, aggregator.Accumulate([]*schemapb.FieldData{
+				getFieldData("category", common.StartOfUserFieldID+1, schemapb.DataType_Int64, categories, 1),
+				getFieldData("price", common.StartOfUserFieldID+2, schemapb.DataType_Double, prices, 1),
+			}, len(categories)))
+			return &internalpb.RetrieveResults{FieldsData: aggregator.PartialFieldsData()}
+		}
+		results := []*internalpb.RetrieveResults{
+			newPartialResult([]int64{3, 1, 3}, []float64{1, 2, 3}),
+			newPartialResult([]int64{2, 1}, []float64{4, 6}),
+			{},
+		}
+
+		ret, err := newAggregationReducer(&queryParams{limit: 2, offset: 1}, req, schema, "test").Reduce(results)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2, 3}, ret.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, "avg(price)", ret.GetFieldsData()[1].GetFieldName())
+		assert.Equal(t, []float64{4, 2}, ret.GetFieldsData()[1].GetScalars().GetDoubleData().GetData())
+	})
+
 	t.Run("test reduceRetrieveResults", func(t *testing.T) {
 		const (
 			Dim                  = 8
@@ -1087,6 +1209,49 @@ func Test_queryTask_createPlan(t *testing.T) {
 		assert.Nil(t, plan.GetQuery().GetPredicates())
 	})
 
+	t.Run("aggregation", func(t *testing.T) {
+		schema := newSchemaInfo(collSchema)
+		tsk := &queryTask{
+			request: &milvuspb.QueryRequest{
+				OutputFields: []string{"VarCharField", "count(*)", "max(Int64Field)", "avg(DoubleField)", "count(*)"},
+				Expr:         "Int64Field > 0",
+			},
+			RetrieveRequest: &internalpb.RetrieveRequest{},
+			queryParams:     &queryParams{limit: typeutil.Unlimited, groupByFields: []string{"VarCharField"}},
+			schema:          schema,
+		}
+		err := tsk.createPlan(context.TODO())
+		assert.NoError(t, err)
+		assert.True(t, tsk.isAggregation())
+		assert.Equal(t, []string{"VarCharField", "count(*)", "max(Int64Field)", "avg(DoubleField)"}, tsk.userOutputFields)
+		assert.Len(t, tsk.RetrieveRequest.GetAggregates(), 3)
+		assert.Len(t, tsk.RetrieveRequest.GetOutputFieldsId(), 3)
+		assert.Equal(t, tsk.RetrieveRequest.GetOutputFieldsId(), tsk.plan.GetOutputFieldIds())
+
+		for _, invalid := range [][]string{
+			{"Int64Field", "count(*)"},
+			{"sum(VarCharField)"},
+			{"sum(*)"},
+			{"max(NotExist)"},
+		} {
+			tsk := &queryTask{
+				request:         &milvuspb.QueryRequest{OutputFields: invalid, Expr: "Int64Field > 0"},
+				RetrieveRequest: &internalpb.RetrieveRequest{},
+				queryParams:     &queryParams{limit: typeutil.Unlimited, groupByFields: []string{"VarCharField"}},
+				schema:          schema,
+			}
+			assert.Error(t, tsk.createPlan(context.TODO()), invalid)
+		}
+
+		tsk = &queryTask{
+			request:         &milvuspb.QueryRequest{OutputFields: []string{"count(*)"}, Expr: "Int64Field > 0"},
+			RetrieveRequest: &internalpb.RetrieveRequest{},
+			queryParams:     &queryParams{limit: typeutil.Unlimited, groupByFields: []string{"FloatVectorField"}},
+			schema:          schema,
+		}
+		assert.Error(t, tsk.createPlan(context.TODO()))
+	})
+
 	t.Run("query without expression", func(t *testing.T) {
 		schema := newSchemaInfo(collSchema)
 		tsk := &queryTask{
@@ -147,6 +147,65 @@ func (_c *MockQueryNodeServer_DeleteBatch_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// ExplainSegments provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryNodeServer) ExplainSegments(_a0 context.Context, _a1 *querypb.ExplainSegmentsRequest) (*querypb.ExplainSegmentsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExplainSegments")
+	}
+
+	var r0 *querypb.ExplainSegmentsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ExplainSegmentsRequest) (*querypb.ExplainSegmentsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ExplainSegmentsRequest) *querypb.ExplainSegmentsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.ExplainSegmentsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ExplainSegmentsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryNodeServer_ExplainSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExplainSegments'
+type MockQueryNodeServer_ExplainSegments_Call struct {
+	*mock.Call
+}
+
+// ExplainSegments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.ExplainSegmentsRequest
+func (_e *MockQueryNodeServer_Expecter) ExplainSegments(_a0 interface{}, _a1 interface{}) *MockQueryNodeServer_ExplainSegments_Call {
+	return &MockQueryNodeServer_ExplainSegments_Call{Call: _e.mock.On("ExplainSegments", _a0, _a1)}
+}
+
+func (_c *MockQueryNodeServer_ExplainSegments_Call) Run(run func(_a0 context.Context, _a1 *querypb.ExplainSegmentsRequest)) *MockQueryNodeServer_ExplainSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.ExplainSegmentsRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryNodeServer_ExplainSegments_Call) Return(_a0 *querypb.ExplainSegmentsResponse, _a1 error) *MockQueryNodeServer_ExplainSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryNodeServer_ExplainSegments_Call) RunAndReturn(run func(context.Context, *querypb.ExplainSegmentsRequest) (*querypb.ExplainSegmentsResponse, error)) *MockQueryNodeServer_ExplainSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetComponentStates provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryNodeServer) GetComponentStates(_a0 context.Context, _a1 *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
 	ret := _m.Called(_a0, _a1)
@@ -106,6 +106,9 @@ type ShardDelegator interface {
 	// analyzer
 	RunAnalyzer(ctx context.Context, req *querypb.RunAnalyzerRequest) ([]*milvuspb.AnalyzerResult, error)
 
+	// explain
+	ExplainSegments(ctx context.Context, req *querypb.ExplainSegmentsRequest) (*querypb.ExplainSegmentsResponse, error)
+
 	// control
 	Serviceable() bool
 	Start()
@@ -1262,6 +1265,48 @@ func (sd *shardDelegator) RunAnalyzer(ctx context.Context, req *querypb.RunAnaly
 	}), nil
 }
 
+// ExplainSegments estimates how many segments a query with the given filter would touch,
+// applying the same segment pruning as Query without executing anything on the segments.
+func (sd *shardDelegator) ExplainSegments(ctx context.Context, req *querypb.ExplainSegmentsRequest) (*querypb.ExplainSegmentsResponse, error) {
+	if err := sd.lifetime.Add(sd.IsWorking); err != nil {
+		return nil, err
+	}
+	defer sd.lifetime.Done()
+
+	sealed, growing, _, version, err := sd.distribution.PinReadableSegments(
+		paramtable.Get().QueryNodeCfg.PartialResultRequiredDataRatio.GetAsFloat(), req.GetPartitionIDs()...)
+	if err != nil {
+		sd.getLogger(ctx).Warn("delegator failed to explain, current distribution is not serviceable", zap.Error(err))
+		return nil, err
+	}
+	defer sd.distribution.Unpin(version)
+
+	sealedNum := lo.SumBy(sealed, func(item SnapshotItem) int { return len(item.Segments) })
+	if paramtable.Get().QueryNodeCfg.EnableSegmentPrune.GetAsBool() {
+		func() {
+			sd.partitionStatsMut.RLock()
+			defer sd.partitionStatsMut.RUnlock()
+			PruneSegments(ctx, sd.partitionStats, nil, &internalpb.RetrieveRequest{
+				CollectionID:       sd.collectionID,
+				PartitionIDs:       req.GetPartitionIDs(),
+				SerializedExprPlan: req.GetSerializedExprPlan(),
+			}, sd.collection.Schema(), sealed, PruneInfo{paramtable.Get().QueryNodeCfg.DefaultSegmentFilterRatio.GetAsFloat()})
+		}()
+	}
+	remainNum := lo.SumBy(sealed, func(item SnapshotItem) int { return len(item.Segments) })
+
+	growingNum := len(growing)
+	if req.GetIgnoreGrowing() {
+		growingNum = 0
+	}
+	return &querypb.ExplainSegmentsResponse{
+		Status:            merr.Success(),
+		SealedSegmentNum:  int64(sealedNum),
+		PrunedSegmentNum:  int64(sealedNum - remainNum),
+		GrowingSegmentNum: int64(growingNum),
+	}, nil
+}
+
 // PartialResultEvaluator evaluates whether partial results should be returned
 // Parameters:
 //   - taskType: the type of task being executed (Search, Query, etc.)
@@ -140,6 +140,65 @@ func (_c *MockShardDelegator_Collection_Call) RunAndReturn(run func() int64) *Mo
 	return _c
 }
 
+// ExplainSegments provides a mock function with given fields: ctx, req
+func (_m *MockShardDelegator) ExplainSegments(ctx context.Context, req *querypb.ExplainSegmentsRequest) (*querypb.ExplainSegmentsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExplainSegments")
+	}
+
+	var r0 *querypb.ExplainSegmentsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ExplainSegmentsRequest) (*querypb.ExplainSegmentsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ExplainSegmentsRequest) *querypb.ExplainSegmentsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.ExplainSegmentsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ExplainSegmentsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockShardDelegator_ExplainSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExplainSegments'
+type MockShardDelegator_ExplainSegments_Call struct {
+	*mock.Call
+}
+
+// ExplainSegments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *querypb.ExplainSegmentsRequest
+func (_e *MockShardDelegator_Expecter) ExplainSegments(ctx interface{}, req interface{}) *MockShardDelegator_ExplainSegments_Call {
+	return &MockShardDelegator_ExplainSegments_Call{Call: _e.mock.On("ExplainSegments", ctx, req)}
+}
+
+func (_c *MockShardDelegator_ExplainSegments_Call) Run(run func(ctx context.Context, req *querypb.ExplainSegmentsRequest)) *MockShardDelegator_ExplainSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.ExplainSegmentsRequest))
+	})
+	return _c
+}
+
+func (_c *MockShardDelegator_ExplainSegments_Call) Return(_a0 *querypb.ExplainSegmentsResponse, _a1 error) *MockShardDelegator_ExplainSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockShardDelegator_ExplainSegments_Call) RunAndReturn(run func(context.Context, *querypb.ExplainSegmentsRequest) (*querypb.ExplainSegmentsResponse, error)) *MockShardDelegator_ExplainSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetChannelQueryView provides a mock function with no fields
 func (_m *MockShardDelegator) GetChannelQueryView() *channelQueryView {
 	ret := _m.Called()
@@ -0,0 +1,125 @@
+package segments
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/reduce"
+	"github.com/milvus-io/milvus/internal/util/segcore"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/segcorepb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func isAggregationQuery(req *querypb.QueryRequest) bool {
+	return len(req.GetReq().GetGroupByFieldIDs()) > 0 || len(req.GetReq().GetAggregates()) > 0
+}
+
+func newAggregator(req *querypb.QueryRequest, schema *schemapb.CollectionSchema) (*reduce.Aggregator, error) {
+	return reduce.NewAggregator(schema, req.GetReq().GetGroupByFieldIDs(), req.GetReq().GetAggregates(),
+		paramtable.Get().QuotaConfig.MaxAggregationGroupNum.GetAsInt64())
+}
+
+// aggregationReducer merges the partial aggregation results of the workers.
+type aggregationReducer struct {
+	req    *querypb.QueryRequest
+	schema *schemapb.CollectionSchema
+}
+
+func (r *aggregationReducer) Reduce(ctx context.Context, results []*internalpb.RetrieveResults) (*internalpb.RetrieveResults, error) {
+	aggregator, err := newAggregator(r.req, r.schema)
+	if err != nil {
+		return nil, err
+	}
+	ret := &internalpb.RetrieveResults{
+		Status: merr.Success(),
+		Ids:    &schemapb.IDs{},
+	}
+	relatedDataSize := int64(0)
+	for _, result := range results {
+		ret.AllRetrieveCount += result.GetAllRetrieveCount()
+		relatedDataSize += result.GetCostAggregation().GetTotalRelatedDataSize()
+		if err := aggregator.Merge(result.GetFieldsData()); err != nil {
+			return nil, err
+		}
+	}
+	ret.FieldsData = aggregator.PartialFieldsData()
+
+	requestCosts := lo.Map(results, func(result *internalpb.RetrieveResults, _ int) *internalpb.CostAggregation {
+		return result.GetCostAggregation()
+	})
+	ret.CostAggregation = mergeRequestCost(requestCosts)
+	if ret.CostAggregation == nil {
+		ret.CostAggregation = &internalpb.CostAggregation{}
+	}
+	ret.CostAggregation.TotalRelatedDataSize = relatedDataSize
+	return ret, nil
+}
+
+func newAggregationReducer(req *querypb.QueryRequest, schema *schemapb.CollectionSchema) *aggregationReducer {
+	return &aggregationReducer{
+		req:    req,
+		schema: schema,
+	}
+}
+
+// aggregateSegment aggregates the rows of the segment into the partial aggregation results, so that only
+// the group states of the segments are kept for aggregationReducerSegcore. The output fields of plan are
+// retrieved and aggregated in batches, at most a batch of the rows is held at a time.
+func aggregateSegment(ctx context.Context, s Segment, req *querypb.QueryRequest, schema *schemapb.CollectionSchema, offsetsPlan, plan *segcore.RetrievePlan) (*segcorepb.RetrieveResults, error) {
+	aggregator, err := newAggregator(req, schema)
+	if err != nil {
+		return nil, err
+	}
+	result, err := retrieveInBatches(ctx, s, offsetsPlan, plan, func(batch *segcorepb.RetrieveResults) error {
+		return aggregator.Accumulate(batch.GetFieldsData(), len(batch.GetOffset()))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &segcorepb.RetrieveResults{
+		Ids:              &schemapb.IDs{},
+		FieldsData:       aggregator.PartialFieldsData(),
+		AllRetrieveCount: result.GetAllRetrieveCount(),
+		HasMoreResult:    result.GetHasMoreResult(),
+	}, nil
+}
+
+// aggregationReducerSegcore merges the partial aggregation results of the segments.
+type aggregationReducerSegcore struct {
+	req    *querypb.QueryRequest
+	schema *schemapb.CollectionSchema
+}
+
+func (r *aggregationReducerSegcore) Reduce(ctx context.Context, results []*segcorepb.RetrieveResults, _ []Segment, _ *segcore.RetrievePlan) (*segcorepb.RetrieveResults, error) {
+	aggregator, err := newAggregator(r.req, r.schema)
+	if err != nil {
+		return nil, err
+	}
+	ret := &segcorepb.RetrieveResults{
+		Ids: &schemapb.IDs{},
+	}
+	for _, result := range results {
+		ret.AllRetrieveCount += result.GetAllRetrieveCount()
+		if err := aggregator.Merge(result.GetFieldsData()); err != nil {
+			return nil, err
+		}
+	}
+	ret.FieldsData = aggregator.PartialFieldsData()
+	log.Ctx(ctx).Debug("merge segment aggregation results",
+		zap.Int("resultNum", len(results)), zap.Int("groupNum", aggregator.GroupNum()))
+	return ret, nil
+}
+
+func newAggregationReducerSegcore(req *querypb.QueryRequest, schema *schemapb.CollectionSchema) *aggregationReducerSegcore {
+	return &aggregationReducerSegcore{
+		req:    req,
+		schema: schema,
+	}
+}
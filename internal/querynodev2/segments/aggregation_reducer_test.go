@@ -0,0 +1,147 @@
+package segments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks/util/mock_segcore"
+	"github.com/milvus-io/milvus/internal/util/segcore"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/segcorepb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+type AggregationReducerSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+	req    *querypb.QueryRequest
+}
+
+func (suite *AggregationReducerSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *AggregationReducerSuite) SetupTest() {
+	suite.schema = &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.StartOfUserFieldID, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: common.StartOfUserFieldID + 1, Name: "category", DataType: schemapb.DataType_Int64},
+			{FieldID: common.StartOfUserFieldID + 2, Name: "score", DataType: schemapb.DataType_Double},
+		},
+	}
+	suite.req = &querypb.QueryRequest{
+		Req: &internalpb.RetrieveRequest{
+			GroupByFieldIDs: []int64{common.StartOfUserFieldID + 1},
+			Aggregates: []*internalpb.Aggregate{
+				{Op: internalpb.AggregateOp_AggregateCount},
+				{Op: internalpb.AggregateOp_AggregateMax, FieldID: common.StartOfUserFieldID + 2},
+			},
+		},
+	}
+}
+
+func TestAggregationReducerSuite(t *testing.T) {
+	suite.Run(t, new(AggregationReducerSuite))
+}
+
+func (suite *AggregationReducerSuite) genSegcoreResult(pks []int64, categories []int64, scores []float64) *segcorepb.RetrieveResults {
+	return &segcorepb.RetrieveResults{
+		Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+		FieldsData: []*schemapb.FieldData{
+			mock_segcore.GenFieldData("pk", common.StartOfUserFieldID, schemapb.DataType_Int64, pks, 1),
+			mock_segcore.GenFieldData("category", common.StartOfUserFieldID+1, schemapb.DataType_Int64, categories, 1),
+			mock_segcore.GenFieldData("score", common.StartOfUserFieldID+2, schemapb.DataType_Double, scores, 1),
+		},
+		AllRetrieveCount: int64(len(pks)),
+	}
+}
+
+// mockSegment mocks a segment whose rows are retrieved in batches, the offsets of the rows are their indexes.
+func (suite *AggregationReducerSuite) mockSegment(offsetsPlan, plan *segcore.RetrievePlan, pks []int64, categories []int64, scores []float64) Segment {
+	segment := NewMockSegment(suite.T())
+	segment.EXPECT().Retrieve(mock.Anything, offsetsPlan).Return(&segcorepb.RetrieveResults{
+		Offset:           lo.RangeFrom(int64(0), len(pks)),
+		AllRetrieveCount: int64(len(pks)),
+	}, nil)
+	segment.EXPECT().RetrieveByOffsets(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, p *segcore.RetrievePlanWithOffsets) (*segcorepb.RetrieveResults, error) {
+			suite.Same(plan, p.RetrievePlan)
+			suite.LessOrEqual(len(p.Offsets), retrieveBatchSize)
+			result := suite.genSegcoreResult(
+				lo.Map(p.Offsets, func(offset int64, _ int) int64 { return pks[offset] }),
+				lo.Map(p.Offsets, func(offset int64, _ int) int64 { return categories[offset] }),
+				lo.Map(p.Offsets, func(offset int64, _ int) float64 { return scores[offset] }))
+			// the rows retrieved by offsets carry no ids
+			result.Ids = nil
+			return result, nil
+		}).Maybe()
+	return segment
+}
+
+func (suite *AggregationReducerSuite) TestReduce() {
+	offsetsPlan, plan := &segcore.RetrievePlan{}, &segcore.RetrievePlan{}
+	segment1, err := aggregateSegment(context.TODO(), suite.mockSegment(offsetsPlan, plan,
+		[]int64{1, 2, 3}, []int64{10, 20, 10}, []float64{0.1, 0.5, 0.9}), suite.req, suite.schema, offsetsPlan, plan)
+	suite.NoError(err)
+	// category 10 and 20 of the segment
+	suite.Equal([]int64{10, 20}, segment1.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	segment2, err := aggregateSegment(context.TODO(), suite.mockSegment(offsetsPlan, plan,
+		nil, nil, nil), suite.req, suite.schema, offsetsPlan, plan)
+	suite.NoError(err)
+	segment3, err := aggregateSegment(context.TODO(), suite.mockSegment(offsetsPlan, plan,
+		[]int64{4, 5}, []int64{20, 30}, []float64{0.7, 0.2}), suite.req, suite.schema, offsetsPlan, plan)
+	suite.NoError(err)
+
+	segcoreReducer := newAggregationReducerSegcore(suite.req, suite.schema)
+	partial1, err := segcoreReducer.Reduce(context.TODO(), []*segcorepb.RetrieveResults{segment1, segment2}, nil, nil)
+	suite.NoError(err)
+	partial2, err := segcoreReducer.Reduce(context.TODO(), []*segcorepb.RetrieveResults{segment3}, nil, nil)
+	suite.NoError(err)
+
+	res, err := newAggregationReducer(suite.req, suite.schema).Reduce(context.TODO(), []*internalpb.RetrieveResults{
+		{FieldsData: partial1.GetFieldsData(), AllRetrieveCount: partial1.GetAllRetrieveCount()},
+		{FieldsData: partial2.GetFieldsData(), AllRetrieveCount: partial2.GetAllRetrieveCount()},
+	})
+	suite.NoError(err)
+	suite.Equal(int64(5), res.GetAllRetrieveCount())
+	// category, count(*) value and count, max(score) value and count
+	suite.Len(res.GetFieldsData(), 5)
+	suite.ElementsMatch([]int64{10, 20, 30}, res.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+
+	paramtable.Get().Save(paramtable.Get().QuotaConfig.MaxAggregationGroupNum.Key, "2")
+	defer paramtable.Get().Reset(paramtable.Get().QuotaConfig.MaxAggregationGroupNum.Key)
+	_, err = newAggregationReducer(suite.req, suite.schema).Reduce(context.TODO(), []*internalpb.RetrieveResults{
+		{FieldsData: partial1.GetFieldsData()},
+		{FieldsData: partial2.GetFieldsData()},
+	})
+	suite.Error(err)
+}
+
+func (suite *AggregationReducerSuite) TestAggregateLargeSegment() {
+	// the segment is larger than the maxOutputSize, its rows are retrieved and aggregated in batches
+	rowNum := 2*retrieveBatchSize + 10
+	paramtable.Get().Save(paramtable.Get().QuotaConfig.MaxOutputSize.Key, "1024")
+	defer paramtable.Get().Reset(paramtable.Get().QuotaConfig.MaxOutputSize.Key)
+
+	pks := lo.RangeFrom(int64(0), rowNum)
+	categories := lo.Map(pks, func(pk int64, _ int) int64 { return pk % 2 })
+	scores := lo.Map(pks, func(pk int64, _ int) float64 { return float64(pk) })
+	offsetsPlan, plan := &segcore.RetrievePlan{}, &segcore.RetrievePlan{}
+	partial, err := aggregateSegment(context.TODO(), suite.mockSegment(offsetsPlan, plan, pks, categories, scores),
+		suite.req, suite.schema, offsetsPlan, plan)
+	suite.NoError(err)
+	suite.Equal(int64(rowNum), partial.GetAllRetrieveCount())
+
+	res, err := newAggregationReducer(suite.req, suite.schema).Reduce(context.TODO(), []*internalpb.RetrieveResults{
+		{FieldsData: partial.GetFieldsData(), AllRetrieveCount: partial.GetAllRetrieveCount()},
+	})
+	suite.NoError(err)
+	suite.ElementsMatch([]int64{0, 1}, res.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+}
@@ -0,0 +1,299 @@
+package segments
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/reduce"
+	"github.com/milvus-io/milvus/internal/util/segcore"
+	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/segcorepb"
+	"github.com/milvus-io/milvus/pkg/v2/util/conc"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// orderByReducer merges the retrieve results ordered by the order by fields of the request,
+// instead of the primary keys.
+type orderByReducer struct {
+	req    *querypb.QueryRequest
+	schema *schemapb.CollectionSchema
+}
+
+func (r *orderByReducer) Reduce(ctx context.Context, results []*internalpb.RetrieveResults) (*internalpb.RetrieveResults, error) {
+	ret := &internalpb.RetrieveResults{
+		Status: merr.Success(),
+		Ids:    &schemapb.IDs{},
+	}
+	relatedDataSize := int64(0)
+	validResults := make([]*internalpb.RetrieveResults, 0, len(results))
+	for _, result := range results {
+		ret.AllRetrieveCount += result.GetAllRetrieveCount()
+		ret.HasMoreResult = ret.HasMoreResult || result.GetHasMoreResult()
+		relatedDataSize += result.GetCostAggregation().GetTotalRelatedDataSize()
+		if len(result.GetFieldsData()) == 0 || typeutil.GetSizeOfIDs(result.GetIds()) == 0 {
+			continue
+		}
+		validResults = append(validResults, result)
+	}
+
+	if len(validResults) > 0 {
+		rows, err := reduce.SelectOrderedRows(validResults, r.req.GetReq().GetOrderByFields(), r.req.GetReq().GetLimit())
+		if err != nil {
+			return nil, err
+		}
+		ret.FieldsData, err = appendOrderedRows(ret.Ids, validResults, rows)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	requestCosts := lo.Map(results, func(result *internalpb.RetrieveResults, _ int) *internalpb.CostAggregation {
+		return result.GetCostAggregation()
+	})
+	ret.CostAggregation = mergeRequestCost(requestCosts)
+	if ret.CostAggregation == nil {
+		ret.CostAggregation = &internalpb.CostAggregation{}
+	}
+	ret.CostAggregation.TotalRelatedDataSize = relatedDataSize
+
+	if err := typeutil2.FillRetrieveResultIfEmpty(typeutil2.NewInternalResult(ret), r.req.GetReq().GetOutputFieldsId(), r.schema); err != nil {
+		return nil, fmt.Errorf("failed to fill internal retrieve results: %s", err.Error())
+	}
+	return ret, nil
+}
+
+func newOrderByReducer(req *querypb.QueryRequest, schema *schemapb.CollectionSchema) *orderByReducer {
+	return &orderByReducer{
+		req:    req,
+		schema: schema,
+	}
+}
+
+// newOrderByRetrievePlan creates the retrieve plan of the first phase of an order by query,
+// which only outputs the primary keys, the timestamps and the order by fields of the matched rows.
+// The other output fields are retrieved by offsets for the selected top rows only when reducing.
+func newOrderByRetrievePlan(col *segcore.CCollection, req *querypb.QueryRequest, schema *schemapb.CollectionSchema) (*segcore.RetrievePlan, error) {
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	outputFieldIDs := []int64{pkField.GetFieldID(), common.TimeStampField}
+	for _, field := range req.GetReq().GetOrderByFields() {
+		if !lo.Contains(outputFieldIDs, field.GetFieldID()) {
+			outputFieldIDs = append(outputFieldIDs, field.GetFieldID())
+		}
+	}
+	return newRetrievePlanWithOutputs(col, req, outputFieldIDs)
+}
+
+// retrieveOrderedSegment retrieves the top limit rows of the segment ordered by the order by fields.
+// The order by fields of the matched rows are retrieved by orderByPlan in batches, and each batch is
+// merged into the top rows selected so far, so at most limit rows and a batch are kept per segment.
+// All the matched rows are kept if the limit is unlimited, which fails once exceeding the maxOutputSize.
+func retrieveOrderedSegment(ctx context.Context, s Segment, req *querypb.QueryRequest, schema *schemapb.CollectionSchema, offsetsPlan, orderByPlan *segcore.RetrievePlan) (*segcorepb.RetrieveResults, error) {
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	limit := req.GetReq().GetLimit()
+	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
+	top := &segcorepb.RetrieveResults{Ids: &schemapb.IDs{}}
+	var topSize int64
+	result, err := retrieveInBatches(ctx, s, offsetsPlan, orderByPlan, func(batch *segcorepb.RetrieveResults) error {
+		pkData, err := typeutil.GetPrimaryFieldData(batch.GetFieldsData(), pkField)
+		if err != nil {
+			return err
+		}
+		batch.Ids = fieldDataToIDs(pkData)
+
+		if limit == typeutil.Unlimited {
+			for i := range batch.GetOffset() {
+				topSize += appendSegmentRow(top, batch, int64(i))
+			}
+			// limit retrieve result to avoid oom
+			if topSize > maxOutputSize {
+				return fmt.Errorf("query results exceed the maxOutputSize Limit %d", maxOutputSize)
+			}
+			return nil
+		}
+
+		candidates := []*segcorepb.RetrieveResults{batch}
+		if len(top.GetOffset()) > 0 {
+			candidates = append(candidates, top)
+		}
+		rows, err := reduce.SelectOrderedRows(candidates, req.GetReq().GetOrderByFields(), limit)
+		if err != nil {
+			return err
+		}
+		top = &segcorepb.RetrieveResults{Ids: &schemapb.IDs{}}
+		for _, row := range rows {
+			appendSegmentRow(top, candidates[row.Result], row.Offset)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	top.AllRetrieveCount = result.GetAllRetrieveCount()
+	top.HasMoreResult = result.GetHasMoreResult()
+	return top, nil
+}
+
+// appendSegmentRow appends the idx-th row of src to dst, and returns the size of the appended fields data.
+func appendSegmentRow(dst, src *segcorepb.RetrieveResults, idx int64) int64 {
+	if dst.FieldsData == nil {
+		dst.FieldsData = typeutil.PrepareResultFieldData(src.GetFieldsData(), 0)
+	}
+	typeutil.AppendPKs(dst.Ids, typeutil.GetPK(src.GetIds(), idx))
+	dst.Offset = append(dst.Offset, src.GetOffset()[idx])
+	return typeutil.AppendFieldData(dst.FieldsData, src.GetFieldsData(), idx)
+}
+
+// fieldDataToIDs converts the primary key field data to the ids.
+func fieldDataToIDs(fieldData *schemapb.FieldData) *schemapb.IDs {
+	switch fieldData.GetType() {
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		return &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: fieldData.GetScalars().GetStringData().GetData()}}}
+	default:
+		return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: fieldData.GetScalars().GetLongData().GetData()}}}
+	}
+}
+
+// orderByReducerSegcore selects the top limit rows of all the segments by the order by fields
+// from the results retrieved by retrieveOrderedSegment, and then retrieves
+// the output fields of the selected rows by offsets with the full retrieve plan.
+type orderByReducerSegcore struct {
+	req     *querypb.QueryRequest
+	schema  *schemapb.CollectionSchema
+	manager *Manager
+}
+
+func (r *orderByReducerSegcore) Reduce(ctx context.Context, results []*segcorepb.RetrieveResults, segments []Segment, plan *segcore.RetrievePlan) (*segcorepb.RetrieveResults, error) {
+	ret := &segcorepb.RetrieveResults{
+		Ids: &schemapb.IDs{},
+	}
+	validResults := make([]*segcorepb.RetrieveResults, 0, len(results))
+	validSegments := make([]Segment, 0, len(results))
+	for i, result := range results {
+		ret.AllRetrieveCount += result.GetAllRetrieveCount()
+		ret.HasMoreResult = ret.HasMoreResult || result.GetHasMoreResult()
+		if len(result.GetFieldsData()) == 0 || typeutil.GetSizeOfIDs(result.GetIds()) == 0 {
+			continue
+		}
+		validResults = append(validResults, result)
+		validSegments = append(validSegments, segments[i])
+	}
+
+	if len(validResults) > 0 {
+		rows, err := reduce.SelectOrderedRows(validResults, r.req.GetReq().GetOrderByFields(), r.req.GetReq().GetLimit())
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			typeutil.AppendPKs(ret.Ids, typeutil.GetPK(validResults[row.Result].GetIds(), row.Offset))
+		}
+		ret.FieldsData, err = r.retrieveByOffsets(ctx, validResults, validSegments, plan, rows)
+		if err != nil {
+			return nil, err
+		}
+		log.Ctx(ctx).Debug("reduce segcore retrieve results by order",
+			zap.Int("resultNum", len(validResults)), zap.Int("selected", len(rows)))
+	}
+
+	if err := typeutil2.FillRetrieveResultIfEmpty(typeutil2.NewSegcoreResults(ret), r.req.GetReq().GetOutputFieldsId(), r.schema); err != nil {
+		return nil, fmt.Errorf("failed to fill segcore retrieve results: %s", err.Error())
+	}
+	return ret, nil
+}
+
+// retrieveByOffsets retrieves the output fields of the selected rows from their segments,
+// and returns the fields data in the order of the rows.
+func (r *orderByReducerSegcore) retrieveByOffsets(ctx context.Context, results []*segcorepb.RetrieveResults, segments []Segment, plan *segcore.RetrievePlan, rows []reduce.RowRef) ([]*schemapb.FieldData, error) {
+	ctx, span := otel.Tracer(typeutil.QueryNodeRole).Start(ctx, "OrderByReducer-RetrieveByOffsets")
+	defer span.End()
+
+	groups := lo.GroupBy(rows, func(row reduce.RowRef) int {
+		return row.Result
+	})
+	segmentResults := make([]*segcorepb.RetrieveResults, len(results))
+	futures := make([]*conc.Future[any], 0, len(groups))
+	for i, rows := range groups {
+		idx, offsets := i, lo.Map(rows, func(row reduce.RowRef, _ int) int64 { return results[i].GetOffset()[row.Offset] })
+		future := GetSQPool().Submit(func() (any, error) {
+			var ret *segcorepb.RetrieveResults
+			var err error
+			if err := doOnSegment(ctx, r.manager, segments[idx], func(ctx context.Context, segment Segment) error {
+				ret, err = segment.RetrieveByOffsets(ctx, &segcore.RetrievePlanWithOffsets{
+					RetrievePlan: plan,
+					Offsets:      offsets,
+				})
+				return err
+			}); err != nil {
+				return nil, err
+			}
+			segmentResults[idx] = ret
+			return nil, nil
+		})
+		futures = append(futures, future)
+	}
+	// Must be BlockOnAll operation here, the plan is freed after the reduce returns.
+	if err := conc.BlockOnAll(futures...); err != nil {
+		return nil, err
+	}
+
+	var fieldsData []*schemapb.FieldData
+	for _, ret := range segmentResults {
+		if len(ret.GetFieldsData()) != 0 {
+			fieldsData = typeutil.PrepareResultFieldData(ret.GetFieldsData(), int64(len(rows)))
+			break
+		}
+	}
+	// the results retrieved by offsets are compacted, use 0,1,2...end
+	cursors := make([]int64, len(segmentResults))
+	var retSize int64
+	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
+	for _, row := range rows {
+		retSize += typeutil.AppendFieldData(fieldsData, segmentResults[row.Result].GetFieldsData(), cursors[row.Result])
+		cursors[row.Result]++
+		// limit retrieve result to avoid oom
+		if retSize > maxOutputSize {
+			return nil, fmt.Errorf("query results exceed the maxOutputSize Limit %d", maxOutputSize)
+		}
+	}
+	return fieldsData, nil
+}
+
+func newOrderByReducerSegcore(req *querypb.QueryRequest, schema *schemapb.CollectionSchema, manager *Manager) *orderByReducerSegcore {
+	return &orderByReducerSegcore{
+		req:     req,
+		schema:  schema,
+		manager: manager,
+	}
+}
+
+// appendOrderedRows appends the primary keys of the selected rows to ids and returns their fields data.
+func appendOrderedRows[T reduce.RetrieveResult](ids *schemapb.IDs, results []T, rows []reduce.RowRef) ([]*schemapb.FieldData, error) {
+	fieldsData := typeutil.PrepareResultFieldData(results[0].GetFieldsData(), int64(len(rows)))
+	var retSize int64
+	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
+	for _, row := range rows {
+		typeutil.AppendPKs(ids, typeutil.GetPK(results[row.Result].GetIds(), row.Offset))
+		retSize += typeutil.AppendFieldData(fieldsData, results[row.Result].GetFieldsData(), row.Offset)
+		// limit retrieve result to avoid oom
+		if retSize > maxOutputSize {
+			return nil, fmt.Errorf("query results exceed the maxOutputSize Limit %d", maxOutputSize)
+		}
+	}
+	return fieldsData, nil
+}
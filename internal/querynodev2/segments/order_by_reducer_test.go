@@ -0,0 +1,184 @@
+package segments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks/util/mock_segcore"
+	"github.com/milvus-io/milvus/internal/util/segcore"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/segcorepb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+type OrderByReducerSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+	req    *querypb.QueryRequest
+}
+
+func (suite *OrderByReducerSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *OrderByReducerSuite) SetupTest() {
+	suite.schema = &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.StartOfUserFieldID, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: common.StartOfUserFieldID + 1, Name: "score", DataType: schemapb.DataType_Double},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+		},
+	}
+	suite.req = &querypb.QueryRequest{
+		Req: &internalpb.RetrieveRequest{
+			Limit:          3,
+			OutputFieldsId: []int64{common.StartOfUserFieldID, common.StartOfUserFieldID + 1, common.TimeStampField},
+			OrderByFields:  []*internalpb.OrderByField{{FieldID: common.StartOfUserFieldID + 1}},
+		},
+	}
+}
+
+func TestOrderByReducerSuite(t *testing.T) {
+	suite.Run(t, new(OrderByReducerSuite))
+}
+
+func (suite *OrderByReducerSuite) genFieldsData(pks []int64, scores []float64, timestamps []int64) []*schemapb.FieldData {
+	return []*schemapb.FieldData{
+		mock_segcore.GenFieldData("pk", common.StartOfUserFieldID, schemapb.DataType_Int64, pks, 1),
+		mock_segcore.GenFieldData("score", common.StartOfUserFieldID+1, schemapb.DataType_Double, scores, 1),
+		mock_segcore.GenFieldData(common.TimeStampFieldName, common.TimeStampField, schemapb.DataType_Int64, timestamps, 1),
+	}
+}
+
+func (suite *OrderByReducerSuite) genIDs(pks []int64) *schemapb.IDs {
+	return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}}
+}
+
+func (suite *OrderByReducerSuite) TestInternalReduce() {
+	results := []*internalpb.RetrieveResults{
+		{
+			Ids:              suite.genIDs([]int64{1, 2, 3}),
+			FieldsData:       suite.genFieldsData([]int64{1, 2, 3}, []float64{0.1, 0.5, 0.9}, []int64{100, 100, 100}),
+			AllRetrieveCount: 3,
+		},
+		{
+			Ids:              suite.genIDs([]int64{4, 1}),
+			FieldsData:       suite.genFieldsData([]int64{4, 1}, []float64{0.7, 0.8}, []int64{100, 200}),
+			AllRetrieveCount: 2,
+		},
+		{},
+	}
+
+	res, err := newOrderByReducer(suite.req, suite.schema).Reduce(context.TODO(), results)
+	suite.NoError(err)
+	suite.Equal([]int64{3, 1, 4}, res.GetIds().GetIntId().GetData())
+	suite.Equal([]float64{0.9, 0.8, 0.7}, res.GetFieldsData()[1].GetScalars().GetDoubleData().GetData())
+	suite.Equal(int64(5), res.GetAllRetrieveCount())
+
+	res, err = newOrderByReducer(suite.req, suite.schema).Reduce(context.TODO(), nil)
+	suite.NoError(err)
+	suite.Len(res.GetFieldsData(), 3)
+	suite.Empty(res.GetFieldsData()[1].GetScalars().GetDoubleData().GetData())
+}
+
+func (suite *OrderByReducerSuite) TestSegcoreReduce() {
+	// the first phase only retrieves the primary keys, the order by fields and the timestamps
+	results := []*segcorepb.RetrieveResults{
+		{
+			Ids:    suite.genIDs([]int64{1, 2, 3}),
+			Offset: []int64{10, 11, 12},
+			FieldsData: []*schemapb.FieldData{
+				mock_segcore.GenFieldData("score", common.StartOfUserFieldID+1, schemapb.DataType_Double, []float64{0.1, 0.5, 0.9}, 1),
+				mock_segcore.GenFieldData(common.TimeStampFieldName, common.TimeStampField, schemapb.DataType_Int64, []int64{100, 100, 100}, 1),
+			},
+		},
+		{
+			Ids:    suite.genIDs([]int64{4, 5}),
+			Offset: []int64{20, 21},
+			FieldsData: []*schemapb.FieldData{
+				mock_segcore.GenFieldData("score", common.StartOfUserFieldID+1, schemapb.DataType_Double, []float64{0.7, 0.2}, 1),
+				mock_segcore.GenFieldData(common.TimeStampFieldName, common.TimeStampField, schemapb.DataType_Int64, []int64{100, 100}, 1),
+			},
+		},
+	}
+	segment1 := NewMockSegment(suite.T())
+	segment1.EXPECT().IsLazyLoad().Return(false).Maybe()
+	segment1.EXPECT().RetrieveByOffsets(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, plan *segcore.RetrievePlanWithOffsets) (*segcorepb.RetrieveResults, error) {
+			suite.Equal([]int64{10, 11}, plan.Offsets)
+			return &segcorepb.RetrieveResults{
+				FieldsData: suite.genFieldsData([]int64{1, 2}, []float64{0.1, 0.5}, []int64{100, 100}),
+			}, nil
+		}).Maybe()
+	segment2 := NewMockSegment(suite.T())
+	segment2.EXPECT().IsLazyLoad().Return(false).Maybe()
+	retrieveCall := segment2.EXPECT().RetrieveByOffsets(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, plan *segcore.RetrievePlanWithOffsets) (*segcorepb.RetrieveResults, error) {
+			suite.Equal([]int64{21}, plan.Offsets)
+			return &segcorepb.RetrieveResults{
+				FieldsData: suite.genFieldsData([]int64{5}, []float64{0.2}, []int64{100}),
+			}, nil
+		}).Maybe()
+	segments := []Segment{segment1, segment2}
+	plan := &segcore.RetrievePlan{}
+
+	suite.req.Req.OrderByFields[0].Ascending = true
+	res, err := newOrderByReducerSegcore(suite.req, suite.schema, nil).Reduce(context.TODO(), results, segments, plan)
+	suite.NoError(err)
+	suite.Equal([]int64{1, 5, 2}, res.GetIds().GetIntId().GetData())
+	suite.Equal([]int64{1, 5, 2}, res.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	suite.Equal([]float64{0.1, 0.2, 0.5}, res.GetFieldsData()[1].GetScalars().GetDoubleData().GetData())
+
+	retrieveCall.Unset()
+	segment2.EXPECT().RetrieveByOffsets(mock.Anything, mock.Anything).Return(nil, errors.New("mock error"))
+	_, err = newOrderByReducerSegcore(suite.req, suite.schema, nil).Reduce(context.TODO(), results, segments, plan)
+	suite.Error(err)
+
+	suite.req.Req.OrderByFields[0].FieldID = 999
+	_, err = newOrderByReducerSegcore(suite.req, suite.schema, nil).Reduce(context.TODO(), results, segments, plan)
+	suite.Error(err)
+}
+
+func (suite *OrderByReducerSuite) TestRetrieveOrderedSegment() {
+	// the segment is larger than the maxOutputSize, its rows are retrieved in batches
+	rowNum := 2*retrieveBatchSize + 10
+	paramtable.Get().Save(paramtable.Get().QuotaConfig.MaxOutputSize.Key, "1024")
+	defer paramtable.Get().Reset(paramtable.Get().QuotaConfig.MaxOutputSize.Key)
+
+	offsetsPlan, orderByPlan := &segcore.RetrievePlan{}, &segcore.RetrievePlan{}
+	segment := NewMockSegment(suite.T())
+	segment.EXPECT().Retrieve(mock.Anything, offsetsPlan).Return(&segcorepb.RetrieveResults{
+		Offset:           lo.RangeFrom(int64(0), rowNum),
+		AllRetrieveCount: int64(rowNum),
+	}, nil)
+	segment.EXPECT().RetrieveByOffsets(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, plan *segcore.RetrievePlanWithOffsets) (*segcorepb.RetrieveResults, error) {
+			suite.Same(orderByPlan, plan.RetrievePlan)
+			suite.LessOrEqual(len(plan.Offsets), retrieveBatchSize)
+			scores := lo.Map(plan.Offsets, func(offset int64, _ int) float64 { return float64(offset) })
+			timestamps := lo.Map(plan.Offsets, func(int64, int) int64 { return 100 })
+			return &segcorepb.RetrieveResults{
+				FieldsData: suite.genFieldsData(plan.Offsets, scores, timestamps),
+			}, nil
+		})
+
+	res, err := retrieveOrderedSegment(context.TODO(), segment, suite.req, suite.schema, offsetsPlan, orderByPlan)
+	suite.NoError(err)
+	suite.ElementsMatch([]int64{int64(rowNum - 1), int64(rowNum - 2), int64(rowNum - 3)}, res.GetOffset())
+	suite.ElementsMatch(res.GetOffset(), res.GetIds().GetIntId().GetData())
+	suite.Equal(int64(rowNum), res.GetAllRetrieveCount())
+
+	// all the rows are kept without limit, which exceed the maxOutputSize
+	suite.req.Req.Limit = typeutil.Unlimited
+	_, err = retrieveOrderedSegment(context.TODO(), segment, suite.req, suite.schema, offsetsPlan, orderByPlan)
+	suite.ErrorContains(err, "exceed the maxOutputSize")
+}
@@ -23,6 +23,12 @@ func CreateInternalReducer(req *querypb.QueryRequest, schema *schemapb.Collectio
 	if req.GetReq().GetIsCount() {
 		return &cntReducer{}
 	}
+	if isAggregationQuery(req) {
+		return newAggregationReducer(req, schema)
+	}
+	if len(req.GetReq().GetOrderByFields()) > 0 {
+		return newOrderByReducer(req, schema)
+	}
 	return newDefaultLimitReducer(req, schema)
 }
 
@@ -34,6 +40,12 @@ func CreateSegCoreReducer(req *querypb.QueryRequest, schema *schemapb.Collection
 	if req.GetReq().GetIsCount() {
 		return &cntReducerSegCore{}
 	}
+	if isAggregationQuery(req) {
+		return newAggregationReducerSegcore(req, schema)
+	}
+	if len(req.GetReq().GetOrderByFields()) > 0 {
+		return newOrderByReducerSegcore(req, schema, manager)
+	}
 	return newDefaultLimitReducerSegcore(req, schema, manager)
 }
 
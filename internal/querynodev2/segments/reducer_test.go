@@ -35,6 +35,16 @@ func (suite *ReducerFactorySuite) TestCreateInternalReducer() {
 	_, suite.ok = suite.ir.(*defaultLimitReducer)
 	suite.True(suite.ok)
 
+	req.Req.OrderByFields = []*internalpb.OrderByField{{FieldID: 100}}
+	suite.ir = CreateInternalReducer(req, nil)
+	_, suite.ok = suite.ir.(*orderByReducer)
+	suite.True(suite.ok)
+
+	req.Req.GroupByFieldIDs = []int64{101}
+	suite.ir = CreateInternalReducer(req, nil)
+	_, suite.ok = suite.ir.(*aggregationReducer)
+	suite.True(suite.ok)
+
 	req.Req.IsCount = true
 
 	suite.ir = CreateInternalReducer(req, nil)
@@ -53,6 +63,16 @@ func (suite *ReducerFactorySuite) TestCreateSegCoreReducer() {
 	_, suite.ok = suite.sr.(*defaultLimitReducerSegcore)
 	suite.True(suite.ok)
 
+	req.Req.OrderByFields = []*internalpb.OrderByField{{FieldID: 100}}
+	suite.sr = CreateSegCoreReducer(req, nil, nil)
+	_, suite.ok = suite.sr.(*orderByReducerSegcore)
+	suite.True(suite.ok)
+
+	req.Req.Aggregates = []*internalpb.Aggregate{{Op: internalpb.AggregateOp_AggregateCount}}
+	suite.sr = CreateSegCoreReducer(req, nil, nil)
+	_, suite.ok = suite.sr.(*aggregationReducerSegcore)
+	suite.True(suite.ok)
+
 	req.Req.IsCount = true
 	suite.sr = CreateSegCoreReducer(req, nil, nil)
 	_, suite.ok = suite.sr.(*cntReducerSegCore)
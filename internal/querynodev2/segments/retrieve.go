@@ -22,12 +22,15 @@ import (
 	"sync"
 
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/util/segcore"
 	"github.com/milvus-io/milvus/internal/util/streamrpc"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/segcorepb"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
@@ -36,6 +39,10 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
+// retrieveBatchSize is the max number of rows retrieved by offsets at a time,
+// when the rows of a segment are retrieved in batches.
+const retrieveBatchSize = 4096
+
 type RetrieveSegmentResult struct {
 	Result  *segcorepb.RetrieveResults
 	Segment Segment
@@ -54,7 +61,42 @@ func retrieveOnSegments(ctx context.Context, mgr *Manager, segments []Segment, s
 		}
 		return false
 	}()
-	plan.SetIgnoreNonPk(!anySegIsLazyLoad && len(segments) > 1 && req.GetReq().GetLimit() != typeutil.Unlimited && plan.ShouldIgnoreNonPk())
+	// the order by fields are required to merge the results ordered by them,
+	// and so are the group by and aggregate fields to aggregate the results
+	plan.SetIgnoreNonPk(!anySegIsLazyLoad && len(segments) > 1 && req.GetReq().GetLimit() != typeutil.Unlimited &&
+		len(req.GetReq().GetOrderByFields()) == 0 && !isAggregationQuery(req) && plan.ShouldIgnoreNonPk())
+
+	retrieve := func(ctx context.Context, s Segment) (*segcorepb.RetrieveResults, error) {
+		return s.Retrieve(ctx, plan)
+	}
+	if !req.GetReq().GetIsCount() && (isAggregationQuery(req) || len(req.GetReq().GetOrderByFields()) > 0) {
+		// the order by and aggregation queries may match all the rows of the segments,
+		// so only the offsets of the matched rows are retrieved at first, and then
+		// the rows are retrieved by offsets in batches to bound the memory usage
+		collection := mgr.Collection.Get(req.GetReq().GetCollectionID())
+		if collection == nil {
+			return nil, merr.WrapErrCollectionNotLoaded(req.GetReq().GetCollectionID())
+		}
+		offsetsPlan, err := newRetrievePlanWithOutputs(collection.GetCCollection(), req, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer offsetsPlan.Delete()
+		if isAggregationQuery(req) {
+			retrieve = func(ctx context.Context, s Segment) (*segcorepb.RetrieveResults, error) {
+				return aggregateSegment(ctx, s, req, collection.Schema(), offsetsPlan, plan)
+			}
+		} else {
+			orderByPlan, err := newOrderByRetrievePlan(collection.GetCCollection(), req, collection.Schema())
+			if err != nil {
+				return nil, err
+			}
+			defer orderByPlan.Delete()
+			retrieve = func(ctx context.Context, s Segment) (*segcorepb.RetrieveResults, error) {
+				return retrieveOrderedSegment(ctx, s, req, collection.Schema(), offsetsPlan, orderByPlan)
+			}
+		}
+	}
 
 	label := metrics.SealedSegmentLabel
 	if segType == commonpb.SegmentState_Growing {
@@ -63,7 +105,7 @@ func retrieveOnSegments(ctx context.Context, mgr *Manager, segments []Segment, s
 
 	retriever := func(ctx context.Context, s Segment) error {
 		tr := timerecord.NewTimeRecorder("retrieveOnSegments")
-		result, err := s.Retrieve(ctx, plan)
+		result, err := retrieve(ctx, s)
 		if err != nil {
 			return err
 		}
@@ -110,6 +152,54 @@ func retrieveOnSegments(ctx context.Context, mgr *Manager, segments []Segment, s
 	return results, nil
 }
 
+// newRetrievePlanWithOutputs creates the retrieve plan of the request which only outputs the given fields.
+func newRetrievePlanWithOutputs(col *segcore.CCollection, req *querypb.QueryRequest, outputFieldIDs []int64) (*segcore.RetrievePlan, error) {
+	planNode := &planpb.PlanNode{}
+	if err := proto.Unmarshal(req.GetReq().GetSerializedExprPlan(), planNode); err != nil {
+		return nil, err
+	}
+	planNode.OutputFieldIds = outputFieldIDs
+	planNode.DynamicFields = nil
+	expr, err := proto.Marshal(planNode)
+	if err != nil {
+		return nil, err
+	}
+	return segcore.NewRetrievePlan(
+		col,
+		expr,
+		req.GetReq().GetMvccTimestamp(),
+		req.GetReq().GetBase().GetMsgID(),
+		req.GetReq().GetConsistencyLevel(),
+		req.GetReq().GetCollectionTtlTimestamps(),
+	)
+}
+
+// retrieveInBatches retrieves the offsets of the rows matched by offsetsPlan, which outputs no fields,
+// then retrieves the output fields of plan by offsets at most retrieveBatchSize rows at a time and
+// passes each batch to fn. The returned result only carries the offsets and the retrieve counts.
+func retrieveInBatches(ctx context.Context, s Segment, offsetsPlan, plan *RetrievePlan, fn func(batch *segcorepb.RetrieveResults) error) (*segcorepb.RetrieveResults, error) {
+	result, err := s.Retrieve(ctx, offsetsPlan)
+	if err != nil {
+		return nil, err
+	}
+	offsets := result.GetOffset()
+	for start := 0; start < len(offsets); start += retrieveBatchSize {
+		batchOffsets := offsets[start:min(start+retrieveBatchSize, len(offsets))]
+		batch, err := s.RetrieveByOffsets(ctx, &segcore.RetrievePlanWithOffsets{
+			RetrievePlan: plan,
+			Offsets:      batchOffsets,
+		})
+		if err != nil {
+			return nil, err
+		}
+		batch.Offset = batchOffsets
+		if err := fn(batch); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
 func retrieveOnSegmentsWithStream(ctx context.Context, mgr *Manager, segments []Segment, segType SegmentType, plan *RetrievePlan, svr streamrpc.QueryStreamServer) error {
 	var (
 		errs = make([]error, len(segments))
@@ -485,6 +485,19 @@ func (node *QueryNode) Init() error {
 			initError = err
 			return
 		}
+		if diskCacheCfg := &paramtable.Get().QueryNodeCfg.DiskCache; diskCacheCfg.Enabled.GetAsBool() {
+			diskCache, err := storage.NewDiskCache(typeutil.QueryNodeRole,
+				path.Join(localRootPath, "disk_cache", typeutil.QueryNodeRole),
+				diskCacheCfg.Capacity.GetAsInt64(), diskCacheCfg.MaxObjectSize.GetAsInt64())
+			if err != nil {
+				log.Error("QueryNode init disk cache failed", zap.Error(err))
+				initError = err
+				return
+			}
+			node.chunkManager = storage.NewDiskCacheChunkManager(node.chunkManager, diskCache, "", diskCacheCfg.Prefixes.GetAsStrings())
+			log.Info("QueryNode disk cache enabled", zap.String("capacity", diskCacheCfg.Capacity.GetValue()),
+				zap.Strings("prefixes", diskCacheCfg.Prefixes.GetAsStrings()))
+		}
 
 		schedulePolicy := paramtable.Get().QueryNodeCfg.SchedulePolicyName.GetValue()
 		node.scheduler = scheduler.NewScheduler(
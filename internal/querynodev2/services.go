@@ -1598,6 +1598,37 @@ func (node *QueryNode) RunAnalyzer(ctx context.Context, req *querypb.RunAnalyzer
 	}, nil
 }
 
+// ExplainSegments estimates the segments that a filter would be executed on in the shard, without executing it.
+func (node *QueryNode) ExplainSegments(ctx context.Context, req *querypb.ExplainSegmentsRequest) (*querypb.ExplainSegmentsResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("channel", req.GetChannel()),
+	)
+	if err := node.lifetime.Add(merr.IsHealthy); err != nil {
+		return &querypb.ExplainSegmentsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	defer node.lifetime.Done()
+
+	sd, ok := node.delegators.Get(req.GetChannel())
+	if !ok {
+		err := merr.WrapErrChannelNotFound(req.GetChannel())
+		log.Warn("ExplainSegments failed, failed to get shard delegator", zap.Error(err))
+		return &querypb.ExplainSegmentsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	resp, err := sd.ExplainSegments(ctx, req)
+	if err != nil {
+		log.Warn("failed to explain segments on delegator", zap.Error(err))
+		return &querypb.ExplainSegmentsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	return resp, nil
+}
+
 type deleteRequestStringer struct {
 	*querypb.DeleteRequest
 }
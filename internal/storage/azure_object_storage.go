@@ -160,7 +160,7 @@ func (AzureObjectStorage *AzureObjectStorage) WalkWithObjects(ctx context.Contex
 				return err
 			}
 			for _, blob := range pageResp.Segment.BlobItems {
-				if !walkFunc(&ChunkObjectInfo{FilePath: *blob.Name, ModifyTime: *blob.Properties.LastModified}) {
+				if !walkFunc(&ChunkObjectInfo{FilePath: *blob.Name, ModifyTime: *blob.Properties.LastModified, Size: getBlobSize(blob)}) {
 					return nil
 				}
 			}
@@ -176,7 +176,7 @@ func (AzureObjectStorage *AzureObjectStorage) WalkWithObjects(ctx context.Contex
 			}
 
 			for _, blob := range pageResp.Segment.BlobItems {
-				if !walkFunc(&ChunkObjectInfo{FilePath: *blob.Name, ModifyTime: *blob.Properties.LastModified}) {
+				if !walkFunc(&ChunkObjectInfo{FilePath: *blob.Name, ModifyTime: *blob.Properties.LastModified, Size: getBlobSize(blob)}) {
 					return nil
 				}
 			}
@@ -194,3 +194,10 @@ func (AzureObjectStorage *AzureObjectStorage) RemoveObject(ctx context.Context,
 	_, err := AzureObjectStorage.Client.NewContainerClient(bucketName).NewBlockBlobClient(objectName).Delete(ctx, &blob.DeleteOptions{})
 	return checkObjectStorageError(objectName, err)
 }
+
+func getBlobSize(blob *container.BlobItem) int64 {
+	if blob.Properties == nil || blob.Properties.ContentLength == nil {
+		return 0
+	}
+	return *blob.Properties.ContentLength
+}
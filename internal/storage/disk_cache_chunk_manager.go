@@ -0,0 +1,547 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/util/conc"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+var (
+	crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+	errDiskCacheChecksumMismatch = errors.New("checksum mismatch")
+)
+
+// DiskCache is a size-bounded LRU cache of the remote objects on the local disk.
+// It may be shared by several chunk managers, whose objects are distinguished by namespaces.
+type DiskCache struct {
+	name          string
+	dir           string
+	capacity      int64
+	maxObjectSize int64
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List // front is the most recently used
+	size    int64
+
+	// generation increases on every invalidation, the generations of the invalidated keys and prefixes
+	// are recorded while there are loads in flight, so that a load overlapping the invalidation of its key
+	// is not cached since it may be stale, and the loads of the other keys are not affected.
+	generation        uint64
+	keyGenerations    map[string]uint64
+	prefixGenerations map[string]uint64
+	loadingKeys       map[string]int
+
+	fileSeq atomic.Uint64
+	loading conc.Singleflight[[]byte]
+}
+
+type diskCacheEntry struct {
+	key      string
+	file     string
+	size     int64
+	checksum uint32
+	verified bool
+
+	// refs is the number of the readers of the local file, the file of the
+	// evicted entry is removed when the last reader is done.
+	refs    int
+	evicted bool
+}
+
+// NewDiskCache creates a DiskCache under dir, the files left by the previous process are cleared.
+func NewDiskCache(name string, dir string, capacity int64, maxObjectSize int64) (*DiskCache, error) {
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, merr.WrapErrIoFailed(dir, err)
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, merr.WrapErrIoFailed(dir, err)
+	}
+	metrics.DiskCacheSize.WithLabelValues(name).Set(0)
+	return &DiskCache{
+		name:              name,
+		dir:               dir,
+		capacity:          capacity,
+		maxObjectSize:     maxObjectSize,
+		entries:           make(map[string]*list.Element),
+		lru:               list.New(),
+		keyGenerations:    make(map[string]uint64),
+		prefixGenerations: make(map[string]uint64),
+		loadingKeys:       make(map[string]int),
+	}, nil
+}
+
+// Size returns the bytes of the cached objects.
+func (c *DiskCache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
+
+func (c *DiskCache) acquire(key string) *diskCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		metrics.DiskCacheAccessCounter.WithLabelValues(c.name, metrics.CacheMissLabel).Inc()
+		return nil
+	}
+	metrics.DiskCacheAccessCounter.WithLabelValues(c.name, metrics.CacheHitLabel).Inc()
+	c.lru.MoveToFront(elem)
+	entry := elem.Value.(*diskCacheEntry)
+	entry.refs++
+	return entry
+}
+
+func (c *DiskCache) release(entry *diskCacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry.refs--
+	if entry.refs == 0 && entry.evicted {
+		c.removeFile(entry)
+	}
+}
+
+// read reads the whole local file of the entry and validates its checksum.
+func (c *DiskCache) read(entry *diskCacheEntry) ([]byte, error) {
+	data, err := ReadFile(entry.file)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) != entry.size || crc32.Checksum(data, crc32cTable) != entry.checksum {
+		return nil, errDiskCacheChecksumMismatch
+	}
+	c.mu.Lock()
+	entry.verified = true
+	c.mu.Unlock()
+	return data, nil
+}
+
+// verify validates the checksum of the entry if it has not been validated since cached,
+// so that the partial reads of the entry can be trusted.
+func (c *DiskCache) verify(entry *diskCacheEntry) error {
+	c.mu.Lock()
+	verified := entry.verified
+	c.mu.Unlock()
+	if verified {
+		return nil
+	}
+	_, err := c.read(entry)
+	return err
+}
+
+// getOrLoad returns the object of key from the local disk, or loads it by load and caches it.
+func (c *DiskCache) getOrLoad(key string, load func() ([]byte, error)) ([]byte, error) {
+	if entry := c.acquire(key); entry != nil {
+		data, err := c.read(entry)
+		c.release(entry)
+		if err == nil {
+			return data, nil
+		}
+		log.Warn("failed to read the object from disk cache, fallback to remote storage",
+			zap.String("cache", c.name), zap.String("key", key), zap.Error(err))
+		c.invalidate(key)
+	}
+
+	data, err, shared := c.loading.Do(key, func() ([]byte, error) {
+		generation := c.beginLoad(key)
+		defer c.endLoad(key)
+		data, err := load()
+		if err != nil {
+			return nil, err
+		}
+		if err := c.put(key, data, generation); err != nil {
+			log.Warn("failed to put the object into disk cache",
+				zap.String("cache", c.name), zap.String("key", key), zap.Error(err))
+		}
+		return data, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if shared {
+		// the callers may modify the returned content
+		data = append([]byte(nil), data...)
+	}
+	return data, nil
+}
+
+// beginLoad registers a load of key in flight, and returns the current generation.
+func (c *DiskCache) beginLoad(key string) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.loadingKeys[key]++
+	return c.generation
+}
+
+// endLoad unregisters the load of key, the recorded generations are dropped once no load is in flight.
+func (c *DiskCache) endLoad(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.loadingKeys[key]--
+	if c.loadingKeys[key] == 0 {
+		delete(c.loadingKeys, key)
+	}
+	if len(c.loadingKeys) == 0 {
+		c.keyGenerations = make(map[string]uint64)
+		c.prefixGenerations = make(map[string]uint64)
+	}
+}
+
+// isStaleLocked returns true if key has been invalidated since generation.
+func (c *DiskCache) isStaleLocked(key string, generation uint64) bool {
+	if c.keyGenerations[key] > generation {
+		return true
+	}
+	for prefix, g := range c.prefixGenerations {
+		if g > generation && strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// put writes the object into a local file and adds it to the cache, it's a no-op if the object
+// is too large or the key has been invalidated since generation.
+func (c *DiskCache) put(key string, data []byte, generation uint64) error {
+	size := int64(len(data))
+	if size > c.maxObjectSize || size > c.capacity {
+		return nil
+	}
+	file := filepath.Join(c.dir, fmt.Sprintf("%d", c.fileSeq.Inc()))
+	tmpFile := file + ".tmp"
+	if err := WriteFile(tmpFile, data, 0o600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFile, file); err != nil {
+		os.Remove(tmpFile)
+		return merr.WrapErrIoFailed(file, err)
+	}
+	entry := &diskCacheEntry{
+		key:      key,
+		file:     file,
+		size:     size,
+		checksum: crc32.Checksum(data, crc32cTable),
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.isStaleLocked(key, generation) {
+		c.removeFile(entry)
+		return nil
+	}
+	if elem, ok := c.entries[key]; ok {
+		c.evictLocked(elem)
+	}
+	newElem := c.lru.PushFront(entry)
+	c.entries[key] = newElem
+	c.size += size
+	for elem := c.lru.Back(); elem != nil && elem != newElem && c.size > c.capacity; {
+		prev := elem.Prev()
+		// skip the entries being read, so that they are not downloaded again soon
+		if elem.Value.(*diskCacheEntry).refs == 0 {
+			c.evictLocked(elem)
+		}
+		elem = prev
+	}
+	metrics.DiskCacheSize.WithLabelValues(c.name).Set(float64(c.size))
+	return nil
+}
+
+// invalidate evicts the object of key, the readers holding it are not affected.
+func (c *DiskCache) invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	if _, ok := c.loadingKeys[key]; ok {
+		c.keyGenerations[key] = c.generation
+	}
+	if elem, ok := c.entries[key]; ok {
+		c.evictLocked(elem)
+		metrics.DiskCacheSize.WithLabelValues(c.name).Set(float64(c.size))
+	}
+}
+
+// invalidatePrefix evicts all the objects whose keys start with prefix.
+func (c *DiskCache) invalidatePrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	if len(c.loadingKeys) > 0 {
+		c.prefixGenerations[prefix] = c.generation
+	}
+	for key, elem := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			c.evictLocked(elem)
+		}
+	}
+	metrics.DiskCacheSize.WithLabelValues(c.name).Set(float64(c.size))
+}
+
+func (c *DiskCache) evictLocked(elem *list.Element) {
+	entry := elem.Value.(*diskCacheEntry)
+	c.lru.Remove(elem)
+	delete(c.entries, entry.key)
+	c.size -= entry.size
+	entry.evicted = true
+	if entry.refs == 0 {
+		c.removeFile(entry)
+	}
+	metrics.DiskCacheEvictionCounter.WithLabelValues(c.name).Inc()
+}
+
+func (c *DiskCache) removeFile(entry *diskCacheEntry) {
+	if err := os.Remove(entry.file); err != nil && !os.IsNotExist(err) {
+		log.Warn("failed to remove the disk cache file",
+			zap.String("cache", c.name), zap.String("file", entry.file), zap.Error(err))
+	}
+}
+
+// DiskCacheChunkManager is a decorator of the remote ChunkManager, which caches the objects
+// read from the remote storage on the local disk.
+// Only the objects under the configured prefixes are cached, the others are passed through.
+// The objects read by segcore through its own chunk manager, such as the index files, never reach it.
+type DiskCacheChunkManager struct {
+	ChunkManager
+	cache     *DiskCache
+	namespace string
+	prefixes  []string
+}
+
+var _ ChunkManager = (*DiskCacheChunkManager)(nil)
+
+// NewDiskCacheChunkManager wraps cm with the disk cache, the namespace distinguishes the objects of
+// different storages sharing the same cache, and the prefixes are relative to the root path of cm.
+func NewDiskCacheChunkManager(cm ChunkManager, cache *DiskCache, namespace string, prefixes []string) *DiskCacheChunkManager {
+	fullPrefixes := make([]string, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		prefix = strings.TrimSpace(prefix)
+		if prefix == "" {
+			continue
+		}
+		fullPrefixes = append(fullPrefixes, path.Join(cm.RootPath(), prefix))
+	}
+	return &DiskCacheChunkManager{
+		ChunkManager: cm,
+		cache:        cache,
+		namespace:    namespace,
+		prefixes:     fullPrefixes,
+	}
+}
+
+func (dcm *DiskCacheChunkManager) cacheable(filePath string) bool {
+	if len(dcm.prefixes) == 0 {
+		return true
+	}
+	for _, prefix := range dcm.prefixes {
+		if strings.HasPrefix(filePath, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (dcm *DiskCacheChunkManager) cacheKey(filePath string) string {
+	return dcm.namespace + ":" + filePath
+}
+
+// Read reads the object from the disk cache, or from the remote storage if it's not cached.
+func (dcm *DiskCacheChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	if !dcm.cacheable(filePath) {
+		return dcm.ChunkManager.Read(ctx, filePath)
+	}
+	// the load is shared by the concurrent readers, it should not be canceled along with the first one
+	loadCtx := context.WithoutCancel(ctx)
+	return dcm.cache.getOrLoad(dcm.cacheKey(filePath), func() ([]byte, error) {
+		return dcm.ChunkManager.Read(loadCtx, filePath)
+	})
+}
+
+// MultiRead reads the objects one by one through the disk cache.
+func (dcm *DiskCacheChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	results := make([][]byte, len(filePaths))
+	var el error
+	for i, filePath := range filePaths {
+		content, err := dcm.Read(ctx, filePath)
+		if err != nil {
+			el = merr.Combine(el, errors.Wrapf(err, "failed to read %s", filePath))
+		}
+		results[i] = content
+	}
+	return results, el
+}
+
+// ReadAt reads the range of the object from the disk cache, the whole object is cached on miss
+// if it's not larger than the max object size of the cache.
+func (dcm *DiskCacheChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	if !dcm.cacheable(filePath) || off < 0 || length < 0 {
+		return dcm.ChunkManager.ReadAt(ctx, filePath, off, length)
+	}
+	key := dcm.cacheKey(filePath)
+	if entry := dcm.cache.acquire(key); entry != nil {
+		data, err := dcm.readEntryAt(entry, off, length)
+		dcm.cache.release(entry)
+		if err == nil {
+			return data, nil
+		}
+		log.Ctx(ctx).Warn("failed to read the object from disk cache, fallback to remote storage",
+			zap.String("filePath", filePath), zap.Error(err))
+		dcm.cache.invalidate(key)
+	}
+
+	size, err := dcm.ChunkManager.Size(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if size > dcm.cache.maxObjectSize || off+length > size {
+		return dcm.ChunkManager.ReadAt(ctx, filePath, off, length)
+	}
+	loadCtx := context.WithoutCancel(ctx)
+	data, err := dcm.cache.getOrLoad(key, func() ([]byte, error) {
+		return dcm.ChunkManager.Read(loadCtx, filePath)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if off+length > int64(len(data)) {
+		return nil, merr.WrapErrIoFailed(filePath, io.ErrUnexpectedEOF)
+	}
+	return append([]byte(nil), data[off:off+length]...), nil
+}
+
+func (dcm *DiskCacheChunkManager) readEntryAt(entry *diskCacheEntry, off int64, length int64) ([]byte, error) {
+	if off+length > entry.size {
+		return nil, io.ErrUnexpectedEOF
+	}
+	if err := dcm.cache.verify(entry); err != nil {
+		return nil, err
+	}
+	file, err := Open(entry.file)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	data := make([]byte, length)
+	if _, err := file.ReadAt(data, off); err != nil {
+		return nil, merr.WrapErrIoFailed(entry.file, err)
+	}
+	return data, nil
+}
+
+// Reader returns the reader of the local file if the object is cached,
+// otherwise the reader of the remote object, which is not cached.
+func (dcm *DiskCacheChunkManager) Reader(ctx context.Context, filePath string) (FileReader, error) {
+	if !dcm.cacheable(filePath) {
+		return dcm.ChunkManager.Reader(ctx, filePath)
+	}
+	key := dcm.cacheKey(filePath)
+	if entry := dcm.cache.acquire(key); entry != nil {
+		reader, err := dcm.openEntry(entry)
+		if err == nil {
+			return reader, nil
+		}
+		dcm.cache.release(entry)
+		log.Ctx(ctx).Warn("failed to open the object from disk cache, fallback to remote storage",
+			zap.String("filePath", filePath), zap.Error(err))
+		dcm.cache.invalidate(key)
+	}
+	return dcm.ChunkManager.Reader(ctx, filePath)
+}
+
+func (dcm *DiskCacheChunkManager) openEntry(entry *diskCacheEntry) (FileReader, error) {
+	if err := dcm.cache.verify(entry); err != nil {
+		return nil, err
+	}
+	file, err := Open(entry.file)
+	if err != nil {
+		return nil, err
+	}
+	return &diskCacheReader{
+		LocalReader: &LocalReader{File: file},
+		release: func() {
+			dcm.cache.release(entry)
+		},
+	}, nil
+}
+
+// Write writes the object to the remote storage and invalidates the cached one.
+func (dcm *DiskCacheChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	err := dcm.ChunkManager.Write(ctx, filePath, content)
+	dcm.cache.invalidate(dcm.cacheKey(filePath))
+	return err
+}
+
+func (dcm *DiskCacheChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	err := dcm.ChunkManager.MultiWrite(ctx, contents)
+	for filePath := range contents {
+		dcm.cache.invalidate(dcm.cacheKey(filePath))
+	}
+	return err
+}
+
+func (dcm *DiskCacheChunkManager) Remove(ctx context.Context, filePath string) error {
+	err := dcm.ChunkManager.Remove(ctx, filePath)
+	dcm.cache.invalidate(dcm.cacheKey(filePath))
+	return err
+}
+
+func (dcm *DiskCacheChunkManager) MultiRemove(ctx context.Context, filePaths []string) error {
+	err := dcm.ChunkManager.MultiRemove(ctx, filePaths)
+	for _, filePath := range filePaths {
+		dcm.cache.invalidate(dcm.cacheKey(filePath))
+	}
+	return err
+}
+
+func (dcm *DiskCacheChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	err := dcm.ChunkManager.RemoveWithPrefix(ctx, prefix)
+	dcm.cache.invalidatePrefix(dcm.cacheKey(prefix))
+	return err
+}
+
+// diskCacheReader releases the cache entry on close, so that the local file
+// is not removed while being read.
+type diskCacheReader struct {
+	*LocalReader
+	once    sync.Once
+	release func()
+}
+
+func (r *diskCacheReader) Close() error {
+	err := r.LocalReader.Close()
+	r.once.Do(r.release)
+	return err
+}
@@ -0,0 +1,236 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/v2/objectstorage"
+)
+
+// countingChunkManager counts the reads reaching the underlying chunk manager.
+type countingChunkManager struct {
+	ChunkManager
+	mu    sync.Mutex
+	reads int
+}
+
+func (cm *countingChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	cm.mu.Lock()
+	cm.reads++
+	cm.mu.Unlock()
+	return cm.ChunkManager.Read(ctx, filePath)
+}
+
+func (cm *countingChunkManager) readCount() int {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	return cm.reads
+}
+
+func TestDiskCacheChunkManager(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	remote := &countingChunkManager{ChunkManager: NewLocalChunkManager(objectstorage.RootPath(root))}
+	statsPath := path.Join(root, "stats_log", "1")
+	insertPath := path.Join(root, "insert_log", "1")
+	require.NoError(t, remote.Write(ctx, statsPath, []byte("0123456789")))
+	require.NoError(t, remote.Write(ctx, insertPath, []byte("abc")))
+
+	newCM := func(t *testing.T, capacity int64, maxObjectSize int64) (*DiskCacheChunkManager, *DiskCache) {
+		remote.mu.Lock()
+		remote.reads = 0
+		remote.mu.Unlock()
+		cache, err := NewDiskCache("test", path.Join(t.TempDir(), "cache"), capacity, maxObjectSize)
+		require.NoError(t, err)
+		return NewDiskCacheChunkManager(remote, cache, "ns", []string{"stats_log"}), cache
+	}
+
+	t.Run("read through", func(t *testing.T) {
+		cm, cache := newCM(t, 1024, 1024)
+		for i := 0; i < 3; i++ {
+			data, err := cm.Read(ctx, statsPath)
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("0123456789"), data)
+		}
+		assert.Equal(t, 1, remote.readCount())
+		assert.Equal(t, int64(10), cache.Size())
+
+		data, err := cm.ReadAt(ctx, statsPath, 2, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("234"), data)
+
+		reader, err := cm.Reader(ctx, statsPath)
+		require.NoError(t, err)
+		size, err := reader.Size()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), size)
+		data, err = io.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("0123456789"), data)
+		assert.NoError(t, reader.Close())
+		assert.Equal(t, 1, remote.readCount())
+
+		// not under the prefixes
+		_, err = cm.Read(ctx, insertPath)
+		assert.NoError(t, err)
+		_, err = cm.Read(ctx, insertPath)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, remote.readCount())
+		assert.Equal(t, int64(10), cache.Size())
+
+		_, err = cm.Read(ctx, path.Join(root, "stats_log", "not_exist"))
+		assert.Error(t, err)
+	})
+
+	t.Run("read at on miss", func(t *testing.T) {
+		cm, cache := newCM(t, 1024, 1024)
+		data, err := cm.ReadAt(ctx, statsPath, 8, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("89"), data)
+		assert.Equal(t, int64(10), cache.Size())
+		data, err = cm.Read(ctx, statsPath)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("0123456789"), data)
+		assert.Equal(t, 1, remote.readCount())
+
+		// too large to cache
+		cm, cache = newCM(t, 1024, 5)
+		data, err = cm.ReadAt(ctx, statsPath, 8, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("89"), data)
+		assert.Equal(t, int64(0), cache.Size())
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		cm, cache := newCM(t, 1024, 1024)
+		p := path.Join(root, "stats_log", "2")
+		require.NoError(t, cm.Write(ctx, p, []byte("old")))
+		data, err := cm.Read(ctx, p)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("old"), data)
+
+		require.NoError(t, cm.Write(ctx, p, []byte("new")))
+		assert.Equal(t, int64(0), cache.Size())
+		data, err = cm.Read(ctx, p)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("new"), data)
+
+		require.NoError(t, cm.Remove(ctx, p))
+		assert.Equal(t, int64(0), cache.Size())
+		_, err = cm.Read(ctx, p)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalidate during load", func(t *testing.T) {
+		_, cache := newCM(t, 1024, 1024)
+		generation := cache.beginLoad("ns:a")
+		cache.beginLoad("ns:b")
+		cache.beginLoad("ns:prefix/c")
+
+		// the invalidations of the other keys don't affect the load
+		cache.invalidate("ns:other")
+		cache.invalidatePrefix("ns:prefix")
+		assert.NoError(t, cache.put("ns:a", []byte("a"), generation))
+		assert.Equal(t, int64(1), cache.Size())
+
+		cache.invalidate("ns:b")
+		assert.NoError(t, cache.put("ns:b", []byte("b"), generation))
+		assert.NoError(t, cache.put("ns:prefix/c", []byte("c"), generation))
+		assert.Equal(t, int64(1), cache.Size())
+
+		cache.endLoad("ns:a")
+		cache.endLoad("ns:b")
+		cache.endLoad("ns:prefix/c")
+		assert.Empty(t, cache.keyGenerations)
+		assert.Empty(t, cache.prefixGenerations)
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		cm, cache := newCM(t, 1024, 1024)
+		_, err := cm.Read(ctx, statsPath)
+		require.NoError(t, err)
+
+		entry := cache.acquire(cm.cacheKey(statsPath))
+		require.NotNil(t, entry)
+		require.NoError(t, os.WriteFile(entry.file, []byte("9876543210"), 0o600))
+		cache.release(entry)
+
+		data, err := cm.Read(ctx, statsPath)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("0123456789"), data)
+		assert.Equal(t, 2, remote.readCount())
+	})
+
+	t.Run("evict", func(t *testing.T) {
+		cm, cache := newCM(t, 15, 1024)
+		p := path.Join(root, "stats_log", "3")
+		require.NoError(t, remote.Write(ctx, p, []byte("abcdefgh")))
+
+		_, err := cm.Read(ctx, statsPath)
+		require.NoError(t, err)
+		reader, err := cm.Reader(ctx, statsPath)
+		require.NoError(t, err)
+
+		// the pinned one is not evicted even if the capacity is exceeded
+		_, err = cm.Read(ctx, p)
+		require.NoError(t, err)
+		assert.Equal(t, int64(18), cache.Size())
+
+		// the file of the evicted entry is kept until the reader is closed
+		cache.invalidate(cm.cacheKey(statsPath))
+		assert.Equal(t, int64(8), cache.Size())
+		data, err := io.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("0123456789"), data)
+		assert.NoError(t, reader.Close())
+
+		_, err = cm.Read(ctx, statsPath)
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), cache.Size())
+		entries, err := os.ReadDir(cache.dir)
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
+
+	t.Run("concurrent read", func(t *testing.T) {
+		cm, _ := newCM(t, 1024, 1024)
+		wg := sync.WaitGroup{}
+		for i := 0; i < 16; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				data, err := cm.Read(ctx, statsPath)
+				assert.NoError(t, err)
+				assert.Equal(t, []byte("0123456789"), data)
+			}()
+		}
+		wg.Wait()
+		assert.LessOrEqual(t, remote.readCount(), 16)
+		data, err := cm.Read(ctx, statsPath)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("0123456789"), data)
+	})
+}
@@ -123,7 +123,7 @@ func (gcs *GcpNativeObjectStorage) WalkWithObjects(ctx context.Context,
 				return nil
 			}
 		} else if objAttrs.Name != "" {
-			if !walkFunc(&ChunkObjectInfo{FilePath: objAttrs.Name, ModifyTime: objAttrs.Updated}) {
+			if !walkFunc(&ChunkObjectInfo{FilePath: objAttrs.Name, ModifyTime: objAttrs.Updated, Size: objAttrs.Size}) {
 				return nil
 			}
 		}
@@ -161,7 +161,7 @@ func (lcm *LocalChunkManager) WalkWithPrefix(ctx context.Context, prefix string,
 			}
 
 			if strings.HasPrefix(filePath, prefix) && !f.IsDir() {
-				if !walkFunc(&ChunkObjectInfo{FilePath: filePath, ModifyTime: f.ModTime()}) {
+				if !walkFunc(&ChunkObjectInfo{FilePath: filePath, ModifyTime: f.ModTime(), Size: f.Size()}) {
 					return nil
 				}
 			}
@@ -185,7 +185,7 @@ func (lcm *LocalChunkManager) WalkWithPrefix(ctx context.Context, prefix string,
 			}
 			return merr.WrapErrIoFailed(filePath, err)
 		}
-		if !walkFunc(&ChunkObjectInfo{FilePath: filePath, ModifyTime: f.ModTime()}) {
+		if !walkFunc(&ChunkObjectInfo{FilePath: filePath, ModifyTime: f.ModTime(), Size: f.Size()}) {
 			return nil
 		}
 	}
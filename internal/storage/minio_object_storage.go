@@ -97,7 +97,7 @@ func (minioObjectStorage *MinioObjectStorage) WalkWithObjects(ctx context.Contex
 		if object.Err != nil {
 			return object.Err
 		}
-		if !walkFunc(&ChunkObjectInfo{FilePath: object.Key, ModifyTime: object.LastModified}) {
+		if !walkFunc(&ChunkObjectInfo{FilePath: object.Key, ModifyTime: object.LastModified, Size: object.Size}) {
 			return nil
 		}
 	}
@@ -46,6 +46,8 @@ type FileReader interface {
 type ChunkObjectInfo struct {
 	FilePath   string
 	ModifyTime time.Time
+	// Size is the size of object in bytes, it is 0 for the directories in non-recursive walking.
+	Size int64
 }
 
 // ChunkManager is to manager chunks.
@@ -186,6 +186,11 @@ type Proxy interface {
 	ImportV2(context.Context, *internalpb.ImportRequest) (*internalpb.ImportResponse, error)
 	GetImportProgress(context.Context, *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error)
 	ListImports(context.Context, *internalpb.ListImportsRequest) (*internalpb.ListImportsResponse, error)
+	ExportV2(context.Context, *internalpb.ExportRequest) (*internalpb.ExportResponse, error)
+	GetExportProgress(context.Context, *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error)
+	ListExports(context.Context, *internalpb.ListExportsRequest) (*internalpb.ListExportsResponse, error)
+	CancelExport(context.Context, *internalpb.CancelExportRequest) (*commonpb.Status, error)
+	Explain(context.Context, *internalpb.ExplainRequest) (*internalpb.ExplainResponse, error)
 }
 
 // ProxyComponent defines the interface of proxy component.
@@ -0,0 +1,308 @@
+package exprutil
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// RowFilter evaluates the filter expression against a row, which maps the field ID to the field value.
+type RowFilter func(row map[int64]any) bool
+
+// rowEvaluator evaluates the expression against a row, the second return value is false
+// if the result is unknown, e.g. a null value is compared, so that the null values never
+// match the filter even if the comparison is negated.
+type rowEvaluator func(row map[int64]any) (bool, bool)
+
+// NewRowFilter compiles the parsed expression into a RowFilter for the rows outside of the segcore,
+// only the logical, range, term and null expressions on the scalar fields are supported.
+func NewRowFilter(expr *planpb.Expr) (RowFilter, error) {
+	if expr == nil {
+		return func(map[int64]any) bool { return true }, nil
+	}
+	eval, err := compileRowEvaluator(expr)
+	if err != nil {
+		return nil, err
+	}
+	return func(row map[int64]any) bool {
+		matched, known := eval(row)
+		return known && matched
+	}, nil
+}
+
+func compileRowEvaluator(expr *planpb.Expr) (rowEvaluator, error) {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_AlwaysTrueExpr:
+		return func(map[int64]any) (bool, bool) { return true, true }, nil
+	case *planpb.Expr_BinaryExpr:
+		return compileBinaryExpr(e.BinaryExpr)
+	case *planpb.Expr_UnaryExpr:
+		if e.UnaryExpr.GetOp() != planpb.UnaryExpr_Not {
+			return nil, unsupportedExpr(expr)
+		}
+		child, err := compileRowEvaluator(e.UnaryExpr.GetChild())
+		if err != nil {
+			return nil, err
+		}
+		return func(row map[int64]any) (bool, bool) {
+			matched, known := child(row)
+			return !matched, known
+		}, nil
+	case *planpb.Expr_UnaryRangeExpr:
+		return compileUnaryRangeExpr(expr, e.UnaryRangeExpr)
+	case *planpb.Expr_BinaryRangeExpr:
+		return compileBinaryRangeExpr(expr, e.BinaryRangeExpr)
+	case *planpb.Expr_TermExpr:
+		return compileTermExpr(expr, e.TermExpr)
+	case *planpb.Expr_NullExpr:
+		if err := checkScalarColumn(expr, e.NullExpr.GetColumnInfo()); err != nil {
+			return nil, err
+		}
+		fieldID := e.NullExpr.GetColumnInfo().GetFieldId()
+		isNull := e.NullExpr.GetOp() == planpb.NullExpr_IsNull
+		return func(row map[int64]any) (bool, bool) {
+			return (row[fieldID] == nil) == isNull, true
+		}, nil
+	default:
+		return nil, unsupportedExpr(expr)
+	}
+}
+
+func compileBinaryExpr(expr *planpb.BinaryExpr) (rowEvaluator, error) {
+	left, err := compileRowEvaluator(expr.GetLeft())
+	if err != nil {
+		return nil, err
+	}
+	right, err := compileRowEvaluator(expr.GetRight())
+	if err != nil {
+		return nil, err
+	}
+	switch expr.GetOp() {
+	case planpb.BinaryExpr_LogicalAnd:
+		return func(row map[int64]any) (bool, bool) {
+			l, lKnown := left(row)
+			if lKnown && !l {
+				return false, true
+			}
+			r, rKnown := right(row)
+			if rKnown && !r {
+				return false, true
+			}
+			return true, lKnown && rKnown
+		}, nil
+	case planpb.BinaryExpr_LogicalOr:
+		return func(row map[int64]any) (bool, bool) {
+			l, lKnown := left(row)
+			if lKnown && l {
+				return true, true
+			}
+			r, rKnown := right(row)
+			if rKnown && r {
+				return true, true
+			}
+			return false, lKnown && rKnown
+		}, nil
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg(fmt.Sprintf("unsupported binary operator %s", expr.GetOp().String()))
+	}
+}
+
+func compileUnaryRangeExpr(expr *planpb.Expr, e *planpb.UnaryRangeExpr) (rowEvaluator, error) {
+	if err := checkScalarColumn(expr, e.GetColumnInfo()); err != nil {
+		return nil, err
+	}
+	fieldID := e.GetColumnInfo().GetFieldId()
+	value := e.GetValue()
+	var match func(v any) (bool, bool)
+	switch e.GetOp() {
+	case planpb.OpType_GreaterThan, planpb.OpType_GreaterEqual, planpb.OpType_LessThan,
+		planpb.OpType_LessEqual, planpb.OpType_Equal, planpb.OpType_NotEqual:
+		op := e.GetOp()
+		match = func(v any) (bool, bool) {
+			c, ok := compareRowValue(v, value)
+			if !ok {
+				return false, false
+			}
+			switch op {
+			case planpb.OpType_GreaterThan:
+				return c > 0, true
+			case planpb.OpType_GreaterEqual:
+				return c >= 0, true
+			case planpb.OpType_LessThan:
+				return c < 0, true
+			case planpb.OpType_LessEqual:
+				return c <= 0, true
+			case planpb.OpType_Equal:
+				return c == 0, true
+			default:
+				return c != 0, true
+			}
+		}
+	case planpb.OpType_PrefixMatch, planpb.OpType_PostfixMatch, planpb.OpType_InnerMatch:
+		pattern := value.GetStringVal()
+		matchFn := map[planpb.OpType]func(string, string) bool{
+			planpb.OpType_PrefixMatch:  strings.HasPrefix,
+			planpb.OpType_PostfixMatch: strings.HasSuffix,
+			planpb.OpType_InnerMatch:   strings.Contains,
+		}[e.GetOp()]
+		match = func(v any) (bool, bool) {
+			s, ok := v.(string)
+			if !ok {
+				return false, false
+			}
+			return matchFn(s, pattern), true
+		}
+	default:
+		return nil, unsupportedExpr(expr)
+	}
+	return func(row map[int64]any) (bool, bool) {
+		v := row[fieldID]
+		if v == nil {
+			return false, false
+		}
+		return match(v)
+	}, nil
+}
+
+func compileBinaryRangeExpr(expr *planpb.Expr, e *planpb.BinaryRangeExpr) (rowEvaluator, error) {
+	if err := checkScalarColumn(expr, e.GetColumnInfo()); err != nil {
+		return nil, err
+	}
+	fieldID := e.GetColumnInfo().GetFieldId()
+	return func(row map[int64]any) (bool, bool) {
+		v := row[fieldID]
+		if v == nil {
+			return false, false
+		}
+		lower, ok := compareRowValue(v, e.GetLowerValue())
+		if !ok {
+			return false, false
+		}
+		upper, ok := compareRowValue(v, e.GetUpperValue())
+		if !ok {
+			return false, false
+		}
+		lowerMatched := lower > 0 || (e.GetLowerInclusive() && lower == 0)
+		upperMatched := upper < 0 || (e.GetUpperInclusive() && upper == 0)
+		return lowerMatched && upperMatched, true
+	}, nil
+}
+
+func compileTermExpr(expr *planpb.Expr, e *planpb.TermExpr) (rowEvaluator, error) {
+	if e.GetIsInField() {
+		return nil, unsupportedExpr(expr)
+	}
+	if err := checkScalarColumn(expr, e.GetColumnInfo()); err != nil {
+		return nil, err
+	}
+	fieldID := e.GetColumnInfo().GetFieldId()
+	values := e.GetValues()
+	return func(row map[int64]any) (bool, bool) {
+		v := row[fieldID]
+		if v == nil {
+			return false, false
+		}
+		for _, value := range values {
+			if c, ok := compareRowValue(v, value); ok && c == 0 {
+				return true, true
+			}
+		}
+		return false, true
+	}, nil
+}
+
+func checkScalarColumn(expr *planpb.Expr, column *planpb.ColumnInfo) error {
+	if len(column.GetNestedPath()) > 0 {
+		return unsupportedExpr(expr)
+	}
+	switch column.GetDataType() {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+		schemapb.DataType_Int64, schemapb.DataType_Float, schemapb.DataType_Double,
+		schemapb.DataType_String, schemapb.DataType_VarChar:
+		return nil
+	default:
+		return unsupportedExpr(expr)
+	}
+}
+
+func unsupportedExpr(expr *planpb.Expr) error {
+	return merr.WrapErrParameterInvalidMsg(fmt.Sprintf("unsupported expression for row filter: %s", expr.String()))
+}
+
+// compareRowValue compares the row value with the generic value, returns false if they are not comparable.
+func compareRowValue(v any, value *planpb.GenericValue) (int, bool) {
+	switch val := value.GetVal().(type) {
+	case *planpb.GenericValue_BoolVal:
+		b, ok := v.(bool)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case b == val.BoolVal:
+			return 0, true
+		case !b:
+			return -1, true
+		default:
+			return 1, true
+		}
+	case *planpb.GenericValue_Int64Val:
+		if i, ok := toInt64(v); ok {
+			return compareOrdered(i, val.Int64Val), true
+		}
+		if f, ok := toFloat64(v); ok {
+			return compareOrdered(f, float64(val.Int64Val)), true
+		}
+	case *planpb.GenericValue_FloatVal:
+		if f, ok := toFloat64(v); ok {
+			return compareOrdered(f, val.FloatVal), true
+		}
+	case *planpb.GenericValue_StringVal:
+		if s, ok := v.(string); ok {
+			return strings.Compare(s, val.StringVal), true
+		}
+	}
+	return 0, false
+}
+
+func compareOrdered[T int64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func toInt64(v any) (int64, bool) {
+	switch i := v.(type) {
+	case int8:
+		return int64(i), true
+	case int16:
+		return int64(i), true
+	case int32:
+		return int64(i), true
+	case int64:
+		return i, true
+	default:
+		return 0, false
+	}
+}
+
+func toFloat64(v any) (float64, bool) {
+	switch f := v.(type) {
+	case float32:
+		return float64(f), true
+	case float64:
+		return f, true
+	default:
+		if i, ok := toInt64(v); ok {
+			return float64(i), true
+		}
+		return 0, false
+	}
+}
@@ -0,0 +1,89 @@
+package exprutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func TestRowFilter(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "test_row_filter",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int32, Nullable: true},
+			{FieldID: 102, Name: "score", DataType: schemapb.DataType_Double},
+			{FieldID: 103, Name: "name", DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{
+				{Key: common.MaxLengthKey, Value: "64"},
+			}},
+			{FieldID: 104, Name: "flag", DataType: schemapb.DataType_Bool},
+			{FieldID: 105, Name: "meta", DataType: schemapb.DataType_JSON},
+			{FieldID: 106, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{
+				{Key: common.DimKey, Value: "4"},
+			}},
+		},
+	}
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	require.NoError(t, err)
+
+	rows := []map[int64]any{
+		{100: int64(1), 101: int32(18), 102: float64(60.5), 103: "alice", 104: true},
+		{100: int64(2), 101: int32(30), 102: float64(90), 103: "bob", 104: false},
+		{100: int64(3), 101: nil, 102: float64(75), 103: "carol", 104: true},
+	}
+
+	cases := []struct {
+		expr    string
+		matched []int64
+	}{
+		{"", []int64{1, 2, 3}},
+		{"pk > 1", []int64{2, 3}},
+		{"age >= 18 and age < 30", []int64{1}},
+		{"not (age < 20)", []int64{2}},
+		{"age is null", []int64{3}},
+		{"age is not null or score > 80", []int64{1, 2}},
+		{"10 < score <= 75", []int64{1, 3}},
+		{"pk in [1, 3]", []int64{1, 3}},
+		{"name not in [\"bob\"]", []int64{1, 3}},
+		{"name like \"ca%\"", []int64{3}},
+		{"name like \"%o%\"", []int64{2, 3}},
+		{"flag == true", []int64{1, 3}},
+		{"score == 90", []int64{2}},
+	}
+	for _, c := range cases {
+		t.Run(c.expr, func(t *testing.T) {
+			filter := RowFilter(func(map[int64]any) bool { return true })
+			if c.expr != "" {
+				expr, err := planparserv2.ParseExpr(helper, c.expr, nil)
+				require.NoError(t, err)
+				filter, err = NewRowFilter(expr)
+				require.NoError(t, err)
+			}
+			matched := make([]int64, 0)
+			for _, row := range rows {
+				if filter(row) {
+					matched = append(matched, row[100].(int64))
+				}
+			}
+			assert.ElementsMatch(t, c.matched, matched)
+		})
+	}
+
+	unsupported := []string{
+		"meta[\"a\"] > 1",
+		"score > age",
+	}
+	for _, exprStr := range unsupported {
+		expr, err := planparserv2.ParseExpr(helper, exprStr, nil)
+		require.NoError(t, err)
+		_, err = NewRowFilter(expr)
+		assert.Error(t, err, exprStr)
+	}
+}
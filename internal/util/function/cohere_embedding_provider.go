@@ -59,7 +59,7 @@ func NewCohereEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functionSchem
 	if err != nil {
 		return nil, err
 	}
-	apiKey, url, err := parseAKAndURL(credentials, functionSchema.Params, params, cohereAIAKEnvStr)
+	apiKey, url, err := ParseAKAndURL(credentials, functionSchema.Params, params, cohereAIAKEnvStr)
 	if err != nil {
 		return nil, err
 	}
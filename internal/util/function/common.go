@@ -109,6 +109,15 @@ const (
 	siliconflowAKEnvStr string = "MILVUSAI_SILICONFLOW_API_KEY"
 )
 
+// environment variables of api keys shared with the rerank model providers
+
+const (
+	CohereAIAKEnvStr    string = cohereAIAKEnvStr
+	VoyageAIAKEnvStr    string = voyageAIAKEnvStr
+	SiliconflowAKEnvStr string = siliconflowAKEnvStr
+	JinaAIAKEnvStr      string = "MILVUSAI_JINAAI_API_KEY"
+)
+
 // TEI and vllm
 
 const (
@@ -122,7 +131,22 @@ const (
 	EnableVllmEnvStr string = "MILVUSAI_ENABLE_VLLM"
 )
 
-func parseAKAndURL(credentials *credentials.Credentials, params []*commonpb.KeyValuePair, confParams map[string]string, apiKeyEnv string) (string, string, error) {
+// onnx
+
+const (
+	maxSeqLengthParamKey string = "max_seq_length"
+	lowerCaseParamKey    string = "do_lower_case"
+
+	onnxModelDirConfKey       string = "model_dir"
+	onnxRemoteModelDirConfKey string = "remote_model_dir"
+	onnxMaxWorkersConfKey     string = "max_workers"
+	onnxIntraOpThreadsConfKey string = "intra_op_threads"
+
+	EnableOnnxEnvStr string = "MILVUSAI_ENABLE_ONNX"
+)
+
+// ParseAKAndURL returns the api key and url of model service, which is shared by embedding and rerank providers.
+func ParseAKAndURL(credentials *credentials.Credentials, params []*commonpb.KeyValuePair, confParams map[string]string, apiKeyEnv string) (string, string, error) {
 	// function param > yaml > env
 	var err error
 	var apiKey, url string
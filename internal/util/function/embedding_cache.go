@@ -0,0 +1,395 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+var (
+	embeddingCacheOnce sync.Once
+	embeddingCache     *EmbeddingCache
+)
+
+// getEmbeddingCache returns the process wide embedding cache, nil if the cache is disabled.
+func getEmbeddingCache() *EmbeddingCache {
+	embeddingCacheOnce.Do(func() {
+		cfg := &paramtable.Get().FunctionCfg
+		if !cfg.EmbeddingCacheEnabled.GetAsBool() {
+			return
+		}
+		cache, err := NewEmbeddingCache(cfg.EmbeddingCacheCapacity.GetAsInt(), cfg.EmbeddingCacheDiskPath.GetValue(), cfg.EmbeddingCacheDiskCapacity.GetAsInt())
+		if err != nil {
+			log.Warn("failed to create embedding cache, embedding cache is disabled", zap.Error(err))
+			return
+		}
+		embeddingCache = cache
+	})
+	return embeddingCache
+}
+
+// EmbeddingCache is a LRU cache of embeddings, the embeddings evicted from memory
+// are spilled to local disk if disk path is configured.
+type EmbeddingCache struct {
+	mu  sync.Mutex
+	mem *simplelru.LRU[string, any]
+	// evicted collects the entries evicted by mem under mu, they are spilled to disk after mu is released
+	evicted []evictedEmbedding
+	disk    *diskEmbeddingCache
+}
+
+type evictedEmbedding struct {
+	key   string
+	value any
+}
+
+// NewEmbeddingCache creates the cache, the embeddings are spilled to a dedicated directory
+// `<diskPath>/embedding_cache/<role>`, the other files under diskPath are never touched.
+func NewEmbeddingCache(capacity int, diskPath string, diskCapacity int) (*EmbeddingCache, error) {
+	c := &EmbeddingCache{}
+	if diskPath != "" {
+		disk, err := newDiskEmbeddingCache(filepath.Join(diskPath, embeddingCacheDirName, paramtable.GetRole()), diskCapacity)
+		if err != nil {
+			return nil, err
+		}
+		c.disk = disk
+	}
+	mem, err := simplelru.NewLRU[string, any](capacity, func(key string, value any) {
+		if c.disk != nil {
+			c.evicted = append(c.evicted, evictedEmbedding{key: key, value: value})
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.mem = mem
+	return c, nil
+}
+
+func (c *EmbeddingCache) Get(key string) (any, bool) {
+	c.mu.Lock()
+	value, ok := c.mem.Get(key)
+	c.mu.Unlock()
+	if ok {
+		return value, true
+	}
+	if c.disk == nil {
+		return nil, false
+	}
+	value, ok = c.disk.get(key)
+	if !ok {
+		return nil, false
+	}
+	// promote to memory
+	c.disk.remove(key)
+	c.Put(key, value)
+	return value, true
+}
+
+func (c *EmbeddingCache) Put(key string, value any) {
+	c.mu.Lock()
+	c.mem.Add(key, value)
+	evicted := c.evicted
+	c.evicted = nil
+	c.mu.Unlock()
+
+	// disk io is done without holding the lock, so that the lookups are not blocked by spilling
+	for _, e := range evicted {
+		c.disk.put(e.key, e.value)
+	}
+}
+
+const embeddingCacheDirName = "embedding_cache"
+
+const (
+	float32EmbdTag byte = iota
+	int8EmbdTag
+)
+
+// diskEmbeddingCache stores one embedding per file, the files are removed when evicted from the index.
+// Files left by previous process are cleaned when created.
+type diskEmbeddingCache struct {
+	dir   string
+	index *lru.Cache[string, struct{}]
+}
+
+func newDiskEmbeddingCache(dir string, capacity int) (*diskEmbeddingCache, error) {
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	d := &diskEmbeddingCache{dir: dir}
+	index, err := lru.NewWithEvict[string, struct{}](capacity, func(key string, _ struct{}) {
+		os.Remove(d.path(key))
+	})
+	if err != nil {
+		return nil, err
+	}
+	d.index = index
+	return d, nil
+}
+
+func (d *diskEmbeddingCache) path(key string) string {
+	return filepath.Join(d.dir, key)
+}
+
+func (d *diskEmbeddingCache) put(key string, value any) {
+	var data []byte
+	switch embd := value.(type) {
+	case []float32:
+		data = make([]byte, 1, 1+4*len(embd))
+		data[0] = float32EmbdTag
+		for _, v := range embd {
+			data = binary.LittleEndian.AppendUint32(data, math.Float32bits(v))
+		}
+	case []int8:
+		data = make([]byte, 1, 1+len(embd))
+		data[0] = int8EmbdTag
+		for _, v := range embd {
+			data = append(data, byte(v))
+		}
+	default:
+		return
+	}
+	// spills run concurrently, write to a unique temp file then rename
+	f, err := os.CreateTemp(d.dir, key+".*.tmp")
+	if err != nil {
+		log.Warn("failed to spill embedding to disk", zap.String("dir", d.dir), zap.Error(err))
+		return
+	}
+	tmp := f.Name()
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp, d.path(key))
+	}
+	if err != nil {
+		os.Remove(tmp)
+		log.Warn("failed to spill embedding to disk", zap.String("path", tmp), zap.Error(err))
+		return
+	}
+	d.index.Add(key, struct{}{})
+}
+
+func (d *diskEmbeddingCache) get(key string) (any, bool) {
+	if !d.index.Contains(key) {
+		return nil, false
+	}
+	data, err := os.ReadFile(d.path(key))
+	if err != nil || len(data) == 0 {
+		return nil, false
+	}
+	switch data[0] {
+	case float32EmbdTag:
+		if (len(data)-1)%4 != 0 {
+			return nil, false
+		}
+		embd := make([]float32, 0, (len(data)-1)/4)
+		for i := 1; i < len(data); i += 4 {
+			embd = append(embd, math.Float32frombits(binary.LittleEndian.Uint32(data[i:])))
+		}
+		return embd, true
+	case int8EmbdTag:
+		embd := make([]int8, 0, len(data)-1)
+		for _, v := range data[1:] {
+			embd = append(embd, int8(v))
+		}
+		return embd, true
+	default:
+		return nil, false
+	}
+}
+
+func (d *diskEmbeddingCache) remove(key string) {
+	d.index.Remove(key)
+}
+
+// cachedEmbeddingProvider looks up embeddings in cache before calling the provider,
+// only the texts missing from cache are sent to the provider.
+type cachedEmbeddingProvider struct {
+	textEmbeddingProvider
+
+	cache    *EmbeddingCache
+	provider string
+	// keyPrefix identifies provider, endpoint, credential, model and other params which affect the embedding,
+	// it is only used as the input of the key hash
+	keyPrefix string
+}
+
+// newCachedEmbeddingProvider wraps embP with the cache. The cache key covers the function params,
+// the provider config in milvus.yaml (e.g. url) and the credential in use, so that the functions
+// calling different endpoints or with different credentials never share entries.
+func newCachedEmbeddingProvider(embP textEmbeddingProvider, cache *EmbeddingCache, provider string, functionSchema *schemapb.FunctionSchema, conf map[string]string, credentialConf map[string]string) *cachedEmbeddingProvider {
+	params := make([]string, 0, len(functionSchema.GetParams()))
+	credentialName := conf[credentialParamKey]
+	for _, param := range functionSchema.GetParams() {
+		key := strings.ToLower(param.GetKey())
+		params = append(params, key+"="+param.GetValue())
+		if key == credentialParamKey {
+			credentialName = param.GetValue()
+		}
+	}
+	sort.Strings(params)
+	confs := make([]string, 0, len(conf))
+	for k, v := range conf {
+		confs = append(confs, k+"="+v)
+	}
+	sort.Strings(confs)
+	secrets := make([]string, 0)
+	if credentialName != "" {
+		for k, v := range credentialConf {
+			if strings.HasPrefix(k, credentialName+".") {
+				secrets = append(secrets, k+"="+v)
+			}
+		}
+	}
+	sort.Strings(secrets)
+	return &cachedEmbeddingProvider{
+		textEmbeddingProvider: embP,
+		cache:                 cache,
+		provider:              provider,
+		keyPrefix: strings.Join([]string{
+			provider,
+			strconv.FormatInt(embP.FieldDim(), 10),
+			strings.Join(params, "&"),
+			strings.Join(confs, "&"),
+			strings.Join(secrets, "&"),
+		}, "\x00"),
+	}
+}
+
+func (p *cachedEmbeddingProvider) key(text string, mode TextEmbeddingMode) string {
+	h := sha256.New()
+	h.Write([]byte(p.keyPrefix))
+	h.Write([]byte{0, byte(mode), 0})
+	h.Write([]byte(text))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (p *cachedEmbeddingProvider) CallEmbedding(texts []string, mode TextEmbeddingMode) (any, error) {
+	if len(texts) == 0 {
+		return p.textEmbeddingProvider.CallEmbedding(texts, mode)
+	}
+	// texts appear multiple times are looked up and embedded once
+	uniqueIdx := make(map[string]int)
+	uniqueTexts := make([]string, 0)
+	for _, text := range texts {
+		if _, ok := uniqueIdx[text]; !ok {
+			uniqueIdx[text] = len(uniqueTexts)
+			uniqueTexts = append(uniqueTexts, text)
+		}
+	}
+	keys := make([]string, len(uniqueTexts))
+	uniqueValues := make([]any, len(uniqueTexts))
+	// index of text in uniqueTexts
+	missIdx := make([]int, 0)
+	missTexts := make([]string, 0)
+	for i, text := range uniqueTexts {
+		keys[i] = p.key(text, mode)
+		if value, ok := p.cache.Get(keys[i]); ok {
+			uniqueValues[i] = value
+			continue
+		}
+		missIdx = append(missIdx, i)
+		missTexts = append(missTexts, text)
+	}
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	metrics.FunctionEmbeddingCacheCounter.WithLabelValues(nodeID, p.provider, metrics.CacheHitLabel).Add(float64(len(uniqueTexts) - len(missTexts)))
+	metrics.FunctionEmbeddingCacheCounter.WithLabelValues(nodeID, p.provider, metrics.CacheMissLabel).Add(float64(len(missTexts)))
+
+	var missValues []any
+	if len(missTexts) > 0 {
+		embds, err := p.textEmbeddingProvider.CallEmbedding(missTexts, mode)
+		if err != nil {
+			return nil, err
+		}
+		switch embds := embds.(type) {
+		case [][]float32:
+			missValues = toAnySlice(embds)
+		case [][]int8:
+			missValues = toAnySlice(embds)
+		default:
+			return nil, fmt.Errorf("Unsupport embedding type: %s", reflect.TypeOf(embds).String())
+		}
+		if len(missValues) != len(missTexts) {
+			return nil, fmt.Errorf("Get embedding failed. The number of texts and embeddings does not match text:[%d], embedding:[%d]", len(missTexts), len(missValues))
+		}
+	}
+	for j, i := range missIdx {
+		uniqueValues[i] = missValues[j]
+		p.cache.Put(keys[i], uniqueValues[i])
+	}
+	values := make([]any, 0, len(texts))
+	for _, text := range texts {
+		values = append(values, uniqueValues[uniqueIdx[text]])
+	}
+
+	switch values[0].(type) {
+	case []float32:
+		return fromAnySlice[float32](values)
+	case []int8:
+		return fromAnySlice[int8](values)
+	default:
+		return nil, fmt.Errorf("Unsupport embedding type: %s", reflect.TypeOf(values[0]).String())
+	}
+}
+
+func toAnySlice[T float32 | int8](embds [][]T) []any {
+	ret := make([]any, 0, len(embds))
+	for _, embd := range embds {
+		ret = append(ret, embd)
+	}
+	return ret
+}
+
+func fromAnySlice[T float32 | int8](values []any) ([][]T, error) {
+	ret := make([][]T, 0, len(values))
+	for _, value := range values {
+		embd, ok := value.([]T)
+		if !ok {
+			return nil, fmt.Errorf("Embedding cache got mixed embedding types")
+		}
+		ret = append(ret, embd)
+	}
+	return ret, nil
+}
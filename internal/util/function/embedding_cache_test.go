@@ -0,0 +1,203 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+func TestEmbeddingCache(t *testing.T) {
+	suite.Run(t, new(EmbeddingCacheSuite))
+}
+
+type EmbeddingCacheSuite struct {
+	suite.Suite
+}
+
+type countingEmbeddingProvider struct {
+	int8Output bool
+	calls      [][]string
+}
+
+func (p *countingEmbeddingProvider) MaxBatch() int {
+	return 16
+}
+
+func (p *countingEmbeddingProvider) FieldDim() int64 {
+	return 2
+}
+
+func (p *countingEmbeddingProvider) CallEmbedding(texts []string, mode TextEmbeddingMode) (any, error) {
+	p.calls = append(p.calls, texts)
+	if p.int8Output {
+		ret := make([][]int8, 0, len(texts))
+		for _, text := range texts {
+			ret = append(ret, []int8{int8(len(text)), int8(mode)})
+		}
+		return ret, nil
+	}
+	ret := make([][]float32, 0, len(texts))
+	for _, text := range texts {
+		ret = append(ret, []float32{float32(len(text)), float32(mode)})
+	}
+	return ret, nil
+}
+
+func (s *EmbeddingCacheSuite) functionSchema(model string) *schemapb.FunctionSchema {
+	return &schemapb.FunctionSchema{
+		Name: "test",
+		Type: schemapb.FunctionType_TextEmbedding,
+		Params: []*commonpb.KeyValuePair{
+			{Key: Provider, Value: openAIProvider},
+			{Key: modelNameParamKey, Value: model},
+		},
+	}
+}
+
+func (s *EmbeddingCacheSuite) TestCachedProvider() {
+	cache, err := NewEmbeddingCache(10, "", 0)
+	s.NoError(err)
+	inner := &countingEmbeddingProvider{}
+	p := newCachedEmbeddingProvider(inner, cache, openAIProvider, s.functionSchema("m1"), nil, nil)
+
+	ret, err := p.CallEmbedding([]string{"a", "bb", "a"}, InsertMode)
+	s.NoError(err)
+	s.Equal([][]float32{{1, 0}, {2, 0}, {1, 0}}, ret)
+	s.Equal([][]string{{"a", "bb"}}, inner.calls)
+
+	// insert and search share the cache only if the mode is the same
+	ret, err = p.CallEmbedding([]string{"bb", "ccc"}, InsertMode)
+	s.NoError(err)
+	s.Equal([][]float32{{2, 0}, {3, 0}}, ret)
+	s.Equal([]string{"ccc"}, inner.calls[1])
+
+	ret, err = p.CallEmbedding([]string{"bb"}, SearchMode)
+	s.NoError(err)
+	s.Equal([][]float32{{2, 1}}, ret)
+	s.Equal([]string{"bb"}, inner.calls[2])
+
+	// all hit
+	_, err = p.CallEmbedding([]string{"a", "bb", "ccc"}, InsertMode)
+	s.NoError(err)
+	s.Len(inner.calls, 3)
+
+	// different model params don't share entries
+	other := newCachedEmbeddingProvider(inner, cache, openAIProvider, s.functionSchema("m2"), nil, nil)
+	_, err = other.CallEmbedding([]string{"a"}, InsertMode)
+	s.NoError(err)
+	s.Len(inner.calls, 4)
+}
+
+func (s *EmbeddingCacheSuite) TestKeyCoversEndpointAndCredential() {
+	cache, err := NewEmbeddingCache(10, "", 0)
+	s.NoError(err)
+	inner := &countingEmbeddingProvider{}
+	credentialConf := map[string]string{
+		"a.apikey": "key-a",
+		"b.apikey": "key-b",
+	}
+	call := func(conf map[string]string, credentials map[string]string) {
+		p := newCachedEmbeddingProvider(inner, cache, openAIProvider, s.functionSchema("m1"), conf, credentials)
+		_, err := p.CallEmbedding([]string{"a"}, InsertMode)
+		s.NoError(err)
+	}
+
+	call(map[string]string{embeddingURLParamKey: "http://host1", credentialParamKey: "a"}, credentialConf)
+	s.Len(inner.calls, 1)
+	call(map[string]string{embeddingURLParamKey: "http://host1", credentialParamKey: "a"}, credentialConf)
+	s.Len(inner.calls, 1)
+	// different url
+	call(map[string]string{embeddingURLParamKey: "http://host2", credentialParamKey: "a"}, credentialConf)
+	s.Len(inner.calls, 2)
+	// different credential
+	call(map[string]string{embeddingURLParamKey: "http://host1", credentialParamKey: "b"}, credentialConf)
+	s.Len(inner.calls, 3)
+	// same credential name with a different secret
+	call(map[string]string{embeddingURLParamKey: "http://host1", credentialParamKey: "a"}, map[string]string{"a.apikey": "rotated"})
+	s.Len(inner.calls, 4)
+}
+
+func (s *EmbeddingCacheSuite) TestInt8() {
+	cache, err := NewEmbeddingCache(10, "", 0)
+	s.NoError(err)
+	inner := &countingEmbeddingProvider{int8Output: true}
+	p := newCachedEmbeddingProvider(inner, cache, cohereProvider, s.functionSchema("m1"), nil, nil)
+	for i := 0; i < 2; i++ {
+		ret, err := p.CallEmbedding([]string{"a", "bb"}, SearchMode)
+		s.NoError(err)
+		s.Equal([][]int8{{1, 1}, {2, 1}}, ret)
+	}
+	s.Len(inner.calls, 1)
+}
+
+func (s *EmbeddingCacheSuite) TestDiskSpill() {
+	dir := s.T().TempDir()
+	other := filepath.Join(dir, "other")
+	s.NoError(os.WriteFile(other, []byte("data"), 0o600))
+	cache, err := NewEmbeddingCache(1, dir, 2)
+	s.NoError(err)
+	// only the dedicated sub directory is cleaned
+	_, err = os.Stat(other)
+	s.NoError(err)
+
+	cache.Put("k1", []float32{1, 2})
+	cache.Put("k2", []int8{3, 4})
+	cache.Put("k3", []float32{5})
+
+	// k1 and k2 spilled to disk
+	v, ok := cache.Get("k1")
+	s.True(ok)
+	s.Equal([]float32{1, 2}, v)
+	v, ok = cache.Get("k2")
+	s.True(ok)
+	s.Equal([]int8{3, 4}, v)
+	v, ok = cache.Get("k3")
+	s.True(ok)
+	s.Equal([]float32{5}, v)
+
+	cache.Put("k4", []float32{6})
+	cache.Put("k5", []float32{7})
+	cache.Put("k6", []float32{8})
+	// disk keeps the 2 most recently spilled entries
+	_, ok = cache.Get("k3")
+	s.False(ok)
+	_, ok = cache.Get("k4")
+	s.True(ok)
+}
+
+func (s *EmbeddingCacheSuite) TestMemoryOnly() {
+	cache, err := NewEmbeddingCache(1, "", 0)
+	s.NoError(err)
+	cache.Put("k1", []float32{1})
+	cache.Put("k2", []float32{2})
+	_, ok := cache.Get("k1")
+	s.False(ok)
+	_, ok = cache.Get("k2")
+	s.True(ok)
+
+	_, err = NewEmbeddingCache(0, "", 0)
+	s.Error(err)
+}
@@ -0,0 +1,245 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onnx
+
+import (
+	"fmt"
+	"math"
+	"path/filepath"
+	"sync"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/conc"
+)
+
+const (
+	ModelFileName = "model.onnx"
+	VocabFileName = "vocab.txt"
+)
+
+var (
+	modelsMu sync.Mutex
+	// loaded models are kept during the lifetime of the process, so that the model file is only loaded once
+	models = make(map[string]*model)
+
+	poolMu sync.Mutex
+	pool   *conc.Pool[[][]float32]
+)
+
+// getPool returns the process wide inference pool, which bounds the number of concurrent inference tasks of all models.
+// The pool is resized if max workers is changed in config.
+func getPool(size int) *conc.Pool[[][]float32] {
+	poolMu.Lock()
+	defer poolMu.Unlock()
+	if pool == nil {
+		pool = conc.NewPool[[][]float32](size)
+	} else if pool.Cap() != size {
+		pool.Resize(size)
+	}
+	return pool
+}
+
+type model struct {
+	session   Session
+	tokenizer *Tokenizer
+}
+
+func loadModel(modelDir string, lowerCase bool, intraOpThreads int) (*model, error) {
+	key := fmt.Sprintf("%s:%t:%d", modelDir, lowerCase, intraOpThreads)
+	modelsMu.Lock()
+	defer modelsMu.Unlock()
+	if m, ok := models[key]; ok {
+		return m, nil
+	}
+
+	tokenizer, err := NewTokenizer(filepath.Join(modelDir, VocabFileName), lowerCase)
+	if err != nil {
+		return nil, fmt.Errorf("Load vocabulary of model %s failed: %v", modelDir, err)
+	}
+	session, err := newSession(filepath.Join(modelDir, ModelFileName), intraOpThreads)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range session.InputNames() {
+		if name != inputIDsName && name != attentionMaskName && name != tokenTypeIDsName {
+			session.Close()
+			return nil, fmt.Errorf("Unsupported model input: %s, only supports [%s, %s, %s]", name, inputIDsName, attentionMaskName, tokenTypeIDsName)
+		}
+	}
+	m := &model{session: session, tokenizer: tokenizer}
+	models[key] = m
+	return m, nil
+}
+
+type OnnxEmbeddingOption struct {
+	// ModelDir is the directory contains model.onnx and vocab.txt
+	ModelDir       string
+	LowerCase      bool
+	MaxSeqLength   int
+	Normalize      bool
+	MaxWorkers     int
+	IntraOpThreads int
+}
+
+// OnnxEmbedding runs sentence embedding models in process, the token embeddings are mean pooled with attention mask.
+type OnnxEmbedding struct {
+	model        *model
+	maxSeqLength int
+	normalize    bool
+	pool         *conc.Pool[[][]float32]
+}
+
+func NewOnnxEmbedding(option *OnnxEmbeddingOption) (*OnnxEmbedding, error) {
+	if option.MaxSeqLength < 2 {
+		return nil, fmt.Errorf("Max sequence length must be larger than 1, but got %d", option.MaxSeqLength)
+	}
+	if option.MaxWorkers <= 0 || option.IntraOpThreads <= 0 {
+		return nil, fmt.Errorf("Max workers and intra op threads must be positive, but got %d and %d", option.MaxWorkers, option.IntraOpThreads)
+	}
+	m, err := loadModel(option.ModelDir, option.LowerCase, option.IntraOpThreads)
+	if err != nil {
+		return nil, err
+	}
+	return &OnnxEmbedding{
+		model:        m,
+		maxSeqLength: option.MaxSeqLength,
+		normalize:    option.Normalize,
+		pool:         getPool(option.MaxWorkers),
+	}, nil
+}
+
+// Embedding splits texts into batches and runs them in the inference pool concurrently.
+func (c *OnnxEmbedding) Embedding(texts []string, batchSize int) ([][]float32, error) {
+	futures := make([]*conc.Future[[][]float32], 0, (len(texts)+batchSize-1)/batchSize)
+	for i := 0; i < len(texts); i += batchSize {
+		end := min(i+batchSize, len(texts))
+		batch := texts[i:end]
+		futures = append(futures, c.pool.Submit(func() ([][]float32, error) {
+			return c.embedBatch(batch)
+		}))
+	}
+	if err := conc.AwaitAll(futures...); err != nil {
+		return nil, err
+	}
+	ret := make([][]float32, 0, len(texts))
+	for _, future := range futures {
+		ret = append(ret, future.Value()...)
+	}
+	return ret, nil
+}
+
+func (c *OnnxEmbedding) embedBatch(texts []string) ([][]float32, error) {
+	batch := len(texts)
+	tokens := make([][]int64, 0, batch)
+	seqLen := 0
+	for _, text := range texts {
+		ids := c.model.tokenizer.Encode(text, c.maxSeqLength)
+		tokens = append(tokens, ids)
+		seqLen = max(seqLen, len(ids))
+	}
+
+	// pad all sequences to the longest one of the batch
+	inputIDs := make([]int64, batch*seqLen)
+	attentionMask := make([]int64, batch*seqLen)
+	for i, ids := range tokens {
+		for j := 0; j < seqLen; j++ {
+			if j < len(ids) {
+				inputIDs[i*seqLen+j] = ids[j]
+				attentionMask[i*seqLen+j] = 1
+			} else {
+				inputIDs[i*seqLen+j] = c.model.tokenizer.padID
+			}
+		}
+	}
+	inputs := make([][]int64, 0, 3)
+	for _, name := range c.model.session.InputNames() {
+		switch name {
+		case inputIDsName:
+			inputs = append(inputs, inputIDs)
+		case attentionMaskName:
+			inputs = append(inputs, attentionMask)
+		case tokenTypeIDsName:
+			inputs = append(inputs, make([]int64, batch*seqLen))
+		}
+	}
+
+	output, shape, err := c.model.session.Run(inputs, batch, seqLen)
+	if err != nil {
+		return nil, err
+	}
+	embds, err := pooling(output, shape, attentionMask, batch, seqLen)
+	if err != nil {
+		return nil, err
+	}
+	if c.normalize {
+		for _, embd := range embds {
+			l2Normalize(embd)
+		}
+	}
+	return embds, nil
+}
+
+// pooling returns sentence embeddings from model output, the output is either
+// token embeddings of shape [batch, seqLen, dim] or sentence embeddings of shape [batch, dim].
+func pooling(output []float32, shape []int64, attentionMask []int64, batch int, seqLen int) ([][]float32, error) {
+	switch {
+	case len(shape) == 2 && shape[0] == int64(batch):
+		dim := int(shape[1])
+		embds := make([][]float32, 0, batch)
+		for i := 0; i < batch; i++ {
+			embds = append(embds, output[i*dim:(i+1)*dim])
+		}
+		return embds, nil
+	case len(shape) == 3 && shape[0] == int64(batch) && shape[1] == int64(seqLen):
+		dim := int(shape[2])
+		embds := make([][]float32, 0, batch)
+		for i := 0; i < batch; i++ {
+			embd := make([]float32, dim)
+			count := float32(0)
+			for j := 0; j < seqLen; j++ {
+				if attentionMask[i*seqLen+j] == 0 {
+					continue
+				}
+				count++
+				token := output[(i*seqLen+j)*dim : (i*seqLen+j+1)*dim]
+				for k := range embd {
+					embd[k] += token[k]
+				}
+			}
+			for k := range embd {
+				embd[k] /= count
+			}
+			embds = append(embds, embd)
+		}
+		return embds, nil
+	default:
+		return nil, fmt.Errorf("Unexpected model output shape %v, batch: %d, sequence length: %d", shape, batch, seqLen)
+	}
+}
+
+func l2Normalize(embd []float32) {
+	sum := float64(0)
+	for _, v := range embd {
+		sum += float64(v) * float64(v)
+	}
+	if sum == 0 {
+		return
+	}
+	norm := float32(math.Sqrt(sum))
+	for i := range embd {
+		embd[i] /= norm
+	}
+}
@@ -0,0 +1,177 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onnx
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockSession struct {
+	inputNames []string
+	dim        int
+	pooled     bool
+}
+
+func (s *mockSession) InputNames() []string {
+	return s.inputNames
+}
+
+// Run returns token embeddings whose values are the token ids
+func (s *mockSession) Run(inputs [][]int64, batch int, seqLen int) ([]float32, []int64, error) {
+	if len(inputs) != len(s.inputNames) {
+		return nil, nil, errors.New("input mismatch")
+	}
+	ids := inputs[0]
+	if s.pooled {
+		output := make([]float32, 0, batch*s.dim)
+		for i := 0; i < batch; i++ {
+			for k := 0; k < s.dim; k++ {
+				output = append(output, float32(ids[i*seqLen]))
+			}
+		}
+		return output, []int64{int64(batch), int64(s.dim)}, nil
+	}
+	output := make([]float32, 0, batch*seqLen*s.dim)
+	for _, id := range ids {
+		for k := 0; k < s.dim; k++ {
+			output = append(output, float32(id))
+		}
+	}
+	return output, []int64{int64(batch), int64(seqLen), int64(s.dim)}, nil
+}
+
+func (s *mockSession) Close() {}
+
+type OnnxEmbeddingSuite struct {
+	suite.Suite
+
+	vocab map[string]int64
+}
+
+func TestOnnxEmbedding(t *testing.T) {
+	suite.Run(t, new(OnnxEmbeddingSuite))
+}
+
+func (s *OnnxEmbeddingSuite) SetupTest() {
+	s.vocab = map[string]int64{}
+	for i, token := range []string{"[PAD]", "[UNK]", "[CLS]", "[SEP]", "hello", "world", ",", "!", "un", "##aff", "##able", "cafe", "中"} {
+		s.vocab[token] = int64(i)
+	}
+}
+
+func (s *OnnxEmbeddingSuite) writeModel(vocab []string) string {
+	dir := s.T().TempDir()
+	s.Require().NoError(os.WriteFile(filepath.Join(dir, VocabFileName), []byte(strings.Join(vocab, "\n")), 0o600))
+	return dir
+}
+
+func (s *OnnxEmbeddingSuite) TestTokenizer() {
+	tokenizer, err := newTokenizer(s.vocab, true)
+	s.Require().NoError(err)
+
+	s.Equal([]int64{2, 4, 6, 5, 7, 3}, tokenizer.Encode("Hello, WORLD!", 512))
+	s.Equal([]int64{2, 8, 9, 10, 3}, tokenizer.Encode("unaffable", 512))
+	s.Equal([]int64{2, 11, 1, 12, 3}, tokenizer.Encode("Café\tunknown中", 512))
+	s.Equal([]int64{2, 4, 3}, tokenizer.Encode("hello world", 3))
+	s.Equal([]int64{2, 3}, tokenizer.Encode("", 3))
+
+	_, err = newTokenizer(map[string]int64{"[PAD]": 0}, true)
+	s.Error(err)
+}
+
+func (s *OnnxEmbeddingSuite) TestEmbedding() {
+	defer func() {
+		newSession = newOrtSession
+	}()
+
+	dir := s.writeModel([]string{"[PAD]", "[UNK]", "[CLS]", "[SEP]", "hello", "world"})
+	newSession = func(modelPath string, intraOpThreads int) (Session, error) {
+		s.Equal(filepath.Join(dir, ModelFileName), modelPath)
+		return &mockSession{inputNames: []string{inputIDsName, attentionMaskName, tokenTypeIDsName}, dim: 2}, nil
+	}
+
+	c, err := NewOnnxEmbedding(&OnnxEmbeddingOption{ModelDir: dir, LowerCase: true, MaxSeqLength: 8, MaxWorkers: 2, IntraOpThreads: 1})
+	s.Require().NoError(err)
+	embds, err := c.Embedding([]string{"hello", "hello world", "world"}, 2)
+	s.Require().NoError(err)
+	// mean of token ids, padding tokens are ignored
+	s.Equal([][]float32{{3, 3}, {3.5, 3.5}, {10.0 / 3, 10.0 / 3}}, embds)
+
+	// model is cached
+	newSession = func(modelPath string, intraOpThreads int) (Session, error) {
+		return nil, errors.New("mock error")
+	}
+	c, err = NewOnnxEmbedding(&OnnxEmbeddingOption{ModelDir: dir, LowerCase: true, MaxSeqLength: 8, Normalize: true, MaxWorkers: 2, IntraOpThreads: 1})
+	s.Require().NoError(err)
+	embds, err = c.Embedding([]string{"hello"}, 2)
+	s.Require().NoError(err)
+	s.InDeltaSlice([]float32{0.7071, 0.7071}, embds[0], 0.0001)
+
+	_, err = NewOnnxEmbedding(&OnnxEmbeddingOption{ModelDir: dir, LowerCase: false, MaxSeqLength: 8, MaxWorkers: 2, IntraOpThreads: 1})
+	s.ErrorContains(err, "mock error")
+}
+
+func (s *OnnxEmbeddingSuite) TestPooledOutput() {
+	defer func() {
+		newSession = newOrtSession
+	}()
+
+	dir := s.writeModel([]string{"[PAD]", "[UNK]", "[CLS]", "[SEP]"})
+	newSession = func(modelPath string, intraOpThreads int) (Session, error) {
+		return &mockSession{inputNames: []string{inputIDsName, attentionMaskName}, dim: 4, pooled: true}, nil
+	}
+	c, err := NewOnnxEmbedding(&OnnxEmbeddingOption{ModelDir: dir, MaxSeqLength: 8, MaxWorkers: 1, IntraOpThreads: 1})
+	s.Require().NoError(err)
+	embds, err := c.Embedding([]string{"a", "b", "c"}, 1)
+	s.Require().NoError(err)
+	s.Len(embds, 3)
+	s.Equal([]float32{2, 2, 2, 2}, embds[2])
+}
+
+func (s *OnnxEmbeddingSuite) TestInvalid() {
+	defer func() {
+		newSession = newOrtSession
+	}()
+
+	_, err := NewOnnxEmbedding(&OnnxEmbeddingOption{ModelDir: s.T().TempDir(), MaxSeqLength: 1, MaxWorkers: 1, IntraOpThreads: 1})
+	s.Error(err)
+	_, err = NewOnnxEmbedding(&OnnxEmbeddingOption{ModelDir: s.T().TempDir(), MaxSeqLength: 8, MaxWorkers: 0, IntraOpThreads: 1})
+	s.Error(err)
+	// vocab not exist
+	_, err = NewOnnxEmbedding(&OnnxEmbeddingOption{ModelDir: s.T().TempDir(), MaxSeqLength: 8, MaxWorkers: 1, IntraOpThreads: 1})
+	s.Error(err)
+
+	dir := s.writeModel([]string{"[PAD]", "[UNK]", "[CLS]", "[SEP]"})
+	newSession = func(modelPath string, intraOpThreads int) (Session, error) {
+		return &mockSession{inputNames: []string{inputIDsName, "pixel_values"}, dim: 4}, nil
+	}
+	_, err = NewOnnxEmbedding(&OnnxEmbeddingOption{ModelDir: dir, MaxSeqLength: 8, MaxWorkers: 1, IntraOpThreads: 1})
+	s.ErrorContains(err, "Unsupported model input")
+
+	s.Run("disabled_runtime", func() {
+		newSession = newOrtSession
+		dir := s.writeModel([]string{"[PAD]", "[UNK]", "[CLS]", "[SEP]"})
+		_, err = NewOnnxEmbedding(&OnnxEmbeddingOption{ModelDir: dir, MaxSeqLength: 8, MaxWorkers: 1, IntraOpThreads: 1})
+		s.Error(err)
+	})
+}
@@ -0,0 +1,255 @@
+//go:build onnx
+// +build onnx
+
+package onnx
+
+/*
+#cgo pkg-config: libonnxruntime
+
+#include <stdlib.h>
+#include <string.h>
+#include <onnxruntime_c_api.h>
+
+static const OrtApi* g_ort = NULL;
+
+typedef struct {
+	OrtSession* session;
+	OrtMemoryInfo* mem;
+	size_t num_inputs;
+	char** input_names;
+	char* output_name;
+} ort_model;
+
+static char* ort_error(OrtStatus* status) {
+	if (status == NULL) {
+		return NULL;
+	}
+	char* msg = strdup(g_ort->GetErrorMessage(status));
+	g_ort->ReleaseStatus(status);
+	return msg;
+}
+
+static char* ort_init(OrtEnv** env) {
+	g_ort = OrtGetApiBase()->GetApi(ORT_API_VERSION);
+	if (g_ort == NULL) {
+		return strdup("onnx runtime api version mismatch");
+	}
+	return ort_error(g_ort->CreateEnv(ORT_LOGGING_LEVEL_WARNING, "milvus", env));
+}
+
+static void ort_release(ort_model* m) {
+	if (m == NULL) {
+		return;
+	}
+	if (m->session != NULL) {
+		g_ort->ReleaseSession(m->session);
+	}
+	if (m->mem != NULL) {
+		g_ort->ReleaseMemoryInfo(m->mem);
+	}
+	if (m->input_names != NULL) {
+		for (size_t i = 0; i < m->num_inputs; i++) {
+			free(m->input_names[i]);
+		}
+		free(m->input_names);
+	}
+	free(m->output_name);
+	free(m);
+}
+
+static char* ort_load(OrtEnv* env, const char* path, int threads, ort_model** out) {
+	OrtAllocator* allocator = NULL;
+	OrtSessionOptions* opts = NULL;
+	char* name = NULL;
+	ort_model* m = calloc(1, sizeof(ort_model));
+	char* err = ort_error(g_ort->GetAllocatorWithDefaultOptions(&allocator));
+	if (err == NULL) {
+		err = ort_error(g_ort->CreateSessionOptions(&opts));
+	}
+	if (err == NULL) {
+		err = ort_error(g_ort->SetIntraOpNumThreads(opts, threads));
+	}
+	if (err == NULL) {
+		err = ort_error(g_ort->SetSessionGraphOptimizationLevel(opts, ORT_ENABLE_ALL));
+	}
+	if (err == NULL) {
+		err = ort_error(g_ort->CreateSession(env, path, opts, &m->session));
+	}
+	if (err == NULL) {
+		err = ort_error(g_ort->CreateCpuMemoryInfo(OrtArenaAllocator, OrtMemTypeDefault, &m->mem));
+	}
+	if (err == NULL) {
+		err = ort_error(g_ort->SessionGetInputCount(m->session, &m->num_inputs));
+	}
+	if (err == NULL) {
+		m->input_names = calloc(m->num_inputs, sizeof(char*));
+		for (size_t i = 0; i < m->num_inputs && err == NULL; i++) {
+			err = ort_error(g_ort->SessionGetInputName(m->session, i, allocator, &name));
+			if (err == NULL) {
+				m->input_names[i] = strdup(name);
+				allocator->Free(allocator, name);
+			}
+		}
+	}
+	if (err == NULL) {
+		err = ort_error(g_ort->SessionGetOutputName(m->session, 0, allocator, &name));
+		if (err == NULL) {
+			m->output_name = strdup(name);
+			allocator->Free(allocator, name);
+		}
+	}
+	if (opts != NULL) {
+		g_ort->ReleaseSessionOptions(opts);
+	}
+	if (err != NULL) {
+		ort_release(m);
+		return err;
+	}
+	*out = m;
+	return NULL;
+}
+
+static const char* ort_input_name(ort_model* m, size_t i) {
+	return m->input_names[i];
+}
+
+// data holds num_inputs int64 tensors of shape [batch, seq_len] one after another.
+static char* ort_run(ort_model* m, int64_t* data, int64_t batch, int64_t seq_len, OrtValue** output) {
+	int64_t shape[2] = {batch, seq_len};
+	size_t n = (size_t)(batch * seq_len);
+	OrtValue** inputs = calloc(m->num_inputs, sizeof(OrtValue*));
+	char* err = NULL;
+	for (size_t i = 0; i < m->num_inputs && err == NULL; i++) {
+		err = ort_error(g_ort->CreateTensorWithDataAsOrtValue(m->mem, data + i * n, n * sizeof(int64_t),
+			shape, 2, ONNX_TENSOR_ELEMENT_DATA_TYPE_INT64, &inputs[i]));
+	}
+	if (err == NULL) {
+		err = ort_error(g_ort->Run(m->session, NULL, (const char* const*)m->input_names, (const OrtValue* const*)inputs,
+			m->num_inputs, (const char* const*)&m->output_name, 1, output));
+	}
+	for (size_t i = 0; i < m->num_inputs; i++) {
+		if (inputs[i] != NULL) {
+			g_ort->ReleaseValue(inputs[i]);
+		}
+	}
+	free(inputs);
+	return err;
+}
+
+static char* ort_output(OrtValue* value, float** data, int64_t* dims, size_t* num_dims) {
+	OrtTensorTypeAndShapeInfo* info = NULL;
+	char* err = ort_error(g_ort->GetTensorTypeAndShape(value, &info));
+	if (err != NULL) {
+		return err;
+	}
+	err = ort_error(g_ort->GetDimensionsCount(info, num_dims));
+	if (err == NULL && *num_dims > 3) {
+		err = strdup("unexpected output tensor rank");
+	}
+	if (err == NULL) {
+		err = ort_error(g_ort->GetDimensions(info, dims, *num_dims));
+	}
+	g_ort->ReleaseTensorTypeAndShapeInfo(info);
+	if (err == NULL) {
+		err = ort_error(g_ort->GetTensorMutableData(value, (void**)data));
+	}
+	return err;
+}
+
+static void ort_release_value(OrtValue* value) {
+	g_ort->ReleaseValue(value);
+}
+*/
+import "C"
+
+import (
+	"fmt"
+	"sync"
+	"unsafe"
+
+	"github.com/cockroachdb/errors"
+)
+
+// RuntimeEnabled reports whether onnx runtime is compiled into this build.
+const RuntimeEnabled = true
+
+var (
+	ortEnv     *C.OrtEnv
+	ortInitErr error
+	ortOnce    sync.Once
+)
+
+func cError(msg *C.char) error {
+	defer C.free(unsafe.Pointer(msg))
+	return errors.New(C.GoString(msg))
+}
+
+// ortSession is the Session backed by onnx runtime C api.
+type ortSession struct {
+	model      *C.ort_model
+	inputNames []string
+}
+
+func newOrtSession(modelPath string, intraOpThreads int) (Session, error) {
+	ortOnce.Do(func() {
+		if msg := C.ort_init(&ortEnv); msg != nil {
+			ortInitErr = cError(msg)
+		}
+	})
+	if ortInitErr != nil {
+		return nil, fmt.Errorf("Init onnx runtime failed: %v", ortInitErr)
+	}
+
+	cPath := C.CString(modelPath)
+	defer C.free(unsafe.Pointer(cPath))
+	var model *C.ort_model
+	if msg := C.ort_load(ortEnv, cPath, C.int(intraOpThreads), &model); msg != nil {
+		return nil, fmt.Errorf("Load onnx model %s failed: %v", modelPath, cError(msg))
+	}
+
+	inputNames := make([]string, 0, int(model.num_inputs))
+	for i := 0; i < int(model.num_inputs); i++ {
+		inputNames = append(inputNames, C.GoString(C.ort_input_name(model, C.size_t(i))))
+	}
+	return &ortSession{model: model, inputNames: inputNames}, nil
+}
+
+func (s *ortSession) InputNames() []string {
+	return s.inputNames
+}
+
+func (s *ortSession) Run(inputs [][]int64, batch int, seqLen int) ([]float32, []int64, error) {
+	if len(inputs) != len(s.inputNames) {
+		return nil, nil, fmt.Errorf("The model requires %d inputs, but got %d", len(s.inputNames), len(inputs))
+	}
+	data := make([]int64, 0, len(inputs)*batch*seqLen)
+	for _, input := range inputs {
+		data = append(data, input...)
+	}
+
+	var output *C.OrtValue
+	if msg := C.ort_run(s.model, (*C.int64_t)(unsafe.Pointer(&data[0])), C.int64_t(batch), C.int64_t(seqLen), &output); msg != nil {
+		return nil, nil, fmt.Errorf("Run onnx model failed: %v", cError(msg))
+	}
+	defer C.ort_release_value(output)
+
+	var outData *C.float
+	var dims [3]C.int64_t
+	var numDims C.size_t
+	if msg := C.ort_output(output, &outData, &dims[0], &numDims); msg != nil {
+		return nil, nil, fmt.Errorf("Read onnx model output failed: %v", cError(msg))
+	}
+	shape := make([]int64, 0, int(numDims))
+	size := int64(1)
+	for i := 0; i < int(numDims); i++ {
+		shape = append(shape, int64(dims[i]))
+		size *= int64(dims[i])
+	}
+	ret := make([]float32, size)
+	copy(ret, unsafe.Slice((*float32)(unsafe.Pointer(outData)), size))
+	return ret, shape, nil
+}
+
+func (s *ortSession) Close() {
+	C.ort_release(s.model)
+}
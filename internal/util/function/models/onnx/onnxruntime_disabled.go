@@ -0,0 +1,15 @@
+//go:build !onnx
+// +build !onnx
+
+package onnx
+
+import (
+	"github.com/cockroachdb/errors"
+)
+
+// RuntimeEnabled reports whether onnx runtime is compiled into this build.
+const RuntimeEnabled = false
+
+func newOrtSession(modelPath string, intraOpThreads int) (Session, error) {
+	return nil, errors.New("onnx runtime is not enabled in this build, please rebuild milvus with build tag onnx")
+}
@@ -0,0 +1,36 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onnx
+
+const (
+	inputIDsName      = "input_ids"
+	attentionMaskName = "attention_mask"
+	tokenTypeIDsName  = "token_type_ids"
+)
+
+// Session is an inference session of an encoder model.
+type Session interface {
+	// InputNames returns the names of model inputs, each one is an int64 tensor of shape [batch, seqLen].
+	InputNames() []string
+	// Run runs the model with inputs ordered by InputNames,
+	// returns the data and shape of the first output tensor.
+	Run(inputs [][]int64, batch int, seqLen int) ([]float32, []int64, error)
+	Close()
+}
+
+// newSession creates the inference session of model file, it's a variable so that it could be mocked in unittest.
+var newSession = newOrtSession
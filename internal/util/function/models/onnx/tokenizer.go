@@ -0,0 +1,187 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onnx
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	clsToken = "[CLS]"
+	sepToken = "[SEP]"
+	padToken = "[PAD]"
+	unkToken = "[UNK]"
+
+	maxWordPieceChars = 100
+)
+
+// Tokenizer is the WordPiece tokenizer used by BERT style encoders.
+type Tokenizer struct {
+	vocab     map[string]int64
+	lowerCase bool
+
+	clsID int64
+	sepID int64
+	padID int64
+	unkID int64
+}
+
+// NewTokenizer loads the vocabulary from vocab file, one token per line and the line number is the token id.
+func NewTokenizer(vocabPath string, lowerCase bool) (*Tokenizer, error) {
+	f, err := os.Open(vocabPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	vocab := make(map[string]int64)
+	scanner := bufio.NewScanner(f)
+	id := int64(0)
+	for scanner.Scan() {
+		token := strings.TrimRight(scanner.Text(), "\r")
+		if _, exist := vocab[token]; !exist {
+			vocab[token] = id
+		}
+		id++
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return newTokenizer(vocab, lowerCase)
+}
+
+func newTokenizer(vocab map[string]int64, lowerCase bool) (*Tokenizer, error) {
+	t := &Tokenizer{vocab: vocab, lowerCase: lowerCase}
+	for token, id := range map[string]*int64{clsToken: &t.clsID, sepToken: &t.sepID, padToken: &t.padID, unkToken: &t.unkID} {
+		tokenID, ok := vocab[token]
+		if !ok {
+			return nil, fmt.Errorf("Special token %s not found in vocabulary", token)
+		}
+		*id = tokenID
+	}
+	return t, nil
+}
+
+// Encode converts text into token ids wrapped by [CLS] and [SEP], the result has at most maxLen tokens.
+func (t *Tokenizer) Encode(text string, maxLen int) []int64 {
+	ids := []int64{t.clsID}
+	for _, word := range t.basicTokenize(text) {
+		if len(ids) >= maxLen-1 {
+			break
+		}
+		ids = append(ids, t.wordPiece(word)...)
+	}
+	if len(ids) > maxLen-1 {
+		ids = ids[:maxLen-1]
+	}
+	return append(ids, t.sepID)
+}
+
+// basicTokenize cleans text and splits it on whitespace, punctuations and CJK characters.
+func (t *Tokenizer) basicTokenize(text string) []string {
+	if t.lowerCase {
+		text = stripAccents(strings.ToLower(text))
+	}
+	words := make([]string, 0)
+	var sb strings.Builder
+	flush := func() {
+		if sb.Len() > 0 {
+			words = append(words, sb.String())
+			sb.Reset()
+		}
+	}
+	for _, r := range text {
+		switch {
+		case r == 0 || r == unicode.ReplacementChar:
+		case unicode.IsSpace(r):
+			flush()
+		case unicode.IsControl(r):
+		case isPunctuation(r) || isCJK(r):
+			flush()
+			words = append(words, string(r))
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	flush()
+	return words
+}
+
+// wordPiece splits a word into sub-words with greedy longest-match-first algorithm.
+func (t *Tokenizer) wordPiece(word string) []int64 {
+	runes := []rune(word)
+	if len(runes) > maxWordPieceChars {
+		return []int64{t.unkID}
+	}
+	ids := make([]int64, 0)
+	for start := 0; start < len(runes); {
+		end := len(runes)
+		matched := false
+		for ; end > start; end-- {
+			piece := string(runes[start:end])
+			if start > 0 {
+				piece = "##" + piece
+			}
+			if id, ok := t.vocab[piece]; ok {
+				ids = append(ids, id)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return []int64{t.unkID}
+		}
+		start = end
+	}
+	return ids
+}
+
+func stripAccents(text string) string {
+	var sb strings.Builder
+	for _, r := range norm.NFD.String(text) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+func isPunctuation(r rune) bool {
+	// treat all non-letter/number ASCII characters as punctuation, e.g. "^", "$" and "`"
+	if (r >= 33 && r <= 47) || (r >= 58 && r <= 64) || (r >= 91 && r <= 96) || (r >= 123 && r <= 126) {
+		return true
+	}
+	return unicode.IsPunct(r)
+}
+
+func isCJK(r rune) bool {
+	return (r >= 0x4E00 && r <= 0x9FFF) ||
+		(r >= 0x3400 && r <= 0x4DBF) ||
+		(r >= 0x20000 && r <= 0x2A6DF) ||
+		(r >= 0x2A700 && r <= 0x2B73F) ||
+		(r >= 0x2B740 && r <= 0x2B81F) ||
+		(r >= 0x2B820 && r <= 0x2CEAF) ||
+		(r >= 0xF900 && r <= 0xFAFF) ||
+		(r >= 0x2F800 && r <= 0x2FA1F)
+}
@@ -0,0 +1,262 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/credentials"
+	"github.com/milvus-io/milvus/internal/util/function/models/onnx"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+var (
+	// fetchModelMu serializes model downloading, so that one model is downloaded only once
+	fetchModelMu sync.Mutex
+	// newModelStorage returns the object storage of remote models, it's a variable so that it could be mocked in unittest.
+	newModelStorage = func(ctx context.Context) (storage.ChunkManager, error) {
+		return storage.NewChunkManagerFactoryWithParam(paramtable.Get()).NewPersistentStorageChunkManager(ctx)
+	}
+)
+
+// OnnxEmbeddingProvider runs the embedding model in process on CPU,
+// the model is loaded from {model_dir}/{model_name} configured in milvus.yaml.
+// If remote_model_dir is configured, the model missing in model_dir is downloaded
+// from {remote_model_dir}/{model_name} of the object storage first.
+//
+// Onnx runtime is only compiled into milvus built with `make USE_ONNX=ON`.
+type OnnxEmbeddingProvider struct {
+	fieldDim int64
+
+	client *onnx.OnnxEmbedding
+
+	ingestionPrompt string
+	searchPrompt    string
+
+	maxBatch int
+}
+
+func isOnnxEnabled(conf map[string]string) bool {
+	// milvus.yaml > env, in process inference is disabled by default
+	if value, exists := conf["enable"]; exists {
+		return strings.ToLower(value) == "true"
+	}
+	return strings.ToLower(os.Getenv(EnableOnnxEnvStr)) == "true"
+}
+
+func parsePositiveInt(key string, value string) (int, error) {
+	v, err := strconv.Atoi(value)
+	if err != nil || v <= 0 {
+		return 0, fmt.Errorf("[%s param's value: %s] is not a valid positive number", key, value)
+	}
+	return v, nil
+}
+
+func NewOnnxEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functionSchema *schemapb.FunctionSchema, params map[string]string, credentials *credentials.Credentials) (*OnnxEmbeddingProvider, error) {
+	if !isOnnxEnabled(params) {
+		return nil, errors.New("Onnx model serving is not enabled")
+	}
+	fieldDim, err := typeutil.GetDim(fieldSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	option := &onnx.OnnxEmbeddingOption{
+		ModelDir:       params[onnxModelDirConfKey],
+		LowerCase:      true,
+		MaxSeqLength:   512,
+		Normalize:      true,
+		MaxWorkers:     4,
+		IntraOpThreads: 1,
+	}
+	if option.ModelDir == "" {
+		return nil, fmt.Errorf("Onnx model dir is not configured, please set function.textEmbedding.providers.%s.%s in milvus.yaml", onnxProvider, onnxModelDirConfKey)
+	}
+	if value, ok := params[onnxMaxWorkersConfKey]; ok && value != "" {
+		if option.MaxWorkers, err = parsePositiveInt(onnxMaxWorkersConfKey, value); err != nil {
+			return nil, err
+		}
+	}
+	if value, ok := params[onnxIntraOpThreadsConfKey]; ok && value != "" {
+		if option.IntraOpThreads, err = parsePositiveInt(onnxIntraOpThreadsConfKey, value); err != nil {
+			return nil, err
+		}
+	}
+
+	var modelName, ingestionPrompt, searchPrompt string
+	maxBatch := 32
+	for _, param := range functionSchema.Params {
+		switch strings.ToLower(param.Key) {
+		case modelNameParamKey:
+			modelName = param.Value
+		case ingestionPromptParamKey:
+			ingestionPrompt = param.Value
+		case searchPromptParamKey:
+			searchPrompt = param.Value
+		case maxClientBatchSizeParamKey:
+			if maxBatch, err = parsePositiveInt(maxClientBatchSizeParamKey, param.Value); err != nil {
+				return nil, err
+			}
+		case maxSeqLengthParamKey:
+			if option.MaxSeqLength, err = parsePositiveInt(maxSeqLengthParamKey, param.Value); err != nil {
+				return nil, err
+			}
+		case normalizeParamKey:
+			if option.Normalize, err = strconv.ParseBool(param.Value); err != nil {
+				return nil, fmt.Errorf("[%s param's value: %s] is invalid, only supports: [true/false]", normalizeParamKey, param.Value)
+			}
+		case lowerCaseParamKey:
+			if option.LowerCase, err = strconv.ParseBool(param.Value); err != nil {
+				return nil, fmt.Errorf("[%s param's value: %s] is invalid, only supports: [true/false]", lowerCaseParamKey, param.Value)
+			}
+		default:
+		}
+	}
+	// model name is relative to the model dir, so that the function can't read arbitrary files
+	if modelName == "" || !filepath.IsLocal(modelName) {
+		return nil, fmt.Errorf("[%s param's value: %s] is invalid, it should be a sub directory of the model dir", modelNameParamKey, modelName)
+	}
+	option.ModelDir = filepath.Join(option.ModelDir, modelName)
+
+	if !onnx.RuntimeEnabled {
+		return nil, errors.New("Onnx runtime is not compiled into this milvus binary, please build milvus with `make USE_ONNX=ON` and onnxruntime installed")
+	}
+	if remoteDir := params[onnxRemoteModelDirConfKey]; remoteDir != "" {
+		if err := fetchOnnxModel(context.Background(), path.Join(remoteDir, modelName), option.ModelDir); err != nil {
+			return nil, err
+		}
+	}
+
+	c, err := onnx.NewOnnxEmbedding(option)
+	if err != nil {
+		return nil, err
+	}
+	return &OnnxEmbeddingProvider{
+		client:          c,
+		fieldDim:        fieldDim,
+		ingestionPrompt: ingestionPrompt,
+		searchPrompt:    searchPrompt,
+		maxBatch:        maxBatch,
+	}, nil
+}
+
+// fetchOnnxModel downloads model files from remoteDir of the object storage into localDir, if not downloaded yet.
+func fetchOnnxModel(ctx context.Context, remoteDir string, localDir string) error {
+	fetchModelMu.Lock()
+	defer fetchModelMu.Unlock()
+
+	missing := make([]string, 0, 2)
+	for _, name := range []string{onnx.ModelFileName, onnx.VocabFileName} {
+		if _, err := os.Stat(filepath.Join(localDir, name)); os.IsNotExist(err) {
+			missing = append(missing, name)
+		} else if err != nil {
+			return err
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	cm, err := newModelStorage(ctx)
+	if err != nil {
+		return fmt.Errorf("Connect to the object storage of onnx models failed: %v", err)
+	}
+	if err := os.MkdirAll(localDir, 0o755); err != nil {
+		return err
+	}
+	for _, name := range missing {
+		if err := downloadFile(ctx, cm, path.Join(remoteDir, name), filepath.Join(localDir, name)); err != nil {
+			return fmt.Errorf("Download onnx model file %s failed: %v", path.Join(remoteDir, name), err)
+		}
+	}
+	return nil
+}
+
+// downloadFile streams the remote file into a temp file, which is renamed to localPath once completed.
+func downloadFile(ctx context.Context, cm storage.ChunkManager, remotePath string, localPath string) error {
+	reader, err := cm.Reader(ctx, remotePath)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	tmp, err := os.CreateTemp(filepath.Dir(localPath), filepath.Base(localPath)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, reader); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), localPath)
+}
+
+func (provider *OnnxEmbeddingProvider) MaxBatch() int {
+	return 5 * provider.maxBatch
+}
+
+func (provider *OnnxEmbeddingProvider) FieldDim() int64 {
+	return provider.fieldDim
+}
+
+func (provider *OnnxEmbeddingProvider) CallEmbedding(texts []string, mode TextEmbeddingMode) (any, error) {
+	prompt := provider.searchPrompt
+	if mode == InsertMode {
+		prompt = provider.ingestionPrompt
+	}
+	if prompt != "" {
+		prompted := make([]string, 0, len(texts))
+		for _, text := range texts {
+			prompted = append(prompted, prompt+text)
+		}
+		texts = prompted
+	}
+
+	data, err := provider.client.Embedding(texts, provider.maxBatch)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != len(texts) {
+		return nil, fmt.Errorf("Get embedding failed. The number of texts and embeddings does not match text:[%d], embedding:[%d]", len(texts), len(data))
+	}
+	for _, item := range data {
+		if len(item) != int(provider.fieldDim) {
+			return nil, fmt.Errorf("The required embedding dim is [%d], but the embedding obtained from the model is [%d]",
+				provider.fieldDim, len(item))
+		}
+	}
+	return data, nil
+}
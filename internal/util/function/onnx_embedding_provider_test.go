@@ -0,0 +1,147 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/credentials"
+	"github.com/milvus-io/milvus/internal/util/function/models/onnx"
+	"github.com/milvus-io/milvus/pkg/v2/objectstorage"
+)
+
+func TestOnnxEmbeddingProvider(t *testing.T) {
+	suite.Run(t, new(OnnxEmbeddingProviderSuite))
+}
+
+type OnnxEmbeddingProviderSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+}
+
+func (s *OnnxEmbeddingProviderSuite) SetupTest() {
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "int64", DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar},
+			{
+				FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "4"},
+				},
+			},
+		},
+	}
+}
+
+func (s *OnnxEmbeddingProviderSuite) createProvider(params []*commonpb.KeyValuePair, conf map[string]string) (textEmbeddingProvider, error) {
+	functionSchema := &schemapb.FunctionSchema{
+		Name:             "test",
+		Type:             schemapb.FunctionType_TextEmbedding,
+		InputFieldNames:  []string{"text"},
+		OutputFieldNames: []string{"vector"},
+		InputFieldIds:    []int64{101},
+		OutputFieldIds:   []int64{102},
+		Params:           params,
+	}
+	return NewOnnxEmbeddingProvider(s.schema.Fields[2], functionSchema, conf, credentials.NewCredentials(map[string]string{}))
+}
+
+func (s *OnnxEmbeddingProviderSuite) TestParams() {
+	modelParams := []*commonpb.KeyValuePair{
+		{Key: modelNameParamKey, Value: "bge-small"},
+	}
+	conf := map[string]string{"enable": "true", onnxModelDirConfKey: s.T().TempDir()}
+
+	{
+		_, err := s.createProvider(modelParams, map[string]string{})
+		s.ErrorContains(err, "not enabled")
+		s.T().Setenv(EnableOnnxEnvStr, "true")
+		_, err = s.createProvider(modelParams, map[string]string{})
+		s.ErrorContains(err, "model dir is not configured")
+	}
+	{
+		_, err := s.createProvider(modelParams, map[string]string{"enable": "true", onnxModelDirConfKey: "/models", onnxMaxWorkersConfKey: "0"})
+		s.ErrorContains(err, onnxMaxWorkersConfKey)
+		_, err = s.createProvider(modelParams, map[string]string{"enable": "true", onnxModelDirConfKey: "/models", onnxIntraOpThreadsConfKey: "a"})
+		s.ErrorContains(err, onnxIntraOpThreadsConfKey)
+	}
+	for _, modelName := range []string{"", "../bge-small", "/models/bge-small"} {
+		_, err := s.createProvider([]*commonpb.KeyValuePair{{Key: modelNameParamKey, Value: modelName}}, conf)
+		s.ErrorContains(err, modelNameParamKey)
+	}
+	for _, param := range []*commonpb.KeyValuePair{
+		{Key: maxClientBatchSizeParamKey, Value: "-1"},
+		{Key: maxSeqLengthParamKey, Value: "a"},
+		{Key: normalizeParamKey, Value: "a"},
+		{Key: lowerCaseParamKey, Value: "a"},
+	} {
+		_, err := s.createProvider(append([]*commonpb.KeyValuePair{param}, modelParams...), conf)
+		s.ErrorContains(err, param.Key)
+	}
+	{
+		// model files not exist
+		_, err := s.createProvider(modelParams, conf)
+		s.Error(err)
+		if !onnx.RuntimeEnabled {
+			s.ErrorContains(err, "USE_ONNX=ON")
+		}
+	}
+}
+
+func (s *OnnxEmbeddingProviderSuite) TestFetchModel() {
+	ctx := context.Background()
+	remoteRoot := s.T().TempDir()
+	cm := storage.NewLocalChunkManager(objectstorage.RootPath(remoteRoot))
+	s.Require().NoError(cm.Write(ctx, path.Join(remoteRoot, "models/bge-small", onnx.ModelFileName), []byte("model")))
+	s.Require().NoError(cm.Write(ctx, path.Join(remoteRoot, "models/bge-small", onnx.VocabFileName), []byte("vocab")))
+
+	oldNewModelStorage := newModelStorage
+	defer func() { newModelStorage = oldNewModelStorage }()
+	connected := 0
+	newModelStorage = func(ctx context.Context) (storage.ChunkManager, error) {
+		connected++
+		return cm, nil
+	}
+
+	localDir := filepath.Join(s.T().TempDir(), "bge-small")
+	s.NoError(fetchOnnxModel(ctx, path.Join(remoteRoot, "models/bge-small"), localDir))
+	content, err := os.ReadFile(filepath.Join(localDir, onnx.ModelFileName))
+	s.NoError(err)
+	s.Equal("model", string(content))
+	content, err = os.ReadFile(filepath.Join(localDir, onnx.VocabFileName))
+	s.NoError(err)
+	s.Equal("vocab", string(content))
+
+	// downloaded model is not fetched again
+	s.NoError(fetchOnnxModel(ctx, path.Join(remoteRoot, "models/bge-small"), localDir))
+	s.Equal(1, connected)
+
+	s.Error(fetchOnnxModel(ctx, path.Join(remoteRoot, "models/not-exist"), filepath.Join(s.T().TempDir(), "not-exist")))
+}
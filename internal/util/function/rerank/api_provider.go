@@ -0,0 +1,209 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package rerank
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/util/credentials"
+	"github.com/milvus-io/milvus/internal/util/function"
+)
+
+const (
+	cohereProviderName      string = "cohere"
+	voyageAIProviderName    string = "voyageai"
+	jinaAIProviderName      string = "jina"
+	siliconflowProviderName string = "siliconflow"
+
+	modelNameParamName string = "model_name"
+)
+
+type paramType int
+
+const (
+	intParam paramType = iota
+	boolParam
+)
+
+// apiProviderConfig describes a hosted rerank service whose API is similar to `POST /rerank`
+// with body `{"model": ..., "query": ..., "documents": [...]}` and
+// response `{"<resultsKey>": [{"index": ..., "relevance_score": ...}]}`.
+type apiProviderConfig struct {
+	name       string
+	defaultURL string
+	apiKeyEnv  string
+	// maxDocs is the max number of documents in one request allowed by the service
+	maxDocs    int
+	resultsKey string
+	// provider specific params passed through to request body
+	extraParams map[string]paramType
+}
+
+var apiProviders = map[string]*apiProviderConfig{
+	cohereProviderName: {
+		name:        cohereProviderName,
+		defaultURL:  "https://api.cohere.com/v2/rerank",
+		apiKeyEnv:   function.CohereAIAKEnvStr,
+		maxDocs:     1000,
+		resultsKey:  "results",
+		extraParams: map[string]paramType{"max_tokens_per_doc": intParam},
+	},
+	voyageAIProviderName: {
+		name:        voyageAIProviderName,
+		defaultURL:  "https://api.voyageai.com/v1/rerank",
+		apiKeyEnv:   function.VoyageAIAKEnvStr,
+		maxDocs:     1000,
+		resultsKey:  "data",
+		extraParams: map[string]paramType{"truncation": boolParam},
+	},
+	jinaAIProviderName: {
+		name:        jinaAIProviderName,
+		defaultURL:  "https://api.jina.ai/v1/rerank",
+		apiKeyEnv:   function.JinaAIAKEnvStr,
+		maxDocs:     1000,
+		resultsKey:  "results",
+		extraParams: map[string]paramType{},
+	},
+	siliconflowProviderName: {
+		name:        siliconflowProviderName,
+		defaultURL:  "https://api.siliconflow.cn/v1/rerank",
+		apiKeyEnv:   function.SiliconflowAKEnvStr,
+		maxDocs:     500,
+		resultsKey:  "results",
+		extraParams: map[string]paramType{"max_chunks_per_doc": intParam, "overlap_tokens": intParam},
+	},
+}
+
+type apiRerankResult struct {
+	Index          int     `json:"index"`
+	RelevanceScore float32 `json:"relevance_score"`
+}
+
+type apiProvider struct {
+	baseModel
+}
+
+func newAPIProvider(conf *apiProviderConfig, params []*commonpb.KeyValuePair, yamlConf map[string]string, credentials *credentials.Credentials) (modelProvider, error) {
+	// function param > milvus.yaml > env
+	apiKey, url, err := function.ParseAKAndURL(credentials, params, yamlConf, conf.apiKeyEnv)
+	if err != nil {
+		return nil, err
+	}
+	if url == "" {
+		url = conf.defaultURL
+	}
+
+	maxBatch := conf.maxDocs
+	requestParams := map[string]any{}
+	for _, param := range params {
+		key := strings.ToLower(param.Key)
+		switch key {
+		case function.EndpointParamKey:
+			url = param.Value
+		case maxBatchKeyName:
+			if maxBatch, err = parseMaxBatch(param.Value); err != nil {
+				return nil, err
+			}
+		case modelNameParamName:
+			requestParams["model"] = param.Value
+		default:
+			pType, ok := conf.extraParams[key]
+			if !ok {
+				continue
+			}
+			switch pType {
+			case intParam:
+				v, err := strconv.ParseInt(param.Value, 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("Rerank params error, %s: %s is not a number", param.Key, param.Value)
+				}
+				requestParams[key] = v
+			case boolParam:
+				v, err := strconv.ParseBool(param.Value)
+				if err != nil {
+					return nil, fmt.Errorf("Rerank params error, %s: %s is not bool type", param.Key, param.Value)
+				}
+				requestParams[key] = v
+			}
+		}
+	}
+
+	if url, err = checkEndpoint(url); err != nil {
+		return nil, err
+	}
+	if _, ok := requestParams["model"]; !ok {
+		return nil, fmt.Errorf("%s rerank lost params %s", conf.name, modelNameParamName)
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("Missing credentials config or configure the %s environment variable in the Milvus service", conf.apiKeyEnv)
+	}
+	if maxBatch <= 0 || maxBatch > conf.maxDocs {
+		return nil, fmt.Errorf("Rerank function params max_batch must be in (0, %d] for %s, but got %d", conf.maxDocs, conf.name, maxBatch)
+	}
+
+	model := baseModel{
+		url:           url,
+		maxBatch:      maxBatch,
+		queryKey:      "query",
+		docKey:        "documents",
+		requestParams: requestParams,
+		headers: map[string]string{
+			"Authorization": "Bearer " + apiKey,
+		},
+		parseScores: func(body []byte, numDocs int) ([]float32, error) {
+			return parseAPIRerankResponse(conf, body, numDocs)
+		},
+	}
+	return &apiProvider{baseModel: model}, nil
+}
+
+// parseAPIRerankResponse returns scores ordered by the numDocs documents in request,
+// the results of service are sorted by relevance score.
+// Each document shall be scored exactly once, otherwise scores would be assigned to wrong rows.
+func parseAPIRerankResponse(conf *apiProviderConfig, body []byte, numDocs int) ([]float32, error) {
+	var resp map[string]json.RawMessage
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("Rerank error, parsing %s response failed: %v", conf.name, err)
+	}
+	var results []apiRerankResult
+	if err := json.Unmarshal(resp[conf.resultsKey], &results); err != nil {
+		return nil, fmt.Errorf("Rerank error, parsing %s response failed: %v", conf.name, err)
+	}
+
+	if len(results) != numDocs {
+		return nil, fmt.Errorf("Rerank error, %d documents in request but got %d results in %s response", numDocs, len(results), conf.name)
+	}
+	scores := make([]float32, numDocs)
+	seen := make([]bool, numDocs)
+	for _, result := range results {
+		if result.Index < 0 || result.Index >= numDocs {
+			return nil, fmt.Errorf("Rerank error, document index %d out of range [0, %d) in %s response", result.Index, numDocs, conf.name)
+		}
+		if seen[result.Index] {
+			return nil, fmt.Errorf("Rerank error, duplicate document index %d in %s response", result.Index, conf.name)
+		}
+		seen[result.Index] = true
+		scores[result.Index] = result.RelevanceScore
+	}
+	return scores, nil
+}
@@ -0,0 +1,165 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package rerank
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/util/function"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestAPIRerankProvider(t *testing.T) {
+	suite.Run(t, new(APIRerankProviderSuite))
+}
+
+type APIRerankProviderSuite struct {
+	suite.Suite
+}
+
+func (s *APIRerankProviderSuite) SetupTest() {
+	paramtable.Init()
+	paramtable.Get().FunctionCfg.RerankModelProviders.GetFunc = func() map[string]string {
+		return map[string]string{}
+	}
+}
+
+func (s *APIRerankProviderSuite) TestNewProvider() {
+	for _, conf := range apiProviders {
+		s.T().Setenv(conf.apiKeyEnv, "mock")
+		params := []*commonpb.KeyValuePair{
+			{Key: providerParamName, Value: conf.name},
+			{Key: modelNameParamName, Value: "rerank-model"},
+		}
+		provider, err := newProvider(params)
+		s.NoError(err)
+		s.Equal(conf.defaultURL, provider.getURL())
+
+		_, err = newProvider(params[:1])
+		s.ErrorContains(err, "lost params model_name")
+
+		_, err = newProvider(append(params, &commonpb.KeyValuePair{Key: maxBatchKeyName, Value: "100000"}))
+		s.ErrorContains(err, "max_batch must be in")
+
+		_, err = newProvider(append(params, &commonpb.KeyValuePair{Key: function.EndpointParamKey, Value: "localhost"}))
+		s.ErrorContains(err, "is not a valid http/https link")
+
+		s.T().Setenv(conf.apiKeyEnv, "")
+		_, err = newProvider(params)
+		s.ErrorContains(err, conf.apiKeyEnv)
+	}
+
+	s.T().Setenv(function.CohereAIAKEnvStr, "mock")
+	_, err := newProvider([]*commonpb.KeyValuePair{
+		{Key: providerParamName, Value: cohereProviderName},
+		{Key: modelNameParamName, Value: "rerank-v3.5"},
+		{Key: "max_tokens_per_doc", Value: "a"},
+	})
+	s.ErrorContains(err, "max_tokens_per_doc")
+
+	paramtable.Get().FunctionCfg.RerankModelProviders.GetFunc = func() map[string]string {
+		return map[string]string{"cohere.url": "http://localhost:8080/v2/rerank"}
+	}
+	provider, err := newProvider([]*commonpb.KeyValuePair{
+		{Key: providerParamName, Value: cohereProviderName},
+		{Key: modelNameParamName, Value: "rerank-v3.5"},
+	})
+	s.NoError(err)
+	s.Equal("http://localhost:8080/v2/rerank", provider.getURL())
+}
+
+func (s *APIRerankProviderSuite) TestRerank() {
+	for _, conf := range apiProviders {
+		var requests []map[string]any
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			s.Equal("Bearer mock", r.Header.Get("Authorization"))
+			body, _ := io.ReadAll(r.Body)
+			var req map[string]any
+			s.NoError(json.Unmarshal(body, &req))
+			requests = append(requests, req)
+
+			// results are sorted by score, score is the length of document
+			docs := req["documents"].([]any)
+			results := make([]map[string]any, 0, len(docs))
+			for i := len(docs) - 1; i >= 0; i-- {
+				results = append(results, map[string]any{"index": i, "relevance_score": float32(len(docs[i].(string)))})
+			}
+			resp, _ := json.Marshal(map[string]any{conf.resultsKey: results})
+			w.WriteHeader(http.StatusOK)
+			w.Write(resp)
+		}))
+
+		s.T().Setenv(conf.apiKeyEnv, "mock")
+		params := []*commonpb.KeyValuePair{
+			{Key: providerParamName, Value: conf.name},
+			{Key: modelNameParamName, Value: "rerank-model"},
+			{Key: function.EndpointParamKey, Value: ts.URL},
+			{Key: maxBatchKeyName, Value: "2"},
+		}
+		for key, pType := range conf.extraParams {
+			if pType == intParam {
+				params = append(params, &commonpb.KeyValuePair{Key: key, Value: "10"})
+			} else {
+				params = append(params, &commonpb.KeyValuePair{Key: key, Value: "true"})
+			}
+		}
+		provider, err := newProvider(params)
+		s.NoError(err)
+		scores, err := provider.rerank(context.Background(), "query", []string{"a", "bbb", "cc"})
+		s.NoError(err)
+		s.Equal([]float32{1, 3, 2}, scores)
+
+		s.Len(requests, 2)
+		s.Equal("rerank-model", requests[0]["model"])
+		s.Equal("query", requests[0]["query"])
+		s.Equal([]any{"cc"}, requests[1]["documents"])
+		for key := range conf.extraParams {
+			s.Contains(requests[0], key)
+		}
+		ts.Close()
+	}
+}
+
+func (s *APIRerankProviderSuite) TestParseResponse() {
+	conf := apiProviders[cohereProviderName]
+	scores, err := parseAPIRerankResponse(conf, []byte(`{"results": [{"index": 1, "relevance_score": 0.9}, {"index": 0, "relevance_score": 0.1}]}`), 2)
+	s.NoError(err)
+	s.Equal([]float32{0.1, 0.9}, scores)
+
+	_, err = parseAPIRerankResponse(conf, []byte(`not json`), 2)
+	s.ErrorContains(err, "parsing cohere response failed")
+	_, err = parseAPIRerankResponse(conf, []byte(`{"data": []}`), 2)
+	s.ErrorContains(err, "parsing cohere response failed")
+	_, err = parseAPIRerankResponse(conf, []byte(`{"results": [{"index": 1, "relevance_score": 0.9}, {"index": 1, "relevance_score": 0.1}]}`), 2)
+	s.ErrorContains(err, "duplicate document index")
+	// index within the results but out of the documents in request
+	_, err = parseAPIRerankResponse(conf, []byte(`{"results": [{"index": 1, "relevance_score": 0.9}]}`), 1)
+	s.ErrorContains(err, "out of range")
+	// results of documents missing
+	_, err = parseAPIRerankResponse(conf, []byte(`{"results": [{"index": 1, "relevance_score": 0.9}]}`), 2)
+	s.ErrorContains(err, "2 documents in request but got 1 results")
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metric"
 )
 
 const (
@@ -40,8 +41,17 @@ const (
 	modelFunctionName string = "model"
 	rrfName           string = "rrf"
 	weightedName      string = "weighted"
+	normFusionName    string = "norm_fusion"
 )
 
+// stageLimitKey limits the number of candidates a rerank stage passes to the next stage,
+// it's only valid for the non-final stages of a rerank pipeline.
+const stageLimitKey string = "stage_limit"
+
+// Scores produced by a rerank stage are always larger-is-better,
+// the following stages treat them as scores of IP metric.
+const intermediateMetric string = metric.IP
+
 const (
 	maxScorer string = "max"
 	sumScorer string = "sum"
@@ -58,15 +68,17 @@ const (
 type rankType int
 
 const (
-	invalidRankType  rankType = iota // invalidRankType   = 0
-	rrfRankType                      // rrfRankType = 1
-	weightedRankType                 // weightedRankType = 2
+	invalidRankType    rankType = iota // invalidRankType   = 0
+	rrfRankType                        // rrfRankType = 1
+	weightedRankType                   // weightedRankType = 2
+	normFusionRankType                 // normFusionRankType = 3
 )
 
 var rankTypeMap = map[string]rankType{
-	"invalid":  invalidRankType,
-	"rrf":      rrfRankType,
-	"weighted": weightedRankType,
+	"invalid":     invalidRankType,
+	"rrf":         rrfRankType,
+	"weighted":    weightedRankType,
+	"norm_fusion": normFusionRankType,
 }
 
 type SearchParams struct {
@@ -115,9 +127,30 @@ func getRerankName(funcSchema *schemapb.FunctionSchema) string {
 	return ""
 }
 
-// Currently only supports single rerank
+// FunctionScore is a pipeline of rerank stages. The first stage merges the results
+// of all sub-searches, each following stage reranks the output of the previous one.
 type FunctionScore struct {
-	reranker Reranker
+	rerankers []Reranker
+	// stageLimits[i] is the number of candidates passed from stage i to stage i+1,
+	// 0 means no truncation.
+	stageLimits []int64
+}
+
+func isFusionRerank(rerankerName string) bool {
+	return rerankerName == rrfName || rerankerName == weightedName || rerankerName == normFusionName
+}
+
+func getStageLimit(funcSchema *schemapb.FunctionSchema) (int64, error) {
+	for _, param := range funcSchema.Params {
+		if strings.ToLower(param.Key) == stageLimitKey {
+			limit, err := strconv.ParseInt(param.Value, 10, 64)
+			if err != nil || limit <= 0 {
+				return 0, fmt.Errorf("Param %s:%s should be a positive integer", stageLimitKey, param.Value)
+			}
+			return limit, nil
+		}
+	}
+	return 0, nil
 }
 
 func createFunction(collSchema *schemapb.CollectionSchema, funcSchema *schemapb.FunctionSchema) (Reranker, error) {
@@ -140,8 +173,10 @@ func createFunction(collSchema *schemapb.CollectionSchema, funcSchema *schemapb.
 		rerankFunc, newRerankErr = newRRFFunction(collSchema, funcSchema)
 	case weightedName:
 		rerankFunc, newRerankErr = newWeightedFunction(collSchema, funcSchema)
+	case normFusionName:
+		rerankFunc, newRerankErr = newNormFusionFunction(collSchema, funcSchema)
 	default:
-		return nil, fmt.Errorf("Unsupported rerank function: [%s] , list of supported [%s,%s,%s,%s]", rerankerName, decayFunctionName, modelFunctionName, rrfName, weightedName)
+		return nil, fmt.Errorf("Unsupported rerank function: [%s] , list of supported [%s,%s,%s,%s,%s]", rerankerName, decayFunctionName, modelFunctionName, rrfName, weightedName, normFusionName)
 	}
 
 	if newRerankErr != nil {
@@ -151,13 +186,27 @@ func createFunction(collSchema *schemapb.CollectionSchema, funcSchema *schemapb.
 }
 
 func NewFunctionScore(collSchema *schemapb.CollectionSchema, funcScoreSchema *schemapb.FunctionScore) (*FunctionScore, error) {
-	if len(funcScoreSchema.Functions) > 1 || len(funcScoreSchema.Functions) == 0 {
-		return nil, fmt.Errorf("Currently only supports one rerank, but got %d", len(funcScoreSchema.Functions))
+	if len(funcScoreSchema.Functions) == 0 {
+		return nil, fmt.Errorf("At least one rerank function is required")
 	}
 	funcScore := &FunctionScore{}
-	var err error
-	if funcScore.reranker, err = createFunction(collSchema, funcScoreSchema.Functions[0]); err != nil {
-		return nil, err
+	for i, funcSchema := range funcScoreSchema.Functions {
+		rerankFunc, err := createFunction(collSchema, funcSchema)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 && isFusionRerank(rerankFunc.GetRankName()) {
+			return nil, fmt.Errorf("Rerank %s merges multiple search results, it can only be the first stage", rerankFunc.GetRankName())
+		}
+		limit, err := getStageLimit(funcSchema)
+		if err != nil {
+			return nil, err
+		}
+		if limit > 0 && i == len(funcScoreSchema.Functions)-1 {
+			return nil, fmt.Errorf("Param %s is not supported by the last rerank stage, which is limited by the search limit", stageLimitKey)
+		}
+		funcScore.rerankers = append(funcScore.rerankers, rerankFunc)
+		funcScore.stageLimits = append(funcScore.stageLimits, limit)
 	}
 	return funcScore, nil
 }
@@ -214,14 +263,30 @@ func NewFunctionScoreWithlegacy(collSchema *schemapb.CollectionSchema, rankParam
 				return nil, fmt.Errorf("Weighted rerank err, norm_score should been bool type, but [norm_score:%s]'s type is %T", normScore, normScore)
 			}
 		}
+	case normFusionRankType:
+		fSchema.Params = append(fSchema.Params, &commonpb.KeyValuePair{Key: reranker, Value: normFusionName})
+		if v, ok := params[WeightsParamsKey]; ok {
+			if d, err := json.Marshal(v); err != nil {
+				return nil, fmt.Errorf("The weights param should be an array")
+			} else {
+				fSchema.Params = append(fSchema.Params, &commonpb.KeyValuePair{Key: WeightsParamsKey, Value: string(d)})
+			}
+		}
+		if normMethod, ok := params[NormMethodKey]; ok {
+			if nm, ok := normMethod.(string); ok {
+				fSchema.Params = append(fSchema.Params, &commonpb.KeyValuePair{Key: NormMethodKey, Value: nm})
+			} else {
+				return nil, fmt.Errorf("Norm fusion rerank err, norm_method should been string type, but [norm_method:%v]'s type is %T", normMethod, normMethod)
+			}
+		}
 	default:
 		return nil, fmt.Errorf("unsupported rank type %s", rankTypeStr)
 	}
-	funcScore := &FunctionScore{}
-	if funcScore.reranker, err = createFunction(collSchema, &fSchema); err != nil {
+	rerankFunc, err := createFunction(collSchema, &fSchema)
+	if err != nil {
 		return nil, err
 	}
-	return funcScore, nil
+	return &FunctionScore{rerankers: []Reranker{rerankFunc}, stageLimits: []int64{0}}, nil
 }
 
 func (fScore *FunctionScore) Process(ctx context.Context, searchParams *SearchParams, multipleMilvusResults []*milvuspb.SearchResults) (*milvuspb.SearchResults, error) {
@@ -244,13 +309,23 @@ func (fScore *FunctionScore) Process(ctx context.Context, searchParams *SearchPa
 	})
 
 	// rankResult only has scores
-	inputs, err := newRerankInputs(allSearchResultData, fScore.reranker.GetInputFieldIDs(), searchParams.isGrouping())
-	if err != nil {
-		return nil, err
-	}
-	rankResult, err := fScore.reranker.Process(ctx, searchParams, inputs)
-	if err != nil {
-		return nil, err
+	stageInputs := allSearchResultData
+	var rankResult *rerankOutputs
+	for i, reranker := range fScore.rerankers {
+		if i > 0 {
+			stageData, err := newStageResultData(rankResult.searchResultData, allSearchResultData, reranker.GetInputFieldIDs(), searchParams.isGrouping())
+			if err != nil {
+				return nil, err
+			}
+			stageInputs = []*schemapb.SearchResultData{stageData}
+		}
+		inputs, err := newRerankInputs(stageInputs, reranker.GetInputFieldIDs(), searchParams.isGrouping())
+		if err != nil {
+			return nil, err
+		}
+		if rankResult, err = reranker.Process(ctx, fScore.stageSearchParams(i, searchParams, stageInputs), inputs); err != nil {
+			return nil, err
+		}
 	}
 
 	ret := &milvuspb.SearchResults{
@@ -260,30 +335,70 @@ func (fScore *FunctionScore) Process(ctx context.Context, searchParams *SearchPa
 	return ret, nil
 }
 
+// stageSearchParams returns the search params of the i-th rerank stage.
+// The last stage applies the limit, offset and round decimal of the search request,
+// intermediate stages keep all candidates unless stage_limit is set.
+func (fScore *FunctionScore) stageSearchParams(i int, searchParams *SearchParams, stageInputs []*schemapb.SearchResultData) *SearchParams {
+	params := *searchParams
+	if i > 0 {
+		params.searchMetrics = []string{intermediateMetric}
+	}
+	if i == len(fScore.rerankers)-1 {
+		return &params
+	}
+	params.offset = 0
+	params.roundDecimal = -1
+	if fScore.stageLimits[i] > 0 {
+		params.limit = fScore.stageLimits[i]
+	} else {
+		limit := int64(0)
+		for _, data := range stageInputs {
+			limit += int64(len(data.GetScores()))
+		}
+		params.limit = limit
+	}
+	return &params
+}
+
 func (fScore *FunctionScore) GetAllInputFieldNames() []string {
 	if fScore == nil {
 		return []string{}
 	}
-	return fScore.reranker.GetInputFieldNames()
+	names := []string{}
+	for _, reranker := range fScore.rerankers {
+		names = append(names, reranker.GetInputFieldNames()...)
+	}
+	return lo.Uniq(names)
 }
 
 func (fScore *FunctionScore) GetAllInputFieldIDs() []int64 {
 	if fScore == nil {
 		return []int64{}
 	}
-	return fScore.reranker.GetInputFieldIDs()
+	ids := []int64{}
+	for _, reranker := range fScore.rerankers {
+		ids = append(ids, reranker.GetInputFieldIDs()...)
+	}
+	return lo.Uniq(ids)
 }
 
 func (fScore *FunctionScore) IsSupportGroup() bool {
 	if fScore == nil {
 		return true
 	}
-	return fScore.reranker.IsSupportGroup()
+	for _, reranker := range fScore.rerankers {
+		if !reranker.IsSupportGroup() {
+			return false
+		}
+	}
+	return true
 }
 
 func (fScore *FunctionScore) RerankName() string {
 	if fScore == nil {
 		return ""
 	}
-	return fScore.reranker.GetRankName()
+	return strings.Join(lo.Map(fScore.rerankers, func(reranker Reranker, _ int) string {
+		return reranker.GetRankName()
+	}), ",")
 }
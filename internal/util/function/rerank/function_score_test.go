@@ -24,6 +24,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
@@ -78,7 +79,7 @@ func (s *FunctionScoreSuite) TestNewFunctionScore() {
 	s.Equal([]string{"ts"}, f.GetAllInputFieldNames())
 	s.Equal([]int64{102}, f.GetAllInputFieldIDs())
 	s.Equal(true, f.IsSupportGroup())
-	s.Equal("decay", f.reranker.GetRankName())
+	s.Equal("decay", f.RerankName())
 
 	{
 		schema.Fields[3].Nullable = true
@@ -95,10 +96,10 @@ func (s *FunctionScoreSuite) TestNewFunctionScore() {
 	}
 
 	{
-		funcScores.Functions = append(funcScores.Functions, functionSchema)
+		funcScores.Functions = []*schemapb.FunctionSchema{}
 		_, err := NewFunctionScore(schema, funcScores)
-		s.ErrorContains(err, "Currently only supports one rerank, but got")
-		funcScores.Functions = funcScores.Functions[:1]
+		s.ErrorContains(err, "At least one rerank function is required")
+		funcScores.Functions = []*schemapb.FunctionSchema{functionSchema}
 	}
 
 	{
@@ -303,6 +304,132 @@ func (s *FunctionScoreSuite) TestFunctionScoreProcess() {
 	}
 }
 
+func (s *FunctionScoreSuite) TestFunctionScorePipeline() {
+	schema := &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "ts", DataType: schemapb.DataType_Int64},
+		},
+	}
+	rrfSchema := &schemapb.FunctionSchema{
+		Name: "rrf",
+		Type: schemapb.FunctionType_Rerank,
+		Params: []*commonpb.KeyValuePair{
+			{Key: reranker, Value: rrfName},
+		},
+	}
+	decaySchema := &schemapb.FunctionSchema{
+		Name:            "decay",
+		Type:            schemapb.FunctionType_Rerank,
+		InputFieldNames: []string{"ts"},
+		Params: []*commonpb.KeyValuePair{
+			{Key: reranker, Value: decayFunctionName},
+			{Key: originKey, Value: "9"},
+			{Key: scaleKey, Value: "10"},
+			{Key: decayKey, Value: "0.5"},
+			{Key: functionKey, Value: "gauss"},
+		},
+	}
+
+	{
+		f, err := NewFunctionScore(schema, &schemapb.FunctionScore{
+			Functions: []*schemapb.FunctionSchema{rrfSchema, decaySchema},
+		})
+		s.NoError(err)
+		s.Equal("rrf,decay", f.RerankName())
+		s.Equal([]string{"ts"}, f.GetAllInputFieldNames())
+		s.Equal([]int64{102}, f.GetAllInputFieldIDs())
+		s.True(f.IsSupportGroup())
+	}
+
+	// fusion rerank is only allowed as the first stage
+	{
+		_, err := NewFunctionScore(schema, &schemapb.FunctionScore{
+			Functions: []*schemapb.FunctionSchema{decaySchema, rrfSchema},
+		})
+		s.ErrorContains(err, "it can only be the first stage")
+	}
+
+	// stage limit
+	{
+		limitedRRF := proto.Clone(rrfSchema).(*schemapb.FunctionSchema)
+		limitedRRF.Params = append(limitedRRF.Params, &commonpb.KeyValuePair{Key: stageLimitKey, Value: "-1"})
+		_, err := NewFunctionScore(schema, &schemapb.FunctionScore{
+			Functions: []*schemapb.FunctionSchema{limitedRRF, decaySchema},
+		})
+		s.ErrorContains(err, "should be a positive integer")
+
+		_, err = NewFunctionScore(schema, &schemapb.FunctionScore{
+			Functions: []*schemapb.FunctionSchema{rrfSchema, proto.Clone(limitedRRF).(*schemapb.FunctionSchema)},
+		})
+		s.Error(err)
+
+		limitedDecay := proto.Clone(decaySchema).(*schemapb.FunctionSchema)
+		limitedDecay.Params = append(limitedDecay.Params, &commonpb.KeyValuePair{Key: stageLimitKey, Value: "5"})
+		_, err = NewFunctionScore(schema, &schemapb.FunctionScore{
+			Functions: []*schemapb.FunctionSchema{rrfSchema, limitedDecay},
+		})
+		s.ErrorContains(err, "is not supported by the last rerank stage")
+	}
+
+	genInputs := func(nq int64) []*milvuspb.SearchResults {
+		return []*milvuspb.SearchResults{
+			{Results: function.GenSearchResultData(nq, 10, schemapb.DataType_Int64, "ts", 102)},
+			{Results: function.GenSearchResultData(nq, 20, schemapb.DataType_Int64, "ts", 102)},
+		}
+	}
+
+	// without stage limit, decay sees all fused candidates
+	{
+		f, err := NewFunctionScore(schema, &schemapb.FunctionScore{
+			Functions: []*schemapb.FunctionSchema{rrfSchema, decaySchema},
+		})
+		s.NoError(err)
+		ret, err := f.Process(context.Background(), NewSearchParams(1, 3, 0, -1, -1, 1, false, "", []string{"COSINE", "COSINE"}), genInputs(1))
+		s.NoError(err)
+		s.Equal([]int64{3}, ret.Results.Topks)
+		s.Equal([]int64{8, 7, 9}, ret.Results.Ids.GetIntId().Data)
+	}
+
+	// decay only sees the top 5 candidates of rrf
+	{
+		limitedRRF := proto.Clone(rrfSchema).(*schemapb.FunctionSchema)
+		limitedRRF.Params = append(limitedRRF.Params, &commonpb.KeyValuePair{Key: stageLimitKey, Value: "5"})
+		f, err := NewFunctionScore(schema, &schemapb.FunctionScore{
+			Functions: []*schemapb.FunctionSchema{limitedRRF, decaySchema},
+		})
+		s.NoError(err)
+		ret, err := f.Process(context.Background(), NewSearchParams(1, 3, 0, -1, -1, 1, false, "", []string{"COSINE", "COSINE"}), genInputs(1))
+		s.NoError(err)
+		s.Equal([]int64{3}, ret.Results.Topks)
+		s.Equal([]int64{4, 3, 2}, ret.Results.Ids.GetIntId().Data)
+		// rrf score * gauss decay
+		s.True(function.FloatsAlmostEqual([]float32{0.02587, 0.02435, 0.02260}, ret.Results.Scores, 0.0001))
+
+		ret, err = f.Process(context.Background(), NewSearchParams(3, 3, 1, -1, -1, 1, false, "", []string{"COSINE", "COSINE"}), genInputs(3))
+		s.NoError(err)
+		s.Equal([]int64{3, 3, 3}, ret.Results.Topks)
+	}
+
+	// grouping
+	{
+		f, err := NewFunctionScore(schema, &schemapb.FunctionScore{
+			Functions: []*schemapb.FunctionSchema{rrfSchema, decaySchema},
+		})
+		s.NoError(err)
+		nq := int64(1)
+		searchData := []*milvuspb.SearchResults{
+			{Results: function.GenSearchResultDataWithGrouping(nq, 5, schemapb.DataType_Int64, "ts", 102, "group", 103, 2)},
+			{Results: function.GenSearchResultDataWithGrouping(nq, 5, schemapb.DataType_Int64, "ts", 102, "group", 103, 2)},
+		}
+		ret, err := f.Process(context.Background(), NewSearchParams(nq, 3, 0, -1, 103, 2, false, "", []string{"COSINE", "COSINE"}), searchData)
+		s.NoError(err)
+		s.Equal([]int64{6}, ret.Results.Topks)
+	}
+}
+
 func (s *FunctionScoreSuite) TestlegacyFunction() {
 	schema := &schemapb.CollectionSchema{
 		Name: "test",
@@ -363,7 +490,7 @@ func (s *FunctionScoreSuite) TestlegacyFunction() {
 		}
 		f, err := NewFunctionScoreWithlegacy(schema, rankParams)
 		s.NoError(err)
-		s.Equal(f.reranker.GetRankName(), weightedName)
+		s.Equal(f.RerankName(), weightedName)
 	}
 	{
 		rankParams := []*commonpb.KeyValuePair{
@@ -389,6 +516,23 @@ func (s *FunctionScoreSuite) TestlegacyFunction() {
 		_, err := NewFunctionScoreWithlegacy(schema, rankParams)
 		s.ErrorContains(err, "Weighted rerank err, norm_score should been bool type")
 	}
+	{
+		rankParams := []*commonpb.KeyValuePair{
+			{Key: legacyRankTypeKey, Value: "norm_fusion"},
+			{Key: legacyRankParamsKey, Value: `{"weights": [0.5, 0.5], "norm_method": "z_score"}`},
+		}
+		f, err := NewFunctionScoreWithlegacy(schema, rankParams)
+		s.NoError(err)
+		s.Equal(f.RerankName(), normFusionName)
+	}
+	{
+		rankParams := []*commonpb.KeyValuePair{
+			{Key: legacyRankTypeKey, Value: "norm_fusion"},
+			{Key: legacyRankParamsKey, Value: `{"norm_method": 1}`},
+		}
+		_, err := NewFunctionScoreWithlegacy(schema, rankParams)
+		s.ErrorContains(err, "Norm fusion rerank err, norm_method should been string type")
+	}
 }
 
 func (s *FunctionScoreSuite) TestFunctionUtil() {
@@ -32,6 +32,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/credentials"
 	"github.com/milvus-io/milvus/internal/util/function"
 	"github.com/milvus-io/milvus/internal/util/function/models/utils"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
@@ -62,9 +63,12 @@ type baseModel struct {
 	queryKey string
 	docKey   string
 
-	truncateParams map[string]any
+	// extra fields of request body, e.g. truncate params and model name
+	requestParams map[string]any
+	headers       map[string]string
 
-	parseScores func([]byte) ([]float32, error)
+	// parseScores parses the response of a request with numDocs documents
+	parseScores func(body []byte, numDocs int) ([]float32, error)
 }
 
 func (base *baseModel) getURL() string {
@@ -72,13 +76,14 @@ func (base *baseModel) getURL() string {
 }
 
 func (base *baseModel) rerank(ctx context.Context, query string, docs []string) ([]float32, error) {
-	requestBodies, err := genRerankRequestBody(query, docs, base.maxBatch, base.queryKey, base.docKey, base.truncateParams)
+	requestBodies, err := genRerankRequestBody(query, docs, base.maxBatch, base.queryKey, base.docKey, base.requestParams)
 	if err != nil {
 		return nil, err
 	}
 	scores := []float32{}
-	for _, requestBody := range requestBodies {
-		rerankResp, err := base.callService(ctx, requestBody, 30)
+	for i, requestBody := range requestBodies {
+		numDocs := min(base.maxBatch, len(docs)-i*base.maxBatch)
+		rerankResp, err := base.callService(ctx, requestBody, numDocs, 30)
 		if err != nil {
 			return nil, fmt.Errorf("Call rerank model failed: %v\n", err)
 		}
@@ -91,17 +96,20 @@ func (base *baseModel) rerank(ctx context.Context, query string, docs []string)
 	return scores, nil
 }
 
-func (base *baseModel) callService(ctx context.Context, requestBody []byte, timeoutSec int64) ([]float32, error) {
+func (base *baseModel) callService(ctx context.Context, requestBody []byte, numDocs int, timeoutSec int64) ([]float32, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSec)*time.Second)
 	defer cancel()
 	headers := map[string]string{
 		"Content-Type": "application/json",
 	}
+	for k, v := range base.headers {
+		headers[k] = v
+	}
 	body, err := utils.RetrySend(ctx, requestBody, http.MethodPost, base.url, headers, 3)
 	if err != nil {
 		return nil, err
 	}
-	return base.parseScores(body)
+	return base.parseScores(body, numDocs)
 }
 
 type vllmRerankRequest struct {
@@ -146,12 +154,12 @@ func newVllmProvider(params []*commonpb.KeyValuePair, conf map[string]string) (m
 	base, _ := url.Parse(endpoint)
 	base.Path = "/v2/rerank"
 	model := baseModel{
-		url:            base.String(),
-		maxBatch:       maxBatch,
-		queryKey:       "query",
-		docKey:         "documents",
-		truncateParams: truncateParams,
-		parseScores: func(body []byte) ([]float32, error) {
+		url:           base.String(),
+		maxBatch:      maxBatch,
+		queryKey:      "query",
+		docKey:        "documents",
+		requestParams: truncateParams,
+		parseScores: func(body []byte, _ int) ([]float32, error) {
 			var rerankResp vllmRerankResponse
 			if err := json.Unmarshal(body, &rerankResp); err != nil {
 				return nil, fmt.Errorf("Rerank error, parsing vllm response failed: %v", err)
@@ -192,12 +200,12 @@ func newTeiProvider(params []*commonpb.KeyValuePair, conf map[string]string) (mo
 	base, _ := url.Parse(endpoint)
 	base.Path = "/rerank"
 	model := baseModel{
-		url:            base.String(),
-		maxBatch:       maxBatch,
-		queryKey:       "query",
-		docKey:         "texts",
-		truncateParams: truncateParams,
-		parseScores: func(body []byte) ([]float32, error) {
+		url:           base.String(),
+		maxBatch:      maxBatch,
+		queryKey:      "query",
+		docKey:        "texts",
+		requestParams: truncateParams,
+		parseScores: func(body []byte, _ int) ([]float32, error) {
 			var results []TEIResponse
 			if err := json.Unmarshal(body, &results); err != nil {
 				return nil, fmt.Errorf("Rerank error, parsing TEI response failed: %v", err)
@@ -232,22 +240,14 @@ func parseParams(params []*commonpb.KeyValuePair) (string, int, map[string]any,
 	for _, param := range params {
 		switch strings.ToLower(param.Key) {
 		case function.EndpointParamKey:
-			base, err := url.Parse(param.Value)
-			if err != nil {
+			var err error
+			if endpoint, err = checkEndpoint(param.Value); err != nil {
 				return "", 0, nil, err
 			}
-			if base.Scheme != "http" && base.Scheme != "https" {
-				return "", 0, nil, fmt.Errorf("Rerank endpoint: [%s] is not a valid http/https link", param.Value)
-			}
-			if base.Host == "" {
-				return "", 0, nil, fmt.Errorf("Rerank endpoint: [%s] is not a valid http/https link", param.Value)
-			}
-			endpoint = base.String()
 		case maxBatchKeyName:
-			if batch, err := strconv.ParseInt(param.Value, 10, 64); err != nil {
-				return "", 0, nil, fmt.Errorf("Rerank params error, maxBatch: %s is not a number", param.Value)
-			} else {
-				maxBatch = int(batch)
+			var err error
+			if maxBatch, err = parseMaxBatch(param.Value); err != nil {
+				return "", 0, nil, err
 			}
 		case vllmTruncateParamName:
 			if vllmTrun, err := strconv.ParseInt(param.Value, 10, 64); err != nil {
@@ -274,7 +274,29 @@ func parseParams(params []*commonpb.KeyValuePair) (string, int, map[string]any,
 	return endpoint, maxBatch, truncateParams, nil
 }
 
-func genRerankRequestBody(query string, documents []string, maxSize int, queryKey string, docKey string, truncateParams map[string]any) ([][]byte, error) {
+func checkEndpoint(endpoint string) (string, error) {
+	base, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	if base.Scheme != "http" && base.Scheme != "https" {
+		return "", fmt.Errorf("Rerank endpoint: [%s] is not a valid http/https link", endpoint)
+	}
+	if base.Host == "" {
+		return "", fmt.Errorf("Rerank endpoint: [%s] is not a valid http/https link", endpoint)
+	}
+	return base.String(), nil
+}
+
+func parseMaxBatch(value string) (int, error) {
+	batch, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("Rerank params error, maxBatch: %s is not a number", value)
+	}
+	return int(batch), nil
+}
+
+func genRerankRequestBody(query string, documents []string, maxSize int, queryKey string, docKey string, requestParams map[string]any) ([][]byte, error) {
 	requestBodies := [][]byte{}
 	for i := 0; i < len(documents); i += maxSize {
 		end := i + maxSize
@@ -285,7 +307,7 @@ func genRerankRequestBody(query string, documents []string, maxSize int, queryKe
 			queryKey: query,
 			docKey:   documents[i:end],
 		}
-		for k, v := range truncateParams {
+		for k, v := range requestParams {
 			requestBody[k] = v
 		}
 		jsonData, err := json.Marshal(requestBody)
@@ -307,6 +329,8 @@ func newProvider(params []*commonpb.KeyValuePair) (modelProvider, error) {
 				return newVllmProvider(params, conf)
 			case teiProviderName:
 				return newTeiProvider(params, conf)
+			case cohereProviderName, voyageAIProviderName, jinaAIProviderName, siliconflowProviderName:
+				return newAPIProvider(apiProviders[provider], params, conf, credentials.NewCredentials(paramtable.Get().CredentialCfg.GetCredentials()))
 			default:
 				return nil, fmt.Errorf("Unknow rerank provider:%s", param.Value)
 			}
@@ -0,0 +1,181 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package rerank
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+const (
+	NormMethodKey string = "norm_method"
+
+	minMaxNorm string = "min_max"
+	zScoreNorm string = "z_score"
+)
+
+// NormFusionFunction normalizes the scores of each sub-search independently,
+// then combines them with a weighted sum.
+//
+// Scores are first converted to "larger is better", so heterogeneous metrics (BM25, COSINE, IP, L2)
+// are comparable after normalization. An id missing from a sub-search contributes nothing to its final score.
+type NormFusionFunction[T PKType] struct {
+	RerankBase
+
+	weight     []float32
+	normMethod string
+}
+
+func newNormFusionFunction(collSchema *schemapb.CollectionSchema, funcSchema *schemapb.FunctionSchema) (Reranker, error) {
+	base, err := newRerankBase(collSchema, funcSchema, normFusionName, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(base.GetInputFieldNames()) != 0 {
+		return nil, fmt.Errorf("The norm_fusion function does not support input parameters, but got %s", base.GetInputFieldNames())
+	}
+
+	var weights []float32
+	normMethod := minMaxNorm
+	for _, param := range funcSchema.Params {
+		switch strings.ToLower(param.Key) {
+		case WeightsParamsKey:
+			if err := json.Unmarshal([]byte(param.Value), &weights); err != nil {
+				return nil, fmt.Errorf("Parse %s param failed, weight should be []float, bug got: %s", WeightsParamsKey, param.Value)
+			}
+			for _, weight := range weights {
+				if weight < 0 || weight > 1 {
+					return nil, fmt.Errorf("rank param weight should be in range [0, 1]")
+				}
+			}
+		case NormMethodKey:
+			normMethod = strings.ToLower(param.Value)
+			if normMethod != minMaxNorm && normMethod != zScoreNorm {
+				return nil, fmt.Errorf("Unsupported %s: [%s], list of supported [%s,%s]", NormMethodKey, param.Value, minMaxNorm, zScoreNorm)
+			}
+		}
+	}
+	if base.pkType == schemapb.DataType_Int64 {
+		return &NormFusionFunction[int64]{RerankBase: *base, weight: weights, normMethod: normMethod}, nil
+	} else {
+		return &NormFusionFunction[string]{RerankBase: *base, weight: weights, normMethod: normMethod}, nil
+	}
+}
+
+// getWeight returns the weight of i-th sub-search, all sub-searches are equally weighted if weights not provided.
+func (fusion *NormFusionFunction[T]) getWeight(i int) float32 {
+	if len(fusion.weight) == 0 {
+		return 1
+	}
+	return fusion.weight[i]
+}
+
+func (fusion *NormFusionFunction[T]) processOneSearchData(ctx context.Context, searchParams *SearchParams, cols []*columns, idGroup map[any]any) (*IDScores[T], error) {
+	if len(fusion.weight) != 0 && len(cols) != len(fusion.weight) {
+		return nil, merr.WrapErrParameterInvalid(fmt.Sprint(len(cols)), fmt.Sprint(len(fusion.weight)), "the length of weights param mismatch with ann search requests")
+	}
+	fusionScores := map[T]float32{}
+	for i, col := range cols {
+		if col.size == 0 {
+			continue
+		}
+		normScores := fusion.normalize(col.scores)
+		ids := col.ids.([]T)
+		for j, id := range ids {
+			fusionScores[id] += fusion.getWeight(i) * normScores[j]
+		}
+	}
+	if searchParams.isGrouping() {
+		return newGroupingIDScores(fusionScores, searchParams, idGroup)
+	}
+	return newIDScores(fusionScores, searchParams), nil
+}
+
+// normalize scales the scores of one sub-search result,
+// min-max maps scores into [0, 1] and z-score maps scores to standard deviations above the minimum.
+//
+// Normalized scores are never below zero, so that an id missing from the sub-search, which contributes
+// nothing, always ranks below the ids returned by it.
+func (fusion *NormFusionFunction[T]) normalize(scores []float32) []float32 {
+	normScores := make([]float32, len(scores))
+	switch fusion.normMethod {
+	case zScoreNorm:
+		var sum float64
+		minScore := scores[0]
+		for _, score := range scores {
+			sum += float64(score)
+			minScore = min(minScore, score)
+		}
+		mean := sum / float64(len(scores))
+		var variance float64
+		for _, score := range scores {
+			variance += (float64(score) - mean) * (float64(score) - mean)
+		}
+		std := math.Sqrt(variance / float64(len(scores)))
+		for i, score := range scores {
+			if std == 0 {
+				// all results are equally the best in this sub-search
+				normScores[i] = 1
+				continue
+			}
+			// shifting by the z-score of the minimum keeps the distances of z-score
+			normScores[i] = float32((float64(score) - float64(minScore)) / std)
+		}
+	default:
+		minScore, maxScore := scores[0], scores[0]
+		for _, score := range scores {
+			minScore = min(minScore, score)
+			maxScore = max(maxScore, score)
+		}
+		for i, score := range scores {
+			if maxScore == minScore {
+				// all results are equally the best in this sub-search
+				normScores[i] = 1
+				continue
+			}
+			normScores[i] = (score - minScore) / (maxScore - minScore)
+		}
+	}
+	return normScores
+}
+
+func (fusion *NormFusionFunction[T]) Process(ctx context.Context, searchParams *SearchParams, inputs *rerankInputs) (*rerankOutputs, error) {
+	outputs := newRerankOutputs(searchParams)
+	for _, cols := range inputs.data {
+		for i, col := range cols {
+			metricType := searchParams.searchMetrics[i]
+			for j, score := range col.scores {
+				col.scores[j] = toGreaterScore(score, metricType)
+			}
+		}
+		idScore, err := fusion.processOneSearchData(ctx, searchParams, cols, inputs.idGroupValue)
+		if err != nil {
+			return nil, err
+		}
+		appendResult(outputs, idScore.ids, idScore.scores)
+	}
+	return outputs, nil
+}
@@ -0,0 +1,209 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package rerank
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/function"
+)
+
+func TestNormFusionFunction(t *testing.T) {
+	suite.Run(t, new(NormFusionFunctionSuite))
+}
+
+type NormFusionFunctionSuite struct {
+	suite.Suite
+
+	schema *schemapb.CollectionSchema
+}
+
+func (s *NormFusionFunctionSuite) SetupTest() {
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar},
+			{
+				FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "4"},
+				},
+			},
+			{FieldID: 102, Name: "ts", DataType: schemapb.DataType_Int64},
+		},
+	}
+}
+
+func (s *NormFusionFunctionSuite) TestNewNormFusionFunction() {
+	functionSchema := &schemapb.FunctionSchema{
+		Name:            "test",
+		Type:            schemapb.FunctionType_Rerank,
+		InputFieldNames: []string{},
+		Params: []*commonpb.KeyValuePair{
+			{Key: reranker, Value: normFusionName},
+		},
+	}
+
+	{
+		f, err := newNormFusionFunction(s.schema, functionSchema)
+		s.NoError(err)
+		s.Equal(minMaxNorm, f.(*NormFusionFunction[int64]).normMethod)
+	}
+	{
+		functionSchema.Params = append(functionSchema.Params,
+			&commonpb.KeyValuePair{Key: WeightsParamsKey, Value: `[0.1, 0.9]`},
+			&commonpb.KeyValuePair{Key: NormMethodKey, Value: "Z_SCORE"},
+		)
+		f, err := createFunction(s.schema, functionSchema)
+		s.NoError(err)
+		s.Equal(normFusionName, f.GetRankName())
+		s.Equal(zScoreNorm, f.(*NormFusionFunction[int64]).normMethod)
+	}
+	{
+		s.schema.Fields[0] = &schemapb.FieldSchema{FieldID: 100, Name: "pk", DataType: schemapb.DataType_VarChar, IsPrimaryKey: true}
+		_, err := newNormFusionFunction(s.schema, functionSchema)
+		s.NoError(err)
+	}
+	{
+		functionSchema.Params[1] = &commonpb.KeyValuePair{Key: WeightsParamsKey, Value: "NotNum"}
+		_, err := newNormFusionFunction(s.schema, functionSchema)
+		s.ErrorContains(err, "param failed, weight should be []float")
+	}
+	{
+		functionSchema.Params[1] = &commonpb.KeyValuePair{Key: WeightsParamsKey, Value: `[10]`}
+		_, err := newNormFusionFunction(s.schema, functionSchema)
+		s.ErrorContains(err, "rank param weight should be in range [0, 1]")
+		functionSchema.Params[1] = &commonpb.KeyValuePair{Key: WeightsParamsKey, Value: `[0.1, 0.9]`}
+	}
+	{
+		functionSchema.Params[2] = &commonpb.KeyValuePair{Key: NormMethodKey, Value: "l2"}
+		_, err := newNormFusionFunction(s.schema, functionSchema)
+		s.ErrorContains(err, "Unsupported norm_method")
+	}
+	{
+		functionSchema.InputFieldNames = []string{"ts"}
+		_, err := newNormFusionFunction(s.schema, functionSchema)
+		s.ErrorContains(err, "The norm_fusion function does not support input parameters,")
+	}
+}
+
+func (s *NormFusionFunctionSuite) TestNormFusionFunctionProcess() {
+	functionSchema := &schemapb.FunctionSchema{
+		Name:            "test",
+		Type:            schemapb.FunctionType_Rerank,
+		InputFieldNames: []string{},
+		Params: []*commonpb.KeyValuePair{
+			{Key: reranker, Value: normFusionName},
+			{Key: WeightsParamsKey, Value: `[0.2, 0.8]`},
+		},
+	}
+
+	// empty
+	{
+		nq := int64(1)
+		f, err := newNormFusionFunction(s.schema, functionSchema)
+		s.NoError(err)
+		inputs, _ := newRerankInputs([]*schemapb.SearchResultData{}, f.GetInputFieldIDs(), false)
+		ret, err := f.Process(context.Background(), NewSearchParams(nq, 3, 2, -1, -1, 1, false, "", []string{"COSINE"}), inputs)
+		s.NoError(err)
+		s.Equal(int64(3), ret.searchResultData.TopK)
+		s.Equal([]int64{}, ret.searchResultData.Topks)
+	}
+	// number of weights not equal to search data
+	{
+		nq := int64(1)
+		f, err := newNormFusionFunction(s.schema, functionSchema)
+		s.NoError(err)
+		data := function.GenSearchResultData(nq, 10, schemapb.DataType_Int64, "", 0)
+		inputs, _ := newRerankInputs([]*schemapb.SearchResultData{data}, f.GetInputFieldIDs(), false)
+		_, err = f.Process(context.Background(), NewSearchParams(nq, 3, 2, -1, -1, 1, false, "", []string{"COSINE"}), inputs)
+		s.ErrorContains(err, "the length of weights param mismatch with ann search requests")
+	}
+	// min-max, nq = 1
+	{
+		nq := int64(1)
+		f, err := newNormFusionFunction(s.schema, functionSchema)
+		s.NoError(err)
+		// id data: 0 - 9, score: 0 - 9
+		data1 := function.GenSearchResultData(nq, 10, schemapb.DataType_Int64, "", 0)
+		// id data: 0 - 3, score: 0 - 3
+		data2 := function.GenSearchResultData(nq, 4, schemapb.DataType_Int64, "", 0)
+		inputs, _ := newRerankInputs([]*schemapb.SearchResultData{data1, data2}, f.GetInputFieldIDs(), false)
+		ret, err := f.Process(context.Background(), NewSearchParams(nq, 3, 0, -1, -1, 1, false, "", []string{"BM25", "COSINE"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{3}, ret.searchResultData.Topks)
+		s.Equal([]int64{3, 2, 1}, ret.searchResultData.Ids.GetIntId().Data)
+		s.True(function.FloatsAlmostEqual([]float32{0.8667, 0.5778, 0.2889}, ret.searchResultData.Scores, 0.001))
+	}
+	// min-max, heterogeneous metrics, default weights
+	{
+		nq := int64(1)
+		functionSchema.Params = functionSchema.Params[:1]
+		f, err := newNormFusionFunction(s.schema, functionSchema)
+		s.NoError(err)
+		data1 := function.GenSearchResultData(nq, 10, schemapb.DataType_Int64, "", 0)
+		data2 := function.GenSearchResultData(nq, 4, schemapb.DataType_Int64, "", 0)
+		inputs, _ := newRerankInputs([]*schemapb.SearchResultData{data1, data2}, f.GetInputFieldIDs(), false)
+		ret, err := f.Process(context.Background(), NewSearchParams(nq, 3, 0, -1, -1, 1, false, "", []string{"BM25", "L2"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{0, 9, 8}, ret.searchResultData.Ids.GetIntId().Data)
+		s.True(function.FloatsAlmostEqual([]float32{1, 1, 0.8889}, ret.searchResultData.Scores, 0.001))
+	}
+	// z-score, nq = 3
+	{
+		nq := int64(3)
+		functionSchema.Params = append(functionSchema.Params, &commonpb.KeyValuePair{Key: NormMethodKey, Value: zScoreNorm})
+		f, err := newNormFusionFunction(s.schema, functionSchema)
+		s.NoError(err)
+		// nq1 id data: 0 - 9
+		// nq2 id data: 10 - 19
+		// nq3 id data: 20 - 29
+		data1 := function.GenSearchResultData(nq, 10, schemapb.DataType_Int64, "", 0)
+		// nq1 id data: 0 - 3
+		// nq2 id data: 4 - 7
+		// nq3 id data: 8 - 11
+		data2 := function.GenSearchResultData(nq, 4, schemapb.DataType_Int64, "", 0)
+		inputs, _ := newRerankInputs([]*schemapb.SearchResultData{data1, data2}, f.GetInputFieldIDs(), false)
+		ret, err := f.Process(context.Background(), NewSearchParams(nq, 3, 0, -1, -1, 1, false, "", []string{"IP", "IP"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{3, 3, 3}, ret.searchResultData.Topks)
+		// id 3 is the best of data2 and above the minimum of data1, ids missing from data2 contribute nothing
+		s.Equal([]int64{3, 9, 8}, ret.searchResultData.Ids.GetIntId().Data[:3])
+		s.True(function.FloatsAlmostEqual([]float32{3.7277, 3.1334, 2.7852}, ret.searchResultData.Scores[:3], 0.001))
+	}
+	// nq = 3, grouping = true, grouping size = 3
+	{
+		nq := int64(3)
+		f, err := newNormFusionFunction(s.schema, functionSchema)
+		s.NoError(err)
+		data1 := function.GenSearchResultDataWithGrouping(nq, 10, schemapb.DataType_Int64, "", 0, "ts", 102, 3)
+		data2 := function.GenSearchResultDataWithGrouping(nq, 4, schemapb.DataType_Int64, "", 0, "ts", 102, 3)
+		inputs, _ := newRerankInputs([]*schemapb.SearchResultData{data1, data2}, f.GetInputFieldIDs(), true)
+		ret, err := f.Process(context.Background(), NewSearchParams(nq, 3, 0, 1, 102, 3, true, "", []string{"COSINE", "COSINE"}), inputs)
+		s.NoError(err)
+		s.Equal([]int64{9, 9, 9}, ret.searchResultData.Topks)
+		s.Equal(int64(9), ret.searchResultData.TopK)
+	}
+}
@@ -431,3 +431,81 @@ func genIdGroupingMap(multipSearchResultData []*schemapb.SearchResultData) (map[
 	}
 	return idGroupValue, nil
 }
+
+type rowLocation struct {
+	resultIdx int
+	row       int64
+}
+
+// newStageResultData turns the output of a rerank stage back into search result data
+// so that it can be consumed by the next stage. Input fields and group by values of
+// the ranked ids are carried over from the original search results.
+func newStageResultData(rankResult *schemapb.SearchResultData, multipSearchResultData []*schemapb.SearchResultData, inputFieldIds []int64, isGrouping bool) (*schemapb.SearchResultData, error) {
+	multipIdField, err := organizeFieldIdData(multipSearchResultData, inputFieldIds)
+	if err != nil {
+		return nil, err
+	}
+
+	nq := rankResult.GetNumQueries()
+	locations := make([]map[any]rowLocation, nq)
+	for i := range locations {
+		locations[i] = map[any]rowLocation{}
+	}
+	for retIdx, searchResult := range multipSearchResultData {
+		start := int64(0)
+		for i := int64(0); i < nq; i++ {
+			size := searchResult.Topks[i]
+			for row := start; row < start+size; row++ {
+				id := typeutil.GetPK(searchResult.Ids, row)
+				if _, exist := locations[i][id]; !exist {
+					locations[i][id] = rowLocation{retIdx, row}
+				}
+			}
+			start += size
+		}
+	}
+
+	ret := &schemapb.SearchResultData{
+		NumQueries: nq,
+		TopK:       rankResult.GetTopK(),
+		FieldsData: make([]*schemapb.FieldData, len(inputFieldIds)),
+		Scores:     rankResult.GetScores(),
+		Ids:        &schemapb.IDs{},
+		Topks:      rankResult.GetTopks(),
+	}
+	groupByValue := make([]*schemapb.FieldData, 1)
+	start := int64(0)
+	for i := int64(0); i < nq; i++ {
+		for row := start; row < start+rankResult.Topks[i]; row++ {
+			id := typeutil.GetPK(rankResult.Ids, row)
+			loc, ok := locations[i][id]
+			if !ok {
+				return nil, fmt.Errorf("Rerank result id %v not found in search results", id)
+			}
+			typeutil.AppendPKs(ret.Ids, id)
+			srcFields := make([]*schemapb.FieldData, 0, len(inputFieldIds))
+			for _, fieldId := range inputFieldIds {
+				field, exist := multipIdField[loc.resultIdx][fieldId]
+				if !exist {
+					return nil, fmt.Errorf("Search reaults mismatch rerank inputs")
+				}
+				srcFields = append(srcFields, field)
+			}
+			typeutil.AppendFieldData(ret.FieldsData, srcFields, loc.row)
+			if isGrouping {
+				typeutil.AppendFieldData(groupByValue, []*schemapb.FieldData{multipSearchResultData[loc.resultIdx].GetGroupByFieldValue()}, loc.row)
+			}
+		}
+		start += rankResult.Topks[i]
+	}
+	if typeutil.GetSizeOfIDs(ret.Ids) == 0 {
+		ret.FieldsData = make([]*schemapb.FieldData, 0)
+	}
+	if isGrouping {
+		ret.GroupByFieldValue = groupByValue[0]
+		if ret.GroupByFieldValue == nil {
+			ret.GroupByFieldValue = &schemapb.FieldData{}
+		}
+	}
+	return ret, nil
+}
@@ -91,7 +91,7 @@ func NewTEIEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functionSchema *
 		}
 	}
 
-	apiKey, _, err := parseAKAndURL(credentials, functionSchema.Params, params, "")
+	apiKey, _, err := ParseAKAndURL(credentials, functionSchema.Params, params, "")
 	if err != nil {
 		return nil, err
 	}
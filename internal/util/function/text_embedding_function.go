@@ -47,6 +47,7 @@ const (
 	cohereProvider       string = "cohere"
 	siliconflowProvider  string = "siliconflow"
 	teiProvider          string = "tei"
+	onnxProvider         string = "onnx"
 )
 
 func hasEmptyString(texts []string) bool {
@@ -99,7 +100,8 @@ func NewTextEmbeddingFunction(coll *schemapb.CollectionSchema, functionSchema *s
 	var embP textEmbeddingProvider
 	var newProviderErr error
 	conf := paramtable.Get().FunctionCfg.GetTextEmbeddingProviderConfig(base.provider)
-	credentials := credentials.NewCredentials(paramtable.Get().CredentialCfg.GetCredentials())
+	credentialConf := paramtable.Get().CredentialCfg.GetCredentials()
+	credentials := credentials.NewCredentials(credentialConf)
 	switch base.provider {
 	case openAIProvider:
 		embP, newProviderErr = NewOpenAIEmbeddingProvider(base.outputFields[0], functionSchema, conf, credentials)
@@ -119,13 +121,18 @@ func NewTextEmbeddingFunction(coll *schemapb.CollectionSchema, functionSchema *s
 		embP, newProviderErr = NewSiliconflowEmbeddingProvider(base.outputFields[0], functionSchema, conf, credentials)
 	case teiProvider:
 		embP, newProviderErr = NewTEIEmbeddingProvider(base.outputFields[0], functionSchema, conf, credentials)
+	case onnxProvider:
+		embP, newProviderErr = NewOnnxEmbeddingProvider(base.outputFields[0], functionSchema, conf, credentials)
 	default:
-		return nil, fmt.Errorf("Unsupported text embedding service provider: [%s] , list of supported [%s, %s, %s, %s, %s, %s, %s, %s, %s]", base.provider, openAIProvider, azureOpenAIProvider, aliDashScopeProvider, bedrockProvider, vertexAIProvider, voyageAIProvider, cohereProvider, siliconflowProvider, teiProvider)
+		return nil, fmt.Errorf("Unsupported text embedding service provider: [%s] , list of supported [%s, %s, %s, %s, %s, %s, %s, %s, %s, %s]", base.provider, openAIProvider, azureOpenAIProvider, aliDashScopeProvider, bedrockProvider, vertexAIProvider, voyageAIProvider, cohereProvider, siliconflowProvider, teiProvider, onnxProvider)
 	}
 
 	if newProviderErr != nil {
 		return nil, newProviderErr
 	}
+	if cache := getEmbeddingCache(); cache != nil {
+		embP = newCachedEmbeddingProvider(embP, cache, base.provider, functionSchema, conf, credentialConf)
+	}
 	return &TextEmbeddingFunction{
 		FunctionBase: *base,
 		embProvider:  embP,
@@ -935,19 +935,19 @@ func (s *TextEmbeddingFunctionSuite) TestProcessBulkInsertFloat32() {
 func (s *TextEmbeddingFunctionSuite) TestParseCredentail() {
 	{
 		cred := credentials.NewCredentials(map[string]string{})
-		ak, url, err := parseAKAndURL(cred, []*commonpb.KeyValuePair{}, map[string]string{}, "")
+		ak, url, err := ParseAKAndURL(cred, []*commonpb.KeyValuePair{}, map[string]string{}, "")
 		s.Equal(ak, "")
 		s.Equal(url, "")
 		s.NoError(err)
 	}
 	{
 		cred := credentials.NewCredentials(map[string]string{})
-		_, _, err := parseAKAndURL(cred, []*commonpb.KeyValuePair{}, map[string]string{"credential": "NotExist"}, "")
+		_, _, err := ParseAKAndURL(cred, []*commonpb.KeyValuePair{}, map[string]string{"credential": "NotExist"}, "")
 		s.ErrorContains(err, "is not a apikey crediential, can not find key")
 	}
 	{
 		cred := credentials.NewCredentials(map[string]string{"mock.apikey": "mock"})
-		_, _, err := parseAKAndURL(cred, []*commonpb.KeyValuePair{}, map[string]string{"credential": "mock"}, "")
+		_, _, err := ParseAKAndURL(cred, []*commonpb.KeyValuePair{}, map[string]string{"credential": "mock"}, "")
 		s.NoError(err)
 	}
 }
@@ -0,0 +1,358 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrowipc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/ipc"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/parquet"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// arrowFileMagic is the leading magic bytes of the Arrow IPC file format (Feather V2),
+// files without it are read as the Arrow IPC streaming format.
+var arrowFileMagic = []byte("ARROW1")
+
+// RecordReader iterates the record batches of a file. Besides the file format and the streaming format
+// of Arrow IPC, the formats decoded into Arrow record batches (e.g. ORC) share the reader by implementing it.
+type RecordReader interface {
+	Schema() *arrow.Schema
+	// Next returns the next record batch, or io.EOF if there is no more record.
+	// The returned record is owned by the caller.
+	Next() (arrow.Record, error)
+	Close()
+}
+
+type streamRecordReader struct {
+	r *ipc.Reader
+}
+
+func (s *streamRecordReader) Schema() *arrow.Schema {
+	return s.r.Schema()
+}
+
+func (s *streamRecordReader) Next() (arrow.Record, error) {
+	if !s.r.Next() {
+		if err := s.r.Err(); err != nil && err != io.EOF {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	rec := s.r.Record()
+	rec.Retain()
+	return rec, nil
+}
+
+func (s *streamRecordReader) Close() {
+	s.r.Release()
+}
+
+type fileRecordReader struct {
+	r   *ipc.FileReader
+	idx int
+}
+
+func (f *fileRecordReader) Schema() *arrow.Schema {
+	return f.r.Schema()
+}
+
+func (f *fileRecordReader) Next() (arrow.Record, error) {
+	if f.idx >= f.r.NumRecords() {
+		return nil, io.EOF
+	}
+	rec, err := f.r.RecordAt(f.idx)
+	if err != nil {
+		return nil, err
+	}
+	f.idx++
+	return rec, nil
+}
+
+func (f *fileRecordReader) Close() {
+	f.r.Close()
+}
+
+// columnReader feeds the column of the current record batch slice to a parquet.FieldReader.
+type columnReader struct {
+	field  arrow.Field
+	column arrow.Array
+}
+
+func (c *columnReader) NextBatch(count int64) (*arrow.Chunked, error) {
+	chunks := make([]arrow.Array, 0, 1)
+	if c.column != nil {
+		chunks = append(chunks, c.column)
+		c.column = nil
+	}
+	return arrow.NewChunked(c.field.Type, chunks), nil
+}
+
+func (c *columnReader) Field() *arrow.Field {
+	return &c.field
+}
+
+// Reader is the same as importutilv2.Reader, which can't be referred by the readers built on
+// NewRecordBatchReader because of import cycle.
+type Reader interface {
+	Size() (int64, error)
+	Read() (*storage.InsertData, error)
+	Close()
+}
+
+type reader struct {
+	ctx    context.Context
+	cm     storage.ChunkManager
+	cmr    storage.FileReader
+	schema *schemapb.CollectionSchema
+
+	path string
+	rr   RecordReader
+
+	fileSize   *atomic.Int64
+	bufferSize int
+	count      int64
+
+	// the record batch being read and the position in it
+	rec    arrow.Record
+	offset int64
+
+	frs     map[int64]*parquet.FieldReader // fieldID -> FieldReader
+	columns map[int64]int                  // fieldID -> column index
+	crs     map[int64]*columnReader        // fieldID -> columnReader
+
+	// rowIndex is the index of the next row to be read
+	rowIndex  int64
+	collector *common.RowErrorCollector
+}
+
+func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, path string, bufferSize int) (*reader, error) {
+	cmReader, err := cm.Reader(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	rr, err := newRecordReader(cmReader)
+	if err != nil {
+		cmReader.Close()
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("new arrow ipc reader failed, path=%s, err=%v", path, err))
+	}
+	return NewRecordBatchReader(ctx, cm, cmReader, rr, schema, path, bufferSize)
+}
+
+// NewRecordBatchReader creates a reader which reads the record batches of rr into InsertData,
+// the reader takes the ownership of cmReader and rr, they are closed along with the reader.
+func NewRecordBatchReader(ctx context.Context, cm storage.ChunkManager, cmReader storage.FileReader, rr RecordReader,
+	schema *schemapb.CollectionSchema, path string, bufferSize int,
+) (*reader, error) {
+	count, err := common.EstimateReadCountPerBatch(bufferSize, schema)
+	if err != nil {
+		rr.Close()
+		cmReader.Close()
+		return nil, err
+	}
+
+	r := &reader{
+		ctx:        ctx,
+		cm:         cm,
+		cmr:        cmReader,
+		schema:     schema,
+		path:       path,
+		rr:         rr,
+		fileSize:   atomic.NewInt64(0),
+		bufferSize: bufferSize,
+		count:      count,
+		columns:    make(map[int64]int),
+		crs:        make(map[int64]*columnReader),
+	}
+	arrSchema := normalizeSchema(rr.Schema())
+	r.frs, err = parquet.CreateArrowFieldReaders(arrSchema, schema, "arrow",
+		func(columnIndex int, field *schemapb.FieldSchema) (*parquet.FieldReader, error) {
+			cr := &columnReader{field: arrSchema.Field(columnIndex)}
+			fr, err := parquet.NewColumnFieldReader(cr, columnIndex, field)
+			if err != nil {
+				return nil, err
+			}
+			r.columns[field.GetFieldID()] = columnIndex
+			r.crs[field.GetFieldID()] = cr
+			return fr, nil
+		})
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+func newRecordReader(cmReader storage.FileReader) (RecordReader, error) {
+	br := bufio.NewReader(cmReader)
+	magic, err := br.Peek(len(arrowFileMagic))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if bytes.Equal(magic, arrowFileMagic) {
+		// the file format requires random access to read the footer
+		if _, err := cmReader.Seek(0, io.SeekCurrent); err != nil {
+			return nil, fmt.Errorf("the Arrow IPC file format requires random access, "+
+				"compressed file must be in the streaming format: %w", err)
+		}
+		fr, err := ipc.NewFileReader(cmReader, ipc.WithAllocator(memory.DefaultAllocator))
+		if err != nil {
+			return nil, err
+		}
+		log.Info("arrow ipc file info", zap.Int("record batch num", fr.NumRecords()))
+		return &fileRecordReader{r: fr}, nil
+	}
+	sr, err := ipc.NewReader(br, ipc.WithAllocator(memory.DefaultAllocator))
+	if err != nil {
+		return nil, err
+	}
+	return &streamRecordReader{r: sr}, nil
+}
+
+// nextSlice returns at most count rows of the record batches, or io.EOF if all the records are consumed.
+func (r *reader) nextSlice(count int64) (arrow.Record, error) {
+	for r.rec == nil || r.offset >= r.rec.NumRows() {
+		if r.rec != nil {
+			r.rec.Release()
+			r.rec = nil
+		}
+		rec, err := r.rr.Next()
+		if err != nil {
+			if err == io.EOF {
+				return nil, io.EOF
+			}
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to read record batch, path=%s, err=%v", r.path, err))
+		}
+		r.rec = rec
+		r.offset = 0
+	}
+	end := r.offset + count
+	if end > r.rec.NumRows() {
+		end = r.rec.NumRows()
+	}
+	slice := r.rec.NewSlice(r.offset, end)
+	r.offset = end
+	return slice, nil
+}
+
+func (r *reader) Read() (*storage.InsertData, error) {
+	insertData, err := storage.NewInsertData(r.schema)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		slice, err := r.nextSlice(r.count)
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		err = r.readSlice(slice, insertData)
+		slice.Release()
+		if err != nil {
+			return nil, err
+		}
+		if insertData.GetMemorySize() >= r.bufferSize {
+			break
+		}
+	}
+	for fieldID := range r.frs {
+		if insertData.Data[fieldID].RowNum() == 0 {
+			return nil, io.EOF
+		}
+	}
+	return insertData, nil
+}
+
+func (r *reader) readSlice(slice arrow.Record, insertData *storage.InsertData) error {
+	if r.collector != nil {
+		for fieldID := range r.frs {
+			column, err := normalizeArray(slice.Column(r.columns[fieldID]))
+			if err != nil {
+				return err
+			}
+			r.crs[fieldID].column = column
+		}
+		_, err := common.ReadBatchTolerantly(r.schema, r.frs, slice.NumRows(), insertData, func(_ int64, err error) error {
+			return r.collector.Collect(r.path, r.rowIndex, err)
+		})
+		r.rowIndex += slice.NumRows()
+		return err
+	}
+	for fieldID, fr := range r.frs {
+		column, err := normalizeArray(slice.Column(r.columns[fieldID]))
+		if err != nil {
+			return err
+		}
+		r.crs[fieldID].column = column
+		data, validData, err := fr.Next(slice.NumRows())
+		if err != nil {
+			return err
+		}
+		if data == nil {
+			continue
+		}
+		err = insertData.Data[fieldID].AppendRows(data, validData)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetRowErrorCollector enables the tolerant mode, the batches failed to read are
+// skipped and collected instead of failing the import.
+func (r *reader) SetRowErrorCollector(collector *common.RowErrorCollector) {
+	r.collector = collector
+}
+
+func (r *reader) Size() (int64, error) {
+	if size := r.fileSize.Load(); size != 0 {
+		return size, nil
+	}
+	size, err := r.cm.Size(r.ctx, r.path)
+	if err != nil {
+		return 0, err
+	}
+	r.fileSize.Store(size)
+	return size, nil
+}
+
+func (r *reader) Close() {
+	if r.rec != nil {
+		r.rec.Release()
+		r.rec = nil
+	}
+	r.rr.Close()
+	if r.cmr != nil {
+		r.cmr.Close()
+	}
+}
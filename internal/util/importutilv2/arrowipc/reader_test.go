@@ -0,0 +1,455 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrowipc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/arrow/ipc"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/storage"
+	importcommon "github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/parquet"
+	"github.com/milvus-io/milvus/internal/util/testutil"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+type bytesFileReader struct {
+	*bytes.Reader
+}
+
+func (r *bytesFileReader) Close() error {
+	return nil
+}
+
+func (r *bytesFileReader) Size() (int64, error) {
+	return r.Reader.Size(), nil
+}
+
+type ReaderSuite struct {
+	suite.Suite
+
+	numRows int
+}
+
+func (s *ReaderSuite) SetupSuite() {
+	paramtable.Get().Init(paramtable.NewBaseTable())
+}
+
+func (s *ReaderSuite) SetupTest() {
+	s.numRows = 100
+}
+
+func (s *ReaderSuite) mockChunkManager(content []byte) storage.ChunkManager {
+	cm := mocks.NewChunkManager(s.T())
+	cm.EXPECT().Reader(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, path string) (storage.FileReader, error) {
+		return &bytesFileReader{Reader: bytes.NewReader(content)}, nil
+	})
+	cm.EXPECT().Size(mock.Anything, mock.Anything).Return(int64(len(content)), nil).Maybe()
+	return cm
+}
+
+// writeRecords writes the records into the Arrow IPC file format or the streaming format.
+func (s *ReaderSuite) writeRecords(arrSchema *arrow.Schema, records []arrow.Record, fileFormat bool) []byte {
+	if fileFormat {
+		// the file writer requires io.WriteSeeker
+		f, err := os.CreateTemp(s.T().TempDir(), "*.arrow")
+		s.NoError(err)
+		defer f.Close()
+		w, err := ipc.NewFileWriter(f, ipc.WithSchema(arrSchema), ipc.WithAllocator(memory.DefaultAllocator))
+		s.NoError(err)
+		for _, rec := range records {
+			s.NoError(w.Write(rec))
+		}
+		s.NoError(w.Close())
+		content, err := os.ReadFile(f.Name())
+		s.NoError(err)
+		return content
+	}
+	buf := &bytes.Buffer{}
+	w := ipc.NewWriter(buf, ipc.WithSchema(arrSchema), ipc.WithAllocator(memory.DefaultAllocator))
+	for _, rec := range records {
+		s.NoError(w.Write(rec))
+	}
+	s.NoError(w.Close())
+	return buf.Bytes()
+}
+
+func (s *ReaderSuite) run(dataType schemapb.DataType, elemType schemapb.DataType, nullable bool, fileFormat bool) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:  101,
+				Name:     "vec",
+				DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.DimKey,
+						Value: "8",
+					},
+				},
+			},
+			{
+				FieldID:     102,
+				Name:        dataType.String(),
+				DataType:    dataType,
+				ElementType: elemType,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.MaxLengthKey,
+						Value: "256",
+					},
+					{
+						Key:   common.MaxCapacityKey,
+						Value: "256",
+					},
+				},
+				Nullable: nullable,
+			},
+		},
+	}
+
+	nullPercent := 0
+	if nullable {
+		nullPercent = 50
+	}
+	insertData, err := testutil.CreateInsertData(schema, s.numRows, nullPercent)
+	s.NoError(err)
+	arrSchema, err := parquet.ConvertToArrowSchema(schema, false)
+	s.NoError(err)
+	columns, err := testutil.BuildArrayData(schema, insertData, false)
+	s.NoError(err)
+	rec := array.NewRecord(arrSchema, columns, int64(s.numRows))
+	defer rec.Release()
+
+	// split the rows into several record batches
+	records := []arrow.Record{rec.NewSlice(0, 30), rec.NewSlice(30, 70), rec.NewSlice(70, int64(s.numRows))}
+	content := s.writeRecords(arrSchema, records, fileFormat)
+
+	// read 16 rows per batch, so that the record batches are sliced across batches
+	reader, err := NewReader(context.Background(), s.mockChunkManager(content), schema, "mockPath", math.MaxInt)
+	s.NoError(err)
+	defer reader.Close()
+	reader.count = 16
+
+	size, err := reader.Size()
+	s.NoError(err)
+	s.Equal(int64(len(content)), size)
+
+	res, err := reader.Read()
+	s.NoError(err)
+	for fieldID, data := range res.Data {
+		s.Equal(s.numRows, data.RowNum())
+		for i := 0; i < s.numRows; i++ {
+			s.Equal(insertData.Data[fieldID].GetRow(i), data.GetRow(i))
+		}
+	}
+	_, err = reader.Read()
+	s.ErrorIs(err, io.EOF)
+}
+
+func (s *ReaderSuite) TestReadScalarFields() {
+	for _, fileFormat := range []bool{false, true} {
+		s.run(schemapb.DataType_Bool, schemapb.DataType_None, false, fileFormat)
+		s.run(schemapb.DataType_Int32, schemapb.DataType_None, true, fileFormat)
+		s.run(schemapb.DataType_Double, schemapb.DataType_None, false, fileFormat)
+		s.run(schemapb.DataType_VarChar, schemapb.DataType_None, true, fileFormat)
+		s.run(schemapb.DataType_JSON, schemapb.DataType_None, false, fileFormat)
+		s.run(schemapb.DataType_Array, schemapb.DataType_Int64, false, fileFormat)
+		s.run(schemapb.DataType_Array, schemapb.DataType_VarChar, true, fileFormat)
+	}
+}
+
+func (s *ReaderSuite) TestLargeAndFixedSizeTypes() {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:  101,
+				Name:     "vec",
+				DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.DimKey,
+						Value: "2",
+					},
+				},
+			},
+			{
+				FieldID:  102,
+				Name:     "str",
+				DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.MaxLengthKey,
+						Value: "16",
+					},
+				},
+			},
+			{
+				FieldID:     103,
+				Name:        "arr",
+				DataType:    schemapb.DataType_Array,
+				ElementType: schemapb.DataType_Int32,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.MaxCapacityKey,
+						Value: "16",
+					},
+				},
+			},
+		},
+	}
+
+	mem := memory.DefaultAllocator
+	arrSchema := arrow.NewSchema([]arrow.Field{
+		{Name: "pk", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "vec", Type: arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Float32)},
+		{Name: "str", Type: arrow.BinaryTypes.LargeString},
+		{Name: "arr", Type: arrow.LargeListOf(arrow.PrimitiveTypes.Int32)},
+	}, nil)
+	builder := array.NewRecordBuilder(mem, arrSchema)
+	defer builder.Release()
+	pkBuilder := builder.Field(0).(*array.Int64Builder)
+	vecBuilder := builder.Field(1).(*array.FixedSizeListBuilder)
+	vecValues := vecBuilder.ValueBuilder().(*array.Float32Builder)
+	strBuilder := builder.Field(2).(*array.LargeStringBuilder)
+	arrBuilder := builder.Field(3).(*array.LargeListBuilder)
+	arrValues := arrBuilder.ValueBuilder().(*array.Int32Builder)
+	for i := 0; i < 10; i++ {
+		pkBuilder.Append(int64(i))
+		vecBuilder.Append(true)
+		vecValues.AppendValues([]float32{float32(i), float32(i) + 0.5}, nil)
+		strBuilder.Append(string(rune('a' + i)))
+		arrBuilder.Append(true)
+		arrValues.AppendValues([]int32{int32(i), int32(i * 2)}, nil)
+	}
+	rec := builder.NewRecord()
+	defer rec.Release()
+	content := s.writeRecords(arrSchema, []arrow.Record{rec.NewSlice(0, 3), rec.NewSlice(3, 10)}, false)
+
+	reader, err := NewReader(context.Background(), s.mockChunkManager(content), schema, "mockPath", math.MaxInt)
+	s.NoError(err)
+	defer reader.Close()
+
+	res, err := reader.Read()
+	s.NoError(err)
+	s.Equal(10, res.GetRowNum())
+	for i := 0; i < 10; i++ {
+		s.Equal(int64(i), res.Data[100].GetRow(i))
+		s.Equal([]float32{float32(i), float32(i) + 0.5}, res.Data[101].GetRow(i))
+		s.Equal(string(rune('a'+i)), res.Data[102].GetRow(i))
+		s.Equal([]int32{int32(i), int32(i * 2)}, res.Data[103].GetRow(i).(*schemapb.ScalarField).GetIntData().GetData())
+	}
+}
+
+func (s *ReaderSuite) TestSchemaMismatch() {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:  101,
+				Name:     "str",
+				DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.MaxLengthKey,
+						Value: "16",
+					},
+				},
+			},
+		},
+	}
+	arrSchema := arrow.NewSchema([]arrow.Field{
+		{Name: "pk", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "str", Type: arrow.PrimitiveTypes.Int32},
+	}, nil)
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, arrSchema)
+	defer builder.Release()
+	builder.Field(0).(*array.Int64Builder).Append(1)
+	builder.Field(1).(*array.Int32Builder).Append(1)
+	rec := builder.NewRecord()
+	defer rec.Release()
+	content := s.writeRecords(arrSchema, []arrow.Record{rec}, true)
+
+	_, err := NewReader(context.Background(), s.mockChunkManager(content), schema, "mockPath", math.MaxInt)
+	s.Error(err)
+	s.Contains(err.Error(), "schema not equal")
+
+	// missing field
+	arrSchema = arrow.NewSchema([]arrow.Field{
+		{Name: "pk", Type: arrow.PrimitiveTypes.Int64},
+	}, nil)
+	builder2 := array.NewRecordBuilder(memory.DefaultAllocator, arrSchema)
+	defer builder2.Release()
+	builder2.Field(0).(*array.Int64Builder).Append(1)
+	rec2 := builder2.NewRecord()
+	defer rec2.Release()
+	content = s.writeRecords(arrSchema, []arrow.Record{rec2}, false)
+	_, err = NewReader(context.Background(), s.mockChunkManager(content), schema, "mockPath", math.MaxInt)
+	s.Error(err)
+
+	// not arrow
+	_, err = NewReader(context.Background(), s.mockChunkManager([]byte("dummy")), schema, "mockPath", math.MaxInt)
+	s.Error(err)
+}
+
+func (s *ReaderSuite) TestRowErrorCollector() {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:  101,
+				Name:     "str",
+				DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.MaxLengthKey,
+						Value: "4",
+					},
+				},
+			},
+		},
+	}
+	arrSchema := arrow.NewSchema([]arrow.Field{
+		{Name: "pk", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "str", Type: arrow.BinaryTypes.String},
+	}, nil)
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, arrSchema)
+	defer builder.Release()
+	records := make([]arrow.Record, 0)
+	for _, strs := range [][]string{{"a", "b"}, {"c", "too long"}, {"d", "e", "f"}} {
+		for _, str := range strs {
+			builder.Field(0).(*array.Int64Builder).Append(int64(len(records)))
+			builder.Field(1).(*array.StringBuilder).Append(str)
+		}
+		rec := builder.NewRecord()
+		defer rec.Release()
+		records = append(records, rec)
+	}
+	content := s.writeRecords(arrSchema, records, false)
+
+	reader, err := NewReader(context.Background(), s.mockChunkManager(content), schema, "mockPath", math.MaxInt)
+	s.NoError(err)
+	defer reader.Close()
+	collector := importcommon.NewRowErrorCollector(importcommon.UnlimitedErrorRows)
+	reader.SetRowErrorCollector(collector)
+
+	// the bad batch is skipped as a whole
+	res, err := reader.Read()
+	s.NoError(err)
+	s.Equal(5, res.GetRowNum())
+	s.Equal([]string{"a", "b", "d", "e", "f"}, res.Data[101].GetDataRows())
+	_, err = reader.Read()
+	s.ErrorIs(err, io.EOF)
+
+	s.Equal(int64(1), collector.Count())
+	rowErr := collector.RowErrors()[0]
+	s.Equal("mockPath", rowErr.File)
+	s.Equal(int64(2), rowErr.Row)
+	s.Equal("str", rowErr.Field)
+}
+
+func (s *ReaderSuite) TestCompressed() {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+		},
+	}
+	arrSchema := arrow.NewSchema([]arrow.Field{
+		{Name: "pk", Type: arrow.PrimitiveTypes.Int64},
+	}, nil)
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, arrSchema)
+	defer builder.Release()
+	builder.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 2, 3}, nil)
+	rec := builder.NewRecord()
+	defer rec.Release()
+
+	gzipCM := func(content []byte) storage.ChunkManager {
+		buf := &bytes.Buffer{}
+		gw := gzip.NewWriter(buf)
+		_, err := gw.Write(content)
+		s.NoError(err)
+		s.NoError(gw.Close())
+		cm := mocks.NewChunkManager(s.T())
+		cm.EXPECT().Reader(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, path string) (storage.FileReader, error) {
+			return importcommon.NewDecompressReader(&bytesFileReader{Reader: bytes.NewReader(buf.Bytes())}, path, importcommon.CompressionGzip, atomic.NewInt64(0))
+		})
+		return cm
+	}
+
+	// streaming format is read sequentially
+	content := s.writeRecords(arrSchema, []arrow.Record{rec}, false)
+	reader, err := NewReader(context.Background(), gzipCM(content), schema, "mockPath", math.MaxInt)
+	s.NoError(err)
+	defer reader.Close()
+	res, err := reader.Read()
+	s.NoError(err)
+	s.Equal(3, res.GetRowNum())
+
+	// file format requires random access
+	content = s.writeRecords(arrSchema, []arrow.Record{rec}, true)
+	_, err = NewReader(context.Background(), gzipCM(content), schema, "mockPath", math.MaxInt)
+	s.Error(err)
+	s.Contains(err.Error(), "requires random access")
+}
+
+func TestArrowIPCReader(t *testing.T) {
+	suite.Run(t, new(ReaderSuite))
+}
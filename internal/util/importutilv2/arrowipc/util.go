@@ -0,0 +1,131 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrowipc
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// The parquet field readers only accept string, binary and list layouts with 32-bit offsets.
+// Arrow IPC writers such as DuckDB, polars and pyarrow commonly produce large_string,
+// large_list and fixed_size_list (e.g. for embeddings), these types are normalized to
+// their 32-bit offsets counterparts before being validated and read.
+
+func normalizeField(field arrow.Field) arrow.Field {
+	field.Type = normalizeType(field.Type)
+	return field
+}
+
+func normalizeType(dt arrow.DataType) arrow.DataType {
+	switch dt.ID() {
+	case arrow.LARGE_STRING:
+		return arrow.BinaryTypes.String
+	case arrow.LARGE_BINARY:
+		return arrow.BinaryTypes.Binary
+	case arrow.LIST:
+		return arrow.ListOfField(normalizeField(dt.(*arrow.ListType).ElemField()))
+	case arrow.LARGE_LIST:
+		return arrow.ListOfField(normalizeField(dt.(*arrow.LargeListType).ElemField()))
+	case arrow.FIXED_SIZE_LIST:
+		return arrow.ListOfField(normalizeField(dt.(*arrow.FixedSizeListType).ElemField()))
+	}
+	return dt
+}
+
+func normalizeSchema(schema *arrow.Schema) *arrow.Schema {
+	fields := make([]arrow.Field, 0, len(schema.Fields()))
+	for _, field := range schema.Fields() {
+		fields = append(fields, normalizeField(field))
+	}
+	metadata := schema.Metadata()
+	return arrow.NewSchema(fields, &metadata)
+}
+
+// toInt32Offsets converts the 64-bit offsets buffer of large types to 32-bit offsets.
+func toInt32Offsets(data arrow.ArrayData) (*memory.Buffer, error) {
+	n := data.Offset() + data.Len() + 1
+	offsets := make([]int32, n)
+	buf := data.Buffers()[1]
+	if buf == nil || buf.Len() < n*arrow.Int64SizeBytes {
+		// empty array, all the offsets are zero
+		return memory.NewBufferBytes(arrow.Int32Traits.CastToBytes(offsets)), nil
+	}
+	largeOffsets := arrow.Int64Traits.CastFromBytes(buf.Bytes())
+	for i := 0; i < n; i++ {
+		if largeOffsets[i] > math.MaxInt32 {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("the size of %s column exceeds 2GB in a record batch, "+
+				"please write smaller record batches", data.DataType().String()))
+		}
+		offsets[i] = int32(largeOffsets[i])
+	}
+	return memory.NewBufferBytes(arrow.Int32Traits.CastToBytes(offsets)), nil
+}
+
+// fixedSizeOffsets returns the offsets buffer of a list layout equivalent to a fixed size list.
+func fixedSizeOffsets(data arrow.ArrayData, size int32) *memory.Buffer {
+	n := data.Offset() + data.Len() + 1
+	offsets := make([]int32, n)
+	for i := 0; i < n; i++ {
+		offsets[i] = int32(i) * size
+	}
+	return memory.NewBufferBytes(arrow.Int32Traits.CastToBytes(offsets))
+}
+
+// normalizeArray converts the array to the layout of normalizeType without copying the values.
+func normalizeArray(arr arrow.Array) (arrow.Array, error) {
+	data := arr.Data()
+	buffers := data.Buffers()
+	switch arr.DataType().ID() {
+	case arrow.LARGE_STRING, arrow.LARGE_BINARY:
+		offsets, err := toInt32Offsets(data)
+		if err != nil {
+			return nil, err
+		}
+		return array.MakeFromData(array.NewData(normalizeType(arr.DataType()), data.Len(),
+			[]*memory.Buffer{buffers[0], offsets, buffers[2]}, nil, data.NullN(), data.Offset())), nil
+	case arrow.LIST, arrow.LARGE_LIST, arrow.FIXED_SIZE_LIST:
+		child, err := normalizeArray(array.MakeFromData(data.Children()[0]))
+		if err != nil {
+			return nil, err
+		}
+		var offsets *memory.Buffer
+		switch dt := arr.DataType().(type) {
+		case *arrow.ListType:
+			if arrow.TypeEqual(dt, normalizeType(dt)) {
+				return arr, nil
+			}
+			offsets = buffers[1]
+		case *arrow.LargeListType:
+			offsets, err = toInt32Offsets(data)
+			if err != nil {
+				return nil, err
+			}
+		case *arrow.FixedSizeListType:
+			offsets = fixedSizeOffsets(data, dt.Len())
+		}
+		return array.MakeFromData(array.NewData(normalizeType(arr.DataType()), data.Len(),
+			[]*memory.Buffer{buffers[0], offsets}, []arrow.ArrayData{child.Data()}, data.NullN(), data.Offset())), nil
+	}
+	return arr, nil
+}
@@ -0,0 +1,270 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutilv2
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/internal/util/nullutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/parameterutil"
+)
+
+// GetColumnMapping returns the column_mapping option, which maps the source column names to the field names.
+func GetColumnMapping(options Options) (map[string]string, error) {
+	mappingStr, err := funcutil.GetAttrByKeyFromRepeatedKV(ColumnMapping, options)
+	if err != nil {
+		return nil, nil
+	}
+	mapping := make(map[string]string)
+	if err = json.Unmarshal([]byte(mappingStr), &mapping); err != nil {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("parse column_mapping failed, value=%s, err=%s", mappingStr, err))
+	}
+	return mapping, nil
+}
+
+// GetFillValues returns the fill_values option, which maps the field names to the constant values.
+func GetFillValues(options Options) (map[string]any, error) {
+	fillStr, err := funcutil.GetAttrByKeyFromRepeatedKV(FillValues, options)
+	if err != nil {
+		return nil, nil
+	}
+	// treat number value as a string, the value is converted according to the data type of field
+	dec := json.NewDecoder(bytes.NewReader([]byte(fillStr)))
+	dec.UseNumber()
+	fillValues := make(map[string]any)
+	if err = dec.Decode(&fillValues); err != nil {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("parse fill_values failed, value=%s, err=%s", fillStr, err))
+	}
+	return fillValues, nil
+}
+
+// IsIgnoreExtraColumns indicates whether the columns not in the collection schema are dropped,
+// instead of being stored in the dynamic field.
+func IsIgnoreExtraColumns(options Options) bool {
+	ignore, err := funcutil.GetAttrByKeyFromRepeatedKV(IgnoreExtraColumns, options)
+	if err != nil || ignore != "true" {
+		return false
+	}
+	return true
+}
+
+// HasColumnOptions indicates whether any of the column options is set.
+func HasColumnOptions(options Options) bool {
+	for _, key := range []string{ColumnMapping, FillValues, IgnoreExtraColumns} {
+		if _, err := funcutil.GetAttrByKeyFromRepeatedKV(key, options); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyColumnOptions returns a copy of the schema adapted to the import files by the column options,
+// which is only used by the readers, the schema of collection is not changed:
+//  1. the mapped fields are renamed to the source column names;
+//  2. the fill values are set as the default values of fields, so that the missing values are filled;
+//  3. the dynamic field is removed if the extra columns are ignored.
+func ApplyColumnOptions(schema *schemapb.CollectionSchema, options Options) (*schemapb.CollectionSchema, error) {
+	mapping, err := GetColumnMapping(options)
+	if err != nil {
+		return nil, err
+	}
+	fillValues, err := GetFillValues(options)
+	if err != nil {
+		return nil, err
+	}
+	schema = proto.Clone(schema).(*schemapb.CollectionSchema)
+	nameToField := lo.KeyBy(schema.GetFields(), func(field *schemapb.FieldSchema) string {
+		return field.GetName()
+	})
+
+	for fieldName, value := range fillValues {
+		field, ok := nameToField[fieldName]
+		if !ok {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("fill_values: field '%s' does not exist", fieldName))
+		}
+		defaultValue, err := convertFillValue(field, value)
+		if err != nil {
+			return nil, err
+		}
+		field.DefaultValue = defaultValue
+	}
+
+	mappedFields := make(map[string]string, len(mapping))
+	for column, fieldName := range mapping {
+		field, ok := nameToField[fieldName]
+		if !ok {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("column_mapping: field '%s' does not exist", fieldName))
+		}
+		if other, ok := mappedFields[fieldName]; ok {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("column_mapping: both column '%s' and '%s' are mapped to field '%s'",
+				other, column, fieldName))
+		}
+		mappedFields[fieldName] = column
+		field.Name = column
+	}
+	names := make(map[string]struct{}, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		if _, ok := names[field.GetName()]; ok {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("column_mapping: column '%s' conflicts with the other field", field.GetName()))
+		}
+		names[field.GetName()] = struct{}{}
+	}
+
+	if IsIgnoreExtraColumns(options) && schema.GetEnableDynamicField() {
+		schema.Fields = lo.Filter(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+			return !field.GetIsDynamic()
+		})
+		schema.EnableDynamicField = false
+	}
+	return schema, nil
+}
+
+func convertFillValue(field *schemapb.FieldSchema, value any) (*schemapb.ValueField, error) {
+	wrapErr := func() error {
+		return merr.WrapErrImportFailed(fmt.Sprintf("fill_values: illegal value '%v' for field '%s' of type %s",
+			value, field.GetName(), field.GetDataType().String()))
+	}
+	if field.GetIsPrimaryKey() || field.GetIsFunctionOutput() || field.GetIsDynamic() {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("fill_values: field '%s' cannot be filled with a constant", field.GetName()))
+	}
+	parseInt := func(bitSize int) (int64, error) {
+		num, ok := value.(json.Number)
+		if !ok {
+			return 0, wrapErr()
+		}
+		v, err := strconv.ParseInt(string(num), 10, bitSize)
+		if err != nil {
+			return 0, wrapErr()
+		}
+		return v, nil
+	}
+	parseFloat := func(bitSize int) (float64, error) {
+		num, ok := value.(json.Number)
+		if !ok {
+			return 0, wrapErr()
+		}
+		v, err := strconv.ParseFloat(string(num), bitSize)
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			return 0, wrapErr()
+		}
+		return v, nil
+	}
+
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		v, ok := value.(bool)
+		if !ok {
+			return nil, wrapErr()
+		}
+		return &schemapb.ValueField{Data: &schemapb.ValueField_BoolData{BoolData: v}}, nil
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		bitSize := map[schemapb.DataType]int{
+			schemapb.DataType_Int8:  8,
+			schemapb.DataType_Int16: 16,
+			schemapb.DataType_Int32: 32,
+		}[field.GetDataType()]
+		v, err := parseInt(bitSize)
+		if err != nil {
+			return nil, err
+		}
+		return &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: int32(v)}}, nil
+	case schemapb.DataType_Int64:
+		v, err := parseInt(64)
+		if err != nil {
+			return nil, err
+		}
+		return &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: v}}, nil
+	case schemapb.DataType_Float:
+		v, err := parseFloat(32)
+		if err != nil {
+			return nil, err
+		}
+		return &schemapb.ValueField{Data: &schemapb.ValueField_FloatData{FloatData: float32(v)}}, nil
+	case schemapb.DataType_Double:
+		v, err := parseFloat(64)
+		if err != nil {
+			return nil, err
+		}
+		return &schemapb.ValueField{Data: &schemapb.ValueField_DoubleData{DoubleData: v}}, nil
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		v, ok := value.(string)
+		if !ok {
+			return nil, wrapErr()
+		}
+		maxLength, err := parameterutil.GetMaxLength(field)
+		if err != nil {
+			return nil, err
+		}
+		if err = common.CheckValidString(v, maxLength, field); err != nil {
+			return nil, err
+		}
+		return &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: v}}, nil
+	default:
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("fill_values: field '%s' of type %s cannot be filled with a constant",
+			field.GetName(), field.GetDataType().String()))
+	}
+}
+
+// fillReader fills the fields missing in the import files with the fill values,
+// for the readers which read files by columns, such as parquet and numpy.
+type fillReader struct {
+	Reader
+	fields []*schemapb.FieldSchema
+}
+
+func newFillReader(reader Reader, schema *schemapb.CollectionSchema) Reader {
+	fields := lo.Filter(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+		return field.GetDefaultValue() != nil
+	})
+	return &fillReader{Reader: reader, fields: fields}
+}
+
+func (r *fillReader) Read() (*storage.InsertData, error) {
+	data, err := r.Reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	rowNum := data.GetRowNum()
+	for _, field := range r.fields {
+		fieldData, ok := data.Data[field.GetFieldID()]
+		if !ok || fieldData.RowNum() != 0 {
+			continue
+		}
+		value, err := nullutil.GetDefaultValue(field)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < rowNum; i++ {
+			if err = fieldData.AppendRow(value); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return data, nil
+}
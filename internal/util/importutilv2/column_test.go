@@ -0,0 +1,154 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutilv2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+)
+
+func newColumnTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		EnableDynamicField: true,
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:  101,
+				Name:     "title",
+				DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: common.MaxLengthKey, Value: "8"},
+				},
+			},
+			{
+				FieldID:  102,
+				Name:     "score",
+				DataType: schemapb.DataType_Int32,
+			},
+			{
+				FieldID:  103,
+				Name:     "vec",
+				DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: common.DimKey, Value: "4"},
+				},
+			},
+			{
+				FieldID:   104,
+				Name:      "$meta",
+				DataType:  schemapb.DataType_JSON,
+				IsDynamic: true,
+			},
+		},
+	}
+}
+
+func TestColumn_ApplyColumnOptions(t *testing.T) {
+	schema := newColumnTestSchema()
+	options := []*commonpb.KeyValuePair{
+		{Key: ColumnMapping, Value: `{"id": "pk", "headline": "title"}`},
+		{Key: FillValues, Value: `{"score": 10}`},
+		{Key: IgnoreExtraColumns, Value: "true"},
+	}
+	assert.True(t, HasColumnOptions(options))
+	newSchema, err := ApplyColumnOptions(schema, options)
+	assert.NoError(t, err)
+
+	// the original schema is not changed
+	assert.Equal(t, "pk", schema.GetFields()[0].GetName())
+	assert.Nil(t, schema.GetFields()[2].GetDefaultValue())
+	assert.Equal(t, 5, len(schema.GetFields()))
+
+	assert.False(t, newSchema.GetEnableDynamicField())
+	assert.Equal(t, 4, len(newSchema.GetFields()))
+	assert.Equal(t, "id", newSchema.GetFields()[0].GetName())
+	assert.Equal(t, "headline", newSchema.GetFields()[1].GetName())
+	assert.Equal(t, int32(10), newSchema.GetFields()[2].GetDefaultValue().GetIntData())
+
+	// swap the names of fields
+	options = []*commonpb.KeyValuePair{
+		{Key: ColumnMapping, Value: `{"title": "pk", "pk": "title"}`},
+	}
+	newSchema, err = ApplyColumnOptions(schema, options)
+	assert.NoError(t, err)
+	assert.Equal(t, "title", newSchema.GetFields()[0].GetName())
+	assert.Equal(t, "pk", newSchema.GetFields()[1].GetName())
+	assert.True(t, newSchema.GetEnableDynamicField())
+
+	assert.False(t, HasColumnOptions(nil))
+}
+
+func TestColumn_ApplyColumnOptions_Failed(t *testing.T) {
+	schema := newColumnTestSchema()
+	cases := []struct {
+		key   string
+		value string
+	}{
+		{ColumnMapping, `abc`},
+		{ColumnMapping, `{"a": "dummy"}`},
+		{ColumnMapping, `{"a": "title", "b": "title"}`},
+		{ColumnMapping, `{"score": "title"}`},
+		{FillValues, `abc`},
+		{FillValues, `{"dummy": 1}`},
+		{FillValues, `{"pk": 1}`},
+		{FillValues, `{"vec": [1, 2, 3, 4]}`},
+		{FillValues, `{"score": "abc"}`},
+		{FillValues, `{"score": 1.5}`},
+		{FillValues, `{"score": 9999999999}`},
+		{FillValues, `{"title": 1}`},
+		{FillValues, `{"title": "too long title"}`},
+	}
+	for _, c := range cases {
+		_, err := ApplyColumnOptions(schema, []*commonpb.KeyValuePair{{Key: c.key, Value: c.value}})
+		assert.Error(t, err, c.value)
+	}
+}
+
+func TestColumn_FillReader(t *testing.T) {
+	schema, err := ApplyColumnOptions(newColumnTestSchema(), []*commonpb.KeyValuePair{
+		{Key: FillValues, Value: `{"score": 10, "title": "abc"}`},
+	})
+	assert.NoError(t, err)
+
+	insertData, err := storage.NewInsertData(schema)
+	assert.NoError(t, err)
+	assert.NoError(t, insertData.Data[100].AppendRow(int64(1)))
+	assert.NoError(t, insertData.Data[100].AppendRow(int64(2)))
+	assert.NoError(t, insertData.Data[101].AppendRow("x"))
+	assert.NoError(t, insertData.Data[101].AppendRow("y"))
+
+	r := NewMockReader(t)
+	r.EXPECT().Read().Return(insertData, nil)
+	reader := newFillReader(r, schema)
+	data, err := reader.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, "x", data.Data[101].GetRow(0))
+	assert.Equal(t, 2, data.Data[102].RowNum())
+	assert.Equal(t, int32(10), data.Data[102].GetRow(1))
+	assert.Equal(t, 0, data.Data[103].RowNum())
+}
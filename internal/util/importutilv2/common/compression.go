@@ -0,0 +1,200 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+type CompressionType int
+
+const (
+	CompressionNone   CompressionType = 0
+	CompressionGzip   CompressionType = 1
+	CompressionZstd   CompressionType = 2
+	CompressionSnappy CompressionType = 3
+
+	// zstdMaxWindowSize limits the window size of zstd frames, which bounds
+	// the memory used by the decoder. 32MB covers all the standard compression
+	// levels, frames requiring a larger window are rejected.
+	zstdMaxWindowSize = 32 << 20
+)
+
+var CompressionTypeName = map[int]string{
+	0: "None",
+	1: "Gzip",
+	2: "Zstd",
+	3: "Snappy",
+}
+
+var compressionExts = map[string]CompressionType{
+	".gz":     CompressionGzip,
+	".gzip":   CompressionGzip,
+	".zst":    CompressionZstd,
+	".zstd":   CompressionZstd,
+	".snappy": CompressionSnappy,
+	".sz":     CompressionSnappy,
+}
+
+func (c CompressionType) String() string {
+	return CompressionTypeName[int(c)]
+}
+
+// MemorySize returns the estimated memory in bytes held by a decompressor
+// of this compression type, in addition to the read buffer.
+func (c CompressionType) MemorySize() int64 {
+	switch c {
+	case CompressionGzip:
+		// 32KB sliding window plus the huffman tables and bufio
+		return 64 << 10
+	case CompressionZstd:
+		// the window plus one block being decoded
+		return zstdMaxWindowSize + 128<<10
+	case CompressionSnappy:
+		// one compressed block and one decoded block of 64KB each
+		return 128 << 10
+	}
+	return 0
+}
+
+// GetCompressionType detects the compression type by the suffix of the path,
+// e.g. "a.csv.gz" is a gzip compressed file.
+func GetCompressionType(path string) CompressionType {
+	return compressionExts[strings.ToLower(filepath.Ext(path))]
+}
+
+// TrimCompressionExt removes the compression suffix of the path if there is one,
+// e.g. "a.csv.gz" becomes "a.csv".
+func TrimCompressionExt(path string) string {
+	ext := filepath.Ext(path)
+	if _, ok := compressionExts[strings.ToLower(ext)]; ok {
+		return strings.TrimSuffix(path, ext)
+	}
+	return path
+}
+
+// decompressReader decompresses the underlying file reader in a streaming way.
+// Random access is not possible on a compressed stream, so ReadAt and Seek
+// are not supported. Size returns the compressed size of the file.
+type decompressReader struct {
+	storage.FileReader
+	path         string
+	decompressor io.Reader
+	closeFn      func()
+	readSize     *atomic.Int64
+}
+
+// NewDecompressReader wraps the file reader with a streaming decompressor,
+// readSize is increased by the number of decompressed bytes read.
+func NewDecompressReader(r storage.FileReader, path string, compression CompressionType, readSize *atomic.Int64) (storage.FileReader, error) {
+	dr := &decompressReader{
+		FileReader: r,
+		path:       path,
+		closeFn:    func() {},
+		readSize:   readSize,
+	}
+	switch compression {
+	case CompressionGzip:
+		gr, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to open gzip file %s, error: %v", path, err))
+		}
+		dr.decompressor = gr
+		dr.closeFn = func() { gr.Close() }
+	case CompressionZstd:
+		zr, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxWindow(zstdMaxWindowSize))
+		if err != nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to open zstd file %s, error: %v", path, err))
+		}
+		dr.decompressor = zr
+		dr.closeFn = zr.Close
+	case CompressionSnappy:
+		dr.decompressor = snappy.NewReader(r)
+	default:
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("unsupported compression type %s, file: %s", compression.String(), path))
+	}
+	return dr, nil
+}
+
+func (r *decompressReader) Read(p []byte) (int, error) {
+	n, err := r.decompressor.Read(p)
+	r.readSize.Add(int64(n))
+	if err != nil && err != io.EOF {
+		return n, merr.WrapErrImportFailed(fmt.Sprintf("failed to decompress file %s, error: %v", r.path, err))
+	}
+	return n, err
+}
+
+func (r *decompressReader) ReadAt(p []byte, off int64) (int, error) {
+	return 0, merr.WrapErrImportFailed(fmt.Sprintf("random access is not supported for compressed file %s", r.path))
+}
+
+func (r *decompressReader) Seek(offset int64, whence int) (int64, error) {
+	return 0, merr.WrapErrImportFailed(fmt.Sprintf("seek is not supported for compressed file %s", r.path))
+}
+
+func (r *decompressReader) Close() error {
+	r.closeFn()
+	return r.FileReader.Close()
+}
+
+// DecompressChunkManager is a ChunkManager whose readers transparently
+// decompress the files, it also accumulates the decompressed size of
+// all the files read through it.
+type DecompressChunkManager struct {
+	storage.ChunkManager
+	compression      CompressionType
+	decompressedSize *atomic.Int64
+}
+
+func NewDecompressChunkManager(cm storage.ChunkManager, compression CompressionType) *DecompressChunkManager {
+	return &DecompressChunkManager{
+		ChunkManager:     cm,
+		compression:      compression,
+		decompressedSize: atomic.NewInt64(0),
+	}
+}
+
+func (cm *DecompressChunkManager) Reader(ctx context.Context, filePath string) (storage.FileReader, error) {
+	r, err := cm.ChunkManager.Reader(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	dr, err := NewDecompressReader(r, filePath, cm.compression, cm.decompressedSize)
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	return dr, nil
+}
+
+// DecompressedSize returns the number of decompressed bytes read so far.
+func (cm *DecompressChunkManager) DecompressedSize() int64 {
+	return cm.decompressedSize.Load()
+}
@@ -0,0 +1,131 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+)
+
+type bytesFileReader struct {
+	*bytes.Reader
+	closed bool
+}
+
+func (r *bytesFileReader) Close() error {
+	r.closed = true
+	return nil
+}
+
+func (r *bytesFileReader) Size() (int64, error) {
+	return r.Reader.Size(), nil
+}
+
+func compress(t *testing.T, compression CompressionType, content []byte) []byte {
+	buf := &bytes.Buffer{}
+	var w io.WriteCloser
+	switch compression {
+	case CompressionGzip:
+		w = gzip.NewWriter(buf)
+	case CompressionZstd:
+		zw, err := zstd.NewWriter(buf)
+		assert.NoError(t, err)
+		w = zw
+	case CompressionSnappy:
+		w = snappy.NewBufferedWriter(buf)
+	}
+	_, err := w.Write(content)
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestCompression_GetCompressionType(t *testing.T) {
+	assert.Equal(t, CompressionGzip, GetCompressionType("a/b.csv.gz"))
+	assert.Equal(t, CompressionGzip, GetCompressionType("a/b.json.GZIP"))
+	assert.Equal(t, CompressionZstd, GetCompressionType("a/b.json.zst"))
+	assert.Equal(t, CompressionZstd, GetCompressionType("a/b.npy.zstd"))
+	assert.Equal(t, CompressionSnappy, GetCompressionType("a/b.csv.snappy"))
+	assert.Equal(t, CompressionSnappy, GetCompressionType("a/b.csv.sz"))
+	assert.Equal(t, CompressionNone, GetCompressionType("a/b.csv"))
+	assert.Equal(t, CompressionNone, GetCompressionType("a/gz"))
+
+	assert.Equal(t, "a/b.csv", TrimCompressionExt("a/b.csv.gz"))
+	assert.Equal(t, "a/b.npy", TrimCompressionExt("a/b.npy.ZST"))
+	assert.Equal(t, "a/b.csv", TrimCompressionExt("a/b.csv"))
+
+	assert.Equal(t, "Gzip", CompressionGzip.String())
+	assert.Equal(t, int64(0), CompressionNone.MemorySize())
+	assert.Greater(t, CompressionZstd.MemorySize(), CompressionGzip.MemorySize())
+}
+
+func TestCompression_DecompressReader(t *testing.T) {
+	content := []byte(strings.Repeat("pk,vec\n1,\"[0.1,0.2]\"\n", 1000))
+	for _, compression := range []CompressionType{CompressionGzip, CompressionZstd, CompressionSnappy} {
+		compressed := compress(t, compression, content)
+		fr := &bytesFileReader{Reader: bytes.NewReader(compressed)}
+		readSize := atomic.NewInt64(0)
+		r, err := NewDecompressReader(fr, "mockPath", compression, readSize)
+		assert.NoError(t, err)
+
+		data, err := io.ReadAll(r)
+		assert.NoError(t, err, compression.String())
+		assert.Equal(t, content, data)
+		assert.Equal(t, int64(len(content)), readSize.Load())
+
+		size, err := r.Size()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(len(compressed)), size)
+
+		_, err = r.ReadAt(make([]byte, 1), 0)
+		assert.Error(t, err)
+		_, err = r.Seek(0, io.SeekStart)
+		assert.Error(t, err)
+
+		assert.NoError(t, r.Close())
+		assert.True(t, fr.closed)
+	}
+}
+
+func TestCompression_DecompressError(t *testing.T) {
+	content := []byte("not compressed")
+	readSize := atomic.NewInt64(0)
+
+	// invalid gzip header is detected on open
+	_, err := NewDecompressReader(&bytesFileReader{Reader: bytes.NewReader(content)}, "mockPath", CompressionGzip, readSize)
+	assert.Error(t, err)
+
+	// zstd and snappy report the error on read
+	for _, compression := range []CompressionType{CompressionZstd, CompressionSnappy} {
+		r, err := NewDecompressReader(&bytesFileReader{Reader: bytes.NewReader(content)}, "mockPath", compression, readSize)
+		assert.NoError(t, err)
+		_, err = io.ReadAll(r)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "mockPath")
+	}
+
+	_, err = NewDecompressReader(&bytesFileReader{Reader: bytes.NewReader(content)}, "mockPath", CompressionNone, readSize)
+	assert.Error(t, err)
+}
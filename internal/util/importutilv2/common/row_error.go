@@ -0,0 +1,230 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// MaxReportedRowErrors is the maximum number of bad rows kept in an error report,
+// the bad rows beyond it are only counted.
+const MaxReportedRowErrors = 10000
+
+// UnlimitedErrorRows means there is no limit on the number of bad rows.
+const UnlimitedErrorRows int64 = -1
+
+// MaxSampleRowErrors is the maximum number of bad rows returned in the import progress.
+const MaxSampleRowErrors = 10
+
+// FieldError is returned by the row parsers to tell which field a parse error comes from.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func WrapFieldError(field string, err error) error {
+	return &FieldError{Field: field, Err: err}
+}
+
+func (e *FieldError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// RowError describes a bad row skipped in the tolerant import mode.
+type RowError struct {
+	File string `json:"file"`
+	// Row is the line number for JSON Lines and CSV files, and the index of the row for JSON files.
+	// For the columnar files, it is the index of the first row of the bad batch, see ReadBatchTolerantly.
+	Row    int64  `json:"row"`
+	Field  string `json:"field,omitempty"`
+	Reason string `json:"reason"`
+}
+
+func (e *RowError) String() string {
+	if e.Field == "" {
+		return fmt.Sprintf("file: %s, row: %d, reason: %s", e.File, e.Row, e.Reason)
+	}
+	return fmt.Sprintf("file: %s, row: %d, field: %s, reason: %s", e.File, e.Row, e.Field, e.Reason)
+}
+
+// AppendRowAtomically appends the row to the insert data as a whole, which is used in the tolerant mode.
+// InsertData.Append may fail after appending some of the fields, which misaligns the columns if
+// the bad row is skipped. The row is appended to a single-row insert data first to check it.
+func AppendRowAtomically(schema *schemapb.CollectionSchema, insertData *storage.InsertData, row map[storage.FieldID]any) error {
+	rowData, err := storage.NewInsertData(schema)
+	if err != nil {
+		return err
+	}
+	if err = rowData.Append(row); err != nil {
+		return err
+	}
+	return insertData.Append(row)
+}
+
+// RowErrorCollector collects the bad rows of the import files in the tolerant import mode.
+// It is not thread-safe, each reader owns a collector.
+type RowErrorCollector struct {
+	maxErrorRows int64
+	count        int64
+	rowErrors    []*RowError
+}
+
+// NewRowErrorCollector creates a collector which allows at most maxErrorRows bad rows,
+// maxErrorRows = 0 means no bad row is allowed, and UnlimitedErrorRows means there is no limit.
+func NewRowErrorCollector(maxErrorRows int64) *RowErrorCollector {
+	return &RowErrorCollector{
+		maxErrorRows: maxErrorRows,
+		rowErrors:    make([]*RowError, 0),
+	}
+}
+
+// Collect records a bad row, it returns an error if the number of bad rows exceeds the limit.
+func (c *RowErrorCollector) Collect(file string, row int64, err error) error {
+	c.count++
+	if len(c.rowErrors) < MaxReportedRowErrors {
+		rowErr := &RowError{
+			File:   file,
+			Row:    row,
+			Reason: err.Error(),
+		}
+		var fieldErr *FieldError
+		if errors.As(err, &fieldErr) {
+			rowErr.Field = fieldErr.Field
+		}
+		c.rowErrors = append(c.rowErrors, rowErr)
+	}
+	if c.maxErrorRows != UnlimitedErrorRows && c.count > c.maxErrorRows {
+		return merr.WrapErrImportFailed(fmt.Sprintf("the number of bad rows exceeds max_error_rows(%d), "+
+			"the last bad row is at %d of file %s, error: %v", c.maxErrorRows, row, file, err))
+	}
+	return nil
+}
+
+// Count returns the number of bad rows collected so far.
+func (c *RowErrorCollector) Count() int64 {
+	return c.count
+}
+
+func (c *RowErrorCollector) RowErrors() []*RowError {
+	return c.rowErrors
+}
+
+// SampleErrors returns the first n bad rows in a readable format.
+func (c *RowErrorCollector) SampleErrors(n int) []string {
+	samples := make([]string, 0, n)
+	for _, rowErr := range c.rowErrors {
+		if len(samples) >= n {
+			break
+		}
+		samples = append(samples, rowErr.String())
+	}
+	return samples
+}
+
+// Report encodes the collected bad rows as JSON Lines, one bad row per line.
+func (c *RowErrorCollector) Report() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	for _, rowErr := range c.rowErrors {
+		if err := enc.Encode(rowErr); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// FieldBatchReader reads the batches of a field from the columnar files, such as Parquet and Numpy files.
+type FieldBatchReader interface {
+	Next(count int64) (any, any, error)
+}
+
+// ReadBatchTolerantly reads a batch of rows of all the fields from the columnar files in the tolerant mode.
+// A single bad row can't be skipped in a column batch, so the batch is skipped as a whole if any field
+// fails to read, and collect is called for each failed field. It returns the number of rows of the batch,
+// 0 if there is no more data.
+func ReadBatchTolerantly[R FieldBatchReader](schema *schemapb.CollectionSchema, frs map[int64]R, count int64,
+	insertData *storage.InsertData, collect func(fieldID int64, err error) error,
+) (int, error) {
+	type batch struct {
+		data      any
+		validData any
+	}
+	batches := make(map[int64]batch, len(frs))
+	failed := make([]int64, 0)
+	errs := make(map[int64]error)
+	for _, field := range schema.GetFields() {
+		fr, ok := frs[field.GetFieldID()]
+		if !ok {
+			continue
+		}
+		data, validData, err := fr.Next(count)
+		if err != nil {
+			failed = append(failed, field.GetFieldID())
+			errs[field.GetFieldID()] = WrapFieldError(field.GetName(), err)
+			continue
+		}
+		if data == nil {
+			return 0, nil
+		}
+		batches[field.GetFieldID()] = batch{data: data, validData: validData}
+	}
+	if len(failed) == 0 {
+		rows := insertData.GetRowNum()
+		for fieldID, b := range batches {
+			if err := insertData.Data[fieldID].AppendRows(b.data, b.validData); err != nil {
+				return 0, err
+			}
+		}
+		return insertData.GetRowNum() - rows, nil
+	}
+	if len(batches) == 0 {
+		// the size of the batch is unknown if all the fields fail, it's impossible to go on
+		return 0, errs[failed[0]]
+	}
+	var rows int
+	for fieldID, b := range batches {
+		fieldData, err := storage.NewFieldData(insertData.Data[fieldID].GetDataType(),
+			typeutil.GetField(schema, fieldID), 0)
+		if err != nil {
+			return 0, err
+		}
+		if err = fieldData.AppendRows(b.data, b.validData); err != nil {
+			return 0, err
+		}
+		rows = fieldData.RowNum()
+		break
+	}
+	for _, fieldID := range failed {
+		if err := collect(fieldID, errs[fieldID]); err != nil {
+			return 0, err
+		}
+	}
+	return rows, nil
+}
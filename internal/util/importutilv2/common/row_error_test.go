@@ -0,0 +1,121 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+)
+
+func TestRowErrorCollector(t *testing.T) {
+	collector := NewRowErrorCollector(2)
+	err := collector.Collect("a.jsonl", 3, WrapFieldError("vec", errors.New("invalid dim")))
+	assert.NoError(t, err)
+	err = collector.Collect("a.jsonl", 7, errors.New("invalid JSON format"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), collector.Count())
+
+	rowErrors := collector.RowErrors()
+	assert.Equal(t, 2, len(rowErrors))
+	assert.Equal(t, &RowError{File: "a.jsonl", Row: 3, Field: "vec", Reason: "invalid dim"}, rowErrors[0])
+	assert.Equal(t, &RowError{File: "a.jsonl", Row: 7, Reason: "invalid JSON format"}, rowErrors[1])
+
+	report, err := collector.Report()
+	assert.NoError(t, err)
+	scanner := bufio.NewScanner(bytes.NewReader(report))
+	lines := 0
+	for scanner.Scan() {
+		rowErr := &RowError{}
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), rowErr))
+		assert.Equal(t, rowErrors[lines], rowErr)
+		lines++
+	}
+	assert.Equal(t, 2, lines)
+
+	samples := collector.SampleErrors(1)
+	assert.Equal(t, []string{"file: a.jsonl, row: 3, field: vec, reason: invalid dim"}, samples)
+	samples = collector.SampleErrors(MaxSampleRowErrors)
+	assert.Equal(t, []string{
+		"file: a.jsonl, row: 3, field: vec, reason: invalid dim",
+		"file: a.jsonl, row: 7, reason: invalid JSON format",
+	}, samples)
+
+	// exceeds max_error_rows
+	err = collector.Collect("a.jsonl", 9, errors.New("invalid JSON format"))
+	assert.Error(t, err)
+	assert.Equal(t, int64(3), collector.Count())
+}
+
+func TestRowErrorCollector_Unlimited(t *testing.T) {
+	collector := NewRowErrorCollector(UnlimitedErrorRows)
+	for i := 0; i < MaxReportedRowErrors+10; i++ {
+		err := collector.Collect("a.csv", int64(i), fmt.Errorf("bad row %d", i))
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int64(MaxReportedRowErrors+10), collector.Count())
+	assert.Equal(t, MaxReportedRowErrors, len(collector.RowErrors()))
+}
+
+func TestRowErrorCollector_ZeroTolerance(t *testing.T) {
+	collector := NewRowErrorCollector(0)
+	err := collector.Collect("a.csv", 1, errors.New("bad row"))
+	assert.Error(t, err)
+	assert.Equal(t, int64(1), collector.Count())
+}
+
+func TestAppendRowAtomically(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{
+				{Key: common.DimKey, Value: "2"},
+			}},
+			{FieldID: 102, Name: "str", DataType: schemapb.DataType_VarChar},
+		},
+	}
+	insertData, err := storage.NewInsertData(schema)
+	assert.NoError(t, err)
+	err = AppendRowAtomically(schema, insertData, map[storage.FieldID]any{100: int64(1), 101: []float32{0.1, 0.2}, 102: "a"})
+	assert.NoError(t, err)
+
+	// the vector has a wrong dim, none of the fields is appended
+	for i := 0; i < 10; i++ {
+		err = AppendRowAtomically(schema, insertData, map[storage.FieldID]any{100: int64(2), 101: []float32{0.1}, 102: "b"})
+		assert.Error(t, err)
+	}
+	for _, field := range insertData.Data {
+		assert.Equal(t, 1, field.RowNum())
+	}
+
+	err = AppendRowAtomically(schema, insertData, map[storage.FieldID]any{100: int64(3), 101: []float32{0.3, 0.4}, 102: "c"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, insertData.GetRowNum())
+	assert.Equal(t, []int64{1, 3}, insertData.Data[100].GetDataRows())
+	assert.Equal(t, []float32{0.1, 0.2, 0.3, 0.4}, insertData.Data[101].GetDataRows())
+	assert.Equal(t, []string{"a", "c"}, insertData.Data[102].GetDataRows())
+}
@@ -47,6 +47,8 @@ type reader struct {
 	bufferSize int
 	count      int64
 	filePath   string
+
+	collector *common.RowErrorCollector
 }
 
 func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, path string, bufferSize int, sep rune, nullkey string) (*reader, error) {
@@ -99,10 +101,24 @@ func (r *reader) Read() (*storage.InsertData, error) {
 		}
 		row, err := r.parser.Parse(value)
 		if err != nil {
+			if r.collector != nil {
+				line, _ := r.cr.FieldPos(0)
+				if err = r.collector.Collect(r.filePath, int64(line), err); err != nil {
+					return nil, err
+				}
+				continue
+			}
 			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to parse row, error: %v", err))
 		}
-		err = insertData.Append(row)
-		if err != nil {
+		if r.collector != nil {
+			if err = common.AppendRowAtomically(r.schema, insertData, row); err != nil {
+				line, _ := r.cr.FieldPos(0)
+				if err = r.collector.Collect(r.filePath, int64(line), err); err != nil {
+					return nil, err
+				}
+				continue
+			}
+		} else if err = insertData.Append(row); err != nil {
 			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to append row, error: %v", err))
 		}
 		cnt++
@@ -122,6 +138,12 @@ func (r *reader) Read() (*storage.InsertData, error) {
 	return insertData, nil
 }
 
+// SetRowErrorCollector enables the tolerant mode, rows failed to parse or append are
+// skipped and collected instead of failing the import.
+func (r *reader) SetRowErrorCollector(collector *common.RowErrorCollector) {
+	r.collector = collector
+}
+
 func (r *reader) Close() {
 	if r.cmr != nil {
 		r.cmr.Close()
@@ -134,7 +134,7 @@ func (r *rowParser) Parse(strArr []string) (Row, error) {
 		if field, ok := r.name2Field[r.header[index]]; ok {
 			data, err := r.parseEntity(field, value)
 			if err != nil {
-				return nil, err
+				return nil, common.WrapFieldError(field.GetName(), err)
 			}
 			row[field.GetFieldID()] = data
 		} else if r.dynamicField != nil {
@@ -161,7 +161,8 @@ func (r *rowParser) Parse(strArr []string) (Row, error) {
 			}
 		}
 		if _, ok := row[fieldID]; !ok {
-			return nil, merr.WrapErrImportFailed(fmt.Sprintf("value of field '%s' is missed", fieldName))
+			return nil, common.WrapFieldError(fieldName,
+				merr.WrapErrImportFailed(fmt.Sprintf("value of field '%s' is missed", fieldName)))
 		}
 	}
 
@@ -0,0 +1,230 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// jsonlReader reads rows from one or more JSON Lines (NDJSON) files,
+// each line of which is a single JSON object describing one row.
+// Files are consumed sequentially and line by line, so the content
+// never needs to be loaded into memory as a whole.
+type jsonlReader struct {
+	ctx    context.Context
+	cm     storage.ChunkManager
+	schema *schemapb.CollectionSchema
+
+	fileSize *atomic.Int64
+	paths    []string
+
+	// state of the file currently being read
+	fileIdx int
+	cmr     storage.FileReader
+	br      *bufio.Reader
+	lineNum int
+
+	bufferSize int
+	count      int64
+
+	parser    RowParser
+	collector *common.RowErrorCollector
+}
+
+func NewJSONLReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, paths []string, bufferSize int) (*jsonlReader, error) {
+	if len(paths) == 0 {
+		return nil, merr.WrapErrImportFailed("no jsonl file to import")
+	}
+	count, err := common.EstimateReadCountPerBatch(bufferSize, schema)
+	if err != nil {
+		return nil, err
+	}
+	reader := &jsonlReader{
+		ctx:        ctx,
+		cm:         cm,
+		schema:     schema,
+		fileSize:   atomic.NewInt64(0),
+		paths:      paths,
+		bufferSize: bufferSize,
+		count:      count,
+	}
+	reader.parser, err = NewRowParser(schema)
+	if err != nil {
+		return nil, err
+	}
+	// open the first file eagerly to fail fast on io errors
+	err = reader.openFile()
+	if err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+func (j *jsonlReader) openFile() error {
+	path := j.paths[j.fileIdx]
+	r, err := j.cm.Reader(j.ctx, path)
+	if err != nil {
+		return merr.WrapErrImportFailed(fmt.Sprintf("read jsonl file failed, path=%s, err=%s", path, err.Error()))
+	}
+	j.cmr = r
+	j.br = bufio.NewReader(r)
+	j.lineNum = 0
+	return nil
+}
+
+func (j *jsonlReader) closeFile() {
+	if j.cmr != nil {
+		j.cmr.Close()
+	}
+	j.cmr = nil
+	j.br = nil
+}
+
+// readLine returns the next non-blank line across all the files,
+// or io.EOF if all the files have been consumed.
+func (j *jsonlReader) readLine() ([]byte, error) {
+	for {
+		if j.br == nil {
+			if j.fileIdx >= len(j.paths) {
+				return nil, io.EOF
+			}
+			if err := j.openFile(); err != nil {
+				return nil, err
+			}
+		}
+		line, err := j.br.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to read line %d of file %s, error: %v",
+				j.lineNum+1, j.paths[j.fileIdx], err))
+		}
+		if len(line) == 0 && err == io.EOF {
+			j.closeFile()
+			j.fileIdx++
+			continue
+		}
+		j.lineNum++
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		return line, nil
+	}
+}
+
+func (j *jsonlReader) parseLine(line []byte) (Row, error) {
+	dec := json.NewDecoder(bytes.NewReader(line))
+	// Treat number value as a string instead of a float64,
+	// see reader.Init for the details.
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, merr.WrapErrImportFailed("invalid JSON Lines format, each line should contain exactly one JSON object")
+	}
+	return j.parser.Parse(value)
+}
+
+func (j *jsonlReader) Read() (*storage.InsertData, error) {
+	insertData, err := storage.NewInsertData(j.schema)
+	if err != nil {
+		return nil, err
+	}
+	var cnt int64 = 0
+	for {
+		line, err := j.readLine()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		row, err := j.parseLine(line)
+		if err != nil {
+			if j.collector != nil {
+				if err = j.collector.Collect(j.paths[j.fileIdx], int64(j.lineNum), err); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to parse row at line %d of file %s, error: %v",
+				j.lineNum, j.paths[j.fileIdx], err))
+		}
+		if j.collector != nil {
+			if err = common.AppendRowAtomically(j.schema, insertData, row); err != nil {
+				if err = j.collector.Collect(j.paths[j.fileIdx], int64(j.lineNum), err); err != nil {
+					return nil, err
+				}
+				continue
+			}
+		} else if err = insertData.Append(row); err != nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to append row at line %d of file %s, err=%s",
+				j.lineNum, j.paths[j.fileIdx], err.Error()))
+		}
+		cnt++
+		if cnt >= j.count {
+			cnt = 0
+			if insertData.GetMemorySize() >= j.bufferSize {
+				break
+			}
+		}
+	}
+	if insertData.GetRowNum() == 0 {
+		return nil, io.EOF
+	}
+	return insertData, nil
+}
+
+// SetRowErrorCollector enables the tolerant mode, lines failed to parse or append are
+// skipped and collected instead of failing the import.
+func (j *jsonlReader) SetRowErrorCollector(collector *common.RowErrorCollector) {
+	j.collector = collector
+}
+
+// Size returns the total size of all the jsonl files.
+func (j *jsonlReader) Size() (int64, error) {
+	if size := j.fileSize.Load(); size != 0 {
+		return size, nil
+	}
+	var total int64
+	for _, path := range j.paths {
+		size, err := j.cm.Size(j.ctx, path)
+		if err != nil {
+			return 0, err
+		}
+		total += size
+	}
+	j.fileSize.Store(total)
+	return total, nil
+}
+
+func (j *jsonlReader) Close() {
+	j.closeFile()
+}
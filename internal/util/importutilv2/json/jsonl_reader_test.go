@@ -0,0 +1,263 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/storage"
+	importcommon "github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/internal/util/testutil"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+type JSONLReaderSuite struct {
+	suite.Suite
+}
+
+func (suite *JSONLReaderSuite) SetupSuite() {
+	paramtable.Get().Init(paramtable.NewBaseTable())
+}
+
+func (suite *JSONLReaderSuite) pkSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+		},
+	}
+}
+
+func (suite *JSONLReaderSuite) mockChunkManager(files map[string]string) *mocks.ChunkManager {
+	cm := mocks.NewChunkManager(suite.T())
+	cm.EXPECT().Reader(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, s string) (storage.FileReader, error) {
+		content, ok := files[s]
+		if !ok {
+			return nil, merr.WrapErrImportFailed("io error")
+		}
+		reader := strings.NewReader(content)
+		return &mockReader{Reader: reader, Closer: io.NopCloser(reader)}, nil
+	}).Maybe()
+	cm.EXPECT().Size(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, s string) (int64, error) {
+		return int64(len(files[s])), nil
+	}).Maybe()
+	return cm
+}
+
+func (suite *JSONLReaderSuite) TestReadMultipleFiles() {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:  101,
+				Name:     "vec",
+				DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.DimKey,
+						Value: "8",
+					},
+				},
+			},
+			{
+				FieldID:  102,
+				Name:     "str",
+				DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.MaxLengthKey,
+						Value: "128",
+					},
+				},
+				Nullable: true,
+			},
+		},
+	}
+
+	numRows := 100
+	insertData, err := testutil.CreateInsertData(schema, numRows)
+	suite.NoError(err)
+	rows, err := testutil.CreateInsertDataRowsForJSON(schema, insertData)
+	suite.NoError(err)
+
+	// split the rows into two shards, with blank lines and
+	// windows line endings in between
+	toLines := func(rows []map[string]any, sep string) string {
+		lines := make([]string, 0, len(rows))
+		for _, row := range rows {
+			b, err := json.Marshal(row)
+			suite.NoError(err)
+			lines = append(lines, string(b))
+		}
+		return strings.Join(lines, sep)
+	}
+	files := map[string]string{
+		"a.jsonl":  toLines(rows[:60], "\n") + "\n\n",
+		"b.ndjson": toLines(rows[60:], "\r\n"),
+	}
+	cm := suite.mockChunkManager(files)
+
+	reader, err := NewJSONLReader(context.Background(), cm, schema, []string{"a.jsonl", "b.ndjson"}, math.MaxInt)
+	suite.NoError(err)
+	defer reader.Close()
+
+	size, err := reader.Size()
+	suite.NoError(err)
+	suite.Equal(int64(len(files["a.jsonl"])+len(files["b.ndjson"])), size)
+
+	res, err := reader.Read()
+	suite.NoError(err)
+	for fieldID, data := range res.Data {
+		suite.Equal(numRows, data.RowNum())
+		for i := 0; i < numRows; i++ {
+			suite.Equal(insertData.Data[fieldID].GetRow(i), data.GetRow(i))
+		}
+	}
+
+	_, err = reader.Read()
+	suite.ErrorIs(err, io.EOF)
+}
+
+func (suite *JSONLReaderSuite) TestReadCount() {
+	lines := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		b, err := json.Marshal(map[string]any{"pk": int64(i)})
+		suite.NoError(err)
+		lines = append(lines, string(b))
+	}
+	files := map[string]string{
+		"a.jsonl": strings.Join(lines[:50], "\n"),
+		"b.jsonl": strings.Join(lines[50:], "\n"),
+	}
+	cm := suite.mockChunkManager(files)
+
+	// buffer size is 320 bytes, each read batch is 40 rows,
+	// Read() is called for 3 times, 40 + 40 + 20
+	reader, err := NewJSONLReader(context.Background(), cm, suite.pkSchema(), []string{"a.jsonl", "b.jsonl"}, 320)
+	suite.NoError(err)
+	defer reader.Close()
+
+	for _, expect := range []int{40, 40, 20} {
+		data, err := reader.Read()
+		suite.NoError(err)
+		suite.Equal(expect, data.GetRowNum())
+	}
+	_, err = reader.Read()
+	suite.ErrorIs(err, io.EOF)
+}
+
+func (suite *JSONLReaderSuite) TestDecodeError() {
+	testDecode := func(content string, expectErr string) {
+		files := map[string]string{
+			"ok.jsonl":  "{\"pk\": 1}\n",
+			"bad.jsonl": content,
+		}
+		cm := suite.mockChunkManager(files)
+		reader, err := NewJSONLReader(context.Background(), cm, suite.pkSchema(), []string{"ok.jsonl", "bad.jsonl"}, math.MaxInt)
+		suite.NoError(err)
+		defer reader.Close()
+
+		_, err = reader.Read()
+		if expectErr == "" {
+			suite.NoError(err)
+		} else {
+			suite.Error(err)
+			suite.Contains(err.Error(), expectErr)
+		}
+	}
+
+	testDecode("", "")
+	testDecode("\n\n{\"pk\": 2}\n", "")
+	testDecode("{\"pk\": 2}\n{\"pk\": 3", "line 2 of file bad.jsonl")
+	testDecode("{\"pk\": 2}\n\n[1, 2]", "line 3 of file bad.jsonl")
+	testDecode("{\"dummy\": 2}", "line 1 of file bad.jsonl")
+	testDecode("{\"pk\": 2} {\"pk\": 3}", "exactly one JSON object")
+	testDecode("{\"pk\": \"a\"}", "line 1 of file bad.jsonl")
+}
+
+func (suite *JSONLReaderSuite) TestTolerant() {
+	files := map[string]string{
+		"a.jsonl": "{\"pk\": 1}\n{\"pk\": \"a\"}\n{\"pk\": 3}\n{\"pk\": 4",
+	}
+	cm := suite.mockChunkManager(files)
+	reader, err := NewJSONLReader(context.Background(), cm, suite.pkSchema(), []string{"a.jsonl"}, math.MaxInt)
+	suite.NoError(err)
+	defer reader.Close()
+	collector := importcommon.NewRowErrorCollector(2)
+	reader.SetRowErrorCollector(collector)
+
+	data, err := reader.Read()
+	suite.NoError(err)
+	suite.Equal(2, data.GetRowNum())
+	suite.Equal(int64(2), collector.Count())
+	rowErrors := collector.RowErrors()
+	suite.Equal(int64(2), rowErrors[0].Row)
+	suite.Equal("pk", rowErrors[0].Field)
+	suite.Equal(int64(4), rowErrors[1].Row)
+	suite.Equal("a.jsonl", rowErrors[1].File)
+
+	// exceeds max_error_rows
+	reader, err = NewJSONLReader(context.Background(), cm, suite.pkSchema(), []string{"a.jsonl"}, math.MaxInt)
+	suite.NoError(err)
+	defer reader.Close()
+	reader.SetRowErrorCollector(importcommon.NewRowErrorCollector(1))
+	_, err = reader.Read()
+	suite.Error(err)
+	suite.Contains(err.Error(), "max_error_rows")
+}
+
+func (suite *JSONLReaderSuite) TestOpenError() {
+	cm := suite.mockChunkManager(map[string]string{"a.jsonl": "{\"pk\": 1}"})
+	_, err := NewJSONLReader(context.Background(), cm, suite.pkSchema(), []string{}, math.MaxInt)
+	suite.Error(err)
+	_, err = NewJSONLReader(context.Background(), cm, suite.pkSchema(), []string{"dummy.jsonl"}, math.MaxInt)
+	suite.Error(err)
+
+	// the second file is opened lazily
+	reader, err := NewJSONLReader(context.Background(), cm, suite.pkSchema(), []string{"a.jsonl", "dummy.jsonl"}, math.MaxInt)
+	suite.NoError(err)
+	defer reader.Close()
+	_, err = reader.Read()
+	suite.Error(err)
+	suite.Contains(err.Error(), "dummy.jsonl")
+}
+
+func TestJSONLReader(t *testing.T) {
+	suite.Run(t, new(JSONLReaderSuite))
+}
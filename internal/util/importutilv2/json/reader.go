@@ -52,6 +52,10 @@ type reader struct {
 	isOldFormat bool
 
 	parser RowParser
+
+	// rowIndex is the index of the next row to be parsed
+	rowIndex  int64
+	collector *common.RowErrorCollector
 }
 
 func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, path string, bufferSize int) (*reader, error) {
@@ -140,12 +144,26 @@ func (j *reader) Read() (*storage.InsertData, error) {
 		if err = j.dec.Decode(&value); err != nil {
 			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to parse row, error: %v", err))
 		}
+		rowIndex := j.rowIndex
+		j.rowIndex++
 		row, err := j.parser.Parse(value)
 		if err != nil {
+			if j.collector != nil {
+				if err = j.collector.Collect(j.filePath, rowIndex, err); err != nil {
+					return nil, err
+				}
+				continue
+			}
 			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to parse row, error: %v", err))
 		}
-		err = insertData.Append(row)
-		if err != nil {
+		if j.collector != nil {
+			if err = common.AppendRowAtomically(j.schema, insertData, row); err != nil {
+				if err = j.collector.Collect(j.filePath, rowIndex, err); err != nil {
+					return nil, err
+				}
+				continue
+			}
+		} else if err = insertData.Append(row); err != nil {
 			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to append row, err=%s", err.Error()))
 		}
 		cnt++
@@ -170,6 +188,12 @@ func (j *reader) Read() (*storage.InsertData, error) {
 	return insertData, nil
 }
 
+// SetRowErrorCollector enables the tolerant mode, rows failed to parse or append are
+// skipped and collected instead of failing the import.
+func (j *reader) SetRowErrorCollector(collector *common.RowErrorCollector) {
+	j.collector = collector
+}
+
 func (j *reader) Size() (int64, error) {
 	if size := j.fileSize.Load(); size != 0 {
 		return size, nil
@@ -131,7 +131,7 @@ func (r *rowParser) Parse(raw any) (Row, error) {
 		if fieldID, ok := r.name2FieldID[key]; ok {
 			data, err := r.parseEntity(fieldID, value)
 			if err != nil {
-				return nil, err
+				return nil, common.WrapFieldError(key, err)
 			}
 			row[fieldID] = data
 		} else if r.dynamicField != nil {
@@ -158,7 +158,8 @@ func (r *rowParser) Parse(raw any) (Row, error) {
 			}
 		}
 		if _, ok = row[fieldID]; !ok {
-			return nil, merr.WrapErrImportFailed(fmt.Sprintf("value of field '%s' is missed", fieldName))
+			return nil, common.WrapFieldError(fieldName,
+				merr.WrapErrImportFailed(fmt.Sprintf("value of field '%s' is missed", fieldName)))
 		}
 	}
 
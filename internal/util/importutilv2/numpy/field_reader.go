@@ -187,6 +187,7 @@ func (c *FieldReader) Next(count int64) (any, any, error) {
 	case schemapb.DataType_JSON:
 		var strs []string
 		strs, err = c.ReadString(readCount)
+		c.readPosition += int(readCount)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -210,7 +211,6 @@ func (c *FieldReader) Next(count int64) (any, any, error) {
 			byteArr = append(byteArr, []byte(str))
 		}
 		data = byteArr
-		c.readPosition += int(readCount)
 	case schemapb.DataType_BinaryVector, schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
 		data, err = ReadN[uint8](c.reader, c.order, readCount)
 		if err != nil {
@@ -235,6 +235,7 @@ func (c *FieldReader) Next(count int64) (any, any, error) {
 			if err != nil {
 				return nil, nil, err
 			}
+			c.readPosition += int(readCount)
 			err = typeutil.VerifyFloats32(data.([]float32))
 			if err != nil {
 				return nil, nil, err
@@ -245,6 +246,7 @@ func (c *FieldReader) Next(count int64) (any, any, error) {
 			if err != nil {
 				return nil, nil, err
 			}
+			c.readPosition += int(readCount)
 			err = typeutil.VerifyFloats64(data64)
 			if err != nil {
 				return nil, nil, err
@@ -253,7 +255,6 @@ func (c *FieldReader) Next(count int64) (any, any, error) {
 				return float32(f)
 			})
 		}
-		c.readPosition += int(readCount)
 	default:
 		return nil, nil, merr.WrapErrImportFailed(fmt.Sprintf("unsupported data type: %s", dt.String()))
 	}
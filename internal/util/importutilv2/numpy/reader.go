@@ -39,6 +39,11 @@ type reader struct {
 
 	count int64
 	frs   map[int64]*FieldReader // fieldID -> FieldReader
+
+	// rowIndex is the index of the next row to be read
+	rowIndex   int64
+	collector  *common.RowErrorCollector
+	fieldPaths map[int64]string // fieldID -> path
 }
 
 func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, paths []string, bufferSize int) (*reader, error) {
@@ -63,15 +68,22 @@ func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.Co
 		crs[fieldID] = cr
 	}
 
+	nameToPath := getNameToPath(paths)
+	fieldPaths := make(map[int64]string, len(crs))
+	for fieldID := range crs {
+		fieldPaths[fieldID] = nameToPath[fields[fieldID].GetName()]
+	}
+
 	return &reader{
-		ctx:      ctx,
-		cm:       cm,
-		cmrs:     readers,
-		schema:   schema,
-		fileSize: atomic.NewInt64(0),
-		paths:    paths,
-		count:    count,
-		frs:      crs,
+		ctx:        ctx,
+		cm:         cm,
+		cmrs:       readers,
+		schema:     schema,
+		fileSize:   atomic.NewInt64(0),
+		paths:      paths,
+		count:      count,
+		frs:        crs,
+		fieldPaths: fieldPaths,
 	}, nil
 }
 
@@ -80,6 +92,22 @@ func (r *reader) Read() (*storage.InsertData, error) {
 	if err != nil {
 		return nil, err
 	}
+	if r.collector != nil {
+		// skip the bad batches until a batch is read
+		for insertData.GetRowNum() == 0 {
+			rows, err := common.ReadBatchTolerantly(r.schema, r.frs, r.count, insertData, func(fieldID int64, err error) error {
+				return r.collector.Collect(r.fieldPaths[fieldID], r.rowIndex, err)
+			})
+			if err != nil {
+				return nil, err
+			}
+			if rows == 0 {
+				return nil, io.EOF
+			}
+			r.rowIndex += int64(rows)
+		}
+		return insertData, nil
+	}
 	for fieldID, cr := range r.frs {
 		data, validData, err := cr.Next(r.count)
 		if err != nil {
@@ -96,6 +124,12 @@ func (r *reader) Read() (*storage.InsertData, error) {
 	return insertData, nil
 }
 
+// SetRowErrorCollector enables the tolerant mode, the batches failed to read are
+// skipped and collected instead of failing the import.
+func (r *reader) SetRowErrorCollector(collector *common.RowErrorCollector) {
+	r.collector = collector
+}
+
 func (r *reader) Size() (int64, error) {
 	if size := r.fileSize.Load(); size != 0 {
 		return size, nil
@@ -35,6 +35,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
@@ -47,12 +48,17 @@ var (
 	reUniPost = regexp.MustCompile(`^[<|>]*?U(\d.*)$`)
 )
 
-func CreateReaders(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, paths []string) (map[int64]storage.FileReader, error) {
-	nameToPath := lo.SliceToMap(paths, func(path string) (string, string) {
-		nameWithExt := filepath.Base(path)
+// getNameToPath maps the field names to the numpy files, the file name without extension is the field name.
+func getNameToPath(paths []string) map[string]string {
+	return lo.SliceToMap(paths, func(path string) (string, string) {
+		nameWithExt := filepath.Base(common.TrimCompressionExt(path))
 		name := strings.TrimSuffix(nameWithExt, filepath.Ext(nameWithExt))
 		return name, path
 	})
+}
+
+func CreateReaders(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, paths []string) (map[int64]storage.FileReader, error) {
+	nameToPath := getNameToPath(paths)
 	nameToField := lo.KeyBy(schema.GetFields(), func(field *schemapb.FieldSchema) string {
 		return field.GetName()
 	})
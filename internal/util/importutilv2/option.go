@@ -27,6 +27,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	storage "github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
@@ -44,6 +45,58 @@ const (
 
 	// CSVNullKey specifies the null key used when importing CSV files.
 	CSVNullKey = "nullkey"
+
+	// DryRun indicates whether to validate the import files only, default to false.
+	// In dry-run mode, only the preimport stage is executed, the files are fully parsed
+	// and checked against the collection schema, but no data is written. The bad rows of all the file types
+	// are reported, the columnar files (Parquet, Numpy, Arrow IPC and ORC) report a bad batch per failed field.
+	DryRun = "dry_run"
+
+	// Priority specifies the scheduling priority of import job, which is one of "low", "normal" and "high",
+	// default to "normal". The import tasks of higher priority jobs take more share of the worker slots.
+	Priority = "priority"
+)
+
+// The values of priority option.
+const (
+	PriorityLow    = "low"
+	PriorityNormal = "normal"
+	PriorityHigh   = "high"
+)
+
+// priorityWeights are the weights of priorities in the fair scheduling of import tasks.
+var priorityWeights = map[string]int64{
+	PriorityLow:    1,
+	PriorityNormal: 2,
+	PriorityHigh:   4,
+}
+
+// Options for tolerant mode, in which bad rows are skipped and reported instead of failing the import.
+// Only JSON, JSON Lines and CSV files support tolerant mode, the limits take effect on each file.
+const (
+	// MaxErrorRows specifies the maximum number of bad rows allowed in a file,
+	// 0 means no bad row is allowed.
+	MaxErrorRows = "max_error_rows"
+
+	// MaxErrorRatio specifies the maximum ratio of bad rows allowed in a file, range (0, 1].
+	MaxErrorRatio = "max_error_ratio"
+)
+
+// Options to adapt the columns of import files to the collection schema,
+// they take effect on all the file types except the binlog files of backup-restore mode.
+const (
+	// ColumnMapping maps the column names in import files to the field names, in JSON format,
+	// such as '{"src_title": "title", "src_vec": "vector"}'.
+	ColumnMapping = "column_mapping"
+
+	// FillValues specifies the constant values of scalar fields in JSON format, such as '{"category": "news"}'.
+	// The values work as the default values of the fields during import, which are used
+	// if the fields are missing in import files or the values are null.
+	FillValues = "fill_values"
+
+	// IgnoreExtraColumns indicates whether to drop the columns not in the collection schema
+	// instead of storing them in the dynamic field, default to false.
+	IgnoreExtraColumns = "ignore_extra_columns"
 )
 
 // Options for backup-restore mode.
@@ -126,6 +179,14 @@ func IsBackup(options Options) bool {
 	return true
 }
 
+func IsDryRun(options Options) bool {
+	dryRun, err := funcutil.GetAttrByKeyFromRepeatedKV(DryRun, options)
+	if err != nil || strings.ToLower(dryRun) != "true" {
+		return false
+	}
+	return true
+}
+
 func IsL0Import(options Options) bool {
 	isL0Import, err := funcutil.GetAttrByKeyFromRepeatedKV(L0Import, options)
 	if err != nil || strings.ToLower(isL0Import) != "true" {
@@ -183,3 +244,67 @@ func GetCSVNullKey(options Options) (string, error) {
 	}
 	return nullKey, nil
 }
+
+// IsTolerant indicates whether the import is in tolerant mode,
+// which is enabled by setting either max_error_rows or max_error_ratio.
+func IsTolerant(options Options) bool {
+	_, err1 := funcutil.GetAttrByKeyFromRepeatedKV(MaxErrorRows, options)
+	_, err2 := funcutil.GetAttrByKeyFromRepeatedKV(MaxErrorRatio, options)
+	return err1 == nil || err2 == nil
+}
+
+// GetMaxErrorRows returns the max_error_rows option, common.UnlimitedErrorRows if not specified.
+// 0 means no bad row is allowed.
+func GetMaxErrorRows(options Options) (int64, error) {
+	maxErrorRows, err := funcutil.GetAttrByKeyFromRepeatedKV(MaxErrorRows, options)
+	if err != nil {
+		return common.UnlimitedErrorRows, nil
+	}
+	rows, err := strconv.ParseInt(maxErrorRows, 10, 64)
+	if err != nil {
+		return 0, merr.WrapErrImportFailed(fmt.Sprintf("parse max_error_rows failed, value=%s, err=%s", maxErrorRows, err))
+	}
+	if rows < 0 {
+		return 0, merr.WrapErrImportFailed(fmt.Sprintf("max_error_rows must not be negative, value=%s", maxErrorRows))
+	}
+	return rows, nil
+}
+
+// GetMaxErrorRatio returns the max_error_ratio option, 0 means no limit on the ratio of bad rows.
+func GetMaxErrorRatio(options Options) (float64, error) {
+	maxErrorRatio, err := funcutil.GetAttrByKeyFromRepeatedKV(MaxErrorRatio, options)
+	if err != nil {
+		return 0, nil
+	}
+	ratio, err := strconv.ParseFloat(maxErrorRatio, 64)
+	if err != nil {
+		return 0, merr.WrapErrImportFailed(fmt.Sprintf("parse max_error_ratio failed, value=%s, err=%s", maxErrorRatio, err))
+	}
+	if ratio <= 0 || ratio > 1 {
+		return 0, merr.WrapErrImportFailed(fmt.Sprintf("max_error_ratio must be in range (0, 1], value=%s", maxErrorRatio))
+	}
+	return ratio, nil
+}
+
+// GetPriority returns the priority option, "normal" if not specified.
+func GetPriority(options Options) (string, error) {
+	priority, err := funcutil.GetAttrByKeyFromRepeatedKV(Priority, options)
+	if err != nil {
+		return PriorityNormal, nil
+	}
+	priority = strings.ToLower(priority)
+	if _, ok := priorityWeights[priority]; !ok {
+		return "", merr.WrapErrImportFailed(fmt.Sprintf("invalid priority '%s', it should be one of %v",
+			priority, []string{PriorityLow, PriorityNormal, PriorityHigh}))
+	}
+	return priority, nil
+}
+
+// GetPriorityWeight returns the scheduling weight of the import job, the invalid priority is treated as "normal".
+func GetPriorityWeight(options Options) int64 {
+	priority, err := GetPriority(options)
+	if err != nil {
+		return priorityWeights[PriorityNormal]
+	}
+	return priorityWeights[priority]
+}
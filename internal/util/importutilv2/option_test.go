@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
 )
@@ -208,8 +209,79 @@ func TestOption_GetStorageVersion(t *testing.T) {
 	assert.Equal(t, int64(2), version) // StorageV2 = 2
 }
 
+func TestOption_Tolerant(t *testing.T) {
+	options := []*commonpb.KeyValuePair{}
+	assert.False(t, IsTolerant(options))
+	rows, err := GetMaxErrorRows(options)
+	assert.NoError(t, err)
+	assert.Equal(t, common.UnlimitedErrorRows, rows)
+	ratio, err := GetMaxErrorRatio(options)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(0), ratio)
+
+	options = []*commonpb.KeyValuePair{
+		{Key: MaxErrorRows, Value: "100"},
+		{Key: MaxErrorRatio, Value: "0.1"},
+	}
+	assert.True(t, IsTolerant(options))
+	rows, err = GetMaxErrorRows(options)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), rows)
+	ratio, err = GetMaxErrorRatio(options)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.1, ratio)
+
+	options = []*commonpb.KeyValuePair{{Key: MaxErrorRows, Value: "0"}}
+	assert.True(t, IsTolerant(options))
+	rows, err = GetMaxErrorRows(options)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), rows)
+
+	options = []*commonpb.KeyValuePair{{Key: MaxErrorRatio, Value: "1"}}
+	assert.True(t, IsTolerant(options))
+	ratio, err = GetMaxErrorRatio(options)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(1), ratio)
+
+	for _, value := range []string{"abc", "-1"} {
+		_, err = GetMaxErrorRows([]*commonpb.KeyValuePair{{Key: MaxErrorRows, Value: value}})
+		assert.Error(t, err)
+	}
+	for _, value := range []string{"abc", "0", "-0.1", "1.5"} {
+		_, err = GetMaxErrorRatio([]*commonpb.KeyValuePair{{Key: MaxErrorRatio, Value: value}})
+		assert.Error(t, err)
+	}
+}
+
+func TestOption_IsDryRun(t *testing.T) {
+	options := []*commonpb.KeyValuePair{}
+	assert.False(t, IsDryRun(options))
+	options = []*commonpb.KeyValuePair{{Key: DryRun, Value: "false"}}
+	assert.False(t, IsDryRun(options))
+	options = []*commonpb.KeyValuePair{{Key: DryRun, Value: "True"}}
+	assert.True(t, IsDryRun(options))
+}
+
 func TestSimple(t *testing.T) {
 	// Simple test to verify the test environment works
 	assert.Equal(t, 1, 1)
 	assert.Equal(t, "test", "test")
 }
+
+func TestOption_Priority(t *testing.T) {
+	priority, err := GetPriority(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, PriorityNormal, priority)
+	assert.Equal(t, int64(2), GetPriorityWeight(nil))
+
+	options := []*commonpb.KeyValuePair{{Key: Priority, Value: "HIGH"}}
+	priority, err = GetPriority(options)
+	assert.NoError(t, err)
+	assert.Equal(t, PriorityHigh, priority)
+	assert.Equal(t, int64(4), GetPriorityWeight(options))
+
+	options = []*commonpb.KeyValuePair{{Key: Priority, Value: "urgent"}}
+	_, err = GetPriority(options)
+	assert.Error(t, err)
+	assert.Equal(t, int64(2), GetPriorityWeight(options))
+}
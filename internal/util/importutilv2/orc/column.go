@@ -0,0 +1,614 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+)
+
+// toArrowType returns the arrow type which the ORC type is read as, the unsupported types
+// (map and union) return an error.
+// Milvus has no temporal or decimal field, so date is read as int64 days since the unix epoch,
+// timestamp is read as int64 nanoseconds since the unix epoch, the wall clock of the timestamp
+// without time zone is taken as UTC, and decimal is read as float64 which may lose precision.
+func toArrowType(types []*orcType, id uint32) (arrow.DataType, error) {
+	if int(id) >= len(types) {
+		return nil, fmt.Errorf("invalid ORC type id %d", id)
+	}
+	t := types[id]
+	switch t.kind {
+	case kindBoolean:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case kindByte:
+		return arrow.PrimitiveTypes.Int8, nil
+	case kindShort:
+		return arrow.PrimitiveTypes.Int16, nil
+	case kindInt:
+		return arrow.PrimitiveTypes.Int32, nil
+	case kindLong, kindDate, kindTimestamp, kindTimestampInstant:
+		return arrow.PrimitiveTypes.Int64, nil
+	case kindFloat:
+		return arrow.PrimitiveTypes.Float32, nil
+	case kindDouble, kindDecimal:
+		return arrow.PrimitiveTypes.Float64, nil
+	case kindString, kindVarchar, kindChar:
+		return arrow.BinaryTypes.String, nil
+	case kindBinary:
+		return arrow.BinaryTypes.Binary, nil
+	case kindList:
+		if len(t.subtypes) != 1 {
+			return nil, fmt.Errorf("invalid ORC array type %d", id)
+		}
+		elem, err := toArrowType(types, t.subtypes[0])
+		if err != nil {
+			return nil, err
+		}
+		return arrow.ListOfField(arrow.Field{Name: "item", Type: elem, Nullable: true}), nil
+	case kindStruct:
+		if len(t.subtypes) != len(t.fieldNames) {
+			return nil, fmt.Errorf("invalid ORC struct type %d", id)
+		}
+		fields := make([]arrow.Field, 0, len(t.subtypes))
+		for i, sub := range t.subtypes {
+			ft, err := toArrowType(types, sub)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, arrow.Field{Name: t.fieldNames[i], Type: ft, Nullable: true})
+		}
+		return arrow.StructOf(fields...), nil
+	}
+	return nil, fmt.Errorf("ORC type %s is not supported", t.kind.String())
+}
+
+// stripeStreams holds the streams and the encodings of the columns of a stripe.
+type stripeStreams struct {
+	streams   map[uint32]map[streamKind]*streamReader // column id -> stream kind -> stream
+	encodings []*columnEncoding
+}
+
+func (s *stripeStreams) get(column uint32, kind streamKind) *streamReader {
+	return s.streams[column][kind]
+}
+
+func (s *stripeStreams) mustGet(column uint32, kind streamKind) (*streamReader, error) {
+	sr := s.get(column, kind)
+	if sr == nil {
+		return nil, fmt.Errorf("stream %d of column %d is missing", kind, column)
+	}
+	return sr, nil
+}
+
+func (s *stripeStreams) encoding(column uint32) encodingKind {
+	if int(column) >= len(s.encodings) {
+		return encodingDirect
+	}
+	return s.encodings[column].kind
+}
+
+// columnDecoder decodes the values of a column in a stripe into the arrow builder.
+type columnDecoder interface {
+	// decode appends n rows, parentPresent indicates which rows are null because their parent
+	// is null, these rows have no value encoded in the column, nil means all parents are present.
+	decode(b array.Builder, n int, parentPresent []bool) error
+}
+
+// presentDecoder decodes the PRESENT stream shared by all the types.
+type presentDecoder struct {
+	present *boolReader
+}
+
+// decodePresent returns the presence of n rows, nil if all the rows are present.
+func (p *presentDecoder) decodePresent(n int, parentPresent []bool) ([]bool, error) {
+	if p.present == nil && parentPresent == nil {
+		return nil, nil
+	}
+	present := make([]bool, n)
+	for i := 0; i < n; i++ {
+		if parentPresent != nil && !parentPresent[i] {
+			continue
+		}
+		if p.present == nil {
+			present[i] = true
+			continue
+		}
+		v, err := p.present.next()
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		present[i] = v
+	}
+	return present, nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func newColumnDecoder(types []*orcType, id uint32, streams *stripeStreams) (columnDecoder, error) {
+	p := presentDecoder{}
+	if sr := streams.get(id, streamPresent); sr != nil {
+		p.present = newBoolReader(sr)
+	}
+	t := types[id]
+	encoding := streams.encoding(id)
+	switch t.kind {
+	case kindBoolean:
+		data, err := streams.mustGet(id, streamData)
+		if err != nil {
+			return nil, err
+		}
+		return &boolDecoder{presentDecoder: p, data: newBoolReader(data)}, nil
+	case kindByte:
+		data, err := streams.mustGet(id, streamData)
+		if err != nil {
+			return nil, err
+		}
+		return &byteDecoder{presentDecoder: p, data: newByteRLEReader(data)}, nil
+	case kindShort, kindInt, kindLong, kindDate:
+		data, err := streams.mustGet(id, streamData)
+		if err != nil {
+			return nil, err
+		}
+		return &intDecoder{presentDecoder: p, data: newIntReader(data, true, encoding.isV2())}, nil
+	case kindTimestamp, kindTimestampInstant:
+		data, err := streams.mustGet(id, streamData)
+		if err != nil {
+			return nil, err
+		}
+		nanos, err := streams.mustGet(id, streamSecondary)
+		if err != nil {
+			return nil, err
+		}
+		return &timestampDecoder{
+			presentDecoder: p,
+			seconds:        newIntReader(data, true, encoding.isV2()),
+			nanos:          newIntReader(nanos, false, encoding.isV2()),
+		}, nil
+	case kindDecimal:
+		data, err := streams.mustGet(id, streamData)
+		if err != nil {
+			return nil, err
+		}
+		scale, err := streams.mustGet(id, streamSecondary)
+		if err != nil {
+			return nil, err
+		}
+		return &decimalDecoder{presentDecoder: p, data: data, scale: newIntReader(scale, true, encoding.isV2())}, nil
+	case kindFloat:
+		data, err := streams.mustGet(id, streamData)
+		if err != nil {
+			return nil, err
+		}
+		return &floatDecoder{presentDecoder: p, data: data, size: 4}, nil
+	case kindDouble:
+		data, err := streams.mustGet(id, streamData)
+		if err != nil {
+			return nil, err
+		}
+		return &floatDecoder{presentDecoder: p, data: data, size: 8}, nil
+	case kindString, kindVarchar, kindChar, kindBinary:
+		return newBytesDecoder(p, id, streams, encoding)
+	case kindList:
+		length, err := streams.mustGet(id, streamLength)
+		if err != nil {
+			return nil, err
+		}
+		elem, err := newColumnDecoder(types, t.subtypes[0], streams)
+		if err != nil {
+			return nil, err
+		}
+		return &listDecoder{presentDecoder: p, length: newIntReader(length, false, encoding.isV2()), elem: elem}, nil
+	case kindStruct:
+		children := make([]columnDecoder, 0, len(t.subtypes))
+		for _, sub := range t.subtypes {
+			child, err := newColumnDecoder(types, sub, streams)
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, child)
+		}
+		return &structDecoder{presentDecoder: p, children: children}, nil
+	}
+	return nil, fmt.Errorf("ORC type %s is not supported", t.kind.String())
+}
+
+type boolDecoder struct {
+	presentDecoder
+	data *boolReader
+}
+
+func (d *boolDecoder) decode(b array.Builder, n int, parentPresent []bool) error {
+	present, err := d.decodePresent(n, parentPresent)
+	if err != nil {
+		return err
+	}
+	builder := b.(*array.BooleanBuilder)
+	for i := 0; i < n; i++ {
+		if present != nil && !present[i] {
+			builder.AppendNull()
+			continue
+		}
+		v, err := d.data.next()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		builder.Append(v)
+	}
+	return nil
+}
+
+type byteDecoder struct {
+	presentDecoder
+	data *byteRLEReader
+}
+
+func (d *byteDecoder) decode(b array.Builder, n int, parentPresent []bool) error {
+	present, err := d.decodePresent(n, parentPresent)
+	if err != nil {
+		return err
+	}
+	builder := b.(*array.Int8Builder)
+	for i := 0; i < n; i++ {
+		if present != nil && !present[i] {
+			builder.AppendNull()
+			continue
+		}
+		v, err := d.data.next()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		builder.Append(int8(v))
+	}
+	return nil
+}
+
+type intDecoder struct {
+	presentDecoder
+	data intReader
+}
+
+func (d *intDecoder) decode(b array.Builder, n int, parentPresent []bool) error {
+	present, err := d.decodePresent(n, parentPresent)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		if present != nil && !present[i] {
+			b.AppendNull()
+			continue
+		}
+		v, err := d.data.next()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		switch builder := b.(type) {
+		case *array.Int16Builder:
+			builder.Append(int16(v))
+		case *array.Int32Builder:
+			builder.Append(int32(v))
+		case *array.Int64Builder:
+			builder.Append(v)
+		default:
+			return fmt.Errorf("unexpected builder %T of integer column", b)
+		}
+	}
+	return nil
+}
+
+const (
+	// orcEpochSeconds is 2015-01-01 00:00:00 UTC, the seconds of ORC timestamps are relative to it.
+	orcEpochSeconds = 1420070400
+	nanosPerSecond  = int64(time.Second)
+)
+
+// timestampDecoder decodes the seconds since the ORC epoch and the nanoseconds into the nanoseconds
+// since the unix epoch, the trailing decimal zeros of nanoseconds are encoded in the low 3 bits.
+type timestampDecoder struct {
+	presentDecoder
+	seconds intReader
+	nanos   intReader
+}
+
+func (d *timestampDecoder) decode(b array.Builder, n int, parentPresent []bool) error {
+	present, err := d.decodePresent(n, parentPresent)
+	if err != nil {
+		return err
+	}
+	builder := b.(*array.Int64Builder)
+	for i := 0; i < n; i++ {
+		if present != nil && !present[i] {
+			builder.AppendNull()
+			continue
+		}
+		seconds, err := d.seconds.next()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		encoded, err := d.nanos.next()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		nanos := encoded >> 3
+		if zeros := encoded & 0x7; zeros != 0 {
+			for j := int64(0); j <= zeros; j++ {
+				nanos *= 10
+			}
+		}
+		seconds += orcEpochSeconds
+		// the seconds of timestamps before the unix epoch are rounded toward zero by the writer,
+		// same as the readers of Apache ORC
+		if seconds < 0 && nanos > 999999 {
+			seconds--
+		}
+		if seconds > (math.MaxInt64-nanos)/nanosPerSecond || seconds < math.MinInt64/nanosPerSecond {
+			return fmt.Errorf("ORC timestamp of %d seconds exceeds the range of int64 nanoseconds", seconds)
+		}
+		builder.Append(seconds*nanosPerSecond + nanos)
+	}
+	return nil
+}
+
+// decimalDecoder decodes the unbounded base 128 varints in zigzag and the scales of decimals.
+type decimalDecoder struct {
+	presentDecoder
+	data  *streamReader
+	scale intReader
+}
+
+func (d *decimalDecoder) decode(b array.Builder, n int, parentPresent []bool) error {
+	present, err := d.decodePresent(n, parentPresent)
+	if err != nil {
+		return err
+	}
+	builder := b.(*array.Float64Builder)
+	for i := 0; i < n; i++ {
+		if present != nil && !present[i] {
+			builder.AppendNull()
+			continue
+		}
+		unscaled, err := readBigVarint(d.data)
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		scale, err := d.scale.next()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		// parse the decimal string to round to the nearest float
+		v, err := strconv.ParseFloat(unscaled.String()+"e"+strconv.FormatInt(-scale, 10), 64)
+		if err != nil {
+			return fmt.Errorf("invalid ORC decimal %se%d: %w", unscaled.String(), -scale, err)
+		}
+		builder.Append(v)
+	}
+	return nil
+}
+
+// floatDecoder decodes the IEEE 754 floating point numbers in little endian.
+type floatDecoder struct {
+	presentDecoder
+	data *streamReader
+	size int
+	buf  [8]byte
+}
+
+func (d *floatDecoder) decode(b array.Builder, n int, parentPresent []bool) error {
+	present, err := d.decodePresent(n, parentPresent)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		if present != nil && !present[i] {
+			b.AppendNull()
+			continue
+		}
+		if _, err := io.ReadFull(d.data, d.buf[:d.size]); err != nil {
+			return unexpectedEOF(err)
+		}
+		switch builder := b.(type) {
+		case *array.Float32Builder:
+			builder.Append(math.Float32frombits(binary.LittleEndian.Uint32(d.buf[:4])))
+		case *array.Float64Builder:
+			builder.Append(math.Float64frombits(binary.LittleEndian.Uint64(d.buf[:8])))
+		default:
+			return fmt.Errorf("unexpected builder %T of floating point column", b)
+		}
+	}
+	return nil
+}
+
+// bytesDecoder decodes string and binary columns in either direct encoding or dictionary encoding.
+type bytesDecoder struct {
+	presentDecoder
+	// direct encoding
+	data   *streamReader
+	length intReader
+	// dictionary encoding, index is read from the DATA stream
+	index      intReader
+	dictionary [][]byte
+}
+
+func newBytesDecoder(p presentDecoder, id uint32, streams *stripeStreams, encoding encodingKind) (*bytesDecoder, error) {
+	d := &bytesDecoder{presentDecoder: p}
+	length, err := streams.mustGet(id, streamLength)
+	if err != nil {
+		return nil, err
+	}
+	lengthReader := newIntReader(length, false, encoding.isV2())
+	data, err := streams.mustGet(id, streamData)
+	if err != nil {
+		return nil, err
+	}
+	if !encoding.isDictionary() {
+		d.data = data
+		d.length = lengthReader
+		return d, nil
+	}
+
+	d.index = newIntReader(data, false, encoding.isV2())
+	size := int(streams.encodings[id].dictionarySize)
+	d.dictionary = make([][]byte, 0, size)
+	// the dictionary data stream is absent if all the entries are empty
+	dictData := streams.get(id, streamDictionaryData)
+	for i := 0; i < size; i++ {
+		l, err := lengthReader.next()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read dictionary of column %d: %w", id, unexpectedEOF(err))
+		}
+		if l < 0 {
+			return nil, fmt.Errorf("invalid dictionary entry length %d of column %d", l, id)
+		}
+		entry := make([]byte, l)
+		if l > 0 {
+			if dictData == nil {
+				return nil, fmt.Errorf("dictionary data stream of column %d is missing", id)
+			}
+			if _, err := io.ReadFull(dictData, entry); err != nil {
+				return nil, fmt.Errorf("failed to read dictionary of column %d: %w", id, unexpectedEOF(err))
+			}
+		}
+		d.dictionary = append(d.dictionary, entry)
+	}
+	return d, nil
+}
+
+func (d *bytesDecoder) next() ([]byte, error) {
+	if d.index != nil {
+		idx, err := d.index.next()
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		if idx < 0 || idx >= int64(len(d.dictionary)) {
+			return nil, fmt.Errorf("dictionary index %d out of range [0, %d)", idx, len(d.dictionary))
+		}
+		return d.dictionary[idx], nil
+	}
+	l, err := d.length.next()
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	if l < 0 {
+		return nil, fmt.Errorf("invalid length %d of string or binary value", l)
+	}
+	v := make([]byte, l)
+	if _, err := io.ReadFull(d.data, v); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	return v, nil
+}
+
+func (d *bytesDecoder) decode(b array.Builder, n int, parentPresent []bool) error {
+	present, err := d.decodePresent(n, parentPresent)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		if present != nil && !present[i] {
+			b.AppendNull()
+			continue
+		}
+		v, err := d.next()
+		if err != nil {
+			return err
+		}
+		switch builder := b.(type) {
+		case *array.StringBuilder:
+			builder.BinaryBuilder.Append(v)
+		case *array.BinaryBuilder:
+			builder.Append(v)
+		default:
+			return fmt.Errorf("unexpected builder %T of string or binary column", b)
+		}
+	}
+	return nil
+}
+
+// listDecoder decodes the lengths of lists, the elements are encoded in the child column.
+type listDecoder struct {
+	presentDecoder
+	length intReader
+	elem   columnDecoder
+}
+
+func (d *listDecoder) decode(b array.Builder, n int, parentPresent []bool) error {
+	present, err := d.decodePresent(n, parentPresent)
+	if err != nil {
+		return err
+	}
+	builder := b.(*array.ListBuilder)
+	offsets := make([]int32, n)
+	valid := make([]bool, n)
+	offset := int64(builder.ValueBuilder().Len())
+	for i := 0; i < n; i++ {
+		offsets[i] = int32(offset)
+		if present != nil && !present[i] {
+			continue
+		}
+		l, err := d.length.next()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		if l < 0 || offset+l > math.MaxInt32 {
+			return fmt.Errorf("invalid array length %d", l)
+		}
+		valid[i] = true
+		offset += l
+	}
+	builder.AppendValues(offsets, valid)
+	return d.elem.decode(builder.ValueBuilder(), int(offset)-builder.ValueBuilder().Len(), nil)
+}
+
+// structDecoder decodes the fields of structs, the fields have values only if the struct is present.
+type structDecoder struct {
+	presentDecoder
+	children []columnDecoder
+}
+
+func (d *structDecoder) decode(b array.Builder, n int, parentPresent []bool) error {
+	present, err := d.decodePresent(n, parentPresent)
+	if err != nil {
+		return err
+	}
+	builder := b.(*array.StructBuilder)
+	valid := present
+	if valid == nil {
+		valid = make([]bool, n)
+		for i := range valid {
+			valid[i] = true
+		}
+	}
+	// the nulls of children are appended by the children
+	builder.AppendValues(valid)
+	for i, child := range d.children {
+		if err := child.decode(builder.FieldBuilder(i), n, present); err != nil {
+			return err
+		}
+	}
+	return nil
+}
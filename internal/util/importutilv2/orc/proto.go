@@ -0,0 +1,396 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The metadata of ORC files are protobuf messages defined by orc_proto.proto of Apache ORC.
+// Only the fields required to read the rows are decoded here, the others (statistics,
+// indexes, bloom filters, encryption) are skipped.
+
+type compressionKind uint64
+
+const (
+	compressionNone   compressionKind = 0
+	compressionZlib   compressionKind = 1
+	compressionSnappy compressionKind = 2
+	compressionLzo    compressionKind = 3
+	compressionLz4    compressionKind = 4
+	compressionZstd   compressionKind = 5
+)
+
+var compressionKindName = map[compressionKind]string{
+	compressionNone:   "NONE",
+	compressionZlib:   "ZLIB",
+	compressionSnappy: "SNAPPY",
+	compressionLzo:    "LZO",
+	compressionLz4:    "LZ4",
+	compressionZstd:   "ZSTD",
+}
+
+func (c compressionKind) String() string {
+	if name, ok := compressionKindName[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("UNKNOWN(%d)", uint64(c))
+}
+
+type typeKind uint64
+
+const (
+	kindBoolean          typeKind = 0
+	kindByte             typeKind = 1
+	kindShort            typeKind = 2
+	kindInt              typeKind = 3
+	kindLong             typeKind = 4
+	kindFloat            typeKind = 5
+	kindDouble           typeKind = 6
+	kindString           typeKind = 7
+	kindBinary           typeKind = 8
+	kindTimestamp        typeKind = 9
+	kindList             typeKind = 10
+	kindMap              typeKind = 11
+	kindStruct           typeKind = 12
+	kindUnion            typeKind = 13
+	kindDecimal          typeKind = 14
+	kindDate             typeKind = 15
+	kindVarchar          typeKind = 16
+	kindChar             typeKind = 17
+	kindTimestampInstant typeKind = 18
+)
+
+var typeKindName = map[typeKind]string{
+	kindBoolean:          "boolean",
+	kindByte:             "tinyint",
+	kindShort:            "smallint",
+	kindInt:              "int",
+	kindLong:             "bigint",
+	kindFloat:            "float",
+	kindDouble:           "double",
+	kindString:           "string",
+	kindBinary:           "binary",
+	kindTimestamp:        "timestamp",
+	kindList:             "array",
+	kindMap:              "map",
+	kindStruct:           "struct",
+	kindUnion:            "uniontype",
+	kindDecimal:          "decimal",
+	kindDate:             "date",
+	kindVarchar:          "varchar",
+	kindChar:             "char",
+	kindTimestampInstant: "timestamp with local time zone",
+}
+
+func (k typeKind) String() string {
+	if name, ok := typeKindName[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", uint64(k))
+}
+
+type streamKind uint64
+
+const (
+	streamPresent        streamKind = 0
+	streamData           streamKind = 1
+	streamLength         streamKind = 2
+	streamDictionaryData streamKind = 3
+	streamSecondary      streamKind = 5
+)
+
+type encodingKind uint64
+
+const (
+	encodingDirect       encodingKind = 0
+	encodingDictionary   encodingKind = 1
+	encodingDirectV2     encodingKind = 2
+	encodingDictionaryV2 encodingKind = 3
+)
+
+// isV2 returns true if the integers are encoded by run length encoding version 2.
+func (e encodingKind) isV2() bool {
+	return e == encodingDirectV2 || e == encodingDictionaryV2
+}
+
+func (e encodingKind) isDictionary() bool {
+	return e == encodingDictionary || e == encodingDictionaryV2
+}
+
+type postScript struct {
+	footerLength         uint64
+	compression          compressionKind
+	compressionBlockSize uint64
+	metadataLength       uint64
+	magic                string
+}
+
+type stripeInformation struct {
+	offset       uint64
+	indexLength  uint64
+	dataLength   uint64
+	footerLength uint64
+	numberOfRows uint64
+}
+
+type orcType struct {
+	kind       typeKind
+	subtypes   []uint32
+	fieldNames []string
+}
+
+type footer struct {
+	stripes      []*stripeInformation
+	types        []*orcType
+	numberOfRows uint64
+}
+
+type stream struct {
+	kind   streamKind
+	column uint32
+	length uint64
+}
+
+type columnEncoding struct {
+	kind           encodingKind
+	dictionarySize uint32
+}
+
+type stripeFooter struct {
+	streams []*stream
+	columns []*columnEncoding
+}
+
+// walkMessage calls fn for each field of the protobuf message, fn consumes the value of the field
+// and returns the number of bytes consumed, or a negative number if the value is invalid.
+func walkMessage(b []byte, fn func(num protowire.Number, typ protowire.Type, b []byte) int) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		n = fn(num, typ, b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// consumeUint64 consumes a varint field into v if the wire type matches, otherwise skips the field.
+func consumeUint64(typ protowire.Type, b []byte, v *uint64) int {
+	if typ != protowire.VarintType {
+		return protowire.ConsumeFieldValue(0, typ, b)
+	}
+	value, n := protowire.ConsumeVarint(b)
+	if n >= 0 {
+		*v = value
+	}
+	return n
+}
+
+// consumeUint32s consumes a repeated uint32 field in either packed or unpacked encoding.
+func consumeUint32s(typ protowire.Type, b []byte, v *[]uint32) int {
+	switch typ {
+	case protowire.VarintType:
+		value, n := protowire.ConsumeVarint(b)
+		if n >= 0 {
+			*v = append(*v, uint32(value))
+		}
+		return n
+	case protowire.BytesType:
+		packed, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return n
+		}
+		for len(packed) > 0 {
+			value, m := protowire.ConsumeVarint(packed)
+			if m < 0 {
+				return m
+			}
+			*v = append(*v, uint32(value))
+			packed = packed[m:]
+		}
+		return n
+	}
+	return protowire.ConsumeFieldValue(0, typ, b)
+}
+
+// consumeMessage consumes a length-delimited field and decodes it by parse.
+func consumeMessage(typ protowire.Type, b []byte, parse func([]byte) error) int {
+	if typ != protowire.BytesType {
+		return protowire.ConsumeFieldValue(0, typ, b)
+	}
+	value, n := protowire.ConsumeBytes(b)
+	if n < 0 {
+		return n
+	}
+	if err := parse(value); err != nil {
+		return -1
+	}
+	return n
+}
+
+func parsePostScript(b []byte) (*postScript, error) {
+	ps := &postScript{}
+	err := walkMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		switch num {
+		case 1:
+			return consumeUint64(typ, b, &ps.footerLength)
+		case 2:
+			return consumeUint64(typ, b, (*uint64)(&ps.compression))
+		case 3:
+			return consumeUint64(typ, b, &ps.compressionBlockSize)
+		case 5:
+			return consumeUint64(typ, b, &ps.metadataLength)
+		case 8000:
+			if typ == protowire.BytesType {
+				value, n := protowire.ConsumeBytes(b)
+				ps.magic = string(value)
+				return n
+			}
+		}
+		return protowire.ConsumeFieldValue(num, typ, b)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid postscript: %w", err)
+	}
+	return ps, nil
+}
+
+func parseStripeInformation(b []byte) (*stripeInformation, error) {
+	si := &stripeInformation{}
+	err := walkMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		switch num {
+		case 1:
+			return consumeUint64(typ, b, &si.offset)
+		case 2:
+			return consumeUint64(typ, b, &si.indexLength)
+		case 3:
+			return consumeUint64(typ, b, &si.dataLength)
+		case 4:
+			return consumeUint64(typ, b, &si.footerLength)
+		case 5:
+			return consumeUint64(typ, b, &si.numberOfRows)
+		}
+		return protowire.ConsumeFieldValue(num, typ, b)
+	})
+	return si, err
+}
+
+func parseType(b []byte) (*orcType, error) {
+	t := &orcType{}
+	err := walkMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		switch num {
+		case 1:
+			return consumeUint64(typ, b, (*uint64)(&t.kind))
+		case 2:
+			return consumeUint32s(typ, b, &t.subtypes)
+		case 3:
+			if typ == protowire.BytesType {
+				value, n := protowire.ConsumeBytes(b)
+				t.fieldNames = append(t.fieldNames, string(value))
+				return n
+			}
+		}
+		return protowire.ConsumeFieldValue(num, typ, b)
+	})
+	return t, err
+}
+
+func parseFooter(b []byte) (*footer, error) {
+	f := &footer{}
+	err := walkMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		switch num {
+		case 3:
+			return consumeMessage(typ, b, func(b []byte) error {
+				si, err := parseStripeInformation(b)
+				f.stripes = append(f.stripes, si)
+				return err
+			})
+		case 4:
+			return consumeMessage(typ, b, func(b []byte) error {
+				t, err := parseType(b)
+				f.types = append(f.types, t)
+				return err
+			})
+		case 6:
+			return consumeUint64(typ, b, &f.numberOfRows)
+		}
+		return protowire.ConsumeFieldValue(num, typ, b)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid footer: %w", err)
+	}
+	return f, nil
+}
+
+func parseStripeFooter(b []byte) (*stripeFooter, error) {
+	sf := &stripeFooter{}
+	err := walkMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		switch num {
+		case 1:
+			return consumeMessage(typ, b, func(b []byte) error {
+				s := &stream{}
+				err := walkMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+					switch num {
+					case 1:
+						return consumeUint64(typ, b, (*uint64)(&s.kind))
+					case 2:
+						var column uint64
+						n := consumeUint64(typ, b, &column)
+						s.column = uint32(column)
+						return n
+					case 3:
+						return consumeUint64(typ, b, &s.length)
+					}
+					return protowire.ConsumeFieldValue(num, typ, b)
+				})
+				sf.streams = append(sf.streams, s)
+				return err
+			})
+		case 2:
+			return consumeMessage(typ, b, func(b []byte) error {
+				e := &columnEncoding{}
+				err := walkMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+					switch num {
+					case 1:
+						return consumeUint64(typ, b, (*uint64)(&e.kind))
+					case 2:
+						var size uint64
+						n := consumeUint64(typ, b, &size)
+						e.dictionarySize = uint32(size)
+						return n
+					}
+					return protowire.ConsumeFieldValue(num, typ, b)
+				})
+				sf.columns = append(sf.columns, e)
+				return err
+			})
+		}
+		return protowire.ConsumeFieldValue(num, typ, b)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid stripe footer: %w", err)
+	}
+	return sf, nil
+}
@@ -0,0 +1,285 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/arrowipc"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+const (
+	orcMagic = "ORC"
+
+	// tailReadSize is the size read from the end of file at first, which usually covers
+	// the postscript and the footer.
+	tailReadSize = 16 << 10
+
+	// batchRows is the max number of rows of a record batch decoded from a stripe.
+	batchRows = 4096
+)
+
+// NewReader creates a reader of ORC files. ORC files are decoded into Arrow record batches,
+// which are read by the same field readers as Parquet and Arrow IPC. Only the top level columns
+// whose names match the fields of collection schema are decoded.
+func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, path string, bufferSize int) (arrowipc.Reader, error) {
+	cmReader, err := cm.Reader(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	rr, err := newRecordReader(cmReader, schema)
+	if err != nil {
+		cmReader.Close()
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("new orc reader failed, path=%s, err=%v", path, err))
+	}
+	return arrowipc.NewRecordBatchReader(ctx, cm, cmReader, rr, schema, path, bufferSize)
+}
+
+// recordReader decodes the stripes of ORC file into Arrow record batches.
+type recordReader struct {
+	r      io.ReaderAt
+	d      *decompressor
+	footer *footer
+
+	schema  *arrow.Schema
+	columns []uint32 // the type ids of the decoded top level columns
+
+	stripe   int
+	left     int64 // the rows left in the current stripe
+	decoders []columnDecoder
+}
+
+func newRecordReader(cmReader storage.FileReader, schema *schemapb.CollectionSchema) (*recordReader, error) {
+	size, err := cmReader.Size()
+	if err != nil {
+		return nil, err
+	}
+	// the ORC file reads the tail by random access, which is impossible on a compressed stream
+	if _, err := cmReader.Seek(0, io.SeekCurrent); err != nil {
+		return nil, fmt.Errorf("ORC file requires random access, compressed file is not supported: %w", err)
+	}
+	if size < int64(len(orcMagic))+1 {
+		return nil, fmt.Errorf("invalid ORC file of %d bytes", size)
+	}
+	tail, err := readAt(cmReader, max(0, size-tailReadSize), min(size, tailReadSize))
+	if err != nil {
+		return nil, err
+	}
+	psLen := int64(tail[len(tail)-1])
+	if psLen+1 > int64(len(tail)) {
+		return nil, fmt.Errorf("invalid ORC postscript length %d", psLen)
+	}
+	ps, err := parsePostScript(tail[int64(len(tail))-1-psLen : len(tail)-1])
+	if err != nil {
+		return nil, err
+	}
+	if ps.magic != orcMagic {
+		return nil, fmt.Errorf("not an ORC file, magic %q", ps.magic)
+	}
+	if ps.compression != compressionNone && ps.compressionBlockSize == 0 {
+		return nil, fmt.Errorf("invalid ORC compression block size 0")
+	}
+	d, err := newDecompressor(ps.compression, ps.compressionBlockSize)
+	if err != nil {
+		return nil, err
+	}
+
+	footerLen := int64(ps.footerLength)
+	footerEnd := size - 1 - psLen
+	if footerLen > footerEnd {
+		d.close()
+		return nil, fmt.Errorf("invalid ORC footer length %d", footerLen)
+	}
+	var footerData []byte
+	if footerLen+1+psLen <= int64(len(tail)) {
+		start := int64(len(tail)) - 1 - psLen - footerLen
+		footerData = tail[start : start+footerLen]
+	} else {
+		footerData, err = readAt(cmReader, footerEnd-footerLen, footerLen)
+		if err != nil {
+			d.close()
+			return nil, err
+		}
+	}
+	footerData, err = newStreamReader(footerData, d).readAll()
+	if err != nil {
+		d.close()
+		return nil, fmt.Errorf("failed to read ORC footer: %w", err)
+	}
+	f, err := parseFooter(footerData)
+	if err != nil {
+		d.close()
+		return nil, err
+	}
+	rr := &recordReader{r: cmReader, d: d, footer: f}
+	if err := rr.initSchema(schema); err != nil {
+		d.close()
+		return nil, err
+	}
+	log.Info("orc file info", zap.Int("stripe num", len(f.stripes)), zap.Uint64("row num", f.numberOfRows),
+		zap.String("compression", ps.compression.String()), zap.Int("column num", len(rr.columns)))
+	return rr, nil
+}
+
+func readAt(r io.ReaderAt, offset int64, length int64) ([]byte, error) {
+	buf := make([]byte, length)
+	n, err := r.ReadAt(buf, offset)
+	if int64(n) == length {
+		return buf, nil
+	}
+	if err == nil || err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return nil, err
+}
+
+// initSchema selects the top level columns to decode by the names of collection fields.
+func (r *recordReader) initSchema(schema *schemapb.CollectionSchema) error {
+	types := r.footer.types
+	if len(types) == 0 || types[0].kind != kindStruct || len(types[0].subtypes) != len(types[0].fieldNames) {
+		return fmt.Errorf("the root type of ORC file must be struct")
+	}
+	names := lo.SliceToMap(schema.GetFields(), func(field *schemapb.FieldSchema) (string, struct{}) {
+		return field.GetName(), struct{}{}
+	})
+	fields := make([]arrow.Field, 0)
+	for i, name := range types[0].fieldNames {
+		if _, ok := names[name]; !ok {
+			continue
+		}
+		dt, err := toArrowType(types, types[0].subtypes[i])
+		if err != nil {
+			return fmt.Errorf("column '%s': %w", name, err)
+		}
+		fields = append(fields, arrow.Field{Name: name, Type: dt, Nullable: true})
+		r.columns = append(r.columns, types[0].subtypes[i])
+	}
+	r.schema = arrow.NewSchema(fields, nil)
+	return nil
+}
+
+func (r *recordReader) Schema() *arrow.Schema {
+	return r.schema
+}
+
+// openStripe reads the streams of the decoded columns of the stripe.
+func (r *recordReader) openStripe(si *stripeInformation) error {
+	footerData, err := readAt(r.r, int64(si.offset+si.indexLength+si.dataLength), int64(si.footerLength))
+	if err != nil {
+		return err
+	}
+	footerData, err = newStreamReader(footerData, r.d).readAll()
+	if err != nil {
+		return err
+	}
+	sf, err := parseStripeFooter(footerData)
+	if err != nil {
+		return err
+	}
+
+	// the streams are stored one by one in the order of the stripe footer
+	selected := make(map[uint32]bool)
+	var collect func(id uint32)
+	collect = func(id uint32) {
+		selected[id] = true
+		for _, sub := range r.footer.types[id].subtypes {
+			collect(sub)
+		}
+	}
+	for _, id := range r.columns {
+		collect(id)
+	}
+	streams := &stripeStreams{
+		streams:   make(map[uint32]map[streamKind]*streamReader),
+		encodings: sf.columns,
+	}
+	offset := si.offset
+	for _, s := range sf.streams {
+		start := offset
+		offset += s.length
+		if !selected[s.column] || s.kind > streamDictionaryData {
+			continue
+		}
+		data, err := readAt(r.r, int64(start), int64(s.length))
+		if err != nil {
+			return err
+		}
+		if streams.streams[s.column] == nil {
+			streams.streams[s.column] = make(map[streamKind]*streamReader)
+		}
+		streams.streams[s.column][s.kind] = newStreamReader(data, r.d)
+	}
+
+	r.decoders = make([]columnDecoder, 0, len(r.columns))
+	for _, id := range r.columns {
+		decoder, err := newColumnDecoder(r.footer.types, id, streams)
+		if err != nil {
+			return err
+		}
+		r.decoders = append(r.decoders, decoder)
+	}
+	r.left = int64(si.numberOfRows)
+	return nil
+}
+
+func (r *recordReader) Next() (arrow.Record, error) {
+	for r.left <= 0 {
+		if r.stripe >= len(r.footer.stripes) {
+			return nil, io.EOF
+		}
+		si := r.footer.stripes[r.stripe]
+		r.stripe++
+		if err := r.openStripe(si); err != nil {
+			return nil, fmt.Errorf("failed to read stripe %d: %w", r.stripe-1, err)
+		}
+	}
+	n := min(r.left, batchRows)
+	columns := make([]arrow.Array, 0, len(r.decoders))
+	defer func() {
+		for _, column := range columns {
+			column.Release()
+		}
+	}()
+	for i, decoder := range r.decoders {
+		builder := array.NewBuilder(memory.DefaultAllocator, r.schema.Field(i).Type)
+		err := decoder.decode(builder, int(n), nil)
+		if err != nil {
+			builder.Release()
+			return nil, fmt.Errorf("failed to decode column '%s' of stripe %d: %w", r.schema.Field(i).Name, r.stripe-1, err)
+		}
+		columns = append(columns, builder.NewArray())
+		builder.Release()
+	}
+	r.left -= n
+	return array.NewRecord(r.schema, columns, n), nil
+}
+
+func (r *recordReader) Close() {
+	r.d.close()
+}
@@ -0,0 +1,780 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/parquet"
+	"github.com/milvus-io/milvus/internal/util/testutil"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// testWriter writes arrow records into ORC file, it covers the encodings read by the reader
+// except the run length encoding version 2 which is only written in the direct sub-encoding.
+type testWriter struct {
+	compression compressionKind
+	blockSize   int
+	v2          bool
+	dictionary  bool
+	// footerKind overrides the kinds of types written in the footer
+	footerKind map[int]typeKind
+
+	types []*orcType
+}
+
+func appendVarintField(b []byte, num protowire.Number, v uint64) []byte {
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+func appendBytesField(b []byte, num protowire.Number, v []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+// compress splits the data into chunks and compresses each chunk.
+func (w *testWriter) compress(data []byte) []byte {
+	if w.compression == compressionNone {
+		return data
+	}
+	out := make([]byte, 0)
+	for len(data) > 0 {
+		chunk := data[:min(len(data), w.blockSize)]
+		data = data[len(chunk):]
+		var compressed []byte
+		switch w.compression {
+		case compressionZlib:
+			buf := &bytes.Buffer{}
+			fw, _ := flate.NewWriter(buf, flate.DefaultCompression)
+			fw.Write(chunk)
+			fw.Close()
+			compressed = buf.Bytes()
+		case compressionSnappy:
+			compressed = snappy.Encode(nil, chunk)
+		case compressionZstd:
+			enc, _ := zstd.NewWriter(nil)
+			compressed = enc.EncodeAll(chunk, nil)
+			enc.Close()
+		case compressionLz4:
+			dst := make([]byte, lz4.CompressBlockBound(len(chunk)))
+			n, _ := lz4.CompressBlock(chunk, dst, nil)
+			compressed = dst[:n]
+		}
+		header := len(compressed) << 1
+		payload := compressed
+		if len(compressed) == 0 || len(compressed) >= len(chunk) {
+			header = len(chunk)<<1 | 1
+			payload = chunk
+		}
+		out = append(out, byte(header), byte(header>>8), byte(header>>16))
+		out = append(out, payload...)
+	}
+	return out
+}
+
+func writeByteRLE(values []byte) []byte {
+	out := make([]byte, 0)
+	for len(values) > 0 {
+		n := min(len(values), 128)
+		out = append(out, byte(int8(-n)))
+		out = append(out, values[:n]...)
+		values = values[n:]
+	}
+	return out
+}
+
+func writeBools(values []bool) []byte {
+	packed := make([]byte, (len(values)+7)/8)
+	for i, v := range values {
+		if v {
+			packed[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	return writeByteRLE(packed)
+}
+
+func (w *testWriter) writeInts(values []int64, signed bool) []byte {
+	encode := func(v int64) uint64 {
+		if signed {
+			return protowire.EncodeZigZag(v)
+		}
+		return uint64(v)
+	}
+	out := make([]byte, 0)
+	if w.v2 {
+		// direct sub-encoding with 64-bit width
+		for len(values) > 0 {
+			n := min(len(values), 512)
+			out = append(out, byte(rleV2Direct<<6|31<<1|(n-1)>>8), byte(n-1))
+			for _, v := range values[:n] {
+				out = binary.BigEndian.AppendUint64(out, encode(v))
+			}
+			values = values[n:]
+		}
+		return out
+	}
+	for len(values) > 0 {
+		// a run of 3 repeated values at least
+		run := 1
+		for run < len(values) && run < 130 && values[run] == values[0] {
+			run++
+		}
+		if run >= 3 {
+			out = append(out, byte(run-3), 0)
+			out = protowire.AppendVarint(out, encode(values[0]))
+			values = values[run:]
+			continue
+		}
+		n := min(len(values), 128)
+		out = append(out, byte(int8(-n)))
+		for _, v := range values[:n] {
+			out = protowire.AppendVarint(out, encode(v))
+		}
+		values = values[n:]
+	}
+	return out
+}
+
+// addType appends the ORC type of arrow type in pre-order and returns the type id.
+func (w *testWriter) addType(dt arrow.DataType) uint32 {
+	id := uint32(len(w.types))
+	t := &orcType{}
+	w.types = append(w.types, t)
+	switch dt := dt.(type) {
+	case *arrow.BooleanType:
+		t.kind = kindBoolean
+	case *arrow.Int8Type:
+		t.kind = kindByte
+	case *arrow.Int16Type:
+		t.kind = kindShort
+	case *arrow.Int32Type:
+		t.kind = kindInt
+	case *arrow.Int64Type:
+		t.kind = kindLong
+	case *arrow.Float32Type:
+		t.kind = kindFloat
+	case *arrow.Float64Type:
+		t.kind = kindDouble
+	case *arrow.StringType:
+		t.kind = kindString
+	case *arrow.BinaryType:
+		t.kind = kindBinary
+	case *arrow.ListType:
+		if dt.Elem().ID() == arrow.UINT8 {
+			// binary vectors are written as binary
+			t.kind = kindBinary
+			break
+		}
+		t.kind = kindList
+		t.subtypes = []uint32{w.addType(dt.Elem())}
+	case *arrow.StructType:
+		t.kind = kindStruct
+		for _, f := range dt.Fields() {
+			t.fieldNames = append(t.fieldNames, f.Name)
+			t.subtypes = append(t.subtypes, w.addType(f.Type))
+		}
+	default:
+		panic("unsupported type " + dt.String())
+	}
+	return id
+}
+
+type testStream struct {
+	kind   streamKind
+	column uint32
+	data   []byte
+}
+
+// writeColumn encodes the rows of the array, the rows not listed have null parents.
+func (w *testWriter) writeColumn(id uint32, arr arrow.Array, rows []int, streams *[]testStream, encodings []*columnEncoding) {
+	t := w.types[id]
+	present := make([]bool, 0, len(rows))
+	values := make([]int, 0, len(rows))
+	for _, row := range rows {
+		present = append(present, arr.IsValid(row))
+		if arr.IsValid(row) {
+			values = append(values, row)
+		}
+	}
+	if len(values) != len(rows) {
+		*streams = append(*streams, testStream{streamPresent, id, writeBools(present)})
+	}
+	encoding := encodingDirect
+	if w.v2 {
+		encoding = encodingDirectV2
+	}
+	encodings[id] = &columnEncoding{kind: encoding}
+	ints := make([]int64, 0, len(values))
+	data := make([]byte, 0)
+	switch a := arr.(type) {
+	case *array.Boolean:
+		bools := make([]bool, 0, len(values))
+		for _, row := range values {
+			bools = append(bools, a.Value(row))
+		}
+		data = writeBools(bools)
+	case *array.Int8:
+		for _, row := range values {
+			data = append(data, byte(a.Value(row)))
+		}
+		data = writeByteRLE(data)
+	case *array.Int16:
+		for _, row := range values {
+			ints = append(ints, int64(a.Value(row)))
+		}
+		data = w.writeInts(ints, true)
+	case *array.Int32:
+		for _, row := range values {
+			ints = append(ints, int64(a.Value(row)))
+		}
+		data = w.writeInts(ints, true)
+	case *array.Int64:
+		for _, row := range values {
+			ints = append(ints, a.Value(row))
+		}
+		data = w.writeInts(ints, true)
+	case *array.Float32:
+		for _, row := range values {
+			data = binary.LittleEndian.AppendUint32(data, math.Float32bits(a.Value(row)))
+		}
+	case *array.Float64:
+		for _, row := range values {
+			data = binary.LittleEndian.AppendUint64(data, math.Float64bits(a.Value(row)))
+		}
+	case *array.String, *array.Binary, *array.List:
+		var value func(row int) []byte
+		switch a := arr.(type) {
+		case *array.String:
+			value = func(row int) []byte { return []byte(a.Value(row)) }
+		case *array.Binary:
+			value = func(row int) []byte { return a.Value(row) }
+		case *array.List:
+			if t.kind == kindList {
+				w.writeList(id, a, values, streams, encodings)
+				return
+			}
+			bytesArr := a.ListValues().(*array.Uint8)
+			value = func(row int) []byte {
+				start, end := a.ValueOffsets(row)
+				return bytesArr.Uint8Values()[start:end]
+			}
+		}
+		if w.dictionary && t.kind == kindString {
+			dict := make(map[string]int)
+			entries := make([]int64, 0)
+			dictData := make([]byte, 0)
+			for _, row := range values {
+				v := string(value(row))
+				idx, ok := dict[v]
+				if !ok {
+					idx = len(dict)
+					dict[v] = idx
+					entries = append(entries, int64(len(v)))
+					dictData = append(dictData, v...)
+				}
+				ints = append(ints, int64(idx))
+			}
+			kind := encodingDictionary
+			if w.v2 {
+				kind = encodingDictionaryV2
+			}
+			encodings[id] = &columnEncoding{kind: kind, dictionarySize: uint32(len(dict))}
+			*streams = append(*streams, testStream{streamData, id, w.writeInts(ints, false)})
+			*streams = append(*streams, testStream{streamLength, id, w.writeInts(entries, false)})
+			*streams = append(*streams, testStream{streamDictionaryData, id, dictData})
+			return
+		}
+		for _, row := range values {
+			v := value(row)
+			data = append(data, v...)
+			ints = append(ints, int64(len(v)))
+		}
+		*streams = append(*streams, testStream{streamLength, id, w.writeInts(ints, false)})
+	case *array.Struct:
+		for i, sub := range t.subtypes {
+			w.writeColumn(sub, a.Field(i), values, streams, encodings)
+		}
+		return
+	default:
+		panic("unsupported array " + arr.DataType().String())
+	}
+	*streams = append(*streams, testStream{streamData, id, data})
+}
+
+func (w *testWriter) writeList(id uint32, a *array.List, rows []int, streams *[]testStream, encodings []*columnEncoding) {
+	lengths := make([]int64, 0, len(rows))
+	elems := make([]int, 0)
+	for _, row := range rows {
+		start, end := a.ValueOffsets(row)
+		lengths = append(lengths, end-start)
+		for i := start; i < end; i++ {
+			elems = append(elems, int(i))
+		}
+	}
+	*streams = append(*streams, testStream{streamLength, id, w.writeInts(lengths, false)})
+	w.writeColumn(w.types[id].subtypes[0], a.ListValues(), elems, streams, encodings)
+}
+
+func (w *testWriter) write(rec arrow.Record, stripeRows int) []byte {
+	w.types = nil
+	root := &orcType{kind: kindStruct}
+	w.types = append(w.types, root)
+	for _, f := range rec.Schema().Fields() {
+		root.fieldNames = append(root.fieldNames, f.Name)
+		root.subtypes = append(root.subtypes, w.addType(f.Type))
+	}
+
+	file := []byte(orcMagic)
+	var stripesInfo []byte
+	for start := 0; start < int(rec.NumRows()); start += stripeRows {
+		end := min(start+stripeRows, int(rec.NumRows()))
+		rows := make([]int, 0, end-start)
+		for i := start; i < end; i++ {
+			rows = append(rows, i)
+		}
+		streams := make([]testStream, 0)
+		encodings := make([]*columnEncoding, len(w.types))
+		encodings[0] = &columnEncoding{kind: encodingDirect}
+		for i, sub := range root.subtypes {
+			w.writeColumn(sub, rec.Column(i), rows, &streams, encodings)
+		}
+
+		offset := len(file)
+		var stripeFooter []byte
+		for _, s := range streams {
+			data := w.compress(s.data)
+			file = append(file, data...)
+			var msg []byte
+			msg = appendVarintField(msg, 1, uint64(s.kind))
+			msg = appendVarintField(msg, 2, uint64(s.column))
+			msg = appendVarintField(msg, 3, uint64(len(data)))
+			stripeFooter = appendBytesField(stripeFooter, 1, msg)
+		}
+		dataLength := len(file) - offset
+		for _, e := range encodings {
+			var msg []byte
+			msg = appendVarintField(msg, 1, uint64(e.kind))
+			if e.dictionarySize > 0 {
+				msg = appendVarintField(msg, 2, uint64(e.dictionarySize))
+			}
+			stripeFooter = appendBytesField(stripeFooter, 2, msg)
+		}
+		stripeFooter = w.compress(stripeFooter)
+		file = append(file, stripeFooter...)
+
+		var info []byte
+		info = appendVarintField(info, 1, uint64(offset))
+		info = appendVarintField(info, 2, 0)
+		info = appendVarintField(info, 3, uint64(dataLength))
+		info = appendVarintField(info, 4, uint64(len(stripeFooter)))
+		info = appendVarintField(info, 5, uint64(end-start))
+		stripesInfo = appendBytesField(stripesInfo, 3, info)
+	}
+
+	var footerMsg []byte
+	footerMsg = appendVarintField(footerMsg, 1, uint64(len(orcMagic)))
+	footerMsg = appendVarintField(footerMsg, 2, uint64(len(file)-len(orcMagic)))
+	footerMsg = append(footerMsg, stripesInfo...)
+	for i, t := range w.types {
+		kind := t.kind
+		if k, ok := w.footerKind[i]; ok {
+			kind = k
+		}
+		var msg []byte
+		msg = appendVarintField(msg, 1, uint64(kind))
+		if len(t.subtypes) > 0 {
+			var packed []byte
+			for _, sub := range t.subtypes {
+				packed = protowire.AppendVarint(packed, uint64(sub))
+			}
+			msg = appendBytesField(msg, 2, packed)
+		}
+		for _, name := range t.fieldNames {
+			msg = appendBytesField(msg, 3, []byte(name))
+		}
+		footerMsg = appendBytesField(footerMsg, 4, msg)
+	}
+	footerMsg = appendVarintField(footerMsg, 6, uint64(rec.NumRows()))
+	footerData := w.compress(footerMsg)
+	file = append(file, footerData...)
+
+	var ps []byte
+	ps = appendVarintField(ps, 1, uint64(len(footerData)))
+	ps = appendVarintField(ps, 2, uint64(w.compression))
+	ps = appendVarintField(ps, 3, uint64(w.blockSize))
+	ps = appendBytesField(ps, 8000, []byte(orcMagic))
+	file = append(file, ps...)
+	return append(file, byte(len(ps)))
+}
+
+type bytesFileReader struct {
+	*bytes.Reader
+}
+
+func (r *bytesFileReader) Close() error {
+	return nil
+}
+
+func (r *bytesFileReader) Size() (int64, error) {
+	return r.Reader.Size(), nil
+}
+
+type ReaderSuite struct {
+	suite.Suite
+
+	numRows int
+}
+
+func (s *ReaderSuite) SetupSuite() {
+	paramtable.Get().Init(paramtable.NewBaseTable())
+}
+
+func (s *ReaderSuite) SetupTest() {
+	s.numRows = 100
+}
+
+func (s *ReaderSuite) mockChunkManager(content []byte) storage.ChunkManager {
+	cm := mocks.NewChunkManager(s.T())
+	cm.EXPECT().Reader(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, path string) (storage.FileReader, error) {
+		return &bytesFileReader{Reader: bytes.NewReader(content)}, nil
+	})
+	cm.EXPECT().Size(mock.Anything, mock.Anything).Return(int64(len(content)), nil).Maybe()
+	return cm
+}
+
+func (s *ReaderSuite) run(w *testWriter, dataType schemapb.DataType, elemType schemapb.DataType, nullable bool) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:  101,
+				Name:     "vec",
+				DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.DimKey,
+						Value: "8",
+					},
+				},
+			},
+			{
+				FieldID:     102,
+				Name:        dataType.String(),
+				DataType:    dataType,
+				ElementType: elemType,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.DimKey,
+						Value: "16",
+					},
+					{
+						Key:   common.MaxLengthKey,
+						Value: "256",
+					},
+					{
+						Key:   common.MaxCapacityKey,
+						Value: "256",
+					},
+				},
+				Nullable: nullable,
+			},
+		},
+	}
+
+	nullPercent := 0
+	if nullable {
+		nullPercent = 50
+	}
+	insertData, err := testutil.CreateInsertData(schema, s.numRows, nullPercent)
+	s.NoError(err)
+	arrSchema, err := parquet.ConvertToArrowSchema(schema, false)
+	s.NoError(err)
+	columns, err := testutil.BuildArrayData(schema, insertData, false)
+	s.NoError(err)
+	rec := array.NewRecord(arrSchema, columns, int64(s.numRows))
+	defer rec.Release()
+
+	// split the rows into several stripes
+	content := w.write(rec, 40)
+
+	reader, err := NewReader(context.Background(), s.mockChunkManager(content), schema, "mockPath", math.MaxInt)
+	s.NoError(err)
+	defer reader.Close()
+
+	size, err := reader.Size()
+	s.NoError(err)
+	s.Equal(int64(len(content)), size)
+
+	res, err := reader.Read()
+	s.NoError(err)
+	for fieldID, data := range res.Data {
+		s.Equal(s.numRows, data.RowNum())
+		for i := 0; i < s.numRows; i++ {
+			s.Equal(insertData.Data[fieldID].GetRow(i), data.GetRow(i))
+		}
+	}
+	_, err = reader.Read()
+	s.ErrorIs(err, io.EOF)
+}
+
+func (s *ReaderSuite) TestReadScalarFields() {
+	for _, w := range []*testWriter{
+		{compression: compressionNone},
+		{compression: compressionNone, v2: true, dictionary: true},
+	} {
+		s.run(w, schemapb.DataType_Bool, schemapb.DataType_None, false)
+		s.run(w, schemapb.DataType_Int8, schemapb.DataType_None, true)
+		s.run(w, schemapb.DataType_Int16, schemapb.DataType_None, false)
+		s.run(w, schemapb.DataType_Int32, schemapb.DataType_None, true)
+		s.run(w, schemapb.DataType_Int64, schemapb.DataType_None, false)
+		s.run(w, schemapb.DataType_Float, schemapb.DataType_None, true)
+		s.run(w, schemapb.DataType_Double, schemapb.DataType_None, false)
+		s.run(w, schemapb.DataType_VarChar, schemapb.DataType_None, true)
+		s.run(w, schemapb.DataType_JSON, schemapb.DataType_None, false)
+		s.run(w, schemapb.DataType_Array, schemapb.DataType_Int64, false)
+		s.run(w, schemapb.DataType_Array, schemapb.DataType_VarChar, true)
+		s.run(w, schemapb.DataType_Array, schemapb.DataType_Float, true)
+	}
+}
+
+func (s *ReaderSuite) TestReadVectorFields() {
+	w := &testWriter{compression: compressionNone, v2: true}
+	s.run(w, schemapb.DataType_BinaryVector, schemapb.DataType_None, false)
+	s.run(w, schemapb.DataType_Float16Vector, schemapb.DataType_None, false)
+	s.run(w, schemapb.DataType_BFloat16Vector, schemapb.DataType_None, false)
+	s.run(w, schemapb.DataType_Int8Vector, schemapb.DataType_None, false)
+	s.run(w, schemapb.DataType_SparseFloatVector, schemapb.DataType_None, false)
+}
+
+func (s *ReaderSuite) TestCompression() {
+	for _, compression := range []compressionKind{compressionZlib, compressionSnappy, compressionZstd, compressionLz4} {
+		// small blocks so that the streams are split into several chunks
+		w := &testWriter{compression: compression, blockSize: 256, v2: true, dictionary: true}
+		s.run(w, schemapb.DataType_VarChar, schemapb.DataType_None, true)
+		s.run(w, schemapb.DataType_Array, schemapb.DataType_Int32, false)
+	}
+}
+
+func (s *ReaderSuite) TestStruct() {
+	// the struct children have values only if the struct is present
+	arrSchema := arrow.NewSchema([]arrow.Field{
+		{Name: "pk", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "st", Type: arrow.StructOf(
+			arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+			arrow.Field{Name: "b", Type: arrow.BinaryTypes.String, Nullable: true},
+		), Nullable: true},
+	}, nil)
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, arrSchema)
+	defer builder.Release()
+	builder.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 2, 3, 4}, nil)
+	sb := builder.Field(1).(*array.StructBuilder)
+	sb.AppendValues([]bool{true, false, true, true})
+	sb.FieldBuilder(0).(*array.Int32Builder).AppendValues([]int32{10, 0, 30, 0}, []bool{true, false, true, false})
+	sb.FieldBuilder(1).(*array.StringBuilder).AppendValues([]string{"a", "", "", "d"}, []bool{true, false, false, true})
+	rec := builder.NewRecord()
+	defer rec.Release()
+
+	w := &testWriter{compression: compressionNone, v2: true}
+	content := w.write(rec, 3)
+	cmReader := &bytesFileReader{Reader: bytes.NewReader(content)}
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "st", DataType: schemapb.DataType_SparseFloatVector},
+		},
+	}
+	rr, err := newRecordReader(cmReader, schema)
+	s.NoError(err)
+	defer rr.Close()
+	s.True(arrow.TypeEqual(arrSchema.Field(1).Type, rr.Schema().Field(1).Type))
+
+	records := make([]arrow.Record, 0)
+	for {
+		r, err := rr.Next()
+		if err == io.EOF {
+			break
+		}
+		s.NoError(err)
+		defer r.Release()
+		records = append(records, r)
+	}
+	s.Len(records, 2)
+	table := array.NewTableFromRecords(rr.Schema(), records)
+	defer table.Release()
+	for i := 0; i < 2; i++ {
+		expected, err := array.Concatenate([]arrow.Array{rec.Column(i)}, memory.DefaultAllocator)
+		s.NoError(err)
+		actual, err := array.Concatenate(table.Column(i).Data().Chunks(), memory.DefaultAllocator)
+		s.NoError(err)
+		s.True(array.Equal(expected, actual), "expected %v, actual %v", expected, actual)
+		expected.Release()
+		actual.Release()
+	}
+}
+
+func (s *ReaderSuite) TestInvalidFile() {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+		},
+	}
+	// not an ORC file
+	_, err := NewReader(context.Background(), s.mockChunkManager([]byte("PAR1 not orc")), schema, "mockPath", math.MaxInt)
+	s.Error(err)
+
+	// unsupported type of the column to import
+	w := &testWriter{compression: compressionNone}
+	arrSchema := arrow.NewSchema([]arrow.Field{{Name: "pk", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, arrSchema)
+	defer builder.Release()
+	builder.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 2, 3}, nil)
+	rec := builder.NewRecord()
+	defer rec.Release()
+	content := w.write(rec, 10)
+	reader, err := NewReader(context.Background(), s.mockChunkManager(content), schema, "mockPath", math.MaxInt)
+	s.NoError(err)
+	reader.Close()
+
+	w.footerKind = map[int]typeKind{1: kindMap}
+	_, err = newRecordReader(&bytesFileReader{Reader: bytes.NewReader(w.write(rec, 10))}, schema)
+	s.ErrorContains(err, "ORC type map is not supported")
+	w.footerKind = nil
+
+	// truncated stripe
+	_, err = NewReader(context.Background(), s.mockChunkManager(content[:10]), schema, "mockPath", math.MaxInt)
+	s.Error(err)
+
+	// schema mismatch, the pk column is read as int64 but the field is varchar
+	schema.Fields[0].DataType = schemapb.DataType_VarChar
+	_, err = NewReader(context.Background(), s.mockChunkManager(content), schema, "mockPath", math.MaxInt)
+	s.Error(err)
+}
+
+func (s *ReaderSuite) TestTemporalAndDecimal() {
+	w := &testWriter{}
+	decodeColumn := func(kind typeKind, dt arrow.DataType, n int, streams map[streamKind][]byte) arrow.Array {
+		types := []*orcType{{kind: kind}}
+		ss := &stripeStreams{streams: map[uint32]map[streamKind]*streamReader{0: {}}}
+		d, err := newDecompressor(compressionNone, 0)
+		s.Require().NoError(err)
+		for kind, data := range streams {
+			ss.streams[0][kind] = newStreamReader(data, d)
+		}
+		decoder, err := newColumnDecoder(types, 0, ss)
+		s.Require().NoError(err)
+		builder := array.NewBuilder(memory.DefaultAllocator, dt)
+		defer builder.Release()
+		s.Require().NoError(decoder.decode(builder, n, nil))
+		return builder.NewArray()
+	}
+
+	// date is read as days since the unix epoch
+	dt, err := toArrowType([]*orcType{{kind: kindDate}}, 0)
+	s.NoError(err)
+	s.Equal(arrow.PrimitiveTypes.Int64, dt)
+	arr := decodeColumn(kindDate, dt, 3, map[streamKind][]byte{streamData: w.writeInts([]int64{0, 19000, -1}, true)})
+	s.Equal([]int64{0, 19000, -1}, arr.(*array.Int64).Int64Values())
+	arr.Release()
+
+	// timestamp is read as nanoseconds since the unix epoch, the nanoseconds 500000000 is encoded
+	// as 5 with 8 trailing zeros, the seconds before the unix epoch are rounded toward zero
+	for _, kind := range []typeKind{kindTimestamp, kindTimestampInstant} {
+		dt, err = toArrowType([]*orcType{{kind: kind}}, 0)
+		s.NoError(err)
+		s.Equal(arrow.PrimitiveTypes.Int64, dt)
+		arr = decodeColumn(kind, dt, 4, map[streamKind][]byte{
+			streamPresent:   writeBools([]bool{true, true, false, true}),
+			streamData:      w.writeInts([]int64{1, -orcEpochSeconds, -orcEpochSeconds - 1}, true),
+			streamSecondary: w.writeInts([]int64{5<<3 | 7, 0, 5<<3 | 7}, false),
+		})
+		s.Equal(`[1420070401500000000 0 (null) -1500000000]`, arr.String())
+		arr.Release()
+	}
+
+	// decimal is read as float64, the unscaled values are varints up to 128 bits
+	dt, err = toArrowType([]*orcType{{kind: kindDecimal}}, 0)
+	s.NoError(err)
+	s.Equal(arrow.PrimitiveTypes.Float64, dt)
+	var data []byte
+	data = protowire.AppendVarint(data, protowire.EncodeZigZag(12345))
+	data = protowire.AppendVarint(data, protowire.EncodeZigZag(-5))
+	// zigzag of 2^100 is 2^101, 15 bytes of varint
+	data = append(data, bytes.Repeat([]byte{0x80}, 14)...)
+	data = append(data, 0x08)
+	arr = decodeColumn(kindDecimal, dt, 3, map[streamKind][]byte{
+		streamData:      data,
+		streamSecondary: w.writeInts([]int64{3, 1, 0}, true),
+	})
+	s.Equal([]float64{12.345, -0.5, math.Ldexp(1, 100)}, arr.(*array.Float64).Float64Values())
+	arr.Release()
+
+	// the scale stream is required
+	_, err = newColumnDecoder([]*orcType{{kind: kindDecimal}}, 0, &stripeStreams{streams: map[uint32]map[streamKind]*streamReader{
+		0: {streamData: newStreamReader(data, &decompressor{kind: compressionNone})},
+	}})
+	s.Error(err)
+
+	// a date column read from file
+	arrSchema := arrow.NewSchema([]arrow.Field{{Name: "pk", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, arrSchema)
+	defer builder.Release()
+	builder.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 20000, -3}, nil)
+	rec := builder.NewRecord()
+	defer rec.Release()
+	w.footerKind = map[int]typeKind{1: kindDate}
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64}},
+	}
+	rr, err := newRecordReader(&bytesFileReader{Reader: bytes.NewReader(w.write(rec, 10))}, schema)
+	s.NoError(err)
+	defer rr.Close()
+	r, err := rr.Next()
+	s.NoError(err)
+	defer r.Release()
+	s.Equal([]int64{1, 20000, -3}, r.Column(0).(*array.Int64).Int64Values())
+}
+
+func TestORCReader(t *testing.T) {
+	suite.Run(t, new(ReaderSuite))
+}
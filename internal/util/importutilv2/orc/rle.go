@@ -0,0 +1,530 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+)
+
+// The run length encodings of ORC, see https://orc.apache.org/specification/ORCv1/.
+
+// readUvarint reads a base 128 varint, io.EOF is returned only if no byte is read.
+func readUvarint(r io.ByteReader) (uint64, error) {
+	var v uint64
+	for shift := uint(0); ; shift += 7 {
+		b, err := r.ReadByte()
+		if err != nil {
+			if shift > 0 && err == io.EOF {
+				return 0, io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+		if shift >= 64 {
+			return 0, fmt.Errorf("varint overflows 64 bits")
+		}
+		v |= uint64(b&0x7f) << shift
+		if b < 0x80 {
+			return v, nil
+		}
+	}
+}
+
+func unZigzag(v uint64) int64 {
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+// readBigVarint reads an unbounded base 128 varint in zigzag, which encodes the decimals up to 128 bits.
+func readBigVarint(r io.ByteReader) (*big.Int, error) {
+	v := new(big.Int)
+	digit := new(big.Int)
+	for shift := uint(0); ; shift += 7 {
+		b, err := r.ReadByte()
+		if err != nil {
+			if shift > 0 && err == io.EOF {
+				return nil, io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		if shift >= 128 {
+			return nil, fmt.Errorf("varint overflows 128 bits")
+		}
+		v.Or(v, digit.Lsh(digit.SetUint64(uint64(b&0x7f)), shift))
+		if b < 0x80 {
+			break
+		}
+	}
+	negative := v.Bit(0) == 1
+	v.Rsh(v, 1)
+	if negative {
+		v.Neg(v).Sub(v, big.NewInt(1))
+	}
+	return v, nil
+}
+
+func readVarint(r io.ByteReader, signed bool) (int64, error) {
+	v, err := readUvarint(r)
+	if err != nil {
+		return 0, err
+	}
+	if signed {
+		return unZigzag(v), nil
+	}
+	return int64(v), nil
+}
+
+// readByteNotEOF reads a byte in the middle of a run, io.EOF is unexpected.
+func readByteNotEOF(r io.ByteReader) (byte, error) {
+	b, err := r.ReadByte()
+	if err == io.EOF {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return b, err
+}
+
+// byteRLEReader decodes the byte run length encoding, a run has a control byte in [0, 127]
+// followed by a byte repeated control+3 times, a control byte in [-128, -1] is followed by
+// -control literal bytes.
+type byteRLEReader struct {
+	r        io.ByteReader
+	literals []byte
+	pos      int
+}
+
+func newByteRLEReader(r io.ByteReader) *byteRLEReader {
+	return &byteRLEReader{r: r, literals: make([]byte, 0, 128)}
+}
+
+func (b *byteRLEReader) next() (byte, error) {
+	if b.pos >= len(b.literals) {
+		if err := b.readRun(); err != nil {
+			return 0, err
+		}
+	}
+	v := b.literals[b.pos]
+	b.pos++
+	return v, nil
+}
+
+func (b *byteRLEReader) readRun() error {
+	control, err := b.r.ReadByte()
+	if err != nil {
+		return err
+	}
+	b.literals = b.literals[:0]
+	b.pos = 0
+	if int8(control) >= 0 {
+		v, err := readByteNotEOF(b.r)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < int(control)+3; i++ {
+			b.literals = append(b.literals, v)
+		}
+		return nil
+	}
+	for i := 0; i < -int(int8(control)); i++ {
+		v, err := readByteNotEOF(b.r)
+		if err != nil {
+			return err
+		}
+		b.literals = append(b.literals, v)
+	}
+	return nil
+}
+
+// boolReader decodes the bits encoded by byte run length encoding, the most significant bit first.
+type boolReader struct {
+	bytes *byteRLEReader
+	value byte
+	left  int
+}
+
+func newBoolReader(r io.ByteReader) *boolReader {
+	return &boolReader{bytes: newByteRLEReader(r)}
+}
+
+func (b *boolReader) next() (bool, error) {
+	if b.left == 0 {
+		v, err := b.bytes.next()
+		if err != nil {
+			return false, err
+		}
+		b.value = v
+		b.left = 8
+	}
+	b.left--
+	return (b.value>>b.left)&1 == 1, nil
+}
+
+// intReader decodes the integers encoded by run length encoding version 1 or 2.
+type intReader interface {
+	next() (int64, error)
+}
+
+func newIntReader(r io.ByteReader, signed bool, v2 bool) intReader {
+	if v2 {
+		return &intRLEv2Reader{r: r, signed: signed, literals: make([]int64, 0, 512)}
+	}
+	return &intRLEv1Reader{r: r, signed: signed, literals: make([]int64, 0, 130)}
+}
+
+// intRLEv1Reader decodes the run length encoding version 1, a run has a control byte in [0, 127]
+// followed by a signed delta byte and a varint base, the run has control+3 values. A control byte
+// in [-128, -1] is followed by -control varint literals.
+type intRLEv1Reader struct {
+	r        io.ByteReader
+	signed   bool
+	literals []int64
+	pos      int
+}
+
+func (i *intRLEv1Reader) next() (int64, error) {
+	if i.pos >= len(i.literals) {
+		if err := i.readRun(); err != nil {
+			return 0, err
+		}
+	}
+	v := i.literals[i.pos]
+	i.pos++
+	return v, nil
+}
+
+func (i *intRLEv1Reader) readRun() error {
+	control, err := i.r.ReadByte()
+	if err != nil {
+		return err
+	}
+	i.literals = i.literals[:0]
+	i.pos = 0
+	if int8(control) >= 0 {
+		delta, err := readByteNotEOF(i.r)
+		if err != nil {
+			return err
+		}
+		base, err := i.readValue()
+		if err != nil {
+			return err
+		}
+		for j := 0; j < int(control)+3; j++ {
+			i.literals = append(i.literals, base+int64(j)*int64(int8(delta)))
+		}
+		return nil
+	}
+	for j := 0; j < -int(int8(control)); j++ {
+		v, err := i.readValue()
+		if err != nil {
+			return err
+		}
+		i.literals = append(i.literals, v)
+	}
+	return nil
+}
+
+func (i *intRLEv1Reader) readValue() (int64, error) {
+	v, err := readVarint(i.r, i.signed)
+	if err == io.EOF {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return v, err
+}
+
+const (
+	rleV2ShortRepeat = 0
+	rleV2Direct      = 1
+	rleV2PatchedBase = 2
+	rleV2Delta       = 3
+)
+
+// decodeBitWidth decodes the 5-bit encoded bit width of run length encoding version 2.
+func decodeBitWidth(code int) int {
+	switch {
+	case code <= 23:
+		return code + 1
+	case code == 24:
+		return 26
+	case code == 25:
+		return 28
+	case code == 26:
+		return 30
+	case code == 27:
+		return 32
+	case code == 28:
+		return 40
+	case code == 29:
+		return 48
+	case code == 30:
+		return 56
+	default:
+		return 64
+	}
+}
+
+// closestFixedBits returns the bit width used to pack the values of n bits.
+func closestFixedBits(n int) int {
+	switch {
+	case n == 0:
+		return 1
+	case n <= 24:
+		return n
+	case n <= 26:
+		return 26
+	case n <= 28:
+		return 28
+	case n <= 30:
+		return 30
+	case n <= 32:
+		return 32
+	case n <= 40:
+		return 40
+	case n <= 48:
+		return 48
+	case n <= 56:
+		return 56
+	default:
+		return 64
+	}
+}
+
+// intRLEv2Reader decodes the run length encoding version 2, which has 4 sub-encodings
+// short repeat, direct, patched base and delta, identified by the 2 highest bits of the header.
+type intRLEv2Reader struct {
+	r        io.ByteReader
+	signed   bool
+	literals []int64
+	pos      int
+}
+
+func (i *intRLEv2Reader) next() (int64, error) {
+	if i.pos >= len(i.literals) {
+		if err := i.readRun(); err != nil {
+			return 0, err
+		}
+	}
+	v := i.literals[i.pos]
+	i.pos++
+	return v, nil
+}
+
+func (i *intRLEv2Reader) readRun() error {
+	header, err := i.r.ReadByte()
+	if err != nil {
+		return err
+	}
+	i.literals = i.literals[:0]
+	i.pos = 0
+	switch header >> 6 {
+	case rleV2ShortRepeat:
+		err = i.readShortRepeat(header)
+	case rleV2Direct:
+		err = i.readDirect(header)
+	case rleV2PatchedBase:
+		err = i.readPatchedBase(header)
+	default:
+		err = i.readDelta(header)
+	}
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// readBigEndian reads an unsigned integer of n bytes in big endian.
+func (i *intRLEv2Reader) readBigEndian(n int) (uint64, error) {
+	var v uint64
+	for j := 0; j < n; j++ {
+		b, err := i.r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		v = v<<8 | uint64(b)
+	}
+	return v, nil
+}
+
+// readInts appends n bit packed unsigned integers of the bit width, the bits are packed
+// from the most significant bit, and the run is padded to a whole byte.
+func (i *intRLEv2Reader) readInts(n int, width int) error {
+	var current uint64
+	bitsLeft := 0
+	for j := 0; j < n; j++ {
+		var v uint64
+		need := width
+		for need > 0 {
+			if bitsLeft == 0 {
+				b, err := i.r.ReadByte()
+				if err != nil {
+					return err
+				}
+				current = uint64(b)
+				bitsLeft = 8
+			}
+			take := min(need, bitsLeft)
+			bitsLeft -= take
+			v = v<<take | (current>>bitsLeft)&(1<<take-1)
+			need -= take
+		}
+		i.literals = append(i.literals, int64(v))
+	}
+	return nil
+}
+
+func (i *intRLEv2Reader) readShortRepeat(header byte) error {
+	width := int((header>>3)&0x07) + 1
+	count := int(header&0x07) + 3
+	u, err := i.readBigEndian(width)
+	if err != nil {
+		return err
+	}
+	v := int64(u)
+	if i.signed {
+		v = unZigzag(u)
+	}
+	for j := 0; j < count; j++ {
+		i.literals = append(i.literals, v)
+	}
+	return nil
+}
+
+func (i *intRLEv2Reader) readDirect(header byte) error {
+	width := decodeBitWidth(int(header>>1) & 0x1f)
+	second, err := i.r.ReadByte()
+	if err != nil {
+		return err
+	}
+	length := (int(header&0x01)<<8 | int(second)) + 1
+	if err := i.readInts(length, width); err != nil {
+		return err
+	}
+	if i.signed {
+		for j := range i.literals {
+			i.literals[j] = unZigzag(uint64(i.literals[j]))
+		}
+	}
+	return nil
+}
+
+func (i *intRLEv2Reader) readPatchedBase(header byte) error {
+	width := decodeBitWidth(int(header>>1) & 0x1f)
+	var h [3]byte
+	for j := range h {
+		b, err := i.r.ReadByte()
+		if err != nil {
+			return err
+		}
+		h[j] = b
+	}
+	length := (int(header&0x01)<<8 | int(h[0])) + 1
+	baseWidth := int(h[1]>>5) + 1
+	patchWidth := decodeBitWidth(int(h[1] & 0x1f))
+	patchGapWidth := int(h[2]>>5) + 1
+	patchListLength := int(h[2] & 0x1f)
+	if patchWidth+width > 64 {
+		return fmt.Errorf("invalid patched base run, data width %d, patch width %d", width, patchWidth)
+	}
+
+	// the most significant bit of the base value is the sign bit
+	u, err := i.readBigEndian(baseWidth)
+	if err != nil {
+		return err
+	}
+	signMask := uint64(1) << (baseWidth*8 - 1)
+	base := int64(u &^ signMask)
+	if u&signMask != 0 {
+		base = -base
+	}
+
+	if err := i.readInts(length, width); err != nil {
+		return err
+	}
+	values := i.literals
+	i.literals = make([]int64, 0, patchListLength)
+	if err := i.readInts(patchListLength, closestFixedBits(patchWidth+patchGapWidth)); err != nil {
+		return err
+	}
+	patches := i.literals
+	i.literals = values
+
+	// apply the patches, a gap of 255 with a zero patch only extends the gap to the next patch
+	patchMask := uint64(1)<<patchWidth - 1
+	idx := 0
+	for j := 0; j < len(patches); j++ {
+		gap := int(uint64(patches[j]) >> patchWidth)
+		patch := uint64(patches[j]) & patchMask
+		idx += gap
+		if gap == 255 && patch == 0 {
+			continue
+		}
+		if idx >= length {
+			return fmt.Errorf("invalid patched base run, patch position %d exceeds run length %d", idx, length)
+		}
+		values[idx] = int64(uint64(values[idx]) | patch<<width)
+	}
+	for j := range values {
+		values[j] += base
+	}
+	return nil
+}
+
+func (i *intRLEv2Reader) readDelta(header byte) error {
+	code := int(header>>1) & 0x1f
+	width := 0
+	if code != 0 {
+		width = decodeBitWidth(code)
+	}
+	second, err := i.r.ReadByte()
+	if err != nil {
+		return err
+	}
+	// the number of values following the first value
+	length := int(header&0x01)<<8 | int(second)
+	first, err := readVarint(i.r, i.signed)
+	if err != nil {
+		return err
+	}
+	i.literals = append(i.literals, first)
+	deltaBase, err := readVarint(i.r, true)
+	if err != nil {
+		return err
+	}
+	if width == 0 {
+		// fixed delta
+		for j := 0; j < length; j++ {
+			i.literals = append(i.literals, i.literals[len(i.literals)-1]+deltaBase)
+		}
+		return nil
+	}
+	if length == 0 {
+		return nil
+	}
+	i.literals = append(i.literals, first+deltaBase)
+	start := len(i.literals)
+	if err := i.readInts(length-1, width); err != nil {
+		return err
+	}
+	prev := i.literals[start-1]
+	for j := start; j < len(i.literals); j++ {
+		if deltaBase < 0 {
+			i.literals[j] = prev - i.literals[j]
+		} else {
+			i.literals[j] = prev + i.literals[j]
+		}
+		prev = i.literals[j]
+	}
+	return nil
+}
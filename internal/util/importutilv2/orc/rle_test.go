@@ -0,0 +1,157 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The examples are from the ORC specification.
+
+func readInts(t *testing.T, r intReader, n int) []int64 {
+	values := make([]int64, 0, n)
+	for i := 0; i < n; i++ {
+		v, err := r.next()
+		assert.NoError(t, err)
+		values = append(values, v)
+	}
+	_, err := r.next()
+	assert.ErrorIs(t, err, io.EOF)
+	return values
+}
+
+func TestByteRLE(t *testing.T) {
+	r := newByteRLEReader(bytes.NewReader([]byte{0x61, 0x00, 0xfe, 0x44, 0x45}))
+	for i := 0; i < 100; i++ {
+		v, err := r.next()
+		assert.NoError(t, err)
+		assert.Equal(t, byte(0), v)
+	}
+	for _, expected := range []byte{0x44, 0x45} {
+		v, err := r.next()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, v)
+	}
+	_, err := r.next()
+	assert.ErrorIs(t, err, io.EOF)
+
+	// truncated run
+	r = newByteRLEReader(bytes.NewReader([]byte{0xfe, 0x44}))
+	_, err = r.next()
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestBoolReader(t *testing.T) {
+	// true, false, false, false, false, false, false, false followed by 8 trues
+	r := newBoolReader(bytes.NewReader([]byte{0xfe, 0x80, 0xff}))
+	for i := 0; i < 16; i++ {
+		v, err := r.next()
+		assert.NoError(t, err)
+		assert.Equal(t, i == 0 || i >= 8, v)
+	}
+	_, err := r.next()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestIntRLEv1(t *testing.T) {
+	// a run of 100 7s
+	r := newIntReader(bytes.NewReader([]byte{0x61, 0x00, 0x07}), false, false)
+	values := readInts(t, r, 100)
+	for _, v := range values {
+		assert.Equal(t, int64(7), v)
+	}
+
+	// a run of 100 values decreasing from 100 to 1
+	r = newIntReader(bytes.NewReader([]byte{0x61, 0xff, 0x64}), false, false)
+	values = readInts(t, r, 100)
+	for i, v := range values {
+		assert.Equal(t, int64(100-i), v)
+	}
+
+	// literals
+	r = newIntReader(bytes.NewReader([]byte{0xfb, 0x02, 0x03, 0x06, 0x07, 0x0b}), false, false)
+	assert.Equal(t, []int64{2, 3, 6, 7, 11}, readInts(t, r, 5))
+
+	// signed literals in zigzag
+	r = newIntReader(bytes.NewReader([]byte{0xfd, 0x01, 0x02, 0xac, 0x02}), true, false)
+	assert.Equal(t, []int64{-1, 1, 150}, readInts(t, r, 3))
+}
+
+func TestIntRLEv2(t *testing.T) {
+	// short repeat
+	r := newIntReader(bytes.NewReader([]byte{0x0a, 0x27, 0x10}), false, true)
+	assert.Equal(t, []int64{10000, 10000, 10000, 10000, 10000}, readInts(t, r, 5))
+
+	// direct
+	r = newIntReader(bytes.NewReader([]byte{0x5e, 0x03, 0x5c, 0xa1, 0xab, 0x1e, 0xde, 0xad, 0xbe, 0xef}), false, true)
+	assert.Equal(t, []int64{23713, 43806, 57005, 48879}, readInts(t, r, 4))
+
+	// patched base
+	r = newIntReader(bytes.NewReader([]byte{
+		0x8e, 0x13, 0x2b, 0x21, 0x07, 0xd0, 0x1e, 0x00, 0x14, 0x70, 0x28, 0x32, 0x3c, 0x46, 0x50, 0x5a,
+		0x64, 0x6e, 0x78, 0x82, 0x8c, 0x96, 0xa0, 0xaa, 0xb4, 0xbe, 0xfc, 0xe8,
+	}), false, true)
+	assert.Equal(t, []int64{
+		2030, 2000, 2020, 1000000, 2040, 2050, 2060, 2070, 2080, 2090,
+		2100, 2110, 2120, 2130, 2140, 2150, 2160, 2170, 2180, 2190,
+	}, readInts(t, r, 20))
+
+	// delta
+	r = newIntReader(bytes.NewReader([]byte{0xc6, 0x09, 0x02, 0x02, 0x22, 0x42, 0x42, 0x46}), false, true)
+	assert.Equal(t, []int64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29}, readInts(t, r, 10))
+
+	// fixed delta, 10 values decreasing from 10 by 2 in zigzag
+	r = newIntReader(bytes.NewReader([]byte{0xc0, 0x09, 0x14, 0x03}), true, true)
+	values := readInts(t, r, 10)
+	for i, v := range values {
+		assert.Equal(t, int64(10-2*i), v)
+	}
+
+	// signed direct in zigzag
+	r = newIntReader(bytes.NewReader([]byte{0x46, 0x02, 0x12, 0x50}), true, true)
+	assert.Equal(t, []int64{-1, 1, -3}, readInts(t, r, 3))
+
+	// truncated run
+	r = newIntReader(bytes.NewReader([]byte{0x5e, 0x03, 0x5c}), false, true)
+	_, err := r.next()
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestBigVarint(t *testing.T) {
+	v, err := readBigVarint(bytes.NewReader([]byte{0xf2, 0xc0, 0x01}))
+	assert.NoError(t, err)
+	assert.Equal(t, "12345", v.String())
+
+	v, err = readBigVarint(bytes.NewReader([]byte{0x09}))
+	assert.NoError(t, err)
+	assert.Equal(t, "-5", v.String())
+
+	// -2^127, the minimum of 128 bits decimal
+	v, err = readBigVarint(bytes.NewReader(append(bytes.Repeat([]byte{0xff}, 18), 0x03)))
+	assert.NoError(t, err)
+	assert.Equal(t, "-170141183460469231731687303715884105728", v.String())
+
+	_, err = readBigVarint(bytes.NewReader(bytes.Repeat([]byte{0xff}, 20)))
+	assert.Error(t, err)
+
+	_, err = readBigVarint(bytes.NewReader([]byte{0x80}))
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
@@ -0,0 +1,187 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// chunkHeaderSize is the size of the header of each compressed chunk, the header is a
+// 3-byte little endian integer, the lowest bit indicates whether the chunk is stored
+// uncompressed, the other bits are the length of the chunk.
+const chunkHeaderSize = 3
+
+// decompressor decompresses the chunks of ORC streams.
+type decompressor struct {
+	kind      compressionKind
+	blockSize int
+	zstd      *zstd.Decoder
+}
+
+func newDecompressor(kind compressionKind, blockSize uint64) (*decompressor, error) {
+	d := &decompressor{kind: kind, blockSize: int(blockSize)}
+	switch kind {
+	case compressionNone, compressionZlib, compressionSnappy, compressionLz4:
+	case compressionZstd:
+		zr, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		d.zstd = zr
+	default:
+		return nil, fmt.Errorf("unsupported ORC compression %s", kind.String())
+	}
+	return d, nil
+}
+
+// decompress decompresses a chunk, the decompressed size should not exceed the compression block size.
+func (d *decompressor) decompress(src []byte, dst []byte) ([]byte, error) {
+	switch d.kind {
+	case compressionZlib:
+		fr := flate.NewReader(bytes.NewReader(src))
+		defer fr.Close()
+		buf := bytes.NewBuffer(dst[:0])
+		n, err := buf.ReadFrom(io.LimitReader(fr, int64(d.blockSize)+1))
+		if err != nil {
+			return nil, err
+		}
+		if n > int64(d.blockSize) {
+			return nil, fmt.Errorf("decompressed chunk exceeds the compression block size %d", d.blockSize)
+		}
+		return buf.Bytes(), nil
+	case compressionSnappy:
+		n, err := snappy.DecodedLen(src)
+		if err != nil {
+			return nil, err
+		}
+		if n > d.blockSize {
+			return nil, fmt.Errorf("decompressed chunk exceeds the compression block size %d", d.blockSize)
+		}
+		return snappy.Decode(dst[:cap(dst)], src)
+	case compressionLz4:
+		if cap(dst) < d.blockSize {
+			dst = make([]byte, d.blockSize)
+		}
+		n, err := lz4.UncompressBlock(src, dst[:d.blockSize])
+		if err != nil {
+			return nil, err
+		}
+		return dst[:n], nil
+	case compressionZstd:
+		out, err := d.zstd.DecodeAll(src, dst[:0])
+		if err != nil {
+			return nil, err
+		}
+		if len(out) > d.blockSize {
+			return nil, fmt.Errorf("decompressed chunk exceeds the compression block size %d", d.blockSize)
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("unsupported ORC compression %s", d.kind.String())
+}
+
+func (d *decompressor) close() {
+	if d.zstd != nil {
+		d.zstd.Close()
+	}
+}
+
+// streamReader reads the bytes of an ORC stream, the chunks of compressed stream
+// are decompressed one by one when they are read.
+type streamReader struct {
+	data []byte
+	d    *decompressor
+
+	chunk []byte
+	pos   int
+	buf   []byte
+}
+
+func newStreamReader(data []byte, d *decompressor) *streamReader {
+	s := &streamReader{data: data, d: d}
+	if d.kind == compressionNone {
+		s.chunk = data
+		s.data = nil
+	}
+	return s
+}
+
+func (s *streamReader) nextChunk() error {
+	if len(s.data) == 0 {
+		return io.EOF
+	}
+	if len(s.data) < chunkHeaderSize {
+		return io.ErrUnexpectedEOF
+	}
+	header := int(s.data[0]) | int(s.data[1])<<8 | int(s.data[2])<<16
+	isOriginal := header&1 == 1
+	length := header >> 1
+	if len(s.data) < chunkHeaderSize+length {
+		return io.ErrUnexpectedEOF
+	}
+	src := s.data[chunkHeaderSize : chunkHeaderSize+length]
+	s.data = s.data[chunkHeaderSize+length:]
+	s.pos = 0
+	if isOriginal {
+		s.chunk = src
+		return nil
+	}
+	if s.buf == nil {
+		s.buf = make([]byte, 0, s.d.blockSize)
+	}
+	chunk, err := s.d.decompress(src, s.buf)
+	if err != nil {
+		return fmt.Errorf("failed to decompress %s chunk: %w", s.d.kind.String(), err)
+	}
+	s.chunk = chunk
+	s.buf = chunk[:0]
+	return nil
+}
+
+func (s *streamReader) ReadByte() (byte, error) {
+	for s.pos >= len(s.chunk) {
+		if err := s.nextChunk(); err != nil {
+			return 0, err
+		}
+	}
+	b := s.chunk[s.pos]
+	s.pos++
+	return b, nil
+}
+
+func (s *streamReader) Read(p []byte) (int, error) {
+	for s.pos >= len(s.chunk) {
+		if err := s.nextChunk(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, s.chunk[s.pos:])
+	s.pos += n
+	return n, nil
+}
+
+// readAll reads the rest of the stream, it is used to read the metadata sections.
+func (s *streamReader) readAll() ([]byte, error) {
+	return io.ReadAll(s)
+}
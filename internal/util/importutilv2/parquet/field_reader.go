@@ -37,9 +37,16 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
+// ColumnReader reads a column of arrow-compatible data in batches,
+// it is implemented by pqarrow.ColumnReader for parquet files.
+type ColumnReader interface {
+	NextBatch(count int64) (*arrow.Chunked, error)
+	Field() *arrow.Field
+}
+
 type FieldReader struct {
 	columnIndex  int
-	columnReader *pqarrow.ColumnReader
+	columnReader ColumnReader
 
 	dim            int
 	field          *schemapb.FieldSchema
@@ -51,9 +58,15 @@ func NewFieldReader(ctx context.Context, reader *pqarrow.FileReader, columnIndex
 	if err != nil {
 		return nil, err
 	}
+	return NewColumnFieldReader(columnReader, columnIndex, field)
+}
 
+// NewColumnFieldReader creates a FieldReader on an arbitrary ColumnReader, so that
+// the other arrow-based formats share the same conversion and validation rules.
+func NewColumnFieldReader(columnReader ColumnReader, columnIndex int, field *schemapb.FieldSchema) (*FieldReader, error) {
 	var dim int64 = 1
 	if typeutil.IsVectorType(field.GetDataType()) && !typeutil.IsSparseFloatVectorType(field.GetDataType()) {
+		var err error
 		dim, err = typeutil.GetDim(field)
 		if err != nil {
 			return nil, err
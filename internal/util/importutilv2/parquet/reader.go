@@ -51,6 +51,10 @@ type reader struct {
 	count      int64
 
 	frs map[int64]*FieldReader // fieldID -> FieldReader
+
+	// rowIndex is the index of the next row to be read
+	rowIndex  int64
+	collector *common.RowErrorCollector
 }
 
 func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, path string, bufferSize int) (*reader, error) {
@@ -106,6 +110,22 @@ func (r *reader) Read() (*storage.InsertData, error) {
 	}
 OUTER:
 	for {
+		if r.collector != nil {
+			rows, err := common.ReadBatchTolerantly(r.schema, r.frs, r.count, insertData, func(_ int64, err error) error {
+				return r.collector.Collect(r.path, r.rowIndex, err)
+			})
+			if err != nil {
+				return nil, err
+			}
+			if rows == 0 {
+				break
+			}
+			r.rowIndex += int64(rows)
+			if insertData.GetMemorySize() >= r.bufferSize {
+				break
+			}
+			continue
+		}
 		for fieldID, cr := range r.frs {
 			data, validData, err := cr.Next(r.count)
 			if err != nil {
@@ -131,6 +151,12 @@ OUTER:
 	return insertData, nil
 }
 
+// SetRowErrorCollector enables the tolerant mode, the batches failed to read are
+// skipped and collected instead of failing the import.
+func (r *reader) SetRowErrorCollector(collector *common.RowErrorCollector) {
+	r.collector = collector
+}
+
 func (r *reader) Size() (int64, error) {
 	if size := r.fileSize.Load(); size != 0 {
 		return size, nil
@@ -43,24 +43,33 @@ func WrapTypeErr(expect string, actual string, field *schemapb.FieldSchema) erro
 }
 
 func CreateFieldReaders(ctx context.Context, fileReader *pqarrow.FileReader, schema *schemapb.CollectionSchema) (map[int64]*FieldReader, error) {
-	nameToField := lo.KeyBy(schema.GetFields(), func(field *schemapb.FieldSchema) string {
-		return field.GetName()
-	})
-
 	pqSchema, err := fileReader.Schema()
 	if err != nil {
 		return nil, merr.WrapErrImportFailed(fmt.Sprintf("get parquet schema failed, err=%v", err))
 	}
+	return CreateArrowFieldReaders(pqSchema, schema, "parquet", func(i int, field *schemapb.FieldSchema) (*FieldReader, error) {
+		return NewFieldReader(ctx, fileReader, i, field)
+	})
+}
+
+// CreateArrowFieldReaders validates the arrow schema of a file against the collection schema,
+// and creates the field readers by newFieldReader for the columns mapped to milvus fields.
+func CreateArrowFieldReaders(arrSchema *arrow.Schema, schema *schemapb.CollectionSchema, format string,
+	newFieldReader func(columnIndex int, field *schemapb.FieldSchema) (*FieldReader, error),
+) (map[int64]*FieldReader, error) {
+	nameToField := lo.KeyBy(schema.GetFields(), func(field *schemapb.FieldSchema) string {
+		return field.GetName()
+	})
 
-	err = isSchemaEqual(schema, pqSchema)
+	err := isSchemaEqual(schema, arrSchema)
 	if err != nil {
 		return nil, merr.WrapErrImportFailed(fmt.Sprintf("schema not equal, err=%v", err))
 	}
 
-	// this loop is for "how many fields are provided by this parquet file?"
+	// this loop is for "how many fields are provided by this file?"
 	readFields := make(map[string]int64)
 	crs := make(map[int64]*FieldReader)
-	for i, pqField := range pqSchema.Fields() {
+	for i, pqField := range arrSchema.Fields() {
 		field, ok := nameToField[pqField.Name]
 		if !ok {
 			// redundant fields, ignore. only accepts a special field "$meta" to store dynamic data
@@ -78,7 +87,7 @@ func CreateFieldReaders(ctx context.Context, fileReader *pqarrow.FileReader, sch
 				fmt.Sprintf("the field '%s' is output by function, no need to provide", field.GetName()))
 		}
 
-		cr, err := NewFieldReader(ctx, fileReader, i, field)
+		cr, err := newFieldReader(i, field)
 		if err != nil {
 			return nil, err
 		}
@@ -90,7 +99,7 @@ func CreateFieldReaders(ctx context.Context, fileReader *pqarrow.FileReader, sch
 		readFields[field.GetName()] = field.GetFieldID()
 	}
 
-	// this loop is for "are there any fields not provided in the parquet file?"
+	// this loop is for "are there any fields not provided in the file?"
 	for _, field := range nameToField {
 		// auto-id field, function output field already checked
 		// dynamic field, nullable field, default value field, not provided or provided both ok
@@ -101,11 +110,11 @@ func CreateFieldReaders(ctx context.Context, fileReader *pqarrow.FileReader, sch
 		// the other field must be provided
 		if _, ok := crs[field.GetFieldID()]; !ok {
 			return nil, merr.WrapErrImportFailed(
-				fmt.Sprintf("no parquet field for milvus field '%s'", field.GetName()))
+				fmt.Sprintf("no %s field for milvus field '%s'", format, field.GetName()))
 		}
 	}
 
-	log.Info("create parquet column readers", zap.Any("readFields", readFields))
+	log.Info(fmt.Sprintf("create %s column readers", format), zap.Any("readFields", readFields))
 	return crs, nil
 }
 
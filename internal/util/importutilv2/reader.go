@@ -21,10 +21,13 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/arrowipc"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/binlog"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/csv"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/json"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/numpy"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/orc"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/parquet"
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
@@ -70,13 +73,61 @@ func NewReader(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	compression, err := GetCompressionType(importFile)
+	if err != nil {
+		return nil, err
+	}
+	var dcm *common.DecompressChunkManager
+	if compression != common.CompressionNone {
+		dcm = common.NewDecompressChunkManager(cm, compression)
+		cm = dcm
+	}
+	if HasColumnOptions(options) {
+		schema, err = ApplyColumnOptions(schema, options)
+		if err != nil {
+			return nil, err
+		}
+	}
+	reader, err := newReader(ctx, cm, schema, importFile, options, bufferSize, fileType)
+	if err != nil {
+		return nil, err
+	}
+	if IsTolerant(options) || IsDryRun(options) {
+		reader, err = newTolerantReader(reader, options, fileType)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if fillValues, _ := GetFillValues(options); len(fillValues) > 0 {
+		reader = newFillReader(reader, schema)
+	}
+	if dcm != nil {
+		reader = &decompressReader{Reader: reader, cm: dcm}
+	}
+	return reader, nil
+}
+
+func newReader(ctx context.Context,
+	cm storage.ChunkManager,
+	schema *schemapb.CollectionSchema,
+	importFile *internalpb.ImportFile,
+	options Options,
+	bufferSize int,
+	fileType FileType,
+) (Reader, error) {
 	switch fileType {
 	case JSON:
 		return json.NewReader(ctx, cm, schema, importFile.GetPaths()[0], bufferSize)
+	case JSONL:
+		return json.NewJSONLReader(ctx, cm, schema, importFile.GetPaths(), bufferSize)
 	case Numpy:
 		return numpy.NewReader(ctx, cm, schema, importFile.GetPaths(), bufferSize)
 	case Parquet:
 		return parquet.NewReader(ctx, cm, schema, importFile.GetPaths()[0], bufferSize)
+	case ArrowIPC:
+		return arrowipc.NewReader(ctx, cm, schema, importFile.GetPaths()[0], bufferSize)
+	case ORC:
+		return orc.NewReader(ctx, cm, schema, importFile.GetPaths()[0], bufferSize)
 	case CSV:
 		sep, err := GetCSVSep(options)
 		if err != nil {
@@ -90,3 +141,68 @@ func NewReader(ctx context.Context,
 	}
 	return nil, merr.WrapErrImportFailed("unexpected import file")
 }
+
+// decompressReader is the reader of compressed import files,
+// the files are decompressed in a streaming way while reading.
+type decompressReader struct {
+	Reader
+	cm *common.DecompressChunkManager
+}
+
+// GetDecompressedSize returns the number of decompressed bytes read by the reader so far,
+// the second return value is false if the import files are not compressed.
+func GetDecompressedSize(reader Reader) (int64, bool) {
+	r, ok := reader.(*decompressReader)
+	if !ok {
+		return 0, false
+	}
+	return r.cm.DecompressedSize(), true
+}
+
+// tolerantReader is the reader in tolerant mode, the bad rows are skipped
+// by the underlying reader and collected into the collector.
+// The readers of columnar files (Parquet, Numpy, Arrow IPC and ORC) skip the bad batches instead of
+// the bad rows, so they only collect errors in dry-run mode, which doesn't import the data.
+type tolerantReader struct {
+	Reader
+	collector *common.RowErrorCollector
+}
+
+func newTolerantReader(reader Reader, options Options, fileType FileType) (Reader, error) {
+	maxErrorRows, err := GetMaxErrorRows(options)
+	if err != nil {
+		reader.Close()
+		return nil, err
+	}
+	if IsTolerant(options) && fileType != JSON && fileType != JSONL && fileType != CSV {
+		reader.Close()
+		return nil, merr.WrapErrImportFailed("tolerant import only supports JSON, JSON Lines and CSV files")
+	}
+	r, ok := reader.(interface {
+		SetRowErrorCollector(collector *common.RowErrorCollector)
+	})
+	if !ok {
+		// dry-run collects bad rows if the file type supports,
+		// otherwise it stops at the first bad row as the normal import does.
+		return reader, nil
+	}
+	collector := common.NewRowErrorCollector(maxErrorRows)
+	r.SetRowErrorCollector(collector)
+	return &tolerantReader{Reader: reader, collector: collector}, nil
+}
+
+// GetRowErrorCollector returns the collector of bad rows, or nil if the reader is not in tolerant mode.
+func GetRowErrorCollector(reader Reader) *common.RowErrorCollector {
+	for {
+		switch r := reader.(type) {
+		case *tolerantReader:
+			return r.collector
+		case *decompressReader:
+			reader = r.Reader
+		case *fillReader:
+			reader = r.Reader
+		default:
+			return nil
+		}
+	}
+}
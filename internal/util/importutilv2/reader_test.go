@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
@@ -99,6 +100,18 @@ func TestImportNewReader(t *testing.T) {
 	}
 	checkFunc("io error", req, options)
 
+	// accepts multiple jsonl files
+	req = &internalpb.ImportFile{
+		Paths: []string{"1.jsonl", "2.jsonl"},
+	}
+	checkFunc("io error", req, options)
+
+	// ndjson file
+	req = &internalpb.ImportFile{
+		Paths: []string{"1.ndjson"},
+	}
+	checkFunc("io error", req, options)
+
 	// accepts multiple numpy files
 	req = &internalpb.ImportFile{
 		Paths: []string{"1.npy", "2.npy"},
@@ -135,7 +148,74 @@ func TestImportNewReader(t *testing.T) {
 	}
 	checkFunc("io error", req, options)
 
+	// compressed csv file
+	req = &internalpb.ImportFile{
+		Paths: []string{"1.csv.gz"},
+	}
+	checkFunc("io error", req, options)
+
+	// compressed numpy files
+	req = &internalpb.ImportFile{
+		Paths: []string{"pk.npy.zst"},
+	}
+	checkFunc("io error", req, options)
+
+	// inconsistent compression types
+	req = &internalpb.ImportFile{
+		Paths: []string{"1.jsonl.gz", "2.jsonl.zst"},
+	}
+	checkFunc("inconsistency in compression types", req, options)
+
+	// compressed parquet is not supported
+	req = &internalpb.ImportFile{
+		Paths: []string{"1.parquet.gz"},
+	}
+	checkFunc("compressed Parquet file is not supported", req, options)
+
+	// accepts only one arrow file
+	req = &internalpb.ImportFile{
+		Paths: []string{"1.arrow", "2.feather"},
+	}
+	checkFunc("inconsistency in file types", req, options)
+	req = &internalpb.ImportFile{
+		Paths: []string{"1.feather", "2.feather"},
+	}
+	checkFunc("accepts only one file", req, options)
+
+	// arrow file
+	req = &internalpb.ImportFile{
+		Paths: []string{"1.arrows"},
+	}
+	checkFunc("io error", req, options)
+
+	// compressed arrow ipc file format is not supported, streaming format is supported
+	req = &internalpb.ImportFile{
+		Paths: []string{"1.feather.zst"},
+	}
+	checkFunc("compressed Arrow IPC file format is not supported", req, options)
+	req = &internalpb.ImportFile{
+		Paths: []string{"1.arrows.gz"},
+	}
+	checkFunc("io error", req, options)
+
+	// orc file
+	req = &internalpb.ImportFile{
+		Paths: []string{"1.orc", "2.orc"},
+	}
+	checkFunc("accepts only one file", req, options)
+	req = &internalpb.ImportFile{
+		Paths: []string{"1.orc"},
+	}
+	checkFunc("io error", req, options)
+	req = &internalpb.ImportFile{
+		Paths: []string{"1.orc.gz"},
+	}
+	checkFunc("compressed ORC file is not supported", req, options)
+
 	// illegal sep
+	req = &internalpb.ImportFile{
+		Paths: []string{"1.csv"},
+	}
 	options = []*commonpb.KeyValuePair{
 		{
 			Key:   CSVSep,
@@ -150,3 +230,12 @@ func TestImportNewReader(t *testing.T) {
 	}
 	checkFunc("unexpected file type", req, options)
 }
+
+func TestImportEstimateDecompressionMemory(t *testing.T) {
+	file := &internalpb.ImportFile{Paths: []string{"1.csv"}}
+	assert.Equal(t, int64(0), EstimateDecompressionMemory(file))
+	file = &internalpb.ImportFile{Paths: []string{"a.npy.zst", "b.npy.zst"}}
+	assert.Equal(t, 2*common.CompressionZstd.MemorySize(), EstimateDecompressionMemory(file))
+	file = &internalpb.ImportFile{Paths: []string{"a.npy.zst", "b.npy.gz"}}
+	assert.Equal(t, int64(0), EstimateDecompressionMemory(file))
+}
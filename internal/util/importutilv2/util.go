@@ -19,9 +19,11 @@ package importutilv2
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/samber/lo"
 
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 )
@@ -29,16 +31,25 @@ import (
 type FileType int
 
 const (
-	Invalid FileType = 0
-	JSON    FileType = 1
-	Numpy   FileType = 2
-	Parquet FileType = 3
-	CSV     FileType = 4
+	Invalid  FileType = 0
+	JSON     FileType = 1
+	Numpy    FileType = 2
+	Parquet  FileType = 3
+	CSV      FileType = 4
+	JSONL    FileType = 5
+	ArrowIPC FileType = 6
+	ORC      FileType = 7
 
 	JSONFileExt    = ".json"
 	NumpyFileExt   = ".npy"
 	ParquetFileExt = ".parquet"
 	CSVFileExt     = ".csv"
+	JSONLFileExt   = ".jsonl"
+	NDJSONFileExt  = ".ndjson"
+	ArrowFileExt   = ".arrow"
+	ArrowsFileExt  = ".arrows"
+	FeatherFileExt = ".feather"
+	ORCFileExt     = ".orc"
 )
 
 var FileTypeName = map[int]string{
@@ -47,6 +58,9 @@ var FileTypeName = map[int]string{
 	2: "Numpy",
 	3: "Parquet",
 	4: "CSV",
+	5: "JSONL",
+	6: "ArrowIPC",
+	7: "ORC",
 }
 
 func (f FileType) String() string {
@@ -57,8 +71,12 @@ func GetFileType(file *internalpb.ImportFile) (FileType, error) {
 	if len(file.GetPaths()) == 0 {
 		return Invalid, merr.WrapErrImportFailed("no file to import")
 	}
+	compression, err := GetCompressionType(file)
+	if err != nil {
+		return Invalid, err
+	}
 	exts := lo.Map(file.GetPaths(), func(path string, _ int) string {
-		return filepath.Ext(path)
+		return filepath.Ext(common.TrimCompressionExt(path))
 	})
 
 	ext := exts[0]
@@ -79,6 +97,10 @@ func GetFileType(file *internalpb.ImportFile) (FileType, error) {
 	case NumpyFileExt:
 		return Numpy, nil
 	case ParquetFileExt:
+		if compression != common.CompressionNone {
+			return Invalid, merr.WrapErrImportFailed("compressed Parquet file is not supported, " +
+				"please use the built-in compression of Parquet instead")
+		}
 		if len(file.GetPaths()) != 1 {
 			return Invalid, merr.WrapErrImportFailed("for Parquet import, accepts only one file")
 		}
@@ -88,6 +110,72 @@ func GetFileType(file *internalpb.ImportFile) (FileType, error) {
 			return Invalid, merr.WrapErrImportFailed("for CSV import, accepts only one file")
 		}
 		return CSV, nil
+	case JSONLFileExt, NDJSONFileExt:
+		// JSON Lines files are usually sharded, accepts multiple files
+		return JSONL, nil
+	case ArrowFileExt, ArrowsFileExt, FeatherFileExt:
+		// the file format reads the footer by random access, which is impossible on a compressed stream
+		if ext != ArrowsFileExt && compression != common.CompressionNone {
+			return Invalid, merr.WrapErrImportFailed("compressed Arrow IPC file format is not supported, " +
+				"please use the Arrow IPC streaming format (.arrows) or the built-in compression of Arrow IPC instead")
+		}
+		if len(file.GetPaths()) != 1 {
+			return Invalid, merr.WrapErrImportFailed("for Arrow IPC import, accepts only one file")
+		}
+		return ArrowIPC, nil
+	case ORCFileExt:
+		// ORC reads the footer by random access as well
+		if compression != common.CompressionNone {
+			return Invalid, merr.WrapErrImportFailed("compressed ORC file is not supported, " +
+				"please use the built-in compression of ORC instead")
+		}
+		if len(file.GetPaths()) != 1 {
+			return Invalid, merr.WrapErrImportFailed("for ORC import, accepts only one file")
+		}
+		return ORC, nil
 	}
 	return Invalid, merr.WrapErrImportFailed(fmt.Sprintf("unexpected file type, files=%v", file.GetPaths()))
 }
+
+// GetCompressionType returns the compression type of the import files,
+// all the files should be compressed in the same way.
+func GetCompressionType(file *internalpb.ImportFile) (common.CompressionType, error) {
+	if len(file.GetPaths()) == 0 {
+		return common.CompressionNone, merr.WrapErrImportFailed("no file to import")
+	}
+	compression := common.GetCompressionType(file.GetPaths()[0])
+	for i := 1; i < len(file.GetPaths()); i++ {
+		if common.GetCompressionType(file.GetPaths()[i]) != compression {
+			return common.CompressionNone, merr.WrapErrImportFailed(
+				fmt.Sprintf("inconsistency in compression types, (%s) vs (%s)",
+					file.GetPaths()[0], file.GetPaths()[i]))
+		}
+	}
+	return compression, nil
+}
+
+// EstimateDecompressionMemory returns the estimated memory held by the
+// decompressors when reading the import files, 0 if they are not compressed.
+func EstimateDecompressionMemory(file *internalpb.ImportFile) int64 {
+	compression, err := GetCompressionType(file)
+	if err != nil {
+		return 0
+	}
+	// numpy files are read simultaneously, so each file holds a decompressor
+	return compression.MemorySize() * int64(len(file.GetPaths()))
+}
+
+// GlobChars are the wildcards of glob pattern in import files, "[" and "]" are literal
+// since they are common in object names.
+const GlobChars = "*?"
+
+// IsPatternPath indicates whether the path is an object storage prefix (ends with "/")
+// or a glob pattern, which is expanded into the matched files by datacoord.
+func IsPatternPath(path string) bool {
+	return strings.HasSuffix(path, "/") || strings.ContainsAny(path, GlobChars)
+}
+
+// HasPatternPath indicates whether any path of the import file is a prefix or glob pattern.
+func HasPatternPath(file *internalpb.ImportFile) bool {
+	return lo.ContainsBy(file.GetPaths(), IsPatternPath)
+}
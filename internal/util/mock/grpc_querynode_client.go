@@ -142,6 +142,10 @@ func (m *GrpcQueryNodeClient) RunAnalyzer(ctx context.Context, in *querypb.RunAn
 	return &milvuspb.RunAnalyzerResponse{}, m.Err
 }
 
+func (m *GrpcQueryNodeClient) ExplainSegments(ctx context.Context, in *querypb.ExplainSegmentsRequest, opts ...grpc.CallOption) (*querypb.ExplainSegmentsResponse, error) {
+	return &querypb.ExplainSegmentsResponse{}, m.Err
+}
+
 func (m *GrpcQueryNodeClient) Close() error {
 	return m.Err
 }
@@ -0,0 +1,558 @@
+package reduce
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+var aggregateOpNames = map[internalpb.AggregateOp]string{
+	internalpb.AggregateOp_AggregateCount: "count",
+	internalpb.AggregateOp_AggregateSum:   "sum",
+	internalpb.AggregateOp_AggregateMin:   "min",
+	internalpb.AggregateOp_AggregateMax:   "max",
+	internalpb.AggregateOp_AggregateAvg:   "avg",
+}
+
+// ParseAggregateOp returns the aggregate op of the function name, e.g. "sum".
+func ParseAggregateOp(name string) (internalpb.AggregateOp, bool) {
+	for op, opName := range aggregateOpNames {
+		if strings.EqualFold(name, opName) {
+			return op, true
+		}
+	}
+	return 0, false
+}
+
+// AggregateName returns the output name of the aggregate, e.g. "sum(price)", fieldName is "*" for count(*).
+func AggregateName(op internalpb.AggregateOp, fieldName string) string {
+	return fmt.Sprintf("%s(%s)", aggregateOpNames[op], fieldName)
+}
+
+// IsGroupBySupported returns whether the field of dataType could be used to group the query results.
+func IsGroupBySupported(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+		schemapb.DataType_Int64, schemapb.DataType_String, schemapb.DataType_VarChar:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsAggregateSupported returns whether the aggregate op could be applied on the field of dataType.
+func IsAggregateSupported(op internalpb.AggregateOp, dataType schemapb.DataType) bool {
+	switch op {
+	case internalpb.AggregateOp_AggregateCount:
+		return !typeutil.IsVectorType(dataType)
+	case internalpb.AggregateOp_AggregateSum, internalpb.AggregateOp_AggregateAvg:
+		return typeutil.IsIntegerType(dataType) || typeutil.IsFloatingType(dataType)
+	case internalpb.AggregateOp_AggregateMin, internalpb.AggregateOp_AggregateMax:
+		return typeutil.IsIntegerType(dataType) || typeutil.IsFloatingType(dataType) || typeutil.IsStringType(dataType)
+	default:
+		return false
+	}
+}
+
+// Aggregator groups the rows by the group by fields and computes the aggregates of each group.
+// The rows with null values are skipped by the aggregates except count(*), and the null values
+// of the group by fields are considered as one group.
+//
+// The partial aggregation results exchanged between the querynodes and the proxy consist of the
+// group by columns, followed by a value column and a count column for each aggregate.
+type Aggregator struct {
+	groupByFields []*schemapb.FieldSchema
+	aggregates    []*internalpb.Aggregate
+	// aggFields[k] is the field of the k-th aggregate, nil for count(*)
+	aggFields   []*schemapb.FieldSchema
+	maxGroupNum int64
+
+	index  map[string]int
+	groups []*aggGroup
+}
+
+type aggGroup struct {
+	// keys are the values of the group by fields, nil for null
+	keys   []any
+	states []aggState
+}
+
+// aggState is the partial result of an aggregate, value is the sum, min or max of the
+// non-null values, and count is the number of them.
+type aggState struct {
+	value any
+	count int64
+}
+
+// NewAggregator creates an aggregator, maxGroupNum limits the number of groups.
+func NewAggregator(schema *schemapb.CollectionSchema, groupByFieldIDs []int64, aggregates []*internalpb.Aggregate, maxGroupNum int64) (*Aggregator, error) {
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, err
+	}
+	a := &Aggregator{
+		groupByFields: make([]*schemapb.FieldSchema, 0, len(groupByFieldIDs)),
+		aggregates:    aggregates,
+		aggFields:     make([]*schemapb.FieldSchema, len(aggregates)),
+		maxGroupNum:   maxGroupNum,
+		index:         make(map[string]int),
+	}
+	for _, fieldID := range groupByFieldIDs {
+		field, err := helper.GetFieldFromID(fieldID)
+		if err != nil {
+			return nil, err
+		}
+		a.groupByFields = append(a.groupByFields, field)
+	}
+	for k, aggregate := range aggregates {
+		if aggregate.GetFieldID() == 0 {
+			if aggregate.GetOp() != internalpb.AggregateOp_AggregateCount {
+				return nil, merr.WrapErrParameterInvalidMsg("only count supports *")
+			}
+			continue
+		}
+		field, err := helper.GetFieldFromID(aggregate.GetFieldID())
+		if err != nil {
+			return nil, err
+		}
+		a.aggFields[k] = field
+	}
+	return a, nil
+}
+
+func (a *Aggregator) getGroup(keys []any) (*aggGroup, error) {
+	key := encodeGroupKey(keys)
+	if i, ok := a.index[key]; ok {
+		return a.groups[i], nil
+	}
+	if a.maxGroupNum > 0 && int64(len(a.groups)) >= a.maxGroupNum {
+		return nil, merr.WrapErrParameterInvalidMsg("the number of groups exceeds the limit %d", a.maxGroupNum)
+	}
+	group := &aggGroup{keys: keys, states: make([]aggState, len(a.aggregates))}
+	a.index[key] = len(a.groups)
+	a.groups = append(a.groups, group)
+	return group, nil
+}
+
+// Accumulate aggregates the retrieved rows, which contain the group by fields and the aggregate fields.
+func (a *Aggregator) Accumulate(fieldsData []*schemapb.FieldData, rowNum int) error {
+	columns := make(map[int64]func(int) any, len(fieldsData))
+	for _, fieldData := range fieldsData {
+		column, _, err := newColumnReader(fieldData)
+		if err != nil {
+			return err
+		}
+		columns[fieldData.GetFieldId()] = column
+	}
+	getColumn := func(fieldID int64) (func(int) any, error) {
+		column, ok := columns[fieldID]
+		if !ok {
+			return nil, merr.WrapErrServiceInternal(fmt.Sprintf("field %d not found in the retrieve result", fieldID))
+		}
+		return column, nil
+	}
+	keyColumns := make([]func(int) any, len(a.groupByFields))
+	for i, field := range a.groupByFields {
+		column, err := getColumn(field.GetFieldID())
+		if err != nil {
+			return err
+		}
+		keyColumns[i] = column
+	}
+	aggColumns := make([]func(int) any, len(a.aggregates))
+	for k, field := range a.aggFields {
+		if field == nil {
+			continue
+		}
+		column, err := getColumn(field.GetFieldID())
+		if err != nil {
+			return err
+		}
+		aggColumns[k] = column
+	}
+
+	for row := 0; row < rowNum; row++ {
+		keys := make([]any, len(keyColumns))
+		for i, column := range keyColumns {
+			keys[i] = column(row)
+		}
+		group, err := a.getGroup(keys)
+		if err != nil {
+			return err
+		}
+		for k, aggregate := range a.aggregates {
+			state := &group.states[k]
+			if aggColumns[k] == nil {
+				state.count++
+				continue
+			}
+			value := aggColumns[k](row)
+			if value == nil {
+				continue
+			}
+			state.count++
+			switch aggregate.GetOp() {
+			case internalpb.AggregateOp_AggregateSum:
+				state.value = addValue(state.value, value)
+			case internalpb.AggregateOp_AggregateAvg:
+				state.value = addValue(state.value, toFloat64(value))
+			case internalpb.AggregateOp_AggregateMin, internalpb.AggregateOp_AggregateMax:
+				state.value = pickValue(aggregate.GetOp(), state.value, value)
+			}
+		}
+	}
+	return nil
+}
+
+// Merge merges the partial aggregation results.
+func (a *Aggregator) Merge(fieldsData []*schemapb.FieldData) error {
+	if len(fieldsData) == 0 {
+		return nil
+	}
+	if len(fieldsData) != len(a.groupByFields)+2*len(a.aggregates) {
+		return merr.WrapErrServiceInternal(fmt.Sprintf("invalid partial aggregation result with %d columns", len(fieldsData)))
+	}
+	var rowNum int
+	columns := make([]func(int) any, len(fieldsData))
+	for i, fieldData := range fieldsData {
+		column, length, err := newColumnReader(fieldData)
+		if err != nil {
+			return err
+		}
+		columns[i], rowNum = column, length
+	}
+	keyColumns, aggColumns := columns[:len(a.groupByFields)], columns[len(a.groupByFields):]
+
+	for row := 0; row < rowNum; row++ {
+		keys := make([]any, len(keyColumns))
+		for i, column := range keyColumns {
+			keys[i] = column(row)
+		}
+		group, err := a.getGroup(keys)
+		if err != nil {
+			return err
+		}
+		for k, aggregate := range a.aggregates {
+			state := &group.states[k]
+			value, count := aggColumns[2*k](row), aggColumns[2*k+1](row)
+			if count != nil {
+				state.count += count.(int64)
+			}
+			if value == nil {
+				continue
+			}
+			switch aggregate.GetOp() {
+			case internalpb.AggregateOp_AggregateSum, internalpb.AggregateOp_AggregateAvg:
+				state.value = addValue(state.value, value)
+			case internalpb.AggregateOp_AggregateMin, internalpb.AggregateOp_AggregateMax:
+				state.value = pickValue(aggregate.GetOp(), state.value, value)
+			}
+		}
+	}
+	return nil
+}
+
+// GroupNum returns the number of groups.
+func (a *Aggregator) GroupNum() int {
+	return len(a.groups)
+}
+
+// PartialFieldsData returns the partial aggregation results to be merged by Merge.
+func (a *Aggregator) PartialFieldsData() []*schemapb.FieldData {
+	fieldsData := a.groupByFieldsData(a.groups)
+	for k, aggregate := range a.aggregates {
+		name := a.aggregateName(k)
+		valueColumn := newColumn(name, a.aggregateType(k), true, len(a.groups))
+		countColumn := newColumn(name+".count", schemapb.DataType_Int64, false, len(a.groups))
+		for _, group := range a.groups {
+			state := group.states[k]
+			value := state.value
+			if aggregate.GetOp() == internalpb.AggregateOp_AggregateCount {
+				value = state.count
+			}
+			appendValue(valueColumn, value)
+			appendValue(countColumn, state.count)
+		}
+		fieldsData = append(fieldsData, valueColumn, countColumn)
+	}
+	return fieldsData
+}
+
+// Results returns the group by columns followed by the aggregate columns. The groups are ordered
+// by the group by fields with nulls last, and at most limit groups after offset are returned.
+func (a *Aggregator) Results(offset, limit int64) []*schemapb.FieldData {
+	groups := a.groups
+	if len(a.groupByFields) == 0 && len(groups) == 0 {
+		// the aggregates over no rows
+		groups = []*aggGroup{{states: make([]aggState, len(a.aggregates))}}
+	}
+	groups = append([]*aggGroup{}, groups...)
+	sort.SliceStable(groups, func(i, j int) bool {
+		return compareGroupKeys(groups[i].keys, groups[j].keys) < 0
+	})
+	if offset >= int64(len(groups)) {
+		groups = nil
+	} else {
+		groups = groups[offset:]
+	}
+	if limit != typeutil.Unlimited && int64(len(groups)) > limit {
+		groups = groups[:limit]
+	}
+
+	fieldsData := a.groupByFieldsData(groups)
+	for k, aggregate := range a.aggregates {
+		op := aggregate.GetOp()
+		column := newColumn(a.aggregateName(k), a.aggregateType(k), op != internalpb.AggregateOp_AggregateCount, len(groups))
+		for _, group := range groups {
+			state := group.states[k]
+			switch {
+			case op == internalpb.AggregateOp_AggregateCount:
+				appendValue(column, state.count)
+			case state.count == 0:
+				appendValue(column, nil)
+			case op == internalpb.AggregateOp_AggregateAvg:
+				appendValue(column, state.value.(float64)/float64(state.count))
+			default:
+				appendValue(column, state.value)
+			}
+		}
+		fieldsData = append(fieldsData, column)
+	}
+	return fieldsData
+}
+
+func (a *Aggregator) groupByFieldsData(groups []*aggGroup) []*schemapb.FieldData {
+	fieldsData := make([]*schemapb.FieldData, 0, len(a.groupByFields)+2*len(a.aggregates))
+	for i, field := range a.groupByFields {
+		column := newColumn(field.GetName(), field.GetDataType(), field.GetNullable(), len(groups))
+		column.FieldId = field.GetFieldID()
+		for _, group := range groups {
+			appendValue(column, group.keys[i])
+		}
+		fieldsData = append(fieldsData, column)
+	}
+	return fieldsData
+}
+
+func (a *Aggregator) aggregateName(k int) string {
+	if a.aggFields[k] == nil {
+		return AggregateName(a.aggregates[k].GetOp(), "*")
+	}
+	return AggregateName(a.aggregates[k].GetOp(), a.aggFields[k].GetName())
+}
+
+// aggregateType returns the data type of the k-th aggregate result.
+func (a *Aggregator) aggregateType(k int) schemapb.DataType {
+	switch a.aggregates[k].GetOp() {
+	case internalpb.AggregateOp_AggregateCount:
+		return schemapb.DataType_Int64
+	case internalpb.AggregateOp_AggregateAvg:
+		return schemapb.DataType_Double
+	case internalpb.AggregateOp_AggregateSum:
+		if typeutil.IsIntegerType(a.aggFields[k].GetDataType()) {
+			return schemapb.DataType_Int64
+		}
+		return schemapb.DataType_Double
+	default:
+		return a.aggFields[k].GetDataType()
+	}
+}
+
+// newColumnReader returns the reader and the row number of the column, the reader returns
+// the value of the row as bool, int64, float64 or string, and nil for null.
+func newColumnReader(fieldData *schemapb.FieldData) (func(int) any, int, error) {
+	var (
+		get    func(int) any
+		length int
+	)
+	switch data := fieldData.GetScalars().GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		get, length = func(i int) any { return data.BoolData.GetData()[i] }, len(data.BoolData.GetData())
+	case *schemapb.ScalarField_IntData:
+		get, length = func(i int) any { return int64(data.IntData.GetData()[i]) }, len(data.IntData.GetData())
+	case *schemapb.ScalarField_LongData:
+		get, length = func(i int) any { return data.LongData.GetData()[i] }, len(data.LongData.GetData())
+	case *schemapb.ScalarField_FloatData:
+		get, length = func(i int) any { return float64(data.FloatData.GetData()[i]) }, len(data.FloatData.GetData())
+	case *schemapb.ScalarField_DoubleData:
+		get, length = func(i int) any { return data.DoubleData.GetData()[i] }, len(data.DoubleData.GetData())
+	case *schemapb.ScalarField_StringData:
+		get, length = func(i int) any { return data.StringData.GetData()[i] }, len(data.StringData.GetData())
+	case *schemapb.ScalarField_JsonData:
+		// only the validity is used by count
+		get, length = func(i int) any { return true }, len(data.JsonData.GetData())
+	case *schemapb.ScalarField_ArrayData:
+		get, length = func(i int) any { return true }, len(data.ArrayData.GetData())
+	default:
+		return nil, 0, merr.WrapErrParameterInvalidMsg("field %s of type %s could not be aggregated",
+			fieldData.GetFieldName(), fieldData.GetType().String())
+	}
+
+	valid := fieldData.GetValidData()
+	if len(valid) == 0 {
+		return get, length, nil
+	}
+	if length >= len(valid) {
+		return func(i int) any {
+			if !valid[i] {
+				return nil
+			}
+			return get(i)
+		}, len(valid), nil
+	}
+	// the column only contains the valid values
+	idxs := make([]int, len(valid))
+	validCnt := 0
+	for i, v := range valid {
+		idxs[i] = validCnt
+		if v {
+			validCnt++
+		}
+	}
+	return func(i int) any {
+		if !valid[i] {
+			return nil
+		}
+		return get(idxs[i])
+	}, len(valid), nil
+}
+
+func newColumn(name string, dataType schemapb.DataType, nullable bool, capacity int) *schemapb.FieldData {
+	fieldData := &schemapb.FieldData{
+		Type:      dataType,
+		FieldName: name,
+	}
+	scalars := &schemapb.ScalarField{}
+	switch dataType {
+	case schemapb.DataType_Bool:
+		scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: make([]bool, 0, capacity)}}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: make([]int32, 0, capacity)}}
+	case schemapb.DataType_Int64:
+		scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: make([]int64, 0, capacity)}}
+	case schemapb.DataType_Float:
+		scalars.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: make([]float32, 0, capacity)}}
+	case schemapb.DataType_Double:
+		scalars.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: make([]float64, 0, capacity)}}
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		scalars.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: make([]string, 0, capacity)}}
+	}
+	fieldData.Field = &schemapb.FieldData_Scalars{Scalars: scalars}
+	if nullable {
+		fieldData.ValidData = make([]bool, 0, capacity)
+	}
+	return fieldData
+}
+
+// appendValue appends the value to the column created by newColumn, nil is appended as the zero value
+// with the valid flag unset.
+func appendValue(fieldData *schemapb.FieldData, value any) {
+	if fieldData.ValidData != nil {
+		fieldData.ValidData = append(fieldData.ValidData, value != nil)
+	}
+	switch data := fieldData.GetScalars().GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		v, _ := value.(bool)
+		data.BoolData.Data = append(data.BoolData.Data, v)
+	case *schemapb.ScalarField_IntData:
+		v, _ := value.(int64)
+		data.IntData.Data = append(data.IntData.Data, int32(v))
+	case *schemapb.ScalarField_LongData:
+		v, _ := value.(int64)
+		data.LongData.Data = append(data.LongData.Data, v)
+	case *schemapb.ScalarField_FloatData:
+		v, _ := value.(float64)
+		data.FloatData.Data = append(data.FloatData.Data, float32(v))
+	case *schemapb.ScalarField_DoubleData:
+		v, _ := value.(float64)
+		data.DoubleData.Data = append(data.DoubleData.Data, v)
+	case *schemapb.ScalarField_StringData:
+		v, _ := value.(string)
+		data.StringData.Data = append(data.StringData.Data, v)
+	}
+}
+
+func addValue(sum, value any) any {
+	switch v := value.(type) {
+	case int64:
+		s, _ := sum.(int64)
+		return s + v
+	case float64:
+		s, _ := sum.(float64)
+		return s + v
+	default:
+		return sum
+	}
+}
+
+func toFloat64(value any) any {
+	if v, ok := value.(int64); ok {
+		return float64(v)
+	}
+	return value
+}
+
+func pickValue(op internalpb.AggregateOp, current, value any) any {
+	if current == nil {
+		return value
+	}
+	result := compareValue(value, current)
+	if (op == internalpb.AggregateOp_AggregateMin && result < 0) || (op == internalpb.AggregateOp_AggregateMax && result > 0) {
+		return value
+	}
+	return current
+}
+
+// compareGroupKeys compares the group keys in ascending order with nulls last.
+func compareGroupKeys(a, b []any) int {
+	for i := range a {
+		switch {
+		case a[i] == nil && b[i] == nil:
+			continue
+		case a[i] == nil:
+			return 1
+		case b[i] == nil:
+			return -1
+		}
+		if result := compareValue(a[i], b[i]); result != 0 {
+			return result
+		}
+	}
+	return 0
+}
+
+func encodeGroupKey(keys []any) string {
+	var sb strings.Builder
+	buf := make([]byte, binary.MaxVarintLen64)
+	for _, key := range keys {
+		switch v := key.(type) {
+		case nil:
+			sb.WriteByte(0)
+		case bool:
+			sb.WriteByte(1)
+			if v {
+				sb.WriteByte(1)
+			} else {
+				sb.WriteByte(0)
+			}
+		case int64:
+			sb.WriteByte(2)
+			sb.Write(binary.BigEndian.AppendUint64(buf[:0], uint64(v)))
+		case float64:
+			sb.WriteByte(3)
+			sb.Write(binary.BigEndian.AppendUint64(buf[:0], math.Float64bits(v)))
+		case string:
+			sb.WriteByte(4)
+			sb.Write(binary.AppendUvarint(buf[:0], uint64(len(v))))
+			sb.WriteString(v)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,129 @@
+package reduce
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func newAggregationTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "tenant", DataType: schemapb.DataType_VarChar, Nullable: true},
+			{FieldID: 102, Name: "price", DataType: schemapb.DataType_Int32, Nullable: true},
+		},
+	}
+}
+
+func newAggregationTestFieldsData(tenants []string, tenantValid []bool, prices []int32, priceValid []bool) []*schemapb.FieldData {
+	return []*schemapb.FieldData{
+		{
+			FieldId:   101,
+			Type:      schemapb.DataType_VarChar,
+			ValidData: tenantValid,
+			Field:     &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: tenants}}}},
+		},
+		{
+			FieldId:   102,
+			Type:      schemapb.DataType_Int32,
+			ValidData: priceValid,
+			Field:     &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: prices}}}},
+		},
+	}
+}
+
+func TestAggregator(t *testing.T) {
+	schema := newAggregationTestSchema()
+	aggregates := []*internalpb.Aggregate{
+		{Op: internalpb.AggregateOp_AggregateCount},
+		{Op: internalpb.AggregateOp_AggregateCount, FieldID: 102},
+		{Op: internalpb.AggregateOp_AggregateSum, FieldID: 102},
+		{Op: internalpb.AggregateOp_AggregateMin, FieldID: 102},
+		{Op: internalpb.AggregateOp_AggregateMax, FieldID: 102},
+		{Op: internalpb.AggregateOp_AggregateAvg, FieldID: 102},
+	}
+
+	// two segments
+	partials := make([][]*schemapb.FieldData, 0)
+	for _, fieldsData := range [][]*schemapb.FieldData{
+		newAggregationTestFieldsData([]string{"a", "b", "a", ""}, []bool{true, true, true, false}, []int32{1, 2, 0, 4}, []bool{true, true, false, true}),
+		// the price column only contains the valid values
+		newAggregationTestFieldsData([]string{"b", "a", "c"}, []bool{true, true, true}, []int32{6, 5}, []bool{true, true, false}),
+	} {
+		a, err := NewAggregator(schema, []int64{101}, aggregates, 10)
+		require.NoError(t, err)
+		require.NoError(t, a.Accumulate(fieldsData, len(fieldsData[0].GetValidData())))
+		partials = append(partials, a.PartialFieldsData())
+	}
+
+	// merge on the delegator and then on the proxy
+	a, err := NewAggregator(schema, []int64{101}, aggregates, 10)
+	require.NoError(t, err)
+	for _, partial := range partials {
+		require.NoError(t, a.Merge(partial))
+	}
+	merged := a.PartialFieldsData()
+	a, err = NewAggregator(schema, []int64{101}, aggregates, 10)
+	require.NoError(t, err)
+	require.NoError(t, a.Merge(merged))
+	assert.Equal(t, 4, a.GroupNum())
+
+	results := a.Results(0, typeutil.Unlimited)
+	require.Len(t, results, 7)
+	assert.Equal(t, "tenant", results[0].GetFieldName())
+	assert.Equal(t, []string{"a", "b", "c", ""}, results[0].GetScalars().GetStringData().GetData())
+	assert.Equal(t, []bool{true, true, true, false}, results[0].GetValidData())
+	assert.Equal(t, "count(*)", results[1].GetFieldName())
+	assert.Equal(t, []int64{3, 2, 1, 1}, results[1].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []int64{2, 2, 0, 1}, results[2].GetScalars().GetLongData().GetData())
+	assert.Equal(t, "sum(price)", results[3].GetFieldName())
+	assert.Equal(t, []int64{6, 8, 0, 4}, results[3].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []bool{true, true, false, true}, results[3].GetValidData())
+	assert.Equal(t, []int32{1, 2, 0, 4}, results[4].GetScalars().GetIntData().GetData())
+	assert.Equal(t, []int32{5, 6, 0, 4}, results[5].GetScalars().GetIntData().GetData())
+	assert.Equal(t, []float64{3, 4, 0, 4}, results[6].GetScalars().GetDoubleData().GetData())
+
+	results = a.Results(1, 2)
+	assert.Equal(t, []string{"b", "c"}, results[0].GetScalars().GetStringData().GetData())
+
+	// exceed the limit of groups
+	a, err = NewAggregator(schema, []int64{101}, aggregates, 3)
+	require.NoError(t, err)
+	for _, partial := range partials {
+		err = a.Merge(partial)
+	}
+	assert.Error(t, err)
+}
+
+func TestAggregatorWithoutGroupBy(t *testing.T) {
+	schema := newAggregationTestSchema()
+	aggregates := []*internalpb.Aggregate{
+		{Op: internalpb.AggregateOp_AggregateCount},
+		{Op: internalpb.AggregateOp_AggregateSum, FieldID: 102},
+	}
+	a, err := NewAggregator(schema, nil, aggregates, 10)
+	require.NoError(t, err)
+	results := a.Results(0, typeutil.Unlimited)
+	assert.Equal(t, []int64{0}, results[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []bool{false}, results[1].GetValidData())
+
+	fieldsData := newAggregationTestFieldsData([]string{"a", "b"}, nil, []int32{1, 2}, nil)
+	require.NoError(t, a.Accumulate(fieldsData, 2))
+	results = a.Results(0, typeutil.Unlimited)
+	assert.Equal(t, []int64{2}, results[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []int64{3}, results[1].GetScalars().GetLongData().GetData())
+
+	_, err = NewAggregator(schema, nil, []*internalpb.Aggregate{{Op: internalpb.AggregateOp_AggregateSum}}, 10)
+	assert.Error(t, err)
+	assert.True(t, IsAggregateSupported(internalpb.AggregateOp_AggregateMax, schemapb.DataType_VarChar))
+	assert.False(t, IsAggregateSupported(internalpb.AggregateOp_AggregateSum, schemapb.DataType_VarChar))
+	op, ok := ParseAggregateOp("AVG")
+	assert.True(t, ok)
+	assert.Equal(t, "avg(price)", AggregateName(op, "price"))
+}
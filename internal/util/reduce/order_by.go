@@ -0,0 +1,278 @@
+package reduce
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// RetrieveResult is the retrieve result to be ordered, e.g. segcorepb.RetrieveResults or internalpb.RetrieveResults.
+type RetrieveResult interface {
+	GetIds() *schemapb.IDs
+	GetFieldsData() []*schemapb.FieldData
+}
+
+// RowRef refers to the row at Offset of the Result-th retrieve result.
+type RowRef struct {
+	Result int
+	Offset int64
+}
+
+// IsOrderBySupported returns whether the field of dataType could be used to order the query results.
+func IsOrderBySupported(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+		schemapb.DataType_Int64, schemapb.DataType_Float, schemapb.DataType_Double,
+		schemapb.DataType_String, schemapb.DataType_VarChar:
+		return true
+	default:
+		return false
+	}
+}
+
+// SelectOrderedRows selects at most limit rows of the retrieve results ordered by the order by fields,
+// the rows with the same order by values are ordered by the primary keys.
+// Only the row with the largest timestamp is kept if the primary key is duplicated, then each result is
+// sorted and truncated to the top limit rows, and finally the results are merged by a heap.
+func SelectOrderedRows[T RetrieveResult](results []T, orderByFields []*internalpb.OrderByField, limit int64) ([]RowRef, error) {
+	cmp, err := newRowComparator(results, orderByFields)
+	if err != nil {
+		return nil, err
+	}
+
+	// dedup by the primary keys
+	type version struct {
+		row RowRef
+		ts  int64
+	}
+	latest := make(map[any]version)
+	for i, result := range results {
+		timestamps := getTimestamps(result)
+		for j := int64(0); j < int64(typeutil.GetSizeOfIDs(result.GetIds())); j++ {
+			pk := typeutil.GetPK(result.GetIds(), j)
+			var ts int64
+			if j < int64(len(timestamps)) {
+				ts = timestamps[j]
+			}
+			if v, ok := latest[pk]; !ok || ts > v.ts {
+				latest[pk] = version{row: RowRef{Result: i, Offset: j}, ts: ts}
+			}
+		}
+	}
+	sorted := make([][]RowRef, len(results))
+	for _, v := range latest {
+		sorted[v.row.Result] = append(sorted[v.row.Result], v.row)
+	}
+
+	// per-result top-K
+	for i := range sorted {
+		rows := sorted[i]
+		sort.Slice(rows, func(a, b int) bool {
+			return cmp.compare(rows[a], rows[b]) < 0
+		})
+		if limit != typeutil.Unlimited && int64(len(rows)) > limit {
+			sorted[i] = rows[:limit]
+		}
+	}
+
+	// merge the sorted results
+	h := &rowHeap{cmp: cmp, sorted: sorted}
+	for i := range sorted {
+		if len(sorted[i]) > 0 {
+			h.cursors = append(h.cursors, rowCursor{result: i})
+		}
+	}
+	heap.Init(h)
+	selected := make([]RowRef, 0)
+	for h.Len() > 0 && (limit == typeutil.Unlimited || int64(len(selected)) < limit) {
+		cursor := &h.cursors[0]
+		selected = append(selected, sorted[cursor.result][cursor.pos])
+		cursor.pos++
+		if cursor.pos >= len(sorted[cursor.result]) {
+			heap.Pop(h)
+		} else {
+			heap.Fix(h, 0)
+		}
+	}
+	return selected, nil
+}
+
+func getTimestamps[T RetrieveResult](result T) []int64 {
+	for _, fieldData := range result.GetFieldsData() {
+		if fieldData.GetFieldId() == common.TimeStampField {
+			return fieldData.GetScalars().GetLongData().GetData()
+		}
+	}
+	return nil
+}
+
+type rowCursor struct {
+	result int
+	pos    int
+}
+
+type rowHeap struct {
+	cmp     *rowComparator
+	sorted  [][]RowRef
+	cursors []rowCursor
+}
+
+func (h *rowHeap) Len() int {
+	return len(h.cursors)
+}
+
+func (h *rowHeap) Less(i, j int) bool {
+	a, b := h.cursors[i], h.cursors[j]
+	return h.cmp.compare(h.sorted[a.result][a.pos], h.sorted[b.result][b.pos]) < 0
+}
+
+func (h *rowHeap) Swap(i, j int) {
+	h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i]
+}
+
+func (h *rowHeap) Push(x any) {
+	h.cursors = append(h.cursors, x.(rowCursor))
+}
+
+func (h *rowHeap) Pop() any {
+	n := len(h.cursors)
+	x := h.cursors[n-1]
+	h.cursors = h.cursors[:n-1]
+	return x
+}
+
+// orderByColumn is the column of an order by field in a retrieve result.
+type orderByColumn struct {
+	valid []bool
+	value func(idx int64) any
+}
+
+func (c *orderByColumn) isNull(idx int64) bool {
+	return len(c.valid) > 0 && !c.valid[idx]
+}
+
+func newOrderByColumn(fieldData *schemapb.FieldData) (*orderByColumn, error) {
+	column := &orderByColumn{valid: fieldData.GetValidData()}
+	switch data := fieldData.GetScalars().GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		column.value = func(idx int64) any { return data.BoolData.GetData()[idx] }
+	case *schemapb.ScalarField_IntData:
+		column.value = func(idx int64) any { return int64(data.IntData.GetData()[idx]) }
+	case *schemapb.ScalarField_LongData:
+		column.value = func(idx int64) any { return data.LongData.GetData()[idx] }
+	case *schemapb.ScalarField_FloatData:
+		column.value = func(idx int64) any { return float64(data.FloatData.GetData()[idx]) }
+	case *schemapb.ScalarField_DoubleData:
+		column.value = func(idx int64) any { return data.DoubleData.GetData()[idx] }
+	case *schemapb.ScalarField_StringData:
+		column.value = func(idx int64) any { return data.StringData.GetData()[idx] }
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg(fmt.Sprintf("field %d of type %s could not be used to order the results",
+			fieldData.GetFieldId(), fieldData.GetType().String()))
+	}
+	return column, nil
+}
+
+type rowComparator struct {
+	orderByFields []*internalpb.OrderByField
+	// columns[i][k] is the column of the k-th order by field in the i-th result
+	columns [][]*orderByColumn
+	ids     []*schemapb.IDs
+}
+
+func newRowComparator[T RetrieveResult](results []T, orderByFields []*internalpb.OrderByField) (*rowComparator, error) {
+	cmp := &rowComparator{
+		orderByFields: orderByFields,
+		columns:       make([][]*orderByColumn, len(results)),
+		ids:           make([]*schemapb.IDs, len(results)),
+	}
+	for i, result := range results {
+		cmp.ids[i] = result.GetIds()
+		if typeutil.GetSizeOfIDs(result.GetIds()) == 0 {
+			continue
+		}
+		fieldsData := make(map[int64]*schemapb.FieldData, len(result.GetFieldsData()))
+		for _, fieldData := range result.GetFieldsData() {
+			fieldsData[fieldData.GetFieldId()] = fieldData
+		}
+		cmp.columns[i] = make([]*orderByColumn, len(orderByFields))
+		for k, field := range orderByFields {
+			fieldData, ok := fieldsData[field.GetFieldID()]
+			if !ok {
+				return nil, merr.WrapErrServiceInternal(fmt.Sprintf("order by field %d not found in the retrieve result", field.GetFieldID()))
+			}
+			column, err := newOrderByColumn(fieldData)
+			if err != nil {
+				return nil, err
+			}
+			cmp.columns[i][k] = column
+		}
+	}
+	return cmp, nil
+}
+
+func (c *rowComparator) compare(a, b RowRef) int {
+	for k, field := range c.orderByFields {
+		colA, colB := c.columns[a.Result][k], c.columns[b.Result][k]
+		nullA, nullB := colA.isNull(a.Offset), colB.isNull(b.Offset)
+		switch {
+		case nullA && nullB:
+			continue
+		case nullA || nullB:
+			// the nulls are placed regardless of the sort direction
+			if nullA == field.GetNullsFirst() {
+				return -1
+			}
+			return 1
+		}
+		result := compareValue(colA.value(a.Offset), colB.value(b.Offset))
+		if !field.GetAscending() {
+			result = -result
+		}
+		if result != 0 {
+			return result
+		}
+	}
+	return compareValue(typeutil.GetPK(c.ids[a.Result], a.Offset), typeutil.GetPK(c.ids[b.Result], b.Offset))
+}
+
+func compareValue(a, b any) int {
+	switch va := a.(type) {
+	case bool:
+		vb := b.(bool)
+		switch {
+		case va == vb:
+			return 0
+		case !va:
+			return -1
+		default:
+			return 1
+		}
+	case int64:
+		return compareOrdered(va, b.(int64))
+	case float64:
+		return compareOrdered(va, b.(float64))
+	case string:
+		return strings.Compare(va, b.(string))
+	default:
+		return 0
+	}
+}
+
+func compareOrdered[T int64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
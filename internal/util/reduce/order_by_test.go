@@ -0,0 +1,75 @@
+package reduce
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func newOrderByTestResult(pks []int64, ages []int64, ageValid []bool, names []string, timestamps []int64) *internalpb.RetrieveResults {
+	return &internalpb.RetrieveResults{
+		Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+		FieldsData: []*schemapb.FieldData{
+			{
+				FieldId:   101,
+				Type:      schemapb.DataType_Int64,
+				ValidData: ageValid,
+				Field:     &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: ages}}}},
+			},
+			{
+				FieldId: 102,
+				Type:    schemapb.DataType_VarChar,
+				Field:   &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: names}}}},
+			},
+			{
+				FieldId: common.TimeStampField,
+				Type:    schemapb.DataType_Int64,
+				Field:   &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: timestamps}}}},
+			},
+		},
+	}
+}
+
+func TestSelectOrderedRows(t *testing.T) {
+	results := []*internalpb.RetrieveResults{
+		newOrderByTestResult([]int64{1, 2, 3}, []int64{30, 0, 20}, []bool{true, false, true}, []string{"a", "b", "c"}, []int64{10, 10, 10}),
+		newOrderByTestResult([]int64{4, 5, 1}, []int64{20, 40, 10}, []bool{true, true, true}, []string{"d", "e", "a"}, []int64{10, 10, 20}),
+		newOrderByTestResult(nil, nil, nil, nil, nil),
+	}
+	pksOf := func(rows []RowRef) []int64 {
+		pks := make([]int64, 0, len(rows))
+		for _, row := range rows {
+			pks = append(pks, typeutil.GetPK(results[row.Result].GetIds(), row.Offset).(int64))
+		}
+		return pks
+	}
+
+	cases := []struct {
+		name   string
+		fields []*internalpb.OrderByField
+		limit  int64
+		pks    []int64
+	}{
+		// pk 1 is updated to age 10 in the second result
+		{"asc nulls last", []*internalpb.OrderByField{{FieldID: 101, Ascending: true}}, typeutil.Unlimited, []int64{1, 3, 4, 5, 2}},
+		{"desc nulls first", []*internalpb.OrderByField{{FieldID: 101, NullsFirst: true}}, typeutil.Unlimited, []int64{2, 5, 3, 4, 1}},
+		{"limit", []*internalpb.OrderByField{{FieldID: 101}}, 2, []int64{5, 3}},
+		{"multiple fields", []*internalpb.OrderByField{{FieldID: 101, Ascending: true}, {FieldID: 102}}, 3, []int64{1, 4, 3}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rows, err := SelectOrderedRows(results, c.fields, c.limit)
+			require.NoError(t, err)
+			assert.Equal(t, c.pks, pksOf(rows))
+		})
+	}
+
+	_, err := SelectOrderedRows(results, []*internalpb.OrderByField{{FieldID: 103}}, 10)
+	assert.Error(t, err)
+}
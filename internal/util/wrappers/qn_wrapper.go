@@ -160,6 +160,10 @@ func (qn *qnServerWrapper) RunAnalyzer(ctx context.Context, in *querypb.RunAnaly
 	return qn.QueryNode.RunAnalyzer(ctx, in)
 }
 
+func (qn *qnServerWrapper) ExplainSegments(ctx context.Context, in *querypb.ExplainSegmentsRequest, _ ...grpc.CallOption) (*querypb.ExplainSegmentsResponse, error) {
+	return qn.QueryNode.ExplainSegments(ctx, in)
+}
+
 func WrapQueryNodeServerAsClient(qn types.QueryNode) types.QueryNodeClient {
 	return &qnServerWrapper{
 		QueryNode: qn,
@@ -0,0 +1,38 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// FunctionEmbeddingCacheCounter counts the hit and miss of text embedding cache,
+	// which is used by the functions running on both proxy and datanode.
+	FunctionEmbeddingCacheCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "function",
+			Name:      "embedding_cache_count",
+			Help:      "count of text embedding cache hits and misses",
+		}, []string{nodeIDLabelName, functionProvider, cacheStateLabelName})
+)
+
+// RegisterFunctionMetrics registers function metrics
+func RegisterFunctionMetrics(registry *prometheus.Registry) {
+	registry.MustRegister(FunctionEmbeddingCacheCounter)
+}
@@ -127,6 +127,7 @@ const (
 	cgoNameLabelName         = `cgo_name`
 	cgoTypeLabelName         = `cgo_type`
 	queueTypeLabelName       = `queue_type`
+	accessLogSinkLabelName   = "sink_name"
 
 	// model function/UDF labels
 	functionTypeName = "function_type_name"
@@ -54,6 +54,30 @@ var (
 			Name:      "op_count",
 			Help:      "count of persistent data operation",
 		}, []string{persistentDataOpType, statusLabelName})
+
+	DiskCacheAccessCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "disk_cache_access_count",
+			Help:      "count of the remote object reads served by the local disk cache, labeled by hit or miss",
+		}, []string{cacheNameLabelName, cacheStateLabelName})
+
+	DiskCacheEvictionCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "disk_cache_eviction_count",
+			Help:      "count of the objects evicted from the local disk cache",
+		}, []string{cacheNameLabelName})
+
+	DiskCacheSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "disk_cache_size",
+			Help:      "bytes of the objects cached on the local disk",
+		}, []string{cacheNameLabelName})
 )
 
 // RegisterStorageMetrics registers storage metrics
@@ -61,4 +85,7 @@ func RegisterStorageMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(PersistentDataKvSize)
 	registry.MustRegister(PersistentDataRequestLatency)
 	registry.MustRegister(PersistentDataOpCounter)
+	registry.MustRegister(DiskCacheAccessCounter)
+	registry.MustRegister(DiskCacheEvictionCounter)
+	registry.MustRegister(DiskCacheSize)
 }
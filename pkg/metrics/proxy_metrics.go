@@ -454,6 +454,15 @@ var (
 			Help:      "latency of function call",
 			Buckets:   buckets,
 		}, []string{nodeIDLabelName, collectionName, functionTypeName, functionProvider, functionName})
+
+	// ProxyAccessLogSinkDroppedCounter counts the access logs dropped by sinks
+	ProxyAccessLogSinkDroppedCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "access_log_sink_dropped_count",
+			Help:      "count of access logs dropped by sink because of full buffer or export failure",
+		}, []string{nodeIDLabelName, accessLogSinkLabelName})
 )
 
 // RegisterProxy registers Proxy metrics
@@ -522,6 +531,7 @@ func RegisterProxy(registry *prometheus.Registry) {
 	registry.MustRegister(ProxyParseExpressionLatency)
 
 	registry.MustRegister(ProxyFunctionlatency)
+	registry.MustRegister(ProxyAccessLogSinkDroppedCounter)
 
 	RegisterStreamingServiceClient(registry)
 }
@@ -70,6 +70,10 @@ const (
 	DataCoord_ImportV2_FullMethodName                    = "/milvus.proto.data.DataCoord/ImportV2"
 	DataCoord_GetImportProgress_FullMethodName           = "/milvus.proto.data.DataCoord/GetImportProgress"
 	DataCoord_ListImports_FullMethodName                 = "/milvus.proto.data.DataCoord/ListImports"
+	DataCoord_ExportV2_FullMethodName                    = "/milvus.proto.data.DataCoord/ExportV2"
+	DataCoord_GetExportProgress_FullMethodName           = "/milvus.proto.data.DataCoord/GetExportProgress"
+	DataCoord_ListExports_FullMethodName                 = "/milvus.proto.data.DataCoord/ListExports"
+	DataCoord_CancelExport_FullMethodName                = "/milvus.proto.data.DataCoord/CancelExport"
 )
 
 // DataCoordClient is the client API for DataCoord service.
@@ -130,6 +134,10 @@ type DataCoordClient interface {
 	ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal, opts ...grpc.CallOption) (*internalpb.ImportResponse, error)
 	GetImportProgress(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error)
 	ListImports(ctx context.Context, in *internalpb.ListImportsRequestInternal, opts ...grpc.CallOption) (*internalpb.ListImportsResponse, error)
+	ExportV2(ctx context.Context, in *internalpb.ExportRequestInternal, opts ...grpc.CallOption) (*internalpb.ExportResponse, error)
+	GetExportProgress(ctx context.Context, in *internalpb.GetExportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetExportProgressResponse, error)
+	ListExports(ctx context.Context, in *internalpb.ListExportsRequestInternal, opts ...grpc.CallOption) (*internalpb.ListExportsResponse, error)
+	CancelExport(ctx context.Context, in *internalpb.CancelExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type dataCoordClient struct {
@@ -564,6 +572,42 @@ func (c *dataCoordClient) ListImports(ctx context.Context, in *internalpb.ListIm
 	return out, nil
 }
 
+func (c *dataCoordClient) ExportV2(ctx context.Context, in *internalpb.ExportRequestInternal, opts ...grpc.CallOption) (*internalpb.ExportResponse, error) {
+	out := new(internalpb.ExportResponse)
+	err := c.cc.Invoke(ctx, DataCoord_ExportV2_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetExportProgress(ctx context.Context, in *internalpb.GetExportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetExportProgressResponse, error) {
+	out := new(internalpb.GetExportProgressResponse)
+	err := c.cc.Invoke(ctx, DataCoord_GetExportProgress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ListExports(ctx context.Context, in *internalpb.ListExportsRequestInternal, opts ...grpc.CallOption) (*internalpb.ListExportsResponse, error) {
+	out := new(internalpb.ListExportsResponse)
+	err := c.cc.Invoke(ctx, DataCoord_ListExports_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) CancelExport(ctx context.Context, in *internalpb.CancelExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, DataCoord_CancelExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataCoordServer is the server API for DataCoord service.
 // All implementations should embed UnimplementedDataCoordServer
 // for forward compatibility
@@ -622,6 +666,10 @@ type DataCoordServer interface {
 	ImportV2(context.Context, *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error)
 	GetImportProgress(context.Context, *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error)
 	ListImports(context.Context, *internalpb.ListImportsRequestInternal) (*internalpb.ListImportsResponse, error)
+	ExportV2(context.Context, *internalpb.ExportRequestInternal) (*internalpb.ExportResponse, error)
+	GetExportProgress(context.Context, *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error)
+	ListExports(context.Context, *internalpb.ListExportsRequestInternal) (*internalpb.ListExportsResponse, error)
+	CancelExport(context.Context, *internalpb.CancelExportRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedDataCoordServer should be embedded to have forward compatible implementations.
@@ -769,6 +817,18 @@ func (UnimplementedDataCoordServer) GetImportProgress(context.Context, *internal
 func (UnimplementedDataCoordServer) ListImports(context.Context, *internalpb.ListImportsRequestInternal) (*internalpb.ListImportsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListImports not implemented")
 }
+func (UnimplementedDataCoordServer) ExportV2(context.Context, *internalpb.ExportRequestInternal) (*internalpb.ExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportV2 not implemented")
+}
+func (UnimplementedDataCoordServer) GetExportProgress(context.Context, *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExportProgress not implemented")
+}
+func (UnimplementedDataCoordServer) ListExports(context.Context, *internalpb.ListExportsRequestInternal) (*internalpb.ListExportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExports not implemented")
+}
+func (UnimplementedDataCoordServer) CancelExport(context.Context, *internalpb.CancelExportRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelExport not implemented")
+}
 
 // UnsafeDataCoordServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to DataCoordServer will
@@ -1627,6 +1687,78 @@ func _DataCoord_ListImports_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_ExportV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.ExportRequestInternal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ExportV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_ExportV2_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ExportV2(ctx, req.(*internalpb.ExportRequestInternal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetExportProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.GetExportProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetExportProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_GetExportProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetExportProgress(ctx, req.(*internalpb.GetExportProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_ListExports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.ListExportsRequestInternal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ListExports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_ListExports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ListExports(ctx, req.(*internalpb.ListExportsRequestInternal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_CancelExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.CancelExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).CancelExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_CancelExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).CancelExport(ctx, req.(*internalpb.CancelExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DataCoord_ServiceDesc is the grpc.ServiceDesc for DataCoord service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1822,6 +1954,22 @@ var DataCoord_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListImports",
 			Handler:    _DataCoord_ListImports_Handler,
 		},
+		{
+			MethodName: "ExportV2",
+			Handler:    _DataCoord_ExportV2_Handler,
+		},
+		{
+			MethodName: "GetExportProgress",
+			Handler:    _DataCoord_GetExportProgress_Handler,
+		},
+		{
+			MethodName: "ListExports",
+			Handler:    _DataCoord_ListExports_Handler,
+		},
+		{
+			MethodName: "CancelExport",
+			Handler:    _DataCoord_CancelExport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
@@ -35,6 +35,11 @@ const (
 	Proxy_ImportV2_FullMethodName                      = "/milvus.proto.proxy.Proxy/ImportV2"
 	Proxy_GetImportProgress_FullMethodName             = "/milvus.proto.proxy.Proxy/GetImportProgress"
 	Proxy_ListImports_FullMethodName                   = "/milvus.proto.proxy.Proxy/ListImports"
+	Proxy_ExportV2_FullMethodName                      = "/milvus.proto.proxy.Proxy/ExportV2"
+	Proxy_GetExportProgress_FullMethodName             = "/milvus.proto.proxy.Proxy/GetExportProgress"
+	Proxy_ListExports_FullMethodName                   = "/milvus.proto.proxy.Proxy/ListExports"
+	Proxy_CancelExport_FullMethodName                  = "/milvus.proto.proxy.Proxy/CancelExport"
+	Proxy_Explain_FullMethodName                       = "/milvus.proto.proxy.Proxy/Explain"
 	Proxy_InvalidateShardLeaderCache_FullMethodName    = "/milvus.proto.proxy.Proxy/InvalidateShardLeaderCache"
 	Proxy_GetSegmentsInfo_FullMethodName               = "/milvus.proto.proxy.Proxy/GetSegmentsInfo"
 	Proxy_GetQuotaMetrics_FullMethodName               = "/milvus.proto.proxy.Proxy/GetQuotaMetrics"
@@ -58,6 +63,11 @@ type ProxyClient interface {
 	ImportV2(ctx context.Context, in *internalpb.ImportRequest, opts ...grpc.CallOption) (*internalpb.ImportResponse, error)
 	GetImportProgress(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error)
 	ListImports(ctx context.Context, in *internalpb.ListImportsRequest, opts ...grpc.CallOption) (*internalpb.ListImportsResponse, error)
+	ExportV2(ctx context.Context, in *internalpb.ExportRequest, opts ...grpc.CallOption) (*internalpb.ExportResponse, error)
+	GetExportProgress(ctx context.Context, in *internalpb.GetExportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetExportProgressResponse, error)
+	ListExports(ctx context.Context, in *internalpb.ListExportsRequest, opts ...grpc.CallOption) (*internalpb.ListExportsResponse, error)
+	CancelExport(ctx context.Context, in *internalpb.CancelExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	Explain(ctx context.Context, in *internalpb.ExplainRequest, opts ...grpc.CallOption) (*internalpb.ExplainResponse, error)
 	InvalidateShardLeaderCache(ctx context.Context, in *InvalidateShardLeaderCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetSegmentsInfo(ctx context.Context, in *internalpb.GetSegmentsInfoRequest, opts ...grpc.CallOption) (*internalpb.GetSegmentsInfoResponse, error)
 	GetQuotaMetrics(ctx context.Context, in *internalpb.GetQuotaMetricsRequest, opts ...grpc.CallOption) (*internalpb.GetQuotaMetricsResponse, error)
@@ -188,6 +198,51 @@ func (c *proxyClient) ListImports(ctx context.Context, in *internalpb.ListImport
 	return out, nil
 }
 
+func (c *proxyClient) ExportV2(ctx context.Context, in *internalpb.ExportRequest, opts ...grpc.CallOption) (*internalpb.ExportResponse, error) {
+	out := new(internalpb.ExportResponse)
+	err := c.cc.Invoke(ctx, Proxy_ExportV2_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) GetExportProgress(ctx context.Context, in *internalpb.GetExportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetExportProgressResponse, error) {
+	out := new(internalpb.GetExportProgressResponse)
+	err := c.cc.Invoke(ctx, Proxy_GetExportProgress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) ListExports(ctx context.Context, in *internalpb.ListExportsRequest, opts ...grpc.CallOption) (*internalpb.ListExportsResponse, error) {
+	out := new(internalpb.ListExportsResponse)
+	err := c.cc.Invoke(ctx, Proxy_ListExports_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) CancelExport(ctx context.Context, in *internalpb.CancelExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, Proxy_CancelExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) Explain(ctx context.Context, in *internalpb.ExplainRequest, opts ...grpc.CallOption) (*internalpb.ExplainResponse, error) {
+	out := new(internalpb.ExplainResponse)
+	err := c.cc.Invoke(ctx, Proxy_Explain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *proxyClient) InvalidateShardLeaderCache(ctx context.Context, in *InvalidateShardLeaderCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, Proxy_InvalidateShardLeaderCache_FullMethodName, in, out, opts...)
@@ -233,6 +288,11 @@ type ProxyServer interface {
 	ImportV2(context.Context, *internalpb.ImportRequest) (*internalpb.ImportResponse, error)
 	GetImportProgress(context.Context, *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error)
 	ListImports(context.Context, *internalpb.ListImportsRequest) (*internalpb.ListImportsResponse, error)
+	ExportV2(context.Context, *internalpb.ExportRequest) (*internalpb.ExportResponse, error)
+	GetExportProgress(context.Context, *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error)
+	ListExports(context.Context, *internalpb.ListExportsRequest) (*internalpb.ListExportsResponse, error)
+	CancelExport(context.Context, *internalpb.CancelExportRequest) (*commonpb.Status, error)
+	Explain(context.Context, *internalpb.ExplainRequest) (*internalpb.ExplainResponse, error)
 	InvalidateShardLeaderCache(context.Context, *InvalidateShardLeaderCacheRequest) (*commonpb.Status, error)
 	GetSegmentsInfo(context.Context, *internalpb.GetSegmentsInfoRequest) (*internalpb.GetSegmentsInfoResponse, error)
 	GetQuotaMetrics(context.Context, *internalpb.GetQuotaMetricsRequest) (*internalpb.GetQuotaMetricsResponse, error)
@@ -281,6 +341,21 @@ func (UnimplementedProxyServer) GetImportProgress(context.Context, *internalpb.G
 func (UnimplementedProxyServer) ListImports(context.Context, *internalpb.ListImportsRequest) (*internalpb.ListImportsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListImports not implemented")
 }
+func (UnimplementedProxyServer) ExportV2(context.Context, *internalpb.ExportRequest) (*internalpb.ExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportV2 not implemented")
+}
+func (UnimplementedProxyServer) GetExportProgress(context.Context, *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExportProgress not implemented")
+}
+func (UnimplementedProxyServer) ListExports(context.Context, *internalpb.ListExportsRequest) (*internalpb.ListExportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExports not implemented")
+}
+func (UnimplementedProxyServer) CancelExport(context.Context, *internalpb.CancelExportRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelExport not implemented")
+}
+func (UnimplementedProxyServer) Explain(context.Context, *internalpb.ExplainRequest) (*internalpb.ExplainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Explain not implemented")
+}
 func (UnimplementedProxyServer) InvalidateShardLeaderCache(context.Context, *InvalidateShardLeaderCacheRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvalidateShardLeaderCache not implemented")
 }
@@ -536,6 +611,96 @@ func _Proxy_ListImports_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Proxy_ExportV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).ExportV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_ExportV2_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).ExportV2(ctx, req.(*internalpb.ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_GetExportProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.GetExportProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).GetExportProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_GetExportProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).GetExportProgress(ctx, req.(*internalpb.GetExportProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_ListExports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.ListExportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).ListExports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_ListExports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).ListExports(ctx, req.(*internalpb.ListExportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_CancelExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.CancelExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).CancelExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_CancelExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).CancelExport(ctx, req.(*internalpb.CancelExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_Explain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.ExplainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).Explain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_Explain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).Explain(ctx, req.(*internalpb.ExplainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Proxy_InvalidateShardLeaderCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InvalidateShardLeaderCacheRequest)
 	if err := dec(in); err != nil {
@@ -649,6 +814,26 @@ var Proxy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListImports",
 			Handler:    _Proxy_ListImports_Handler,
 		},
+		{
+			MethodName: "ExportV2",
+			Handler:    _Proxy_ExportV2_Handler,
+		},
+		{
+			MethodName: "GetExportProgress",
+			Handler:    _Proxy_GetExportProgress_Handler,
+		},
+		{
+			MethodName: "ListExports",
+			Handler:    _Proxy_ListExports_Handler,
+		},
+		{
+			MethodName: "CancelExport",
+			Handler:    _Proxy_CancelExport_Handler,
+		},
+		{
+			MethodName: "Explain",
+			Handler:    _Proxy_Explain_Handler,
+		},
 		{
 			MethodName: "InvalidateShardLeaderCache",
 			Handler:    _Proxy_InvalidateShardLeaderCache_Handler,
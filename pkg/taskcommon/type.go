@@ -26,6 +26,7 @@ const (
 	Index      Type = "Index"
 	Stats      Type = "Stats"
 	Analyze    Type = "Analyze"
+	Export     Type = "Export"
 )
 
 var TypeList = []Type{
@@ -35,4 +36,5 @@ var TypeList = []Type{
 	Index,
 	Stats,
 	Analyze,
+	Export,
 }
@@ -65,6 +65,10 @@ const (
 	PrivilegeGroupWord = "PrivilegeGroup"
 	AnyWord            = "*"
 
+	// PrivilegeExport is the privilege to export the data of a collection into the object storage,
+	// it's defined by milvus instead of the ObjectPrivilege enum of milvus-proto.
+	PrivilegeExport = "PrivilegeExport"
+
 	IdentifierKey = "identifier"
 
 	HeaderUserAgent = "user-agent"
@@ -102,6 +106,7 @@ var (
 			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeQuery.String()),
 			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeLoadBalance.String()),
 			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeImport.String()),
+			MetaStore2API(PrivilegeExport),
 
 			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeGetLoadingProgress.String()),
 			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeGetLoadState.String()),
@@ -223,6 +228,7 @@ var (
 		commonpb.ObjectPrivilege_PrivilegeDelete.String(),
 		commonpb.ObjectPrivilege_PrivilegeUpsert.String(),
 		commonpb.ObjectPrivilege_PrivilegeImport.String(),
+		PrivilegeExport,
 		commonpb.ObjectPrivilege_PrivilegeFlush.String(),
 		commonpb.ObjectPrivilege_PrivilegeCompaction.String(),
 		commonpb.ObjectPrivilege_PrivilegeLoadBalance.String(),
@@ -260,6 +266,7 @@ var (
 		commonpb.ObjectPrivilege_PrivilegeDelete.String(),
 		commonpb.ObjectPrivilege_PrivilegeUpsert.String(),
 		commonpb.ObjectPrivilege_PrivilegeImport.String(),
+		PrivilegeExport,
 		commonpb.ObjectPrivilege_PrivilegeFlush.String(),
 		commonpb.ObjectPrivilege_PrivilegeCompaction.String(),
 		commonpb.ObjectPrivilege_PrivilegeLoadBalance.String(),
@@ -313,6 +320,7 @@ var (
 			commonpb.ObjectPrivilege_PrivilegeDelete.String(),
 			commonpb.ObjectPrivilege_PrivilegeUpsert.String(),
 			commonpb.ObjectPrivilege_PrivilegeImport.String(),
+			PrivilegeExport,
 			commonpb.ObjectPrivilege_PrivilegeFlush.String(),
 			commonpb.ObjectPrivilege_PrivilegeCompaction.String(),
 			commonpb.ObjectPrivilege_PrivilegeLoadBalance.String(),
@@ -435,9 +443,14 @@ func MetaStore2API(name string) string {
 	return name
 }
 
-func PrivilegeNameForAPI(name string) string {
+// isBuiltinPrivilege checks if the name is a built-in privilege or privilege group in metastore format.
+func isBuiltinPrivilege(name string) bool {
 	_, ok := commonpb.ObjectPrivilege_value[name]
-	if !ok {
+	return ok || name == PrivilegeExport
+}
+
+func PrivilegeNameForAPI(name string) string {
+	if !isBuiltinPrivilege(name) {
 		if strings.HasPrefix(name, PrivilegeGroupWord) {
 			return typeutil.After(name, PrivilegeGroupWord)
 		}
@@ -449,8 +462,7 @@ func PrivilegeNameForAPI(name string) string {
 func PrivilegeNameForMetastore(name string) string {
 	// check if name is single privilege
 	dbPrivilege := PrivilegeWord + name
-	_, ok := commonpb.ObjectPrivilege_value[dbPrivilege]
-	if !ok {
+	if !isBuiltinPrivilege(dbPrivilege) {
 		// check if name is privilege group
 		dbPrivilege := PrivilegeGroupWord + name
 		_, ok := commonpb.ObjectPrivilege_value[dbPrivilege]
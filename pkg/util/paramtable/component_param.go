@@ -1532,6 +1532,12 @@ type AccessLogConfig struct {
 	RemotePath    ParamItem  `refreshable:"false"`
 	RemoteMaxTime ParamItem  `refreshable:"false"`
 	Formatter     ParamGroup `refreshable:"false"`
+	Sinks         ParamGroup `refreshable:"false"`
+
+	SampleRatio      ParamItem  `refreshable:"false"`
+	AlwaysLogOnError ParamItem  `refreshable:"false"`
+	SamplingRules    ParamGroup `refreshable:"false"`
+	RedactionRules   ParamGroup `refreshable:"false"`
 
 	CacheSize          ParamItem `refreshable:"false"`
 	CacheFlushInterval ParamItem `refreshable:"false"`
@@ -1882,6 +1888,51 @@ please adjust in embedded Milvus: false`,
 	}
 	p.AccessLog.Formatter.Init(base.mgr)
 
+	p.AccessLog.Sinks = ParamGroup{
+		KeyPrefix: "proxy.accessLog.sinks.",
+		Version:   "2.6.0",
+		Doc: `Extra sinks exporting access logs over http, in addition to the local file or stdout.
+Each sink is configured by <name>.type(jsonl or otlp), <name>.url, <name>.methods(all methods if empty),
+<name>.formatter(use the formatter of the method if empty) and <name>.cacheSize.
+Logs are batched by a write cache of cacheSize bytes(proxy.accessLog.cacheSize or 1MB if not set) flushed every cacheFlushInterval,
+each flush is exported in one request and the logs failed to export are dropped.`,
+	}
+	p.AccessLog.Sinks.Init(base.mgr)
+
+	p.AccessLog.SampleRatio = ParamItem{
+		Key:          "proxy.accessLog.sampling.ratio",
+		Version:      "2.6.0",
+		DefaultValue: "1",
+		Doc:          "The ratio of requests to write access log, in range [0, 1]. Used when no sampling rule matches the request.",
+		Export:       true,
+	}
+	p.AccessLog.SampleRatio.Init(base.mgr)
+
+	p.AccessLog.AlwaysLogOnError = ParamItem{
+		Key:          "proxy.accessLog.sampling.alwaysLogOnError",
+		Version:      "2.6.0",
+		DefaultValue: "true",
+		Doc:          "Whether to always write access log of failed requests regardless of sampling ratio.",
+		Export:       true,
+	}
+	p.AccessLog.AlwaysLogOnError.Init(base.mgr)
+
+	p.AccessLog.SamplingRules = ParamGroup{
+		KeyPrefix: "proxy.accessLog.sampling.rules.",
+		Version:   "2.6.0",
+		Doc: `Sampling ratio for specified databases and methods, configured by <name>.ratio, <name>.databases and <name>.methods.
+The most specific matched rule is used, rules with methods take precedence over rules with only databases.`,
+	}
+	p.AccessLog.SamplingRules.Init(base.mgr)
+
+	p.AccessLog.RedactionRules = ParamGroup{
+		KeyPrefix: "proxy.accessLog.redaction.",
+		Version:   "2.6.0",
+		Doc: `Redaction of access log fields, configured by <name>.fields, <name>.action(hash or mask), <name>.databases and <name>.methods.
+Supported fields: user_name, user_addr, database_name, collection_name, partition_name, method_expr, output_fields, search_params, query_params.`,
+	}
+	p.AccessLog.RedactionRules.Init(base.mgr)
+
 	p.ShardLeaderCacheInterval = ParamItem{
 		Key:          "proxy.shardLeaderCacheInterval",
 		Version:      "2.2.4",
@@ -2947,9 +2998,14 @@ type queryNodeConfig struct {
 	IDFWriteConcurrenct ParamItem `refreshable:"true"`
 	// partial search
 	PartialResultRequiredDataRatio ParamItem `refreshable:"true"`
+
+	// local disk cache of the remote objects
+	DiskCache diskCacheConfig
 }
 
 func (p *queryNodeConfig) init(base *BaseTable) {
+	p.DiskCache.init("queryNode", base)
+
 	p.IDFEnableDisk = ParamItem{
 		Key:          "queryNode.idfOracle.enableDisk",
 		Version:      "2.6.0",
@@ -4064,12 +4120,23 @@ type dataCoordConfig struct {
 	ImportCheckIntervalHigh         ParamItem `refreshable:"true"`
 	ImportCheckIntervalLow          ParamItem `refreshable:"true"`
 	MaxFilesPerImportReq            ParamItem `refreshable:"true"`
+	MaxExpandedFilesPerImportReq    ParamItem `refreshable:"true"`
+	MaxExpandedSizeInGBPerImportReq ParamItem `refreshable:"true"`
 	MaxImportJobNum                 ParamItem `refreshable:"true"`
+	MaxConcurrentImportTaskNumPerDB ParamItem `refreshable:"true"`
 	WaitForIndex                    ParamItem `refreshable:"true"`
 	ImportPreAllocIDExpansionFactor ParamItem `refreshable:"true"`
 	ImportFileNumPerSlot            ParamItem `refreshable:"true"`
 	ImportMemoryLimitPerSlot        ParamItem `refreshable:"true"`
 
+	// export
+	ExportTaskRetention        ParamItem `refreshable:"true"`
+	ExportCheckInterval        ParamItem `refreshable:"true"`
+	MaxSegmentNumPerExportTask ParamItem `refreshable:"true"`
+	MaxExportJobNum            ParamItem `refreshable:"true"`
+	ExportRootPath             ParamItem `refreshable:"true"`
+	ExportMaxFileSize          ParamItem `refreshable:"true"`
+
 	GracefulStopTimeout ParamItem `refreshable:"true"`
 
 	ClusteringCompactionSlotUsage ParamItem `refreshable:"true"`
@@ -4967,6 +5034,26 @@ if param targetVecIndexVersion is not set, the default value is -1, which means
 	}
 	p.MaxFilesPerImportReq.Init(base.mgr)
 
+	p.MaxExpandedFilesPerImportReq = ParamItem{
+		Key:          "dataCoord.import.maxExpandedFileNumPerReq",
+		Version:      "2.6.0",
+		Doc:          "The maximum number of files expanded from the prefixes and glob patterns per single import request.",
+		DefaultValue: "10000",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.MaxExpandedFilesPerImportReq.Init(base.mgr)
+
+	p.MaxExpandedSizeInGBPerImportReq = ParamItem{
+		Key:          "dataCoord.import.maxExpandedSizeInGBPerReq",
+		Version:      "2.6.0",
+		Doc:          "The maximum total size (in GB) of files expanded from the prefixes and glob patterns per single import request.",
+		DefaultValue: "1024",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.MaxExpandedSizeInGBPerImportReq.Init(base.mgr)
+
 	p.MaxImportJobNum = ParamItem{
 		Key:          "dataCoord.import.maxImportJobNum",
 		Version:      "2.4.14",
@@ -4977,6 +5064,16 @@ if param targetVecIndexVersion is not set, the default value is -1, which means
 	}
 	p.MaxImportJobNum.Init(base.mgr)
 
+	p.MaxConcurrentImportTaskNumPerDB = ParamItem{
+		Key:          "dataCoord.import.maxConcurrentTaskNumPerDB",
+		Version:      "2.6.0",
+		Doc:          "The maximum number of running pre-import/import tasks per database, 0 means no limit.",
+		DefaultValue: "0",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.MaxConcurrentImportTaskNumPerDB.Init(base.mgr)
+
 	p.WaitForIndex = ParamItem{
 		Key:          "dataCoord.import.waitForIndex",
 		Version:      "2.4.0",
@@ -5019,6 +5116,72 @@ if param targetVecIndexVersion is not set, the default value is -1, which means
 	}
 	p.ImportMemoryLimitPerSlot.Init(base.mgr)
 
+	p.ExportTaskRetention = ParamItem{
+		Key:          "dataCoord.export.taskRetention",
+		Version:      "2.6.0",
+		Doc:          "The retention period in seconds for export jobs in the Completed or Failed state.",
+		DefaultValue: "10800",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.ExportTaskRetention.Init(base.mgr)
+
+	p.ExportCheckInterval = ParamItem{
+		Key:          "dataCoord.export.checkInterval",
+		Version:      "2.6.0",
+		Doc:          "The interval for checking export jobs, measured in seconds.",
+		DefaultValue: "2",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.ExportCheckInterval.Init(base.mgr)
+
+	p.MaxSegmentNumPerExportTask = ParamItem{
+		Key:          "dataCoord.export.maxSegmentNumPerTask",
+		Version:      "2.6.0",
+		Doc:          "The maximum number of segments exported by a single export task.",
+		DefaultValue: "16",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.MaxSegmentNumPerExportTask.Init(base.mgr)
+
+	p.MaxExportJobNum = ParamItem{
+		Key:          "dataCoord.export.maxExportJobNum",
+		Version:      "2.6.0",
+		Doc:          "Maximum number of export jobs that are executing or pending.",
+		DefaultValue: "64",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.MaxExportJobNum.Init(base.mgr)
+
+	p.ExportRootPath = ParamItem{
+		Key:     "dataCoord.export.rootPath",
+		Version: "2.6.0",
+		Doc: `The root path of the export files, relative to minio.rootPath.
+The output path of an export request is a relative path under it, absolute paths and ".." are rejected.`,
+		DefaultValue: "export",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.ExportRootPath.Init(base.mgr)
+
+	p.ExportMaxFileSize = ParamItem{
+		Key:     "dataCoord.export.maxFileSizeInMB",
+		Version: "2.6.0",
+		Doc: `The maximum size (in MB) of an export file, which is also the default of the export option max_file_size.
+The export file is buffered in the memory of dataNode before it's uploaded.`,
+		DefaultValue: "256",
+		Formatter: func(v string) string {
+			fileSize := getAsFloat(v)
+			return fmt.Sprintf("%d", int64(megaBytes2Bytes(fileSize)))
+		},
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.ExportMaxFileSize.Init(base.mgr)
+
 	p.GracefulStopTimeout = ParamItem{
 		Key:          "dataCoord.gracefulStopTimeout",
 		Version:      "2.3.7",
@@ -5238,6 +5401,12 @@ type dataNodeConfig struct {
 	ImportDeleteBufferSize      ParamItem `refreshable:"true"`
 	ImportMemoryLimitPercentage ParamItem `refreshable:"true"`
 
+	// export
+	ExportBufferSize ParamItem `refreshable:"true"`
+
+	// local disk cache of the remote objects
+	DiskCache diskCacheConfig
+
 	// Compaction
 	L0BatchMemoryRatio       ParamItem `refreshable:"true"`
 	L0CompactionMaxBatchSize ParamItem `refreshable:"true"`
@@ -5588,6 +5757,22 @@ if this parameter <= 0, will set it as 10`,
 	}
 	p.ImportMemoryLimitPercentage.Init(base.mgr)
 
+	p.ExportBufferSize = ParamItem{
+		Key:          "dataNode.export.bufferSizeInMB",
+		Version:      "2.6.0",
+		Doc:          "The buffer size (in MB) of rows written as a row group of the parquet file during export.",
+		DefaultValue: "64",
+		Formatter: func(v string) string {
+			bufferSize := getAsFloat(v)
+			return fmt.Sprintf("%d", int(megaBytes2Bytes(bufferSize)))
+		},
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.ExportBufferSize.Init(base.mgr)
+
+	p.DiskCache.init("dataNode", base)
+
 	p.L0BatchMemoryRatio = ParamItem{
 		Key:          "dataNode.compaction.levelZeroBatchMemoryRatio",
 		Version:      "2.4.0",
@@ -501,6 +501,15 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 1.0, Params.PartialResultRequiredDataRatio.GetAsFloat())
 		params.Save(Params.PartialResultRequiredDataRatio.Key, "0.8")
 		assert.Equal(t, 0.8, Params.PartialResultRequiredDataRatio.GetAsFloat())
+
+		assert.False(t, Params.DiskCache.Enabled.GetAsBool())
+		assert.Equal(t, int64(10240*1024*1024), Params.DiskCache.Capacity.GetAsInt64())
+		assert.Equal(t, int64(256*1024*1024), Params.DiskCache.MaxObjectSize.GetAsInt64())
+		assert.Empty(t, Params.DiskCache.Prefixes.GetAsStrings())
+		params.Save("queryNode.diskCache.capacityInMB", "1")
+		params.Save("queryNode.diskCache.prefixes", "stats_log,delta_log")
+		assert.Equal(t, int64(1024*1024), Params.DiskCache.Capacity.GetAsInt64())
+		assert.Equal(t, []string{"stats_log", "delta_log"}, Params.DiskCache.Prefixes.GetAsStrings())
 	})
 
 	t.Run("test dataCoordConfig", func(t *testing.T) {
@@ -521,10 +530,19 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 2*time.Second, Params.ImportCheckIntervalHigh.GetAsDuration(time.Second))
 		assert.Equal(t, 120*time.Second, Params.ImportCheckIntervalLow.GetAsDuration(time.Second))
 		assert.Equal(t, 1024, Params.MaxFilesPerImportReq.GetAsInt())
+		assert.Equal(t, 10000, Params.MaxExpandedFilesPerImportReq.GetAsInt())
+		assert.Equal(t, 1024, Params.MaxExpandedSizeInGBPerImportReq.GetAsInt())
 		assert.Equal(t, 1024, Params.MaxImportJobNum.GetAsInt())
+		assert.Equal(t, 0, Params.MaxConcurrentImportTaskNumPerDB.GetAsInt())
 		assert.Equal(t, true, Params.WaitForIndex.GetAsBool())
 		assert.Equal(t, 1, Params.ImportFileNumPerSlot.GetAsInt())
 		assert.Equal(t, 160*1024*1024, Params.ImportMemoryLimitPerSlot.GetAsInt())
+		assert.Equal(t, 10800*time.Second, Params.ExportTaskRetention.GetAsDuration(time.Second))
+		assert.Equal(t, 2*time.Second, Params.ExportCheckInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 16, Params.MaxSegmentNumPerExportTask.GetAsInt())
+		assert.Equal(t, 64, Params.MaxExportJobNum.GetAsInt())
+		assert.Equal(t, "export", Params.ExportRootPath.GetValue())
+		assert.Equal(t, int64(256*1024*1024), Params.ExportMaxFileSize.GetAsInt64())
 
 		params.Save("datacoord.gracefulStopTimeout", "100")
 		assert.Equal(t, 100*time.Second, Params.GracefulStopTimeout.GetAsDuration(time.Second))
@@ -622,6 +640,8 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 4, Params.ImportConcurrencyPerCPUCore.GetAsInt())
 		assert.Equal(t, int64(16), Params.MaxImportFileSizeInGB.GetAsInt64())
 		assert.Equal(t, 16*1024*1024, Params.ImportBaseBufferSize.GetAsInt())
+		assert.Equal(t, 64*1024*1024, Params.ExportBufferSize.GetAsInt())
+		assert.Equal(t, "dataNode.diskCache.enabled", Params.DiskCache.Enabled.Key)
 		assert.Equal(t, 16*1024*1024, Params.ImportDeleteBufferSize.GetAsInt())
 		assert.Equal(t, 10.0, Params.ImportMemoryLimitPercentage.GetAsFloat())
 		params.Save("datanode.gracefulStopTimeout", "100")
@@ -0,0 +1,86 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paramtable
+
+import "fmt"
+
+// diskCachedReads describes the reads of each role which go through the disk cache,
+// the objects loaded by segcore, such as the insert logs and index files of queryNode, are never cached.
+var diskCachedReads = map[string]string{
+	"queryNode": "e.g. stats_log,bm25_stats,delta_log. Only the stats logs and delta logs of segments loaded by queryNode are read through the cache, " +
+		"the insert logs and index files are loaded by segcore and never cached",
+	"dataNode": "e.g. insert_log,delta_log,stats_log. Only the binlogs read by compaction and sort tasks and the import files are read through the cache, " +
+		"the index files are read by segcore when building index and never cached",
+}
+
+// diskCacheConfig is the config of the read-through local disk cache of the remote objects,
+// each role owns a cache configured under its own domain, e.g. queryNode.diskCache.
+type diskCacheConfig struct {
+	Domain        string    `refreshable:"false"`
+	Enabled       ParamItem `refreshable:"false"`
+	Capacity      ParamItem `refreshable:"false"`
+	MaxObjectSize ParamItem `refreshable:"false"`
+	Prefixes      ParamItem `refreshable:"false"`
+}
+
+func (p *diskCacheConfig) init(domain string, base *BaseTable) {
+	p.Domain = domain
+
+	p.Enabled = ParamItem{
+		Key:          p.Domain + ".diskCache.enabled",
+		Version:      "2.6.0",
+		DefaultValue: "false",
+		Doc: "Whether to cache the objects read from the remote storage on the local disk of " + p.Domain +
+			", the cache files are placed under localStorage.path and cleared on start",
+		Export: true,
+	}
+	p.Enabled.Init(base.mgr)
+
+	p.Capacity = ParamItem{
+		Key:          p.Domain + ".diskCache.capacityInMB",
+		Version:      "2.6.0",
+		DefaultValue: "10240",
+		Doc:          "The max size (in MB) of the objects cached on the local disk, the least recently used objects are evicted once it's exceeded",
+		Formatter: func(v string) string {
+			return fmt.Sprintf("%d", int64(megaBytes2Bytes(getAsFloat(v))))
+		},
+		Export: true,
+	}
+	p.Capacity.Init(base.mgr)
+
+	p.MaxObjectSize = ParamItem{
+		Key:          p.Domain + ".diskCache.maxObjectSizeInMB",
+		Version:      "2.6.0",
+		DefaultValue: "256",
+		Doc:          "The objects larger than it (in MB) are never cached",
+		Formatter: func(v string) string {
+			return fmt.Sprintf("%d", int64(megaBytes2Bytes(getAsFloat(v))))
+		},
+		Export: true,
+	}
+	p.MaxObjectSize.Init(base.mgr)
+
+	p.Prefixes = ParamItem{
+		Key:          p.Domain + ".diskCache.prefixes",
+		Version:      "2.6.0",
+		DefaultValue: "",
+		Doc: "Comma separated path prefixes relative to the root path of the remote storage, only the objects under them are cached, " +
+			"all the objects read through the cache are cached if empty, " + diskCachedReads[p.Domain],
+		Export: true,
+	}
+	p.Prefixes.Init(base.mgr)
+}
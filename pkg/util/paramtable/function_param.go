@@ -23,6 +23,11 @@ import (
 type functionConfig struct {
 	TextEmbeddingProviders ParamGroup `refreshable:"true"`
 	RerankModelProviders   ParamGroup `refreshable:"true"`
+
+	EmbeddingCacheEnabled      ParamItem `refreshable:"false"`
+	EmbeddingCacheCapacity     ParamItem `refreshable:"false"`
+	EmbeddingCacheDiskPath     ParamItem `refreshable:"false"`
+	EmbeddingCacheDiskCapacity ParamItem `refreshable:"false"`
 }
 
 func (p *functionConfig) init(base *BaseTable) {
@@ -68,6 +73,16 @@ func (p *functionConfig) init(base *BaseTable) {
 				return "Your VertexAI embedding url"
 			case "vertexai.credential":
 				return "The name in the crendential configuration item"
+			case "onnx.enable":
+				return "Whether to enable in-process onnx model inference, which requires milvus built with `make USE_ONNX=ON`"
+			case "onnx.model_dir":
+				return "Local directory of onnx models, each model is a sub directory containing model.onnx and vocab.txt"
+			case "onnx.remote_model_dir":
+				return "Path of onnx models in the object storage configured by minio, models missing in model_dir are downloaded from it"
+			case "onnx.max_workers":
+				return "Max number of concurrent inference tasks of all onnx models"
+			case "onnx.intra_op_threads":
+				return "Number of threads used by each onnx inference task"
 			default:
 				return ""
 			}
@@ -85,12 +100,52 @@ func (p *functionConfig) init(base *BaseTable) {
 				return "Whether to enable TEI rerank service"
 			case "vllm.enable":
 				return "Whether to enable vllm rerank service"
+			case "cohere.credential", "voyageai.credential", "jina.credential", "siliconflow.credential":
+				return "The name in the crendential configuration item"
+			case "cohere.url", "voyageai.url", "jina.url", "siliconflow.url":
+				return "Your rerank service url, Default is the official rerank url"
 			default:
 				return ""
 			}
 		},
 	}
 	p.RerankModelProviders.Init(base.mgr)
+
+	p.EmbeddingCacheEnabled = ParamItem{
+		Key:          "function.textEmbedding.cache.enabled",
+		Version:      "2.6.0",
+		DefaultValue: "false",
+		Doc:          "Whether to cache the results of text embedding functions, the cache is shared by insert and search",
+		Export:       true,
+	}
+	p.EmbeddingCacheEnabled.Init(base.mgr)
+
+	p.EmbeddingCacheCapacity = ParamItem{
+		Key:          "function.textEmbedding.cache.capacity",
+		Version:      "2.6.0",
+		DefaultValue: "100000",
+		Doc:          "Max number of embeddings kept in memory",
+		Export:       true,
+	}
+	p.EmbeddingCacheCapacity.Init(base.mgr)
+
+	p.EmbeddingCacheDiskPath = ParamItem{
+		Key:          "function.textEmbedding.cache.diskPath",
+		Version:      "2.6.0",
+		DefaultValue: "",
+		Doc:          "Local directory to spill embeddings evicted from memory, disk spill is disabled if empty. The embeddings are stored under the embedding_cache sub directory, which is cleaned at startup",
+		Export:       true,
+	}
+	p.EmbeddingCacheDiskPath.Init(base.mgr)
+
+	p.EmbeddingCacheDiskCapacity = ParamItem{
+		Key:          "function.textEmbedding.cache.diskCapacity",
+		Version:      "2.6.0",
+		DefaultValue: "1000000",
+		Doc:          "Max number of embeddings kept on disk",
+		Export:       true,
+	}
+	p.EmbeddingCacheDiskCapacity.Init(base.mgr)
 }
 
 const (
@@ -137,6 +137,7 @@ type quotaConfig struct {
 	MaxInsertSize                  ParamItem `refreshable:"true"`
 	MaxResourceGroupNumOfQueryNode ParamItem `refreshable:"true"`
 	MaxGroupSize                   ParamItem `refreshable:"true"`
+	MaxAggregationGroupNum         ParamItem `refreshable:"true"`
 
 	// limit writing
 	ForceDenyWriting                      ParamItem `refreshable:"true"`
@@ -1670,6 +1671,15 @@ Check https://milvus.io/docs/limitations.md for more details.`,
 	}
 	p.MaxGroupSize.Init(base.mgr)
 
+	p.MaxAggregationGroupNum = ParamItem{
+		Key:          "quotaAndLimits.limits.maxAggregationGroupNum",
+		Version:      "2.6.0",
+		Doc:          `maximum number of groups when doing query group by aggregation`,
+		DefaultValue: "100000",
+		Export:       true,
+	}
+	p.MaxAggregationGroupNum.Init(base.mgr)
+
 	// limit writing
 	p.ForceDenyWriting = ParamItem{
 		Key:          "quotaAndLimits.limitWriting.forceDeny",